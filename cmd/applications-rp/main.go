@@ -30,6 +30,7 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/builder"
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
 	metricsservice "github.com/radius-project/radius/pkg/metrics/service"
+	pr_ctrl "github.com/radius-project/radius/pkg/portableresources/backend/controller"
 	profilerservice "github.com/radius-project/radius/pkg/profiler/service"
 	"github.com/radius-project/radius/pkg/recipes/controllerconfig"
 	"github.com/radius-project/radius/pkg/server"
@@ -112,17 +113,23 @@ func main() {
 		hostingSvc = append(hostingSvc, data.NewEmbeddedETCDService(data.EmbeddedETCDServiceOptions{ClientConfigSink: client}))
 	}
 
-	builders, err := builders(options)
+	recipeControllerConfig, err := controllerconfig.New(options)
 	if err != nil {
 		log.Fatal(err) //nolint:forbidigo // this is OK inside the main function.
 	}
 
+	builders := builders(recipeControllerConfig)
+
 	hostingSvc = append(
 		hostingSvc,
 		server.NewAPIService(options, builders),
 		server.NewAsyncWorker(options, builders),
 	)
 
+	if options.Config.DriftDetection != nil && options.Config.DriftDetection.Enabled {
+		hostingSvc = append(hostingSvc, server.NewDriftDetector(options, driftDetectionControllers(recipeControllerConfig)))
+	}
+
 	tracerOpts := options.Config.TracerProvider
 	tracerOpts.ServiceName = serviceName
 	hostingSvc = append(hostingSvc, &trace.Service{Options: tracerOpts})
@@ -144,17 +151,27 @@ func main() {
 	}
 }
 
-func builders(options hostoptions.HostOptions) ([]builder.Builder, error) {
-	config, err := controllerconfig.New(options)
-	if err != nil {
-		return nil, err
-	}
-
+func builders(config *controllerconfig.RecipeControllerConfig) []builder.Builder {
 	return []builder.Builder{
 		corerp_setup.SetupNamespace(config).GenerateBuilder(),
 		daprrp_setup.SetupNamespace(config).GenerateBuilder(),
 		msgrp_setup.SetupNamespace(config).GenerateBuilder(),
 		dsrp_setup.SetupNamespace(config).GenerateBuilder(),
 		// Add resource provider builders...
-	}, nil
+	}
+}
+
+func driftDetectionControllers(config *controllerconfig.RecipeControllerConfig) map[string]pr_ctrl.DriftControllerFactoryFunc {
+	controllers := map[string]pr_ctrl.DriftControllerFactoryFunc{}
+	for _, c := range []map[string]pr_ctrl.DriftControllerFactoryFunc{
+		daprrp_setup.SetupDriftDetection(config),
+		msgrp_setup.SetupDriftDetection(config),
+		dsrp_setup.SetupDriftDetection(config),
+	} {
+		for resourceType, factory := range c {
+			controllers[resourceType] = factory
+		}
+	}
+
+	return controllers
 }
@@ -38,6 +38,7 @@ import (
 	app_status "github.com/radius-project/radius/pkg/cli/cmd/app/status"
 	bicep_publish "github.com/radius-project/radius/pkg/cli/cmd/bicep/publish"
 	credential "github.com/radius-project/radius/pkg/cli/cmd/credential"
+	"github.com/radius-project/radius/pkg/cli/cmd/debug"
 	cmd_deploy "github.com/radius-project/radius/pkg/cli/cmd/deploy"
 	env_create "github.com/radius-project/radius/pkg/cli/cmd/env/create"
 	env_delete "github.com/radius-project/radius/pkg/cli/cmd/env/delete"
@@ -51,10 +52,14 @@ import (
 	install_kubernetes "github.com/radius-project/radius/pkg/cli/cmd/install/kubernetes"
 	"github.com/radius-project/radius/pkg/cli/cmd/radinit"
 	recipe_list "github.com/radius-project/radius/pkg/cli/cmd/recipe/list"
+	recipe_plan "github.com/radius-project/radius/pkg/cli/cmd/recipe/plan"
 	recipe_register "github.com/radius-project/radius/pkg/cli/cmd/recipe/register"
 	recipe_show "github.com/radius-project/radius/pkg/cli/cmd/recipe/show"
+	recipe_test "github.com/radius-project/radius/pkg/cli/cmd/recipe/test"
 	recipe_unregister "github.com/radius-project/radius/pkg/cli/cmd/recipe/unregister"
+	resource_cancel "github.com/radius-project/radius/pkg/cli/cmd/resource/cancel"
 	resource_delete "github.com/radius-project/radius/pkg/cli/cmd/resource/delete"
+	resource_history "github.com/radius-project/radius/pkg/cli/cmd/resource/history"
 	resource_list "github.com/radius-project/radius/pkg/cli/cmd/resource/list"
 	resource_show "github.com/radius-project/radius/pkg/cli/cmd/resource/show"
 	"github.com/radius-project/radius/pkg/cli/cmd/run"
@@ -221,15 +226,27 @@ func initSubCommands() {
 	deleteCmd, _ := resource_delete.NewCommand(framework)
 	resourceCmd.AddCommand(deleteCmd)
 
+	cancelCmd, _ := resource_cancel.NewCommand(framework)
+	resourceCmd.AddCommand(cancelCmd)
+
+	historyCmd, _ := resource_history.NewCommand(framework)
+	resourceCmd.AddCommand(historyCmd)
+
 	listRecipeCmd, _ := recipe_list.NewCommand(framework)
 	recipeCmd.AddCommand(listRecipeCmd)
 
+	planRecipeCmd, _ := recipe_plan.NewCommand(framework)
+	recipeCmd.AddCommand(planRecipeCmd)
+
 	registerRecipeCmd, _ := recipe_register.NewCommand(framework)
 	recipeCmd.AddCommand(registerRecipeCmd)
 
 	showRecipeCmd, _ := recipe_show.NewCommand(framework)
 	recipeCmd.AddCommand(showRecipeCmd)
 
+	testRecipeCmd, _ := recipe_test.NewCommand(framework)
+	recipeCmd.AddCommand(testRecipeCmd)
+
 	unregisterRecipeCmd, _ := recipe_unregister.NewCommand(framework)
 	recipeCmd.AddCommand(unregisterRecipeCmd)
 
@@ -239,6 +256,9 @@ func initSubCommands() {
 	groupCmd := group.NewCommand(framework)
 	RootCmd.AddCommand(groupCmd)
 
+	debugCmd := debug.NewCommand(framework)
+	RootCmd.AddCommand(debugCmd)
+
 	initCmd, _ := radinit.NewCommand(framework)
 	RootCmd.AddCommand(initCmd)
 
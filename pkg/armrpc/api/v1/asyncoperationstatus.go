@@ -39,4 +39,22 @@ type AsyncOperationStatus struct {
 
 	// Error represents the error occurred during provisioning.
 	Error *ErrorDetails `json:"error,omitempty"`
+
+	// Attempts represents the failed attempts of the async operation which were retried.
+	Attempts []OperationAttempt `json:"attempts,omitempty"`
+}
+
+// OperationAttempt represents a failed attempt of an async operation which was retried.
+type OperationAttempt struct {
+	// Attempt is the number of the attempt, starting from 1.
+	Attempt int `json:"attempt"`
+
+	// EndTime represents the time when the attempt failed.
+	EndTime time.Time `json:"endTime"`
+
+	// Error represents the error which caused the attempt to fail.
+	Error *ErrorDetails `json:"error,omitempty"`
+
+	// NextAttemptTime represents the time when the operation is retried.
+	NextAttemptTime time.Time `json:"nextAttemptTime"`
 }
@@ -0,0 +1,61 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"time"
+)
+
+// OperationHistoryEntry represents an entry of the operation history of a resource. An entry is recorded for each
+// asynchronous operation on the resource and is kept after the operation status expires or the resource is deleted.
+type OperationHistoryEntry struct {
+	// ID represents the id of the operation history entry.
+	ID string `json:"id,omitempty"`
+
+	// Name represents the name of the operation history entry and is set to the async operation id.
+	Name string `json:"name,omitempty"`
+
+	// Type represents the type of the operation history entry.
+	Type string `json:"type,omitempty"`
+
+	// ResourceID represents the id of the resource on which the operation was run.
+	ResourceID string `json:"resourceID,omitempty"`
+
+	// OperationType represents the type of the operation such as "APPLICATIONS.CORE/CONTAINERS|PUT".
+	OperationType string `json:"operationType,omitempty"`
+
+	// Status represents the latest known state of the operation.
+	Status ProvisioningState `json:"status,omitempty"`
+
+	// StartTime represents the operation start time.
+	StartTime time.Time `json:"startTime,omitempty"`
+
+	// EndTime represents the operation end time.
+	EndTime *time.Time `json:"endTime,omitempty"`
+
+	// Error represents the error that caused the operation to fail.
+	Error *ErrorDetails `json:"error,omitempty"`
+
+	// HomeTenantID is the caller's home tenant id such as the value from x-ms-home-tenant-id header.
+	HomeTenantID string `json:"homeTenantID,omitempty"`
+
+	// ClientObjectID is the caller's client id such as the value from x-ms-client-object-id header.
+	ClientObjectID string `json:"clientObjectID,omitempty"`
+
+	// Traceparent is the W3C trace parent of the request which started the operation.
+	Traceparent string `json:"traceparent,omitempty"`
+}
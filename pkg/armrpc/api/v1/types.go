@@ -105,6 +105,9 @@ const (
 	// OperationProxy is used for controllers that proxy the underlying request without classifying the type of operation.
 	OperationProxy OperationMethod = "PROXY"
 
+	// OperationCancel is used for the custom action to cancel an in-flight async operation.
+	OperationCancel OperationMethod = "CANCEL"
+
 	Separator = "|"
 )
 
@@ -56,6 +56,16 @@ type Request struct {
 
 	// OperationTimeout represents the timeout duration of async operation.
 	OperationTimeout *time.Duration `json:"asyncOperationTimeout"`
+
+	// RetryCount represents the number of times the operation was retried by the retry policy after it failed.
+	RetryCount int `json:"retryCount,omitempty"`
+
+	// Deadline represents the time by which the operation must complete. It's set when the operation starts running so
+	// that the operation timeout is enforced across the runs of an operation that is requeued with RequeueAfter.
+	Deadline *time.Time `json:"deadline,omitempty"`
+	// RedeliveryCount represents the number of times the messages of the operation were redelivered after their lock
+	// expired, before the operation was requeued with RequeueAfter.
+	RedeliveryCount int `json:"redeliveryCount,omitempty"`
 }
 
 // Timeout gets the operation timeout and returns the default timeout unless it specifies.
@@ -66,6 +76,12 @@ func (r *Request) Timeout() time.Duration {
 	return *r.OperationTimeout
 }
 
+// Attempt returns the attempt number of the operation for the message with the given dequeue count, including the
+// retries by the retry policy and the redeliveries of the previous messages of the operation.
+func (r *Request) Attempt(dequeueCount int) int {
+	return r.RetryCount + r.RedeliveryCount + dequeueCount
+}
+
 // ARMRequestContext creates v1.ARMRequestContext object from async operation request. It returns error if the given resource id is invalid.
 func (r *Request) ARMRequestContext() (*v1.ARMRequestContext, error) {
 	rID, err := resources.Parse(r.ResourceID)
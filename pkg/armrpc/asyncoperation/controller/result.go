@@ -17,6 +17,8 @@ limitations under the License.
 package controller
 
 import (
+	"time"
+
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 )
 
@@ -25,6 +27,10 @@ type Result struct {
 	// Requeue tells the Controller to requeue the reconcile key. Defaults to false.
 	Requeue bool
 
+	// RequeueAfter tells the worker to run the operation again after the given duration without completing
+	// the operation. This is used by polling-style operations so that they do not hold worker slots while waiting.
+	RequeueAfter time.Duration
+
 	// Error represents the error when status is Cancelled or Failed.
 	Error *v1.ErrorDetails
 
@@ -39,6 +45,11 @@ func NewCanceledResult(message string) Result {
 	return r
 }
 
+// NewRequeueAfterResult creates a new Result object which asks the worker to run the operation again after the given duration.
+func NewRequeueAfterResult(after time.Duration) Result {
+	return Result{RequeueAfter: after}
+}
+
 // NewFailedResult creates a new Result object with the given error details and sets the failed flag to true.
 func NewFailedResult(err v1.ErrorDetails) Result {
 	r := Result{}
@@ -52,6 +63,7 @@ func (r *Result) SetFailed(err v1.ErrorDetails, requeue bool) {
 		r = &Result{}
 	}
 	r.Requeue = requeue
+	r.RequeueAfter = 0
 	r.SetProvisioningState(v1.ProvisioningStateFailed)
 	r.Error = &err
 }
@@ -63,6 +75,7 @@ func (r *Result) SetCanceled(message string) {
 		r = &Result{}
 	}
 	r.Requeue = false
+	r.RequeueAfter = 0
 	r.SetProvisioningState(v1.ProvisioningStateCanceled)
 	r.Error = &v1.ErrorDetails{
 		Code:    v1.CodeOperationCanceled,
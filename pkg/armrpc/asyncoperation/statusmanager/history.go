@@ -0,0 +1,172 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package statusmanager
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/metrics"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// OperationHistoryTypeName is the name of the nested resource type which stores the operation history of a resource.
+	OperationHistoryTypeName = "operations"
+)
+
+// OperationHistoryResourceType returns the resource type of the operation history entries of the given resource.
+//
+// Example:
+//
+//	Applications.Core/containers -> Applications.Core/containers/operations
+func OperationHistoryResourceType(id resources.ID) string {
+	return id.Type() + "/" + OperationHistoryTypeName
+}
+
+// OperationHistoryEntryID returns the id of the operation history entry of the given resource and operation.
+func OperationHistoryEntryID(id resources.ID, operationID uuid.UUID) string {
+	return id.String() + "/" + OperationHistoryTypeName + "/" + operationID.String()
+}
+
+// OperationHistoryQuery returns the query to list the operation history entries of the given resource.
+func OperationHistoryQuery(id resources.ID) store.Query {
+	return store.Query{
+		RootScope:          id.RootScope(),
+		ResourceType:       OperationHistoryResourceType(id),
+		RoutingScopePrefix: id.RoutingScope(),
+	}
+}
+
+func (aom *statusManager) getHistoryClient(ctx context.Context, id resources.ID) (store.StorageClient, error) {
+	return aom.storeProvider.GetStorageClient(ctx, OperationHistoryResourceType(id))
+}
+
+// appendHistoryEntry saves a new operation history entry for the given operation request. It returns the storage client
+// of the operation history and the saved entry, or a nil entry if it can't be saved.
+func (aom *statusManager) appendHistoryEntry(ctx context.Context, sCtx *v1.ARMRequestContext, aos *Status, traceparent string) (store.StorageClient, *store.Object) {
+	historyClient, err := aom.getHistoryClient(ctx, sCtx.ResourceID)
+	if err != nil {
+		recordHistoryFailure(ctx, sCtx.ResourceID, sCtx.OperationID, err)
+		return nil, nil
+	}
+
+	entry := &v1.OperationHistoryEntry{
+		ID:             OperationHistoryEntryID(sCtx.ResourceID, sCtx.OperationID),
+		Name:           sCtx.OperationID.String(),
+		Type:           OperationHistoryResourceType(sCtx.ResourceID),
+		ResourceID:     sCtx.ResourceID.String(),
+		OperationType:  sCtx.OperationType.String(),
+		Status:         aos.Status,
+		StartTime:      aos.StartTime,
+		HomeTenantID:   sCtx.HomeTenantID,
+		ClientObjectID: sCtx.ClientObjectID,
+		Traceparent:    traceparent,
+	}
+
+	obj := &store.Object{
+		Metadata: store.Metadata{ID: entry.ID},
+		Data:     entry,
+	}
+
+	if err := historyClient.Save(ctx, obj); err != nil {
+		recordHistoryFailure(ctx, sCtx.ResourceID, sCtx.OperationID, err)
+		return historyClient, nil
+	}
+
+	return historyClient, obj
+}
+
+// completeHistoryEntry records the final state, the end time and the error of an operation in its operation history entry.
+// The entry is created from the operation status when the operation was queued before its resource kept an operation history.
+func (aom *statusManager) completeHistoryEntry(ctx context.Context, id resources.ID, operationID uuid.UUID, aos *Status) {
+	if err := aom.saveCompletedHistoryEntry(ctx, id, operationID, aos); err != nil {
+		recordHistoryFailure(ctx, id, operationID, err)
+	}
+}
+
+func (aom *statusManager) saveCompletedHistoryEntry(ctx context.Context, id resources.ID, operationID uuid.UUID, aos *Status) error {
+	historyClient, err := aom.getHistoryClient(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	entry := &v1.OperationHistoryEntry{}
+	etag := ""
+	obj, err := historyClient.Get(ctx, OperationHistoryEntryID(id, operationID))
+	if errors.Is(err, &store.ErrNotFound{}) {
+		entry = &v1.OperationHistoryEntry{
+			ID:             OperationHistoryEntryID(id, operationID),
+			Name:           operationID.String(),
+			Type:           OperationHistoryResourceType(id),
+			ResourceID:     id.String(),
+			StartTime:      aos.StartTime,
+			HomeTenantID:   aos.HomeTenantID,
+			ClientObjectID: aos.ClientObjectID,
+		}
+		obj = &store.Object{Metadata: store.Metadata{ID: entry.ID}}
+	} else if err != nil {
+		return err
+	} else {
+		if err := obj.As(entry); err != nil {
+			return err
+		}
+		etag = obj.ETag
+	}
+
+	entry.Status = aos.Status
+	entry.EndTime = aos.EndTime
+	entry.Error = aos.Error
+	obj.Data = entry
+
+	return historyClient.Save(ctx, obj, store.WithETag(etag))
+}
+
+// failHistoryEntry marks the operation history entry as failed when the operation could not be queued.
+func (aom *statusManager) failHistoryEntry(ctx context.Context, historyClient store.StorageClient, sCtx *v1.ARMRequestContext, obj *store.Object, cause error) {
+	entry, ok := obj.Data.(*v1.OperationHistoryEntry)
+	if !ok {
+		recordHistoryFailure(ctx, sCtx.ResourceID, sCtx.OperationID, errors.New("operation history entry is invalid"))
+		return
+	}
+
+	now := time.Now().UTC()
+	entry.Status = v1.ProvisioningStateFailed
+	entry.EndTime = &now
+	entry.Error = &v1.ErrorDetails{
+		Code:    v1.CodeInternal,
+		Message: cause.Error(),
+	}
+
+	if err := historyClient.Save(ctx, obj, store.WithETag(obj.ETag)); err != nil {
+		recordHistoryFailure(ctx, sCtx.ResourceID, sCtx.OperationID, err)
+	}
+}
+
+// recordHistoryFailure logs the failure to write the operation history and records it in the metrics. The operation
+// history is informational, so its failures never fail or roll back the operation itself.
+func recordHistoryFailure(ctx context.Context, id resources.ID, operationID uuid.UUID, err error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	logger.Error(err, "failed to write the operation history", "resourceID", id.String(), "operationID", operationID.String())
+	metrics.DefaultAsyncOperationMetrics.RecordFailedOperationHistoryWrite(ctx, id)
+}
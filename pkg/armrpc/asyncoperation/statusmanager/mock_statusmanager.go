@@ -81,6 +81,34 @@ func (mr *MockStatusManagerMockRecorder) QueueAsyncOperation(arg0, arg1, arg2 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueueAsyncOperation", reflect.TypeOf((*MockStatusManager)(nil).QueueAsyncOperation), arg0, arg1, arg2)
 }
 
+// RecordAttempt mocks base method.
+func (m *MockStatusManager) RecordAttempt(arg0 context.Context, arg1 resources.ID, arg2 uuid.UUID, arg3 v1.OperationAttempt) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordAttempt", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordAttempt indicates an expected call of RecordAttempt.
+func (mr *MockStatusManagerMockRecorder) RecordAttempt(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordAttempt", reflect.TypeOf((*MockStatusManager)(nil).RecordAttempt), arg0, arg1, arg2, arg3)
+}
+
+// Restart mocks base method.
+func (m *MockStatusManager) Restart(arg0 context.Context, arg1 resources.ID, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restart", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restart indicates an expected call of Restart.
+func (mr *MockStatusManagerMockRecorder) Restart(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restart", reflect.TypeOf((*MockStatusManager)(nil).Restart), arg0, arg1, arg2)
+}
+
 // Update mocks base method.
 func (m *MockStatusManager) Update(arg0 context.Context, arg1 resources.ID, arg2 uuid.UUID, arg3 v1.ProvisioningState, arg4 *time.Time, arg5 *v1.ErrorDetails) error {
 	m.ctrl.T.Helper()
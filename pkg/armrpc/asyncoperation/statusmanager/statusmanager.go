@@ -48,6 +48,14 @@ type QueueOperationOptions struct {
 	OperationTimeout time.Duration
 	// RetryAfter specifies the value of the Retry-After header that will be used for async operations.
 	RetryAfter time.Duration
+	// Resource specifies the resource to save together with the async operation status. When the resource and the
+	// status share a storage client they are saved in a single transaction. The ETag of Resource is updated when it's saved.
+	//
+	// With CosmosDB, resources and operation statuses are stored in different collections, so the two saves are not
+	// atomic: the resource is saved first and a failure to save the status leaves the resource update in place.
+	Resource *store.Object
+	// ResourceETag specifies the ETag precondition used when saving Resource.
+	ResourceETag string
 }
 
 //go:generate mockgen -destination=./mock_statusmanager.go -package=statusmanager -self_package github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager StatusManager
@@ -60,6 +68,10 @@ type StatusManager interface {
 	QueueAsyncOperation(ctx context.Context, sCtx *v1.ARMRequestContext, options QueueOperationOptions) error
 	// Update updates an async operation status.
 	Update(ctx context.Context, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails) error
+	// RecordAttempt appends the failed attempt which is retried to an async operation status.
+	RecordAttempt(ctx context.Context, id resources.ID, operationID uuid.UUID, attempt v1.OperationAttempt) error
+	// Restart sets a completed async operation status back to accepted so that the operation is run again.
+	Restart(ctx context.Context, id resources.ID, operationID uuid.UUID) error
 	// Delete deletes an async operation status.
 	Delete(ctx context.Context, id resources.ID, operationID uuid.UUID) error
 }
@@ -82,8 +94,10 @@ func (aom *statusManager) getClient(ctx context.Context, id resources.ID) (store
 	return aom.storeProvider.GetStorageClient(ctx, id.ProviderNamespace()+"/operationstatuses")
 }
 
-// QueueAsyncOperation creates and saves a new status resource with the given parameters in datastore, and queues
-// a request message. If an error occurs, the status is deleted using the storeClient.
+// QueueAsyncOperation creates and saves a new status resource with the given parameters in datastore, along with the
+// resource in options if it is set, appends an entry to the operation history of the resource, and queues a request message.
+// If an error occurs, the status is deleted using the storeClient. Failures to write the operation history are logged
+// and don't fail the operation.
 func (aom *statusManager) QueueAsyncOperation(ctx context.Context, sCtx *v1.ARMRequestContext, options QueueOperationOptions) error {
 	ctx, span := trace.StartProducerSpan(ctx, "statusmanager.QueueAsyncOperation publish", trace.FrontendTracerName)
 	defer span.End()
@@ -116,16 +130,30 @@ func (aom *statusManager) QueueAsyncOperation(ctx context.Context, sCtx *v1.ARMR
 		return err
 	}
 
-	err = storeClient.Save(ctx, &store.Object{
+	status := &store.Object{
 		Metadata: store.Metadata{ID: opID},
 		Data:     aos,
-	})
+	}
+
+	if options.Resource != nil {
+		err = aom.saveWithResource(ctx, storeClient, sCtx.ResourceID, status, options)
+	} else {
+		err = storeClient.Save(ctx, status)
+	}
 
 	if err != nil {
 		return err
 	}
 
+	// The operation history is informational, so the operation is queued even if it can't be recorded.
+	historyClient, entry := aom.appendHistoryEntry(ctx, sCtx, aos, trace.ExtractTraceparent(ctx))
+
 	if err = aom.queueRequestMessage(ctx, sCtx, aos, options.OperationTimeout); err != nil {
+		// The operation history is append-only, so the entry is kept and records that the operation failed.
+		if entry != nil {
+			aom.failHistoryEntry(ctx, historyClient, sCtx, entry, err)
+		}
+
 		delErr := storeClient.Delete(ctx, opID)
 		if delErr != nil {
 			return delErr
@@ -138,6 +166,29 @@ func (aom *statusManager) QueueAsyncOperation(ctx context.Context, sCtx *v1.ARMR
 	return nil
 }
 
+// saveWithResource saves the operation status and the resource. They are saved in a single transaction when they share
+// a storage client, so that a failure can't leave an operation status without its resource update or vice versa.
+func (aom *statusManager) saveWithResource(ctx context.Context, storeClient store.StorageClient, id resources.ID, status *store.Object, options QueueOperationOptions) error {
+	resourceClient, err := aom.storeProvider.GetStorageClient(ctx, id.Type())
+	if err != nil {
+		return err
+	}
+
+	if resourceClient != storeClient {
+		// The resource and the status are stored separately so they can't be saved in a transaction.
+		if err := resourceClient.Save(ctx, options.Resource, store.WithETag(options.ResourceETag)); err != nil {
+			return err
+		}
+
+		return storeClient.Save(ctx, status)
+	}
+
+	return storeClient.Transaction(ctx, []store.TransactionOperation{
+		store.TransactionSave(options.Resource, store.WithETag(options.ResourceETag)),
+		store.TransactionSave(status),
+	})
+}
+
 // Get gets a status object from the datastore or an error if the retrieval fails.
 func (aom *statusManager) Get(ctx context.Context, id resources.ID, operationID uuid.UUID) (*Status, error) {
 	storeClient, err := aom.getClient(ctx, id)
@@ -159,7 +210,12 @@ func (aom *statusManager) Get(ctx context.Context, id resources.ID, operationID
 }
 
 // Update retrieves an existing operation status resource from the store, updates its fields with the
-// given parameters, and saves it back to the store.
+// given parameters, and saves it back to the store. When the state is terminal, the result is also recorded in the
+// operation history of the resource. Failures to write the operation history are logged and don't fail the update.
+//
+// The status is saved with the ETag it was read with, so that a concurrent cancellation by the user is never lost: an
+// operation canceled by the user stays canceled whatever result it completes with, and an operation which has already
+// succeeded or failed can't be canceled, which returns store.ErrConcurrency.
 func (aom *statusManager) Update(ctx context.Context, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails) error {
 	opID := aom.operationStatusResourceID(id, operationID)
 	storeClient, err := aom.getClient(ctx, id)
@@ -177,6 +233,14 @@ func (aom *statusManager) Update(ctx context.Context, id resources.ID, operation
 		return err
 	}
 
+	if s.Status == v1.ProvisioningStateCanceled && state != v1.ProvisioningStateCanceled {
+		// The operation was canceled by the user while it was running. Keep the error of the cancellation.
+		state = v1.ProvisioningStateCanceled
+		opError = nil
+	} else if state == v1.ProvisioningStateCanceled && (s.Status == v1.ProvisioningStateSucceeded || s.Status == v1.ProvisioningStateFailed) {
+		return &store.ErrConcurrency{}
+	}
+
 	s.Status = state
 	if endTime != nil {
 		s.EndTime = endTime
@@ -190,6 +254,70 @@ func (aom *statusManager) Update(ctx context.Context, id resources.ID, operation
 
 	obj.Data = s
 
+	if err := storeClient.Save(ctx, obj, store.WithETag(obj.ETag)); err != nil {
+		return err
+	}
+
+	if state.IsTerminal() {
+		aom.completeHistoryEntry(ctx, id, operationID, s)
+	}
+
+	return nil
+}
+
+// RecordAttempt retrieves an existing operation status resource from the store, appends the given attempt to its
+// attempt history, and saves it back to the store.
+func (aom *statusManager) RecordAttempt(ctx context.Context, id resources.ID, operationID uuid.UUID, attempt v1.OperationAttempt) error {
+	opID := aom.operationStatusResourceID(id, operationID)
+	storeClient, err := aom.getClient(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	obj, err := storeClient.Get(ctx, opID)
+	if err != nil {
+		return err
+	}
+
+	s := &Status{}
+	if err := obj.As(s); err != nil {
+		return err
+	}
+
+	s.Attempts = append(s.Attempts, attempt)
+	s.LastUpdatedTime = time.Now().UTC()
+
+	obj.Data = s
+
+	return storeClient.Save(ctx, obj, store.WithETag(obj.ETag))
+}
+
+// Restart retrieves an existing operation status resource from the store, sets it back to accepted and clears its end
+// time and error, and saves it back to the store. The attempt history is kept.
+func (aom *statusManager) Restart(ctx context.Context, id resources.ID, operationID uuid.UUID) error {
+	opID := aom.operationStatusResourceID(id, operationID)
+	storeClient, err := aom.getClient(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	obj, err := storeClient.Get(ctx, opID)
+	if err != nil {
+		return err
+	}
+
+	s := &Status{}
+	if err := obj.As(s); err != nil {
+		return err
+	}
+
+	s.Status = v1.ProvisioningStateAccepted
+	s.EndTime = nil
+	s.Error = nil
+	s.LastUpdatedTime = time.Now().UTC()
+
+	obj.Data = s
+
 	return storeClient.Save(ctx, obj, store.WithETag(obj.ETag))
 }
 
@@ -37,6 +37,7 @@ type asyncOperationsManagerTest struct {
 	manager       StatusManager
 	storeProvider *dataprovider.MockDataStorageProvider
 	storeClient   *store.MockStorageClient
+	historyClient *store.MockStorageClient
 	queue         *queue.MockClient
 }
 
@@ -56,9 +57,11 @@ func setup(tb testing.TB) (asyncOperationsManagerTest, *gomock.Controller) {
 	dp := dataprovider.NewMockDataStorageProvider(ctrl)
 	sc := store.NewMockStorageClient(ctrl)
 	dp.EXPECT().GetStorageClient(gomock.Any(), "Applications.Core/operationstatuses").Return(sc, nil)
+	hc := store.NewMockStorageClient(ctrl)
+	dp.EXPECT().GetStorageClient(gomock.Any(), "Applications.Core/container/operations").Return(hc, nil).AnyTimes()
 	enq := queue.NewMockClient(ctrl)
 	aom := New(dp, enq, "test-location")
-	return asyncOperationsManagerTest{manager: aom, storeProvider: dp, storeClient: sc, queue: enq}, ctrl
+	return asyncOperationsManagerTest{manager: aom, storeProvider: dp, storeClient: sc, historyClient: hc, queue: enq}, ctrl
 }
 
 var reqCtx = &v1.ARMRequestContext{
@@ -158,6 +161,17 @@ func TestCreateAsyncOperationStatus(t *testing.T) {
 
 			// We can't expect an async operation to be queued if it is not saved to the DB.
 			if tt.SaveErr == nil {
+				aomTest.historyClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+						entry := obj.Data.(*v1.OperationHistoryEntry)
+						require.Equal(t, reqCtx.ResourceID.String()+"/operations/"+reqCtx.OperationID.String(), obj.ID)
+						require.Equal(t, "Applications.Core/container/operations", entry.Type)
+						require.Equal(t, reqCtx.OperationType.String(), entry.OperationType)
+						require.Equal(t, v1.ProvisioningStateAccepted, entry.Status)
+						require.Equal(t, reqCtx.ClientObjectID, entry.ClientObjectID)
+						require.Equal(t, reqCtx.HomeTenantID, entry.HomeTenantID)
+						return nil
+					})
 				aomTest.queue.EXPECT().Enqueue(gomock.Any(), gomock.Any(), gomock.Any()).Return(tt.EnqueueErr)
 			}
 
@@ -166,6 +180,18 @@ func TestCreateAsyncOperationStatus(t *testing.T) {
 				aomTest.storeClient.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any()).Return(tt.DeleteErr)
 			}
 
+			// The operation history entry is kept and records that the operation failed to be queued.
+			if tt.EnqueueErr != nil {
+				aomTest.historyClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+						entry := obj.Data.(*v1.OperationHistoryEntry)
+						require.Equal(t, v1.ProvisioningStateFailed, entry.Status)
+						require.NotNil(t, entry.EndTime)
+						require.Equal(t, enqueueErr, entry.Error.Message)
+						return nil
+					})
+			}
+
 			options := QueueOperationOptions{
 				OperationTimeout: operationTimeoutDuration,
 				RetryAfter:       opererationRetryAfterDuration,
@@ -191,6 +217,86 @@ func TestCreateAsyncOperationStatus(t *testing.T) {
 	}
 }
 
+func TestCreateAsyncOperationStatus_HistoryError(t *testing.T) {
+	aomTest, mctrl := setup(t)
+	defer mctrl.Finish()
+
+	// The operation is queued and its status is kept when the operation history can't be written.
+	aomTest.storeClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	aomTest.historyClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf(saveErr))
+	aomTest.queue.EXPECT().Enqueue(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	aomTest.storeClient.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	options := QueueOperationOptions{
+		OperationTimeout: operationTimeoutDuration,
+		RetryAfter:       opererationRetryAfterDuration,
+	}
+	err := aomTest.manager.QueueAsyncOperation(context.TODO(), reqCtx, options)
+	require.NoError(t, err)
+}
+
+func TestCreateAsyncOperationStatusWithResource(t *testing.T) {
+	resource := &store.Object{
+		Metadata: store.Metadata{ID: reqCtx.ResourceID.String()},
+		Data:     map[string]any{"name": "container0"},
+	}
+	options := QueueOperationOptions{
+		OperationTimeout: operationTimeoutDuration,
+		RetryAfter:       opererationRetryAfterDuration,
+		Resource:         resource,
+		ResourceETag:     "resource-etag",
+	}
+
+	t.Run("create_transaction", func(t *testing.T) {
+		aomTest, mctrl := setup(t)
+		defer mctrl.Finish()
+
+		aomTest.storeProvider.EXPECT().GetStorageClient(gomock.Any(), reqCtx.ResourceID.Type()).Return(aomTest.storeClient, nil)
+		aomTest.storeClient.EXPECT().Transaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, operations []store.TransactionOperation) error {
+				require.Len(t, operations, 2)
+				require.Equal(t, resource, operations[0].Object)
+				require.Equal(t, "resource-etag", operations[0].ETag)
+				require.Equal(t, store.TransactionOperationSave, operations[1].Type)
+				require.IsType(t, &Status{}, operations[1].Object.Data)
+				return nil
+			})
+		aomTest.historyClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		aomTest.queue.EXPECT().Enqueue(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+		err := aomTest.manager.QueueAsyncOperation(context.TODO(), reqCtx, options)
+		require.NoError(t, err)
+	})
+
+	t.Run("create_transaction-error", func(t *testing.T) {
+		aomTest, mctrl := setup(t)
+		defer mctrl.Finish()
+
+		aomTest.storeProvider.EXPECT().GetStorageClient(gomock.Any(), reqCtx.ResourceID.Type()).Return(aomTest.storeClient, nil)
+		aomTest.storeClient.EXPECT().Transaction(gomock.Any(), gomock.Any()).Return(&store.ErrConcurrency{})
+
+		err := aomTest.manager.QueueAsyncOperation(context.TODO(), reqCtx, options)
+		require.ErrorIs(t, err, &store.ErrConcurrency{})
+	})
+
+	t.Run("create_separate-clients", func(t *testing.T) {
+		aomTest, mctrl := setup(t)
+		defer mctrl.Finish()
+
+		resourceClient := store.NewMockStorageClient(mctrl)
+		aomTest.storeProvider.EXPECT().GetStorageClient(gomock.Any(), reqCtx.ResourceID.Type()).Return(resourceClient, nil)
+		gomock.InOrder(
+			resourceClient.EXPECT().Save(gomock.Any(), resource, gomock.Any()).Return(nil),
+			aomTest.storeClient.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil),
+			aomTest.historyClient.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil),
+		)
+		aomTest.queue.EXPECT().Enqueue(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+		err := aomTest.manager.QueueAsyncOperation(context.TODO(), reqCtx, options)
+		require.NoError(t, err)
+	})
+}
+
 func TestDeleteAsyncOperationStatus(t *testing.T) {
 	deleteCases := []struct {
 		Desc      string
@@ -328,3 +434,219 @@ func TestUpdateAsyncOperationStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateAsyncOperationStatus_RecordsHistory(t *testing.T) {
+	rid, err := resources.ParseResource(azureEnvResourceID)
+	require.NoError(t, err)
+	endTime := time.Now().UTC()
+	opErr := &v1.ErrorDetails{Code: v1.CodeInternal, Message: "deployment failed"}
+
+	historyCases := []struct {
+		Desc     string
+		Existing *store.Object
+	}{
+		{
+			Desc: "existing-entry",
+			Existing: &store.Object{
+				Metadata: store.Metadata{ID: OperationHistoryEntryID(rid, opID), ETag: "history-etag"},
+				Data: &v1.OperationHistoryEntry{
+					ID:            OperationHistoryEntryID(rid, opID),
+					ResourceID:    rid.String(),
+					OperationType: "APPLICATIONS.CORE/ENVIRONMENTS|PUT",
+					Status:        v1.ProvisioningStateAccepted,
+					Traceparent:   "trace",
+				},
+			},
+		},
+		{
+			Desc:     "missing-entry",
+			Existing: nil,
+		},
+	}
+
+	for _, tt := range historyCases {
+		t.Run(tt.Desc, func(t *testing.T) {
+			aomTest, mctrl := setup(t)
+			defer mctrl.Finish()
+
+			aomTest.storeClient.EXPECT().Get(gomock.Any(), gomock.Any()).
+				Return(&store.Object{Metadata: store.Metadata{ID: opID.String()}, Data: testAos}, nil)
+			aomTest.storeClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+			historyClient := store.NewMockStorageClient(mctrl)
+			aomTest.storeProvider.EXPECT().GetStorageClient(gomock.Any(), "Applications.Core/environments/operations").Return(historyClient, nil)
+			if tt.Existing != nil {
+				historyClient.EXPECT().Get(gomock.Any(), OperationHistoryEntryID(rid, opID)).Return(tt.Existing, nil)
+			} else {
+				historyClient.EXPECT().Get(gomock.Any(), OperationHistoryEntryID(rid, opID)).Return(nil, &store.ErrNotFound{ID: OperationHistoryEntryID(rid, opID)})
+			}
+			historyClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+					entry := &v1.OperationHistoryEntry{}
+					require.NoError(t, obj.As(entry))
+					require.Equal(t, OperationHistoryEntryID(rid, opID), obj.ID)
+					require.Equal(t, rid.String(), entry.ResourceID)
+					require.Equal(t, v1.ProvisioningStateFailed, entry.Status)
+					require.Equal(t, &endTime, entry.EndTime)
+					require.Equal(t, opErr, entry.Error)
+					if tt.Existing != nil {
+						require.Equal(t, "APPLICATIONS.CORE/ENVIRONMENTS|PUT", entry.OperationType)
+						require.Equal(t, "trace", entry.Traceparent)
+					}
+					return nil
+				})
+
+			err := aomTest.manager.Update(context.TODO(), rid, opID, v1.ProvisioningStateFailed, &endTime, opErr)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestUpdateAsyncOperationStatus_HistoryError(t *testing.T) {
+	aomTest, mctrl := setup(t)
+	defer mctrl.Finish()
+
+	rid, err := resources.ParseResource(azureEnvResourceID)
+	require.NoError(t, err)
+
+	updating := &Status{AsyncOperationStatus: v1.AsyncOperationStatus{ID: opID.String(), Status: v1.ProvisioningStateUpdating}}
+	aomTest.storeClient.EXPECT().Get(gomock.Any(), gomock.Any()).
+		Return(&store.Object{Metadata: store.Metadata{ID: opID.String(), ETag: "etag"}, Data: updating}, nil)
+	aomTest.storeClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	// The terminal status is saved, so the update succeeds when the operation history can't be written.
+	historyClient := store.NewMockStorageClient(mctrl)
+	aomTest.storeProvider.EXPECT().GetStorageClient(gomock.Any(), "Applications.Core/environments/operations").Return(historyClient, nil)
+	historyClient.EXPECT().Get(gomock.Any(), OperationHistoryEntryID(rid, opID)).Return(nil, fmt.Errorf(getErr))
+
+	endTime := time.Now().UTC()
+	err = aomTest.manager.Update(context.TODO(), rid, opID, v1.ProvisioningStateSucceeded, &endTime, nil)
+	require.NoError(t, err)
+}
+
+func TestUpdateAsyncOperationStatus_Canceled(t *testing.T) {
+	rid, err := resources.ParseResource(azureEnvResourceID)
+	require.NoError(t, err)
+	cancelErr := &v1.ErrorDetails{Code: v1.CodeOperationCanceled, Message: "Operation was canceled by the user."}
+
+	t.Run("completed-after-cancel", func(t *testing.T) {
+		aomTest, mctrl := setup(t)
+		defer mctrl.Finish()
+
+		canceled := &Status{AsyncOperationStatus: v1.AsyncOperationStatus{ID: opID.String(), Status: v1.ProvisioningStateCanceled, Error: cancelErr}}
+		aomTest.storeClient.EXPECT().Get(gomock.Any(), gomock.Any()).
+			Return(&store.Object{Metadata: store.Metadata{ID: opID.String(), ETag: "etag"}, Data: canceled}, nil)
+		aomTest.storeClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+				s := &Status{}
+				require.NoError(t, obj.As(s))
+				require.Equal(t, v1.ProvisioningStateCanceled, s.Status)
+				require.Equal(t, cancelErr, s.Error)
+				require.NotNil(t, s.EndTime)
+				return nil
+			})
+
+		historyClient := store.NewMockStorageClient(mctrl)
+		aomTest.storeProvider.EXPECT().GetStorageClient(gomock.Any(), "Applications.Core/environments/operations").Return(historyClient, nil)
+		historyClient.EXPECT().Get(gomock.Any(), OperationHistoryEntryID(rid, opID)).Return(nil, &store.ErrNotFound{ID: OperationHistoryEntryID(rid, opID)})
+		historyClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+		endTime := time.Now().UTC()
+		err := aomTest.manager.Update(context.TODO(), rid, opID, v1.ProvisioningStateSucceeded, &endTime, nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("canceled-after-completion", func(t *testing.T) {
+		aomTest, mctrl := setup(t)
+		defer mctrl.Finish()
+
+		succeeded := &Status{AsyncOperationStatus: v1.AsyncOperationStatus{ID: opID.String(), Status: v1.ProvisioningStateSucceeded}}
+		aomTest.storeClient.EXPECT().Get(gomock.Any(), gomock.Any()).
+			Return(&store.Object{Metadata: store.Metadata{ID: opID.String(), ETag: "etag"}, Data: succeeded}, nil)
+
+		err := aomTest.manager.Update(context.TODO(), rid, opID, v1.ProvisioningStateCanceled, nil, cancelErr)
+		require.ErrorIs(t, err, &store.ErrConcurrency{})
+	})
+}
+
+func TestRecordAttempt(t *testing.T) {
+	aomTest, mctrl := setup(t)
+	defer mctrl.Finish()
+
+	status := &Status{
+		AsyncOperationStatus: v1.AsyncOperationStatus{
+			ID:     opID.String(),
+			Name:   opID.String(),
+			Status: v1.ProvisioningStateUpdating,
+		},
+	}
+
+	aomTest.storeClient.
+		EXPECT().
+		Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&store.Object{Metadata: store.Metadata{ID: opID.String(), ETag: "etag"}, Data: status}, nil)
+
+	attempt := v1.OperationAttempt{
+		Attempt:         1,
+		EndTime:         time.Now().UTC(),
+		Error:           &v1.ErrorDetails{Code: v1.CodeConflict, Message: "conflict"},
+		NextAttemptTime: time.Now().UTC().Add(time.Minute),
+	}
+
+	aomTest.storeClient.
+		EXPECT().
+		Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+			saved := obj.Data.(*Status)
+			require.Equal(t, v1.ProvisioningStateUpdating, saved.Status)
+			require.Equal(t, []v1.OperationAttempt{attempt}, saved.Attempts)
+			return nil
+		})
+
+	rid, err := resources.ParseResource(azureEnvResourceID)
+	require.NoError(t, err)
+	err = aomTest.manager.RecordAttempt(context.TODO(), rid, opID, attempt)
+	require.NoError(t, err)
+}
+
+func TestRestart(t *testing.T) {
+	aomTest, mctrl := setup(t)
+	defer mctrl.Finish()
+
+	endTime := time.Now().UTC()
+	attempts := []v1.OperationAttempt{{Attempt: 1, EndTime: endTime}}
+	status := &Status{
+		AsyncOperationStatus: v1.AsyncOperationStatus{
+			ID:       opID.String(),
+			Name:     opID.String(),
+			Status:   v1.ProvisioningStateFailed,
+			EndTime:  &endTime,
+			Error:    &v1.ErrorDetails{Code: v1.CodeInternal, Message: "exceeded max retry count"},
+			Attempts: attempts,
+		},
+		LastUpdatedTime: endTime,
+	}
+
+	aomTest.storeClient.
+		EXPECT().
+		Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&store.Object{Metadata: store.Metadata{ID: opID.String(), ETag: "etag"}, Data: status}, nil)
+
+	aomTest.storeClient.
+		EXPECT().
+		Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+			saved := obj.Data.(*Status)
+			require.Equal(t, v1.ProvisioningStateAccepted, saved.Status)
+			require.Nil(t, saved.EndTime)
+			require.Nil(t, saved.Error)
+			require.False(t, saved.LastUpdatedTime.Before(endTime))
+			require.Equal(t, attempts, saved.Attempts)
+			return nil
+		})
+
+	rid, err := resources.ParseResource(azureEnvResourceID)
+	require.NoError(t, err)
+	err = aomTest.manager.Restart(context.TODO(), rid, opID)
+	require.NoError(t, err)
+}
@@ -30,6 +30,7 @@ type ControllerFactoryFunc func(opts ctrl.Options) (ctrl.Controller, error)
 // ControllerRegistry is an registry to register async controllers.
 type ControllerRegistry struct {
 	ctrlMap   map[string]ctrl.Controller
+	policyMap map[string]RetryPolicy
 	ctrlMapMu sync.RWMutex
 	sp        dataprovider.DataStorageProvider
 }
@@ -37,13 +38,24 @@ type ControllerRegistry struct {
 // NewControllerRegistry creates an ControllerRegistry instance.
 func NewControllerRegistry(sp dataprovider.DataStorageProvider) *ControllerRegistry {
 	return &ControllerRegistry{
-		ctrlMap: map[string]ctrl.Controller{},
-		sp:      sp,
+		ctrlMap:   map[string]ctrl.Controller{},
+		policyMap: map[string]RetryPolicy{},
+		sp:        sp,
 	}
 }
 
-// Register registers controller.
+// Register registers controller with the default retry policy of the worker.
 func (h *ControllerRegistry) Register(ctx context.Context, resourceType string, method v1.OperationMethod, factoryFn ControllerFactoryFunc, opts ctrl.Options) error {
+	return h.RegisterWithRetryPolicy(ctx, resourceType, method, factoryFn, opts, RetryPolicy{})
+}
+
+// RegisterWithRetryPolicy registers controller with the retry policy used when the operation fails. It returns an
+// error if the retry policy is invalid.
+func (h *ControllerRegistry) RegisterWithRetryPolicy(ctx context.Context, resourceType string, method v1.OperationMethod, factoryFn ControllerFactoryFunc, opts ctrl.Options, policy RetryPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+
 	h.ctrlMapMu.Lock()
 	defer h.ctrlMapMu.Unlock()
 
@@ -62,6 +74,7 @@ func (h *ControllerRegistry) Register(ctx context.Context, resourceType string,
 	}
 
 	h.ctrlMap[ot.String()] = ctrl
+	h.policyMap[ot.String()] = policy
 	return nil
 }
 
@@ -76,3 +89,12 @@ func (h *ControllerRegistry) Get(operationType v1.OperationType) ctrl.Controller
 
 	return nil
 }
+
+// GetRetryPolicy gets the retry policy of the registered async controller. The zero value is returned if the
+// controller is not registered.
+func (h *ControllerRegistry) GetRetryPolicy(operationType v1.OperationType) RetryPolicy {
+	h.ctrlMapMu.RLock()
+	defer h.ctrlMapMu.RUnlock()
+
+	return h.policyMap[operationType.String()]
+}
@@ -56,13 +56,26 @@ func TestRegister_Get(t *testing.T) {
 	}, ctrlOpts)
 	require.NoError(t, err)
 
-	err = registry.Register(context.TODO(), opPut.Type, opPut.Method, func(opts ctrl.Options) (ctrl.Controller, error) {
+	policy := RetryPolicy{MaxAttempts: 5, RetryableErrorCodes: []string{v1.CodeConflict}}
+	err = registry.RegisterWithRetryPolicy(context.TODO(), opPut.Type, opPut.Method, func(opts ctrl.Options) (ctrl.Controller, error) {
 		return &testAsyncController{
 			BaseController: ctrl.NewBaseAsyncController(ctrlOpts),
 		}, nil
-	}, ctrlOpts)
+	}, ctrlOpts, policy)
 	require.NoError(t, err)
 
+	require.Equal(t, RetryPolicy{}, registry.GetRetryPolicy(opGet))
+	require.Equal(t, policy, registry.GetRetryPolicy(opPut))
+
+	opDelete := v1.OperationType{Type: "Applications.Core/environments", Method: v1.OperationDelete}
+	err = registry.RegisterWithRetryPolicy(context.TODO(), opDelete.Type, opDelete.Method, func(opts ctrl.Options) (ctrl.Controller, error) {
+		return &testAsyncController{
+			BaseController: ctrl.NewBaseAsyncController(ctrlOpts),
+		}, nil
+	}, ctrlOpts, RetryPolicy{Jitter: -1})
+	require.Error(t, err)
+	require.Nil(t, registry.Get(opDelete))
+
 	ctrl := registry.Get(opGet)
 	require.NotNil(t, ctrl)
 	ctrl = registry.Get(opPut)
@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package worker
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+)
+
+const (
+	// defaultInitialRetryBackoff is the default backoff duration before the first retry of a failed operation.
+	defaultInitialRetryBackoff = time.Duration(5) * time.Second
+
+	// defaultMaxRetryBackoff is the default maximum backoff duration between the retries of a failed operation.
+	defaultMaxRetryBackoff = time.Duration(5) * time.Minute
+)
+
+// RetryPolicy configures how the worker retries an async operation which failed. The backoff duration doubles on
+// every retry, starting from InitialBackoff, up to MaxBackoff.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts to process the operation, including the first attempt.
+	// If this is 0 then the worker's MaxOperationRetryCount is used.
+	MaxAttempts int
+
+	// InitialBackoff is the backoff duration before the first retry. If this is 0 then 5 seconds is used.
+	InitialBackoff time.Duration
+
+	// MaxBackoff is the maximum backoff duration between the retries. If this is 0 then 5 minutes is used.
+	MaxBackoff time.Duration
+
+	// Jitter is the fraction of the backoff duration, between 0 and 1, which is randomized so that the operations
+	// failing at the same time are not retried at the same time.
+	Jitter float64
+
+	// RetryableErrorCodes is the list of v1.ErrorDetails codes which are retried. The operations failing with the
+	// other codes fail right away. If this is empty then failed operations are not retried.
+	RetryableErrorCodes []string
+}
+
+// Validate returns an error if the policy is invalid.
+func (p RetryPolicy) Validate() error {
+	if p.Jitter < 0 || p.Jitter > 1 {
+		return fmt.Errorf("retry policy jitter must be between 0 and 1, got %v", p.Jitter)
+	}
+	return nil
+}
+
+// withDefaults returns the copy of the policy with the default values applied to the unset fields.
+func (p RetryPolicy) withDefaults(maxAttempts int) RetryPolicy {
+	if p.MaxAttempts == 0 {
+		p.MaxAttempts = maxAttempts
+	}
+	if p.InitialBackoff == time.Duration(0) {
+		p.InitialBackoff = defaultInitialRetryBackoff
+	}
+	if p.MaxBackoff == time.Duration(0) {
+		p.MaxBackoff = defaultMaxRetryBackoff
+	}
+	return p
+}
+
+// IsRetryable returns true if the operation failed with the given error should be retried.
+func (p RetryPolicy) IsRetryable(err *v1.ErrorDetails) bool {
+	if err == nil {
+		return false
+	}
+
+	for _, code := range p.RetryableErrorCodes {
+		if code == err.Code {
+			return true
+		}
+	}
+	return false
+}
+
+// Backoff returns the backoff duration before the given retry, starting from 1.
+func (p RetryPolicy) Backoff(retry int) time.Duration {
+	backoff := p.InitialBackoff
+	for i := 1; i < retry && backoff < p.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > p.MaxBackoff {
+		backoff = p.MaxBackoff
+	}
+
+	if p.Jitter > 0 {
+		// Randomize the backoff within [backoff * (1 - Jitter), backoff * (1 + Jitter)).
+		backoff += time.Duration(p.Jitter * float64(backoff) * (2*rand.Float64() - 1))
+	}
+
+	return backoff
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package worker
+
+import (
+	"testing"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryPolicy_WithDefaults(t *testing.T) {
+	policy := RetryPolicy{}.withDefaults(3)
+	require.Equal(t, 3, policy.MaxAttempts)
+	require.Equal(t, defaultInitialRetryBackoff, policy.InitialBackoff)
+	require.Equal(t, defaultMaxRetryBackoff, policy.MaxBackoff)
+
+	policy = RetryPolicy{MaxAttempts: 10, InitialBackoff: time.Second, MaxBackoff: time.Minute}.withDefaults(3)
+	require.Equal(t, 10, policy.MaxAttempts)
+	require.Equal(t, time.Second, policy.InitialBackoff)
+	require.Equal(t, time.Minute, policy.MaxBackoff)
+}
+
+func TestRetryPolicy_Validate(t *testing.T) {
+	require.NoError(t, RetryPolicy{}.Validate())
+	require.NoError(t, RetryPolicy{Jitter: 0.2}.Validate())
+	require.NoError(t, RetryPolicy{Jitter: 1}.Validate())
+	require.EqualError(t, RetryPolicy{Jitter: -0.1}.Validate(), "retry policy jitter must be between 0 and 1, got -0.1")
+	require.EqualError(t, RetryPolicy{Jitter: 1.5}.Validate(), "retry policy jitter must be between 0 and 1, got 1.5")
+}
+
+func TestRetryPolicy_IsRetryable(t *testing.T) {
+	policy := RetryPolicy{RetryableErrorCodes: []string{v1.CodeConflict, v1.CodeInternal}}
+
+	require.True(t, policy.IsRetryable(&v1.ErrorDetails{Code: v1.CodeConflict}))
+	require.True(t, policy.IsRetryable(&v1.ErrorDetails{Code: v1.CodeInternal}))
+	require.False(t, policy.IsRetryable(&v1.ErrorDetails{Code: v1.CodeInvalid}))
+	require.False(t, policy.IsRetryable(nil))
+	require.False(t, RetryPolicy{}.IsRetryable(&v1.ErrorDetails{Code: v1.CodeInternal}))
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := RetryPolicy{InitialBackoff: time.Second, MaxBackoff: 5 * time.Second}
+
+	require.Equal(t, time.Second, policy.Backoff(1))
+	require.Equal(t, 2*time.Second, policy.Backoff(2))
+	require.Equal(t, 4*time.Second, policy.Backoff(3))
+	require.Equal(t, 5*time.Second, policy.Backoff(4))
+	require.Equal(t, 5*time.Second, policy.Backoff(100))
+
+	policy.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		backoff := policy.Backoff(2)
+		require.GreaterOrEqual(t, backoff, time.Second)
+		require.Less(t, backoff, 3*time.Second)
+	}
+}
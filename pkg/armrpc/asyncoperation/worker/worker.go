@@ -59,6 +59,12 @@ const (
 
 	// defaultDequeueInterval is the default duration for the dequeue interval.
 	defaultDequeueInterval = time.Duration(200) * time.Millisecond
+
+	// defaultCancellationCheckInterval is the default interval to check if the running operation is canceled.
+	defaultCancellationCheckInterval = time.Duration(10) * time.Second
+
+	// operationCanceledMessage is the error message of the operation canceled by the user.
+	operationCanceledMessage = "Operation was canceled by the user."
 )
 
 // Options configures AsyncRequestProcessorWorker
@@ -66,7 +72,8 @@ type Options struct {
 	// MaxOperationConcurrency is the maximum concurrency to process async request operation.
 	MaxOperationConcurrency int
 
-	// MaxOperationRetryCount is the maximum retry count to process async request operation.
+	// MaxOperationRetryCount is the maximum retry count to process async request operation. This is the default
+	// RetryPolicy.MaxAttempts of the operations registered without it.
 	MaxOperationRetryCount int
 
 	// MessageExtendMargin is the margin duration for clock skew before extending message lock.
@@ -80,6 +87,9 @@ type Options struct {
 
 	// DequeueIntervalDuration is the duration for the dequeue interval.
 	DequeueIntervalDuration time.Duration
+
+	// CancellationCheckInterval is the interval to check if the running operation is canceled by the user.
+	CancellationCheckInterval time.Duration
 }
 
 // AsyncRequestProcessWorker is the worker to process async requests.
@@ -116,6 +126,9 @@ func New(
 	if options.DequeueIntervalDuration == time.Duration(0) {
 		options.DequeueIntervalDuration = defaultDequeueInterval
 	}
+	if options.CancellationCheckInterval == time.Duration(0) {
+		options.CancellationCheckInterval = defaultCancellationCheckInterval
+	}
 
 	return &AsyncRequestProcessWorker{
 		options:      options,
@@ -178,14 +191,23 @@ func (w *AsyncRequestProcessWorker) Start(ctx context.Context) error {
 				return
 			}
 
-			if msgreq.DequeueCount > w.options.MaxOperationRetryCount {
-				errMsg := fmt.Sprintf("exceeded max retry count to process async operation message: %d", msgreq.DequeueCount)
+			// A message requeued from the dead-letter queue starts the operation over.
+			if !msgreq.RequeuedAt.IsZero() {
+				if err := w.restartRequeuedOperation(reqCtx, msgreq, op, asyncCtrl.StorageClient()); err != nil {
+					opLogger.Error(err, "failed to restart the requeued operation")
+					return
+				}
+			}
+
+			// The attempts include the retries by the retry policy and the redeliveries of the message after its lock expired.
+			if attempt := op.Attempt(msgreq.DequeueCount); attempt > w.retryPolicy(armReqCtx.OperationType).MaxAttempts {
+				errMsg := fmt.Sprintf("exceeded max retry count to process async operation message: %d", attempt)
 				opLogger.Error(nil, errMsg)
 				failed := ctrl.NewFailedResult(v1.ErrorDetails{
 					Code:    v1.CodeInternal,
 					Message: errMsg,
 				})
-				w.completeOperation(reqCtx, msgreq, failed, asyncCtrl.StorageClient())
+				w.deadLetterOperation(reqCtx, msgreq, failed, asyncCtrl.StorageClient())
 				return
 			}
 
@@ -193,11 +215,17 @@ func (w *AsyncRequestProcessWorker) Start(ctx context.Context) error {
 			// 1. The same message is delivered twice in multiple instances.
 			// 2. provisioningState is not matched between resource and operationStatuses
 
-			dup, err := w.isDuplicated(reqCtx, asyncCtrl.StorageClient(), op.ResourceID, op.OperationID)
+			status, dup, err := w.isDuplicated(reqCtx, asyncCtrl.StorageClient(), op.ResourceID, op.OperationID)
 			if err != nil {
 				opLogger.Error(err, "failed to check potential deduplication.")
 				return
 			}
+			if dup && status.Status == v1.ProvisioningStateCanceled && status.EndTime == nil {
+				// The operation was canceled by the user before it started or while it was waiting for the retry.
+				opLogger.Info("operation was canceled")
+				w.completeOperation(reqCtx, msgreq, newUserCanceledResult(op), asyncCtrl.StorageClient())
+				return
+			}
 			if dup {
 				opLogger.Info("duplicated message detected")
 				return
@@ -225,6 +253,25 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 		logger.Error(err, "failed to unmarshal queue message.")
 		return
 	}
+
+	opStartAt := time.Now()
+
+	// The deadline is kept in the message so that it's carried over when the operation is requeued.
+	if asyncReq.Deadline == nil {
+		deadline := opStartAt.Add(asyncReq.Timeout()).UTC()
+		asyncReq.Deadline = &deadline
+		data, err := json.Marshal(asyncReq)
+		if err != nil {
+			logger.Error(err, "failed to marshal queue message.")
+			return
+		}
+		message.Data = data
+	} else if !opStartAt.Before(*asyncReq.Deadline) {
+		logger.Info("Async operation has exceeded its deadline.")
+		w.completeOperation(ctx, message, newTimedOutResult(asyncReq), asyncCtrl.StorageClient())
+		return
+	}
+
 	asyncReqCtx, opCancel := context.WithCancel(ctx)
 	// Ensure that asyncReqCtx context is cancelled when runOperation returns.
 	// That is, cancelling asyncReqCtx signals to ctrl.Run() to cancel the execution,
@@ -232,7 +279,6 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 	defer opCancel()
 
 	opDone := make(chan struct{}, 1)
-	opStartAt := time.Now()
 
 	// Start new go routine to cancel and timeout async operation.
 	go func() {
@@ -252,9 +298,10 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 
 		logger.Info("Start processing operation.")
 		result, err := asyncCtrl.Run(asyncReqCtx, asyncReq)
-		// There are two cases when asyncReqCtx is canceled.
-		// 1. When the operation is timed out, w.completeOperation will be called in L186
-		// 2. When parent context is canceled or done, we need to requeue the operation to reprocess the request.
+		// There are three cases when asyncReqCtx is canceled.
+		// 1. When the operation is timed out, w.completeOperation will be called by the operation timeout handler.
+		// 2. When the operation is canceled by the user, w.completeOperation will be called by the cancellation check.
+		// 3. When parent context is canceled or done, we need to requeue the operation to reprocess the request.
 		// Such cases should not call w.completeOperation.
 		if !errors.Is(asyncReqCtx.Err(), context.Canceled) {
 			if err != nil {
@@ -267,8 +314,10 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 		trace.SetAsyncResultStatus(result, span)
 	}()
 
-	operationTimeoutAfter := time.After(asyncReq.Timeout())
+	operationTimeoutAfter := time.After(time.Until(*asyncReq.Deadline))
 	messageExtendAfter := w.getMessageExtendDuration(message.NextVisibleAt)
+	cancellationCheck := time.NewTicker(w.options.CancellationCheckInterval)
+	defer cancellationCheck.Stop()
 
 	for {
 		select {
@@ -285,10 +334,17 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 			logger.Info("Cancelling async operation.")
 
 			opCancel()
-			errMessage := fmt.Sprintf("Operation (%s) has timed out because it was processing longer than %d s.", asyncReq.OperationType, int(asyncReq.Timeout().Seconds()))
-			result := ctrl.NewCanceledResult(errMessage)
-			result.Error.Target = asyncReq.ResourceID
-			w.completeOperation(ctx, message, result, asyncCtrl.StorageClient())
+			w.completeOperation(ctx, message, newTimedOutResult(asyncReq), asyncCtrl.StorageClient())
+			return
+
+		case <-cancellationCheck.C:
+			if !w.isCanceled(ctx, asyncReq) {
+				continue
+			}
+
+			logger.Info("Cancelling async operation canceled by the user.")
+			opCancel()
+			w.completeOperation(ctx, message, newUserCanceledResult(asyncReq), asyncCtrl.StorageClient())
 			return
 
 		case <-ctx.Done():
@@ -306,6 +362,14 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 	}
 }
 
+// newTimedOutResult returns the result of the operation that did not complete before its deadline.
+func newTimedOutResult(asyncReq *ctrl.Request) ctrl.Result {
+	errMessage := fmt.Sprintf("Operation (%s) has timed out because it was processing longer than %d s.", asyncReq.OperationType, int(asyncReq.Timeout().Seconds()))
+	result := ctrl.NewCanceledResult(errMessage)
+	result.Error.Target = asyncReq.ResourceID
+	return result
+}
+
 func extractError(err error) v1.ErrorDetails {
 	if clientErr, ok := err.(*v1.ErrClientRP); ok {
 		return v1.ErrorDetails{Code: clientErr.Code, Message: clientErr.Message}
@@ -322,6 +386,22 @@ func (w *AsyncRequestProcessWorker) completeOperation(ctx context.Context, messa
 		return
 	}
 
+	// The operation may have been canceled by the user after the last cancellation check. The cancellation wins over the
+	// result of the controller so that the canceled status isn't overwritten.
+	if result.ProvisioningState() != v1.ProvisioningStateCanceled && w.isCanceled(ctx, req) {
+		logger.Info("Operation was canceled by the user before it completed.")
+		result = newUserCanceledResult(req)
+	}
+
+	if result.RequeueAfter > 0 {
+		w.requeueOperationAfter(ctx, message, result.RequeueAfter)
+		return
+	}
+
+	if result.ProvisioningState() == v1.ProvisioningStateFailed && w.retryOperation(ctx, message, req, result) {
+		return
+	}
+
 	err := w.updateResourceAndOperationStatus(ctx, sc, req, result.ProvisioningState(), result.Error)
 	if err != nil {
 		logger.Error(err, "failed to update resource and/or operation status")
@@ -338,6 +418,176 @@ func (w *AsyncRequestProcessWorker) completeOperation(ctx context.Context, messa
 	metrics.DefaultAsyncOperationMetrics.RecordAsyncOperation(ctx, req, &result)
 }
 
+// requeueOperationAfter enqueues the operation again with the delayed delivery and finishes the current message, leaving
+// the operation in progress. The operation will be run again by any worker after the given duration.
+func (w *AsyncRequestProcessWorker) requeueOperationAfter(ctx context.Context, message *queue.Message, after time.Duration) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	req := &ctrl.Request{}
+	if err := json.Unmarshal(message.Data, req); err != nil {
+		logger.Error(err, "failed to unmarshal queue message.")
+		return
+	}
+
+	// The dequeue count restarts with the new message, so the redeliveries of the current message are carried over
+	// to keep counting them towards the max attempts.
+	if message.DequeueCount > 1 {
+		req.RedeliveryCount += message.DequeueCount - 1
+	}
+
+	data, err := json.Marshal(req)
+	if err != nil {
+		logger.Error(err, "failed to marshal queue message.")
+		return
+	}
+
+	// Enqueue the new message first. If it fails, the current message will be redelivered after its lock expires.
+	if err := w.requestQueue.Enqueue(ctx, &queue.Message{ContentType: message.ContentType, Data: data}, queue.WithDelay(after)); err != nil {
+		logger.Error(err, "failed to requeue the operation")
+		return
+	}
+
+	if err := w.requestQueue.FinishMessage(ctx, message); err != nil {
+		logger.Error(err, "failed to finish the message")
+	}
+
+	logger.Info("Operation will be run again.", "requeueAfter", after.String())
+}
+
+// retryOperation enqueues the failed operation again with the backoff of its retry policy and records the failed attempt on
+// the operation status, leaving the operation in progress. It returns false if the retry policy does not allow the retry.
+func (w *AsyncRequestProcessWorker) retryOperation(ctx context.Context, message *queue.Message, req *ctrl.Request, result ctrl.Result) bool {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	opType, ok := v1.ParseOperationType(req.OperationType)
+	if !ok {
+		return false
+	}
+
+	// The operations which ask to be requeued are retried with the backoff even if their error is not retryable,
+	// instead of waiting for the message lock to expire.
+	policy := w.retryPolicy(opType)
+	attempt := req.Attempt(message.DequeueCount)
+	if (!result.Requeue && !policy.IsRetryable(result.Error)) || attempt >= policy.MaxAttempts {
+		return false
+	}
+
+	rID, err := resources.ParseResource(req.ResourceID)
+	if err != nil {
+		logger.Error(err, "failed to parse resource ID")
+		return false
+	}
+
+	backoff := policy.Backoff(req.RetryCount + 1)
+	retry := *req
+	retry.RetryCount++
+	// Each retry by the retry policy gets its own timeout.
+	retry.Deadline = nil
+	// The dequeue count restarts with the new message, so the redeliveries of the current message are carried over
+	// to keep counting them towards the max attempts.
+	if message.DequeueCount > 1 {
+		retry.RedeliveryCount += message.DequeueCount - 1
+	}
+	if err := w.requestQueue.Enqueue(ctx, queue.NewMessage(&retry), queue.WithDelay(backoff)); err != nil {
+		logger.Error(err, "failed to retry the operation")
+		return false
+	}
+
+	// The attempt history is informational, so the retry goes ahead even if it can't be recorded.
+	now := time.Now().UTC()
+	err = w.sm.RecordAttempt(ctx, rID, req.OperationID, v1.OperationAttempt{
+		Attempt:         attempt,
+		EndTime:         now,
+		Error:           result.Error,
+		NextAttemptTime: now.Add(backoff),
+	})
+	if err != nil {
+		logger.Error(err, "failed to record the failed attempt", "operationID", req.OperationID.String())
+	}
+
+	if err := w.requestQueue.FinishMessage(ctx, message); err != nil {
+		logger.Error(err, "failed to finish the message")
+	}
+
+	logger.Info("Operation will be retried.", "attempt", attempt, "backoff", backoff.String())
+	return true
+}
+
+// retryPolicy returns the retry policy of the operation type with the defaults of the worker applied.
+func (w *AsyncRequestProcessWorker) retryPolicy(operationType v1.OperationType) RetryPolicy {
+	policy := RetryPolicy{}
+	if w.registry != nil {
+		policy = w.registry.GetRetryPolicy(operationType)
+	}
+	return policy.withDefaults(w.options.MaxOperationRetryCount)
+}
+
+// deadLetterOperation marks the operation as failed and moves the message to the dead-letter queue with the error of
+// the result so that it can be inspected, requeued or purged later.
+func (w *AsyncRequestProcessWorker) deadLetterOperation(ctx context.Context, message *queue.Message, result ctrl.Result, sc store.StorageClient) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	req := &ctrl.Request{}
+	if err := json.Unmarshal(message.Data, req); err != nil {
+		logger.Error(err, "failed to unmarshal queue message.")
+		return
+	}
+
+	err := w.updateResourceAndOperationStatus(ctx, sc, req, result.ProvisioningState(), result.Error)
+	if err != nil {
+		logger.Error(err, "failed to update resource and/or operation status")
+		return
+	}
+
+	lastError := ""
+	if result.Error != nil {
+		lastError = result.Error.Message
+	}
+
+	if err := w.requestQueue.DeadLetterMessage(ctx, message, lastError); err != nil {
+		logger.Error(err, "failed to move the message to the dead-letter queue")
+	}
+
+	metrics.DefaultAsyncOperationMetrics.RecordAsyncOperation(ctx, req, &result)
+}
+
+// restartRequeuedOperation starts the operation of the message requeued from the dead-letter queue over. The attempts
+// and the deadline of the operation are reset, and the failed status which was written when the message was
+// dead-lettered is set back to accepted, so that the operation isn't detected as a duplicate and is run again.
+func (w *AsyncRequestProcessWorker) restartRequeuedOperation(ctx context.Context, message *queue.Message, req *ctrl.Request, sc store.StorageClient) error {
+	req.RetryCount = 0
+	req.RedeliveryCount = 0
+	req.Deadline = nil
+	data, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	message.Data = data
+
+	rID, err := resources.ParseResource(req.ResourceID)
+	if err != nil {
+		return err
+	}
+
+	status, err := w.sm.Get(ctx, rID, req.OperationID)
+	if err != nil {
+		return err
+	}
+
+	// The status was already restarted if it was updated after the message was requeued, e.g. when the message is
+	// redelivered after its lock expired.
+	if !status.Status.IsTerminal() || !status.LastUpdatedTime.Before(message.RequeuedAt) {
+		return nil
+	}
+
+	opType, _ := v1.ParseOperationType(req.OperationType)
+	err = updateResourceState(ctx, sc, rID.String(), v1.ProvisioningStateAccepted)
+	if err != nil && !(opType.Method == http.MethodDelete && errors.Is(&store.ErrNotFound{ID: rID.String()}, err)) {
+		return err
+	}
+
+	return w.sm.Restart(ctx, rID, req.OperationID)
+}
+
 func (w *AsyncRequestProcessWorker) updateResourceAndOperationStatus(ctx context.Context, sc store.StorageClient, req *ctrl.Request, state v1.ProvisioningState, opErr *v1.ErrorDetails) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
@@ -366,15 +616,15 @@ func (w *AsyncRequestProcessWorker) updateResourceAndOperationStatus(ctx context
 	return nil
 }
 
-func (w *AsyncRequestProcessWorker) isDuplicated(ctx context.Context, sc store.StorageClient, resourceID string, operationID uuid.UUID) (bool, error) {
+func (w *AsyncRequestProcessWorker) isDuplicated(ctx context.Context, sc store.StorageClient, resourceID string, operationID uuid.UUID) (*manager.Status, bool, error) {
 	rID, err := resources.ParseResource(resourceID)
 	if err != nil {
-		return false, err
+		return nil, false, err
 	}
 
 	status, err := w.sm.Get(ctx, rID, operationID)
 	if err != nil {
-		return false, err
+		return nil, false, err
 	}
 
 	// 1. If the operation is in updating state and the last updated time is within the deduplication duration, we consider it as a duplicated operation.
@@ -382,10 +632,37 @@ func (w *AsyncRequestProcessWorker) isDuplicated(ctx context.Context, sc store.S
 	if (status.Status == v1.ProvisioningStateUpdating && status.LastUpdatedTime.IsZero() &&
 		status.LastUpdatedTime.Add(w.options.DeduplicationDuration).After(time.Now().UTC())) ||
 		status.Status.IsTerminal() {
-		return true, nil
+		return status, true, nil
 	}
 
-	return false, nil
+	return status, false, nil
+}
+
+// isCanceled returns true if the operation status is marked as canceled by the user. The status is checked again on
+// the next interval when it can't be retrieved.
+func (w *AsyncRequestProcessWorker) isCanceled(ctx context.Context, req *ctrl.Request) bool {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	rID, err := resources.ParseResource(req.ResourceID)
+	if err != nil {
+		logger.Error(err, "failed to parse resource ID")
+		return false
+	}
+
+	status, err := w.sm.Get(ctx, rID, req.OperationID)
+	if err != nil {
+		logger.Error(err, "failed to get operation status", "operationID", req.OperationID.String())
+		return false
+	}
+
+	return status.Status == v1.ProvisioningStateCanceled
+}
+
+// newUserCanceledResult returns the result of the operation canceled by the user.
+func newUserCanceledResult(req *ctrl.Request) ctrl.Result {
+	result := ctrl.NewCanceledResult(operationCanceledMessage)
+	result.Error.Target = req.ResourceID
+	return result
 }
 
 func (w *AsyncRequestProcessWorker) getMessageExtendDuration(visibleAt time.Time) time.Duration {
@@ -18,9 +18,11 @@ package worker
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -265,6 +267,121 @@ func TestStart_MaxDequeueCount(t *testing.T) {
 	<-done
 
 	require.Equal(t, expectedDequeueCount+2, testMessage.DequeueCount)
+
+	// The message must be moved to the dead-letter queue.
+	deadLetters, err := tCtx.testQueue.ListDeadLetterMessages(tCtx.ctx)
+	require.NoError(t, err)
+	require.Len(t, deadLetters, 1)
+	require.Equal(t, testMessage.ID, deadLetters[0].ID)
+	require.Contains(t, deadLetters[0].LastError, "exceeded max retry count")
+}
+
+func TestStart_RequeueDeadLetter(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, 1*time.Minute)
+	defer mctrl.Finish()
+
+	// The status manager keeps the status of the operation so that the requeued operation sees the failed status
+	// written when the message was dead-lettered.
+	var mu sync.Mutex
+	status := &manager.Status{AsyncOperationStatus: v1.AsyncOperationStatus{Status: v1.ProvisioningStateAccepted}}
+	getStatus := func() *manager.Status {
+		mu.Lock()
+		defer mu.Unlock()
+		copied := *status
+		return &copied
+	}
+	setStatus := func(state v1.ProvisioningState) {
+		mu.Lock()
+		defer mu.Unlock()
+		status.Status = state
+		status.LastUpdatedTime = time.Now().UTC()
+	}
+
+	tCtx.mockSC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+			return newTestResourceObject(), nil
+		}).AnyTimes()
+	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id resources.ID, operationID uuid.UUID) (*manager.Status, error) {
+			return getStatus(), nil
+		}).AnyTimes()
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails) error {
+			setStatus(state)
+			return nil
+		}).AnyTimes()
+	tCtx.mockSM.EXPECT().Restart(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id resources.ID, operationID uuid.UUID) error {
+			setStatus(v1.ProvisioningStateAccepted)
+			return nil
+		}).Times(1)
+	tCtx.mockSP.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(store.StorageClient(tCtx.mockSC), nil).AnyTimes()
+
+	registry := NewControllerRegistry(tCtx.mockSP)
+	worker := New(Options{MaxOperationRetryCount: 1, DequeueIntervalDuration: defaultTestDequeueInterval}, tCtx.mockSM, tCtx.testQueue, registry)
+
+	called := make(chan bool, 1)
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(ctrl.Options{StorageClient: tCtx.mockSC}),
+		fn: func(ctx context.Context) (ctrl.Result, error) {
+			called <- true
+			return ctrl.Result{}, nil
+		},
+	}
+
+	ctx, cancel := tCtx.cancellable(0)
+	err := registry.Register(
+		ctx,
+		testResourceType, v1.OperationPut,
+		func(opts ctrl.Options) (ctrl.Controller, error) {
+			return testCtrl, nil
+		}, ctrl.Options{
+			DataProvider: tCtx.mockSP,
+		})
+	require.NoError(t, err)
+
+	// Queue the operation which exceeds the max attempts on its next dequeue.
+	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
+	err = tCtx.testQueue.Enqueue(ctx, testMessage)
+	require.NoError(t, err)
+	testMessage.DequeueCount = 1
+
+	done := make(chan struct{}, 1)
+	go func() {
+		err = worker.Start(ctx)
+		require.NoError(t, err)
+		close(done)
+	}()
+
+	tCtx.drainQueueOrAssert(t)
+
+	deadLetters, err := tCtx.testQueue.ListDeadLetterMessages(ctx)
+	require.NoError(t, err)
+	require.Len(t, deadLetters, 1)
+	require.Equal(t, v1.ProvisioningStateFailed, getStatus().Status)
+	require.Empty(t, called)
+
+	// Requeue the dead-lettered message. The operation must be run again.
+	err = tCtx.testQueue.RequeueDeadLetterMessage(ctx, deadLetters[0].ID)
+	require.NoError(t, err)
+
+	select {
+	case <-called:
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "the requeued operation was not run")
+	}
+
+	tCtx.drainQueueOrAssert(t)
+
+	// Cancelling worker loop
+	cancel()
+	<-done
+
+	require.Equal(t, v1.ProvisioningStateSucceeded, getStatus().Status)
+	deadLetters, err = tCtx.testQueue.ListDeadLetterMessages(tCtx.ctx)
+	require.NoError(t, err)
+	require.Empty(t, deadLetters)
 }
 
 func TestStart_MaxConcurrency(t *testing.T) {
@@ -418,6 +535,72 @@ func TestStart_RunOperation(t *testing.T) {
 	require.Equal(t, 1, testMessage.DequeueCount)
 }
 
+func TestStart_CanceledOperation(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	canceledStatus := &manager.Status{
+		AsyncOperationStatus: v1.AsyncOperationStatus{
+			Status:    v1.ProvisioningStateCanceled,
+			StartTime: time.Now().UTC(),
+		},
+	}
+
+	// set up mocks
+	tCtx.mockSC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+			return newTestResourceObject(), nil
+		}).AnyTimes()
+	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(canceledStatus, nil).Times(1)
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq(v1.ProvisioningStateCanceled), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	tCtx.mockSP.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(store.StorageClient(tCtx.mockSC), nil).Times(1)
+
+	registry := NewControllerRegistry(tCtx.mockSP)
+	worker := New(Options{DequeueIntervalDuration: defaultTestDequeueInterval}, tCtx.mockSM, tCtx.testQueue, registry)
+
+	called := false
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(ctrl.Options{StorageClient: tCtx.mockSC}),
+		fn: func(ctx context.Context) (ctrl.Result, error) {
+			called = true
+			return ctrl.Result{}, nil
+		},
+	}
+
+	ctx, cancel := tCtx.cancellable(0)
+	err := registry.Register(
+		ctx,
+		testResourceType, v1.OperationPut,
+		func(opts ctrl.Options) (ctrl.Controller, error) {
+			return testCtrl, nil
+		}, ctrl.Options{
+			DataProvider: tCtx.mockSP,
+		})
+	require.NoError(t, err)
+
+	done := make(chan struct{}, 1)
+	go func() {
+		err = worker.Start(ctx)
+		require.NoError(t, err)
+		close(done)
+	}()
+
+	// Queue the operation canceled before it is processed.
+	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
+	err = tCtx.testQueue.Enqueue(ctx, testMessage)
+	require.NoError(t, err)
+
+	tCtx.drainQueueOrAssert(t)
+
+	// Cancelling worker loop
+	cancel()
+	<-done
+
+	require.Equal(t, 1, testMessage.DequeueCount)
+	require.False(t, called)
+}
+
 func TestRunOperation_Successfully(t *testing.T) {
 	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
 	defer mctrl.Finish()
@@ -428,6 +611,7 @@ func TestRunOperation_Successfully(t *testing.T) {
 			return newTestResourceObject(), nil
 		}).AnyTimes()
 	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(testOperationStatus, nil).AnyTimes()
 	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
@@ -457,6 +641,241 @@ func TestRunOperation_Successfully(t *testing.T) {
 	require.Equal(t, 0, tCtx.internalQ.Len(), "message is finished")
 }
 
+func TestRunOperation_RequeueAfter(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(testOperationStatus, nil).AnyTimes()
+
+	// The operation must not be completed when the controller asks to run it again.
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
+	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
+	require.NoError(t, err)
+	worker := New(Options{}, tCtx.mockSM, tCtx.testQueue, nil)
+
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(ctrl.Options{StorageClient: tCtx.mockSC}),
+		fn: func(ctx context.Context) (ctrl.Result, error) {
+			return ctrl.NewRequeueAfterResult(50 * time.Millisecond), nil
+		},
+	}
+
+	msg, err := tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+	require.NoError(t, err)
+	worker.runOperation(context.Background(), msg, testCtrl)
+
+	// Ensure that the message is finished and the operation is requeued with the delay.
+	require.Equal(t, 1, tCtx.internalQ.Len())
+	_, err = tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+	require.ErrorIs(t, err, queue.ErrMessageNotFound)
+
+	time.Sleep(100 * time.Millisecond)
+
+	requeued, err := tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+	require.NoError(t, err)
+	require.NotEqual(t, msg.ID, requeued.ID)
+	require.Equal(t, 1, requeued.DequeueCount)
+
+	// The requeued operation keeps the deadline of the first run.
+	original := &ctrl.Request{}
+	err = json.Unmarshal(testMessage.Data, original)
+	require.NoError(t, err)
+	requeuedReq := &ctrl.Request{}
+	err = json.Unmarshal(requeued.Data, requeuedReq)
+	require.NoError(t, err)
+	require.Equal(t, original.OperationID, requeuedReq.OperationID)
+	require.NotNil(t, requeuedReq.Deadline)
+	require.WithinDuration(t, time.Now().Add(ctrl.DefaultAsyncOperationTimeout), *requeuedReq.Deadline, 5*time.Second)
+	require.Zero(t, requeuedReq.RedeliveryCount)
+}
+
+func TestRunOperation_RequeueAfter_Timeout(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	tCtx.mockSC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+			return newTestResourceObject(), nil
+		}).AnyTimes()
+	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(testOperationStatus, nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), v1.ProvisioningStateCanceled, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ resources.ID, _ uuid.UUID, _ v1.ProvisioningState, _ *time.Time, opError *v1.ErrorDetails) error {
+			require.True(t, strings.HasPrefix(opError.Message, "Operation (APPLICATIONS.CORE/ENVIRONMENTS|PUT) has timed out"))
+			return nil
+		}).Times(1)
+
+	// Each run is shorter than the timeout, but the operation is requeued until the timeout is exceeded.
+	testMessage := genTestMessage(uuid.New(), 200*time.Millisecond)
+	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
+	require.NoError(t, err)
+	worker := New(Options{}, tCtx.mockSM, tCtx.testQueue, nil)
+
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(ctrl.Options{StorageClient: tCtx.mockSC}),
+		fn: func(ctx context.Context) (ctrl.Result, error) {
+			return ctrl.NewRequeueAfterResult(20 * time.Millisecond), nil
+		},
+	}
+
+	runs := 0
+	for startAt := time.Now(); tCtx.internalQ.Len() > 0; {
+		require.Less(t, time.Since(startAt), 5*time.Second, "operation did not time out")
+
+		msg, err := tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+		if errors.Is(err, queue.ErrMessageNotFound) {
+			time.Sleep(5 * time.Millisecond)
+			continue
+		}
+		require.NoError(t, err)
+
+		worker.runOperation(context.Background(), msg, testCtrl)
+		runs++
+	}
+
+	require.Greater(t, runs, 1)
+}
+
+func TestRunOperation_RequeueAfter_RedeliveryCount(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(testOperationStatus, nil).AnyTimes()
+
+	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
+	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
+	require.NoError(t, err)
+	worker := New(Options{}, tCtx.mockSM, tCtx.testQueue, nil)
+
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(ctrl.Options{StorageClient: tCtx.mockSC}),
+		fn: func(ctx context.Context) (ctrl.Result, error) {
+			return ctrl.NewRequeueAfterResult(time.Millisecond), nil
+		},
+	}
+
+	msg, err := tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+	require.NoError(t, err)
+
+	// The message was redelivered twice after its lock expired before this run.
+	msg.DequeueCount = 3
+	worker.runOperation(context.Background(), msg, testCtrl)
+
+	time.Sleep(10 * time.Millisecond)
+	requeued, err := tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+	require.NoError(t, err)
+
+	requeuedReq := &ctrl.Request{}
+	err = json.Unmarshal(requeued.Data, requeuedReq)
+	require.NoError(t, err)
+	require.Equal(t, 2, requeuedReq.RedeliveryCount)
+	require.Equal(t, 3, requeuedReq.Attempt(requeued.DequeueCount))
+}
+
+func TestRunOperation_RetryPolicy(t *testing.T) {
+	tests := []struct {
+		name         string
+		maxAttempts  int
+		dequeueCount int
+		requeue      bool
+		retried      bool
+	}{
+		{name: "retryable error is retried", maxAttempts: 3, dequeueCount: 1, retried: true},
+		{name: "retryable error exceeding max attempts fails", maxAttempts: 1, dequeueCount: 1, retried: false},
+		{name: "requeued failure is retried", maxAttempts: 3, dequeueCount: 1, requeue: true, retried: true},
+		{name: "redelivered message is retried", maxAttempts: 5, dequeueCount: 3, retried: true},
+		{name: "redelivered message exceeding max attempts fails", maxAttempts: 3, dequeueCount: 3, retried: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+			defer mctrl.Finish()
+
+			tCtx.mockSP.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(store.StorageClient(tCtx.mockSC), nil).Times(1)
+			tCtx.mockSC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+					return newTestResourceObject(), nil
+				}).AnyTimes()
+			tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(testOperationStatus, nil).AnyTimes()
+
+			opID := uuid.New()
+			if tt.retried {
+				tCtx.mockSM.EXPECT().RecordAttempt(gomock.Any(), gomock.Any(), opID, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, id resources.ID, operationID uuid.UUID, attempt v1.OperationAttempt) error {
+						require.Equal(t, tt.dequeueCount, attempt.Attempt)
+						if tt.requeue {
+							require.Equal(t, v1.CodeInternal, attempt.Error.Code)
+						} else {
+							require.Equal(t, v1.CodeConflict, attempt.Error.Code)
+						}
+						require.True(t, attempt.NextAttemptTime.After(attempt.EndTime))
+						return nil
+					}).Times(1)
+			} else {
+				tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), opID, v1.ProvisioningStateFailed, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			}
+
+			registry := NewControllerRegistry(tCtx.mockSP)
+			testCtrl := &testAsyncController{
+				BaseController: ctrl.NewBaseAsyncController(ctrl.Options{StorageClient: tCtx.mockSC}),
+				fn: func(ctx context.Context) (ctrl.Result, error) {
+					if tt.requeue {
+						// The error is not retryable, but the controller asks to requeue the operation.
+						result := ctrl.Result{}
+						result.SetFailed(v1.ErrorDetails{Code: v1.CodeInternal, Message: "internal"}, true)
+						return result, nil
+					}
+					return ctrl.Result{}, &v1.ErrClientRP{Code: v1.CodeConflict, Message: "conflict"}
+				},
+			}
+			policy := RetryPolicy{
+				MaxAttempts:         tt.maxAttempts,
+				InitialBackoff:      50 * time.Millisecond,
+				RetryableErrorCodes: []string{v1.CodeConflict},
+			}
+			err := registry.RegisterWithRetryPolicy(tCtx.ctx, "Applications.Core/environments", v1.OperationPut, func(opts ctrl.Options) (ctrl.Controller, error) {
+				return testCtrl, nil
+			}, ctrl.Options{}, policy)
+			require.NoError(t, err)
+
+			worker := New(Options{}, tCtx.mockSM, tCtx.testQueue, registry)
+
+			err = tCtx.testQueue.Enqueue(tCtx.ctx, genTestMessage(opID, ctrl.DefaultAsyncOperationTimeout))
+			require.NoError(t, err)
+			msg, err := tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+			require.NoError(t, err)
+			msg.DequeueCount = tt.dequeueCount
+			worker.runOperation(context.Background(), msg, testCtrl)
+
+			if !tt.retried {
+				require.Equal(t, 0, tCtx.internalQ.Len(), "message is finished")
+				return
+			}
+
+			// The retry is delivered after the backoff.
+			require.Equal(t, 1, tCtx.internalQ.Len())
+			_, err = tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+			require.ErrorIs(t, err, queue.ErrMessageNotFound)
+
+			time.Sleep(100 * time.Millisecond)
+
+			retried, err := tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+			require.NoError(t, err)
+			req := &ctrl.Request{}
+			err = json.Unmarshal(retried.Data, req)
+			require.NoError(t, err)
+			require.Equal(t, opID, req.OperationID)
+			require.Equal(t, 1, req.RetryCount)
+			require.Equal(t, tt.dequeueCount-1, req.RedeliveryCount)
+			require.Equal(t, tt.dequeueCount+1, req.Attempt(retried.DequeueCount))
+		})
+	}
+}
+
 func TestRunOperation_ExtendMessageLock(t *testing.T) {
 	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
 	defer mctrl.Finish()
@@ -467,6 +886,7 @@ func TestRunOperation_ExtendMessageLock(t *testing.T) {
 			return newTestResourceObject(), nil
 		}).AnyTimes()
 	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(testOperationStatus, nil).AnyTimes()
 	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
@@ -595,6 +1015,109 @@ func TestRunOperation_Timeout(t *testing.T) {
 	require.Equal(t, 0, tCtx.internalQ.Len(), "message is finished")
 }
 
+func TestRunOperation_CanceledByUser(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	canceledStatus := &manager.Status{
+		AsyncOperationStatus: v1.AsyncOperationStatus{
+			Status:    v1.ProvisioningStateCanceled,
+			StartTime: time.Now().UTC(),
+		},
+	}
+
+	// set up mocks
+	tCtx.mockSC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+			return newTestResourceObject(), nil
+		}).AnyTimes()
+	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(canceledStatus, nil).MinTimes(1)
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ resources.ID, _ uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails) error {
+			require.Equal(t, v1.ProvisioningStateCanceled, state)
+			require.NotNil(t, endTime)
+			require.Equal(t, v1.CodeOperationCanceled, opError.Code)
+			require.Equal(t, operationCanceledMessage, opError.Message)
+			return nil
+		}).Times(1)
+
+	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
+	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
+	require.NoError(t, err)
+	worker := New(Options{CancellationCheckInterval: 10 * time.Millisecond}, tCtx.mockSM, tCtx.testQueue, nil)
+
+	opts := ctrl.Options{
+		StorageClient: tCtx.mockSC,
+		DataProvider:  tCtx.mockSP,
+		GetDeploymentProcessor: func() deployment.DeploymentProcessor {
+			return deployment.NewMockDeploymentProcessor(mctrl)
+		},
+	}
+
+	done := make(chan struct{}, 1)
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(opts),
+		fn: func(ctx context.Context) (ctrl.Result, error) {
+			<-ctx.Done()
+			close(done)
+			return ctrl.Result{}, nil
+		},
+	}
+
+	msg, err := tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+	require.NoError(t, err)
+	worker.runOperation(context.Background(), msg, testCtrl)
+	<-done
+
+	require.Equal(t, 0, tCtx.internalQ.Len(), "message is finished")
+}
+
+func TestRunOperation_CanceledByUserBeforeCompletion(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	canceledStatus := &manager.Status{
+		AsyncOperationStatus: v1.AsyncOperationStatus{
+			Status:    v1.ProvisioningStateCanceled,
+			StartTime: time.Now().UTC(),
+		},
+	}
+
+	// set up mocks
+	tCtx.mockSC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+			return newTestResourceObject(), nil
+		}).AnyTimes()
+	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(canceledStatus, nil).Times(1)
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ resources.ID, _ uuid.UUID, state v1.ProvisioningState, _ *time.Time, opError *v1.ErrorDetails) error {
+			require.Equal(t, v1.ProvisioningStateCanceled, state)
+			require.Equal(t, v1.CodeOperationCanceled, opError.Code)
+			return nil
+		}).Times(1)
+
+	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
+	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
+	require.NoError(t, err)
+	// The operation completes before the first cancellation check.
+	worker := New(Options{CancellationCheckInterval: time.Hour}, tCtx.mockSM, tCtx.testQueue, nil)
+
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(ctrl.Options{StorageClient: tCtx.mockSC}),
+		fn: func(ctx context.Context) (ctrl.Result, error) {
+			return ctrl.Result{}, nil
+		},
+	}
+
+	msg, err := tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+	require.NoError(t, err)
+	worker.runOperation(context.Background(), msg, testCtrl)
+
+	require.Equal(t, 0, tCtx.internalQ.Len(), "message is finished")
+}
+
 func TestRunOperation_PanicController(t *testing.T) {
 	tCtx, _ := newTestContext(t, defaultTestLockTime)
 
@@ -41,7 +41,7 @@ type Builder struct {
 }
 
 // defaultHandlerOptions returns HandlerOption for the default operations such as getting operationStatuses and
-// operationResults.
+// operationResults, and canceling operations.
 func defaultHandlerOptions(
 	ctx context.Context,
 	rootRouter chi.Router,
@@ -75,6 +75,14 @@ func defaultHandlerOptions(
 		ControllerFactory: defaultoperation.NewGetOperationStatus,
 	})
 
+	handlers = append(handlers, server.HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              fmt.Sprintf("%s/providers/%s/locations/{location}/operationstatuses/{operationId}/cancel", rootScopePath, namespace),
+		ResourceType:      statusType,
+		Method:            v1.OperationCancel,
+		ControllerFactory: defaultoperation.NewCancelOperation,
+	})
+
 	handlers = append(handlers, server.HandlerOptions{
 		ParentRouter:      rootRouter,
 		Path:              fmt.Sprintf("%s/providers/%s/locations/{location}/operationresults/{operationId}", rootScopePath, namespace),
@@ -174,7 +182,7 @@ func (b *Builder) ApplyAsyncHandler(ctx context.Context, registry *worker.Contro
 		}
 
 		if h.AsyncController != nil {
-			err := registry.Register(ctx, h.ResourceType, h.Method, h.AsyncController, ctrlOpts)
+			err := registry.RegisterWithRetryPolicy(ctx, h.ResourceType, h.Method, h.AsyncController, ctrlOpts, h.AsyncRetryPolicy)
 			if err != nil {
 				return err
 			}
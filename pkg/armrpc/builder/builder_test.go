@@ -98,6 +98,14 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: "Applications.Compute/containers", Method: "ACTIONGETRESOURCE"},
 		Path:          "/resourcegroups/testrg/providers/applications.compute/containers/container0/getresource",
 		Method:        http.MethodPost,
+	}, {
+		OperationType: v1.OperationType{Type: "Applications.Compute/containers/operations", Method: v1.OperationList},
+		Path:          "/resourcegroups/testrg/providers/applications.compute/containers/container0/operations",
+		Method:        http.MethodGet,
+	}, {
+		OperationType: v1.OperationType{Type: "Applications.Compute/containers/operations", Method: v1.OperationGet},
+		Path:          "/resourcegroups/testrg/providers/applications.compute/containers/container0/operations/00000000-0000-0000-0000-000000000001",
+		Method:        http.MethodGet,
 	},
 	// applications.compute/containers/secrets
 	{
@@ -120,6 +128,10 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: "Applications.Compute/containers/secrets", Method: v1.OperationDelete},
 		Path:          "/resourcegroups/testrg/providers/applications.compute/containers/container0/secrets/secret0",
 		Method:        http.MethodDelete,
+	}, {
+		OperationType: v1.OperationType{Type: "Applications.Compute/containers/secrets/operations", Method: v1.OperationList},
+		Path:          "/resourcegroups/testrg/providers/applications.compute/containers/container0/secrets/secret0/operations",
+		Method:        http.MethodGet,
 	},
 	// applications.compute/webassemblies
 	{
@@ -160,6 +172,10 @@ var defaultHandlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: "Applications.Compute/operationStatuses", Method: v1.OperationGet},
 		Path:          "/providers/applications.compute/locations/global/operationstatuses/00000000-0000-0000-0000-000000000000",
 		Method:        http.MethodGet,
+	}, {
+		OperationType: v1.OperationType{Type: "Applications.Compute/operationStatuses", Method: v1.OperationCancel},
+		Path:          "/providers/applications.compute/locations/global/operationstatuses/00000000-0000-0000-0000-000000000000/cancel",
+		Method:        http.MethodPost,
 	}, {
 		OperationType: v1.OperationType{Type: "Applications.Compute/operationResults", Method: v1.OperationGet},
 		Path:          "/providers/applications.compute/locations/global/operationresults/00000000-0000-0000-0000-000000000000",
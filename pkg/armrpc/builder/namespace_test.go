@@ -249,6 +249,18 @@ func TestNamespaceBuild(t *testing.T) {
 			path:                "/stop",
 			method:              "ACTIONSTOP",
 		},
+		{
+			resourceType:        "Applications.Compute/virtualMachines/operations",
+			resourceNamePattern: "applications.compute/virtualmachines/{virtualMachineName}/operations",
+			path:                "",
+			method:              "LIST",
+		},
+		{
+			resourceType:        "Applications.Compute/virtualMachines/operations",
+			resourceNamePattern: "applications.compute/virtualmachines/{virtualMachineName}/operations/{operationId}",
+			path:                "",
+			method:              "GET",
+		},
 		{
 			resourceType:        "Applications.Compute/virtualMachines/networks",
 			resourceNamePattern: "applications.compute/virtualmachines/{virtualMachineName}/networks",
@@ -285,6 +297,12 @@ func TestNamespaceBuild(t *testing.T) {
 			path:                "/connect",
 			method:              "ACTIONCONNECT",
 		},
+		{
+			resourceType:        "Applications.Compute/virtualMachines/networks/operations",
+			resourceNamePattern: "applications.compute/virtualmachines/{virtualMachineName}/networks/{networkName}/operations",
+			path:                "",
+			method:              "LIST",
+		},
 		{
 			resourceType:        "Applications.Compute/virtualMachines/disks",
 			resourceNamePattern: "applications.compute/virtualmachines/{virtualMachineName}/disks",
@@ -22,6 +22,7 @@ import (
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	asyncctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
 	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/worker"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
@@ -55,6 +56,10 @@ type Operation[T any] struct {
 	// If this is 0 then the default value of v1.DefaultRetryAfter will be used. Consider setting this to a smaller
 	// value like 5 seconds if your operations will complete quickly.
 	AsyncOperationRetryAfter time.Duration
+
+	// AsyncRetryPolicy is the retry policy of the async job worker when the async operation fails. By default,
+	// failed operations are not retried.
+	AsyncRetryPolicy worker.RetryPolicy
 }
 
 // ResourceOption is the option for ResourceNode. It defines model converters for request and response
@@ -136,6 +141,8 @@ func (r *ResourceOption[P, T]) BuildHandlerOutputs(opts BuildOptions) []*Operati
 		}
 	}
 
+	hs = append(hs, r.operationHistoryOutputs(opts)...)
+
 	return append(hs, r.customActionOutputs(opts)...)
 }
 
@@ -266,6 +273,7 @@ func (r *ResourceOption[P, T]) putOutput(opts BuildOptions) *OperationRegistrati
 		}
 	}
 	h.AsyncController = r.Put.AsyncJobController
+	h.AsyncRetryPolicy = r.Put.AsyncRetryPolicy
 
 	return h
 }
@@ -280,6 +288,7 @@ func (r *ResourceOption[P, T]) patchOutput(opts BuildOptions) *OperationRegistra
 		ResourceNamePattern: opts.ResourceNamePattern + "/" + opts.ParameterName,
 		Method:              v1.OperationPatch,
 		AsyncController:     r.Patch.AsyncJobController,
+		AsyncRetryPolicy:    r.Patch.AsyncRetryPolicy,
 	}
 
 	if r.Patch.APIController != nil {
@@ -317,6 +326,7 @@ func (r *ResourceOption[P, T]) deleteOutput(opts BuildOptions) *OperationRegistr
 		ResourceNamePattern: opts.ResourceNamePattern + "/" + opts.ParameterName,
 		Method:              v1.OperationDelete,
 		AsyncController:     r.Delete.AsyncJobController,
+		AsyncRetryPolicy:    r.Delete.AsyncRetryPolicy,
 	}
 
 	if r.Delete.APIController != nil {
@@ -344,6 +354,28 @@ func (r *ResourceOption[P, T]) deleteOutput(opts BuildOptions) *OperationRegistr
 	return h
 }
 
+// operationHistoryOutputs registers the operation history of the resource as the nested "operations" resource type,
+// which is available for every resource type.
+func (r *ResourceOption[P, T]) operationHistoryOutputs(opts BuildOptions) []*OperationRegistration {
+	historyType := opts.ResourceType + "/" + manager.OperationHistoryTypeName
+	historyPattern := opts.ResourceNamePattern + "/" + opts.ParameterName + "/" + manager.OperationHistoryTypeName
+
+	return []*OperationRegistration{
+		{
+			ResourceType:        historyType,
+			ResourceNamePattern: historyPattern,
+			Method:              v1.OperationList,
+			APIController:       defaultoperation.NewListOperationHistory,
+		},
+		{
+			ResourceType:        historyType,
+			ResourceNamePattern: historyPattern + "/{operationId}",
+			Method:              v1.OperationGet,
+			APIController:       defaultoperation.NewGetOperationHistory,
+		},
+	}
+}
+
 func (r *ResourceOption[P, T]) customActionOutputs(opts BuildOptions) []*OperationRegistration {
 	handlers := []*OperationRegistration{}
 
@@ -359,6 +391,7 @@ func (r *ResourceOption[P, T]) customActionOutputs(opts BuildOptions) []*Operati
 			Method:              v1.OperationMethod(customActionPrefix + strings.ToUpper(name)),
 			APIController:       handle.APIController,
 			AsyncController:     handle.AsyncJobController,
+			AsyncRetryPolicy:    handle.AsyncRetryPolicy,
 		}
 		handlers = append(handlers, h)
 	}
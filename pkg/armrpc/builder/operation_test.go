@@ -453,6 +453,35 @@ func TestResourceOption_DeleteOutput(t *testing.T) {
 	})
 }
 
+func TestResourceOption_OperationHistoryOutputs(t *testing.T) {
+	option := &ResourceOption[*rpctest.TestResourceDataModel, rpctest.TestResourceDataModel]{
+		linkedNode: &ResourceNode{Name: "virtualMachines", Kind: TrackedResourceKind},
+	}
+
+	hs := option.operationHistoryOutputs(testBuildOptionsWithName)
+	require.Len(t, hs, 2)
+
+	require.NotNil(t, hs[0].APIController)
+	require.NotNil(t, hs[1].APIController)
+
+	// Reset APIController to nil for comparison
+	hs[0].APIController = nil
+	hs[1].APIController = nil
+
+	require.Equal(t, []*OperationRegistration{
+		{
+			ResourceType:        "Applications.Compute/virtualMachines/operations",
+			ResourceNamePattern: "applications.compute/virtualmachines/{virtualMachineName}/operations",
+			Method:              v1.OperationList,
+		},
+		{
+			ResourceType:        "Applications.Compute/virtualMachines/operations",
+			ResourceNamePattern: "applications.compute/virtualmachines/{virtualMachineName}/operations/{operationId}",
+			Method:              v1.OperationGet,
+		},
+	}, hs)
+}
+
 func TestResourceOption_CustomActionOutput(t *testing.T) {
 	node := &ResourceNode{Name: "virtualMachines", Kind: TrackedResourceKind}
 	t.Run("valid custom action", func(t *testing.T) {
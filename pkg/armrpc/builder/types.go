@@ -79,4 +79,7 @@ type OperationRegistration struct {
 
 	// AsyncController represents the async controller handler.
 	AsyncController worker.ControllerFactoryFunc
+
+	// AsyncRetryPolicy represents the retry policy of the async controller.
+	AsyncRetryPolicy worker.RetryPolicy
 }
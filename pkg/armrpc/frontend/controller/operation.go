@@ -163,35 +163,45 @@ func (c *Operation[P, T]) PrepareResource(ctx context.Context, req *http.Request
 	return nil, nil
 }
 
-// PrepareAsyncOperation saves the initial state and queue the async operation.
+// PrepareAsyncOperation saves the initial state and queue the async operation. The resource is saved together
+// with the async operation status so that a failure can't leave one without the other.
 func (c *Operation[P, T]) PrepareAsyncOperation(ctx context.Context, newResource *T, initialState v1.ProvisioningState, asyncTimeout time.Duration, etag *string) (rest.Response, error) {
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 
 	P(newResource).SetProvisioningState(initialState)
 
-	var err error
-	*etag, err = c.SaveResource(ctx, serviceCtx.ResourceID.String(), newResource, *etag)
-	if err != nil {
-		return nil, err
+	resource := &store.Object{
+		Metadata: store.Metadata{
+			ID: serviceCtx.ResourceID.String(),
+		},
+		Data: newResource,
 	}
 
 	options := sm.QueueOperationOptions{
 		OperationTimeout: asyncTimeout,
 		RetryAfter:       v1.DefaultRetryAfterDuration,
+		Resource:         resource,
+		ResourceETag:     *etag,
 	}
 	if c.resourceOptions.AsyncOperationRetryAfter != 0 {
 		options.RetryAfter = c.resourceOptions.AsyncOperationRetryAfter
 	}
 
 	if err := c.StatusManager().QueueAsyncOperation(ctx, serviceCtx, options); err != nil {
+		// The ETag is only set if the resource was saved, otherwise there is nothing to roll back.
+		if resource.ETag == "" {
+			return nil, err
+		}
+
 		P(newResource).SetProvisioningState(v1.ProvisioningStateFailed)
-		_, rbErr := c.SaveResource(ctx, serviceCtx.ResourceID.String(), newResource, *etag)
+		_, rbErr := c.SaveResource(ctx, serviceCtx.ResourceID.String(), newResource, resource.ETag)
 		if rbErr != nil {
 			return nil, rbErr
 		}
 		return nil, err
 	}
 
+	*etag = resource.ETag
 	return nil, nil
 }
 
@@ -0,0 +1,91 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+
+	"github.com/google/uuid"
+)
+
+var _ ctrl.Controller = (*CancelOperation)(nil)
+
+// CancelOperation is the controller implementation to cancel an in-flight async operation.
+type CancelOperation struct {
+	ctrl.BaseController
+}
+
+// NewCancelOperation creates a new CancelOperation.
+func NewCancelOperation(opts ctrl.Options) (ctrl.Controller, error) {
+	return &CancelOperation{ctrl.NewBaseController(opts)}, nil
+}
+
+// Run marks the async operation as canceled and returns its status. The worker processing the operation stops it
+// and completes it as canceled. It returns NotFound if the operation is not found, and Conflict if the operation
+// is already completed.
+func (e *CancelOperation) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	os := &manager.Status{}
+	_, err := e.GetResource(ctx, serviceCtx.ResourceID.String(), os)
+	if err != nil && errors.Is(&store.ErrNotFound{ID: serviceCtx.ResourceID.String()}, err) {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if os.Status.IsTerminal() {
+		return rest.NewConflictResponse(fmt.Sprintf("Operation %s has already completed with the status %s.", serviceCtx.ResourceID.Name(), os.Status)), nil
+	}
+
+	operationID, err := uuid.Parse(serviceCtx.ResourceID.Name())
+	if err != nil {
+		return rest.NewBadRequestResponse(fmt.Sprintf("Invalid operation id %s.", serviceCtx.ResourceID.Name())), nil
+	}
+
+	linkedID, err := resources.ParseResource(os.LinkedResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The end time is set by the worker when it completes the canceled operation.
+	opErr := &v1.ErrorDetails{
+		Code:    v1.CodeOperationCanceled,
+		Message: "Operation was canceled by the user.",
+		Target:  os.LinkedResourceID,
+	}
+	err = e.StatusManager().Update(ctx, linkedID, operationID, v1.ProvisioningStateCanceled, nil, opErr)
+	if errors.Is(err, &store.ErrConcurrency{}) {
+		return rest.NewConflictResponse(fmt.Sprintf("Operation %s was updated while it was being canceled. Please try again.", serviceCtx.ResourceID.Name())), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	os.Status = v1.ProvisioningStateCanceled
+	os.Error = opErr
+	return rest.NewOKResponse(os.AsyncOperationStatus), nil
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCancelOperationRun(t *testing.T) {
+	linkedResourceID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0"
+
+	newStatus := func(state v1.ProvisioningState) *manager.Status {
+		return &manager.Status{
+			AsyncOperationStatus: v1.AsyncOperationStatus{
+				ID:        "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Applications.Core/locations/westus/operationStatuses/00000000-0000-0000-0000-000000000000",
+				Name:      "00000000-0000-0000-0000-000000000000",
+				Status:    state,
+				StartTime: time.Now().UTC(),
+			},
+			LinkedResourceID: linkedResourceID,
+		}
+	}
+
+	tests := []struct {
+		name       string
+		status     *manager.Status
+		updateErr  error
+		statusCode int
+	}{
+		{name: "cancel non-existing operation", status: nil, statusCode: http.StatusNotFound},
+		{name: "cancel completed operation", status: newStatus(v1.ProvisioningStateSucceeded), statusCode: http.StatusConflict},
+		{name: "cancel operation updated concurrently", status: newStatus(v1.ProvisioningStateUpdating), updateErr: &store.ErrConcurrency{}, statusCode: http.StatusConflict},
+		{name: "cancel in-flight operation", status: newStatus(v1.ProvisioningStateUpdating), statusCode: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			defer mctrl.Finish()
+
+			mStorageClient := store.NewMockStorageClient(mctrl)
+			mStatusManager := manager.NewMockStatusManager(mctrl)
+
+			w := httptest.NewRecorder()
+			req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodPost, cancelOperationTestHeaderFile, nil)
+			require.NoError(t, err)
+			ctx := rpctest.NewARMRequestContext(req)
+
+			mStorageClient.
+				EXPECT().
+				Get(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+					if tt.status == nil {
+						return nil, &store.ErrNotFound{ID: id}
+					}
+					return &store.Object{
+						Metadata: store.Metadata{ID: id},
+						Data:     tt.status,
+					}, nil
+				})
+
+			if tt.status != nil && !tt.status.Status.IsTerminal() {
+				mStatusManager.
+					EXPECT().
+					Update(gomock.Any(), gomock.Any(), uuid.Nil, v1.ProvisioningStateCanceled, nil, gomock.Any()).
+					DoAndReturn(func(_ context.Context, id resources.ID, _ uuid.UUID, _ v1.ProvisioningState, _ *time.Time, opError *v1.ErrorDetails) error {
+						require.Equal(t, linkedResourceID, id.String())
+						require.Equal(t, v1.CodeOperationCanceled, opError.Code)
+						return tt.updateErr
+					})
+			}
+
+			ctl, err := NewCancelOperation(ctrl.Options{
+				StorageClient: mStorageClient,
+				StatusManager: mStatusManager,
+			})
+			require.NoError(t, err)
+
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, tt.statusCode, w.Result().StatusCode)
+
+			if tt.statusCode == http.StatusOK {
+				actualOutput := &v1.AsyncOperationStatus{}
+				_ = json.Unmarshal(w.Body.Bytes(), actualOutput)
+				require.Equal(t, v1.ProvisioningStateCanceled, actualOutput.Status)
+				require.Equal(t, v1.CodeOperationCanceled, actualOutput.Error.Code)
+			}
+		})
+	}
+}
@@ -82,17 +82,28 @@ func TestDefaultAsyncDelete(t *testing.T) {
 				Times(1)
 
 			if tt.getErr == nil && !tt.rejectedByFilter && appDataModel.InternalMetadata.AsyncProvisioningState.IsTerminal() {
-				expectedOptions := statusmanager.QueueOperationOptions{
-					OperationTimeout: asyncOperationTimeout,
-					RetryAfter:       asyncOperationRetryAfter,
-				}
-				msm.EXPECT().QueueAsyncOperation(gomock.Any(), gomock.Any(), expectedOptions).
-					Return(tt.qErr).
+				// The resource is saved together with the operation status by the status manager.
+				msm.EXPECT().QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, sCtx *v1.ARMRequestContext, options statusmanager.QueueOperationOptions) error {
+						require.Equal(t, asyncOperationTimeout, options.OperationTimeout)
+						require.Equal(t, asyncOperationRetryAfter, options.RetryAfter)
+						require.Equal(t, sCtx.ResourceID.String(), options.Resource.ID)
+						require.Equal(t, tt.etag, options.ResourceETag)
+
+						if tt.saveErr != nil {
+							return tt.saveErr
+						}
+
+						options.Resource.ETag = "new-etag"
+						return tt.qErr
+					}).
 					Times(1)
 
-				mds.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
-					Return(tt.saveErr).
-					Times(1)
+				if tt.saveErr == nil && tt.qErr != nil {
+					mds.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
+						Return(nil).
+						Times(1)
+				}
 			}
 
 			opts := ctrl.Options{
@@ -99,24 +99,27 @@ func TestDefaultAsyncPut_Create(t *testing.T) {
 				Times(1)
 
 			if tt.getErr == nil || errors.Is(&store.ErrNotFound{}, tt.getErr) {
-				mds.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
-					Return(tt.saveErr).
+				// The resource is saved together with the operation status by the status manager.
+				msm.EXPECT().QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, sCtx *v1.ARMRequestContext, options statusmanager.QueueOperationOptions) error {
+						require.Equal(t, asyncOperationTimeout, options.OperationTimeout)
+						require.Equal(t, asyncOperationRetryAfter, options.RetryAfter)
+						require.Equal(t, sCtx.ResourceID.String(), options.Resource.ID)
+						require.Equal(t, "", options.ResourceETag)
+
+						if tt.saveErr != nil {
+							return tt.saveErr
+						}
+
+						options.Resource.ETag = "new-etag"
+						return tt.qErr
+					}).
 					Times(1)
 
-				if tt.saveErr == nil {
-					expectedOptions := statusmanager.QueueOperationOptions{
-						OperationTimeout: asyncOperationTimeout,
-						RetryAfter:       asyncOperationRetryAfter,
-					}
-					msm.EXPECT().QueueAsyncOperation(gomock.Any(), gomock.Any(), expectedOptions).
-						Return(tt.qErr).
+				if tt.saveErr == nil && tt.qErr != nil {
+					mds.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
+						Return(tt.rbErr).
 						Times(1)
-
-					if tt.qErr != nil {
-						mds.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
-							Return(tt.rbErr).
-							Times(1)
-					}
 				}
 			}
 
@@ -275,20 +278,22 @@ func TestDefaultAsyncPut_Update(t *testing.T) {
 				Times(1)
 
 			if tt.getErr == nil && !tt.skipSave {
-				mds.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
-					Return(tt.saveErr).
+				// The resource is saved together with the operation status by the status manager.
+				msm.EXPECT().QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, sCtx *v1.ARMRequestContext, options statusmanager.QueueOperationOptions) error {
+						if tt.saveErr != nil {
+							return tt.saveErr
+						}
+
+						options.Resource.ETag = "new-etag"
+						return tt.qErr
+					}).
 					Times(1)
 
-				if tt.saveErr == nil {
-					msm.EXPECT().QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).
-						Return(tt.qErr).
+				if tt.saveErr == nil && tt.qErr != nil {
+					mds.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
+						Return(tt.rbErr).
 						Times(1)
-
-					if tt.qErr != nil {
-						mds.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
-							Return(tt.rbErr).
-							Times(1)
-					}
 				}
 			}
 
@@ -0,0 +1,55 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+var _ ctrl.Controller = (*GetOperationHistory)(nil)
+
+// GetOperationHistory is the controller implementation to get an entry of the operation history of a resource.
+type GetOperationHistory struct {
+	ctrl.BaseController
+}
+
+// NewGetOperationHistory creates a new GetOperationHistory.
+func NewGetOperationHistory(opts ctrl.Options) (ctrl.Controller, error) {
+	return &GetOperationHistory{ctrl.NewBaseController(opts)}, nil
+}
+
+// Run returns the operation history entry of the operation, or a NotFound error if the entry is not found.
+func (e *GetOperationHistory) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	entry := &v1.OperationHistoryEntry{}
+	_, err := e.GetResource(ctx, serviceCtx.ResourceID.String(), entry)
+	if errors.Is(err, &store.ErrNotFound{}) {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return rest.NewOKResponse(entry), nil
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+var _ ctrl.Controller = (*ListOperationHistory)(nil)
+
+// ListOperationHistory is the controller implementation to list the operation history of a resource.
+type ListOperationHistory struct {
+	ctrl.BaseController
+}
+
+// NewListOperationHistory creates a new ListOperationHistory.
+func NewListOperationHistory(opts ctrl.Options) (ctrl.Controller, error) {
+	return &ListOperationHistory{ctrl.NewBaseController(opts)}, nil
+}
+
+// Run queries the operation history entries of the resource and returns the paginated list. The history is returned
+// even if the resource no longer exists so that its deletion can be inspected.
+func (e *ListOperationHistory) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	// The request id is the collection id of the operation history, for example .../containers/my-container/operations.
+	query := manager.OperationHistoryQuery(serviceCtx.ResourceID.Truncate())
+
+	result, err := e.StorageClient().Query(ctx, query, store.WithPaginationToken(serviceCtx.SkipToken), store.WithMaxQueryItemCount(serviceCtx.Top))
+	if err != nil {
+		return nil, err
+	}
+
+	items := []any{}
+	for _, item := range result.Items {
+		entry := &v1.OperationHistoryEntry{}
+		if err := item.As(entry); err != nil {
+			return nil, err
+		}
+		items = append(items, entry)
+	}
+
+	return rest.NewOKResponse(&v1.PaginatedList{
+		Value:    items,
+		NextLink: ctrl.GetNextLinkURL(ctx, req, result.PaginationToken),
+	}), nil
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testutil"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListOperationHistoryRun(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	mStorageClient := store.NewMockStorageClient(mctrl)
+	ctx := context.Background()
+
+	entry := &v1.OperationHistoryEntry{}
+	require.NoError(t, json.Unmarshal(testutil.ReadFixture("operationhistory_datamodel.json"), entry))
+
+	w := httptest.NewRecorder()
+	req, err := rpctest.NewHTTPRequestFromJSON(ctx, http.MethodGet, operationHistoryListTestHeaderFile, nil)
+	require.NoError(t, err)
+	ctx = rpctest.NewARMRequestContext(req)
+
+	mStorageClient.
+		EXPECT().
+		Query(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, query store.Query, _ ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+			require.Equal(t, store.Query{
+				RootScope:          "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg",
+				ResourceType:       "applications.core/environments/operations",
+				RoutingScopePrefix: "applications.core/environments/env0",
+			}, query)
+
+			return &store.ObjectQueryResult{
+				Items: []store.Object{{Metadata: store.Metadata{ID: entry.ID}, Data: entry}},
+			}, nil
+		})
+
+	ctl, err := NewListOperationHistory(ctrl.Options{
+		StorageClient: mStorageClient,
+	})
+	require.NoError(t, err)
+
+	resp, err := ctl.Run(ctx, w, req)
+	require.NoError(t, err)
+	_ = resp.Apply(ctx, w, req)
+	require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+	actualOutput := &struct {
+		Value []v1.OperationHistoryEntry `json:"value"`
+	}{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), actualOutput))
+	require.Equal(t, []v1.OperationHistoryEntry{*entry}, actualOutput.Value)
+}
+
+func TestGetOperationHistoryRun(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	mStorageClient := store.NewMockStorageClient(mctrl)
+	ctx := context.Background()
+
+	entry := &v1.OperationHistoryEntry{}
+	require.NoError(t, json.Unmarshal(testutil.ReadFixture("operationhistory_datamodel.json"), entry))
+
+	t.Run("get non-existing entry", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(ctx, http.MethodGet, operationHistoryTestHeaderFile, nil)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(req)
+
+		mStorageClient.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+				return nil, &store.ErrNotFound{ID: id}
+			})
+
+		ctl, err := NewGetOperationHistory(ctrl.Options{
+			StorageClient: mStorageClient,
+		})
+		require.NoError(t, err)
+
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+	})
+
+	t.Run("get existing entry", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(ctx, http.MethodGet, operationHistoryTestHeaderFile, nil)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(req)
+
+		mStorageClient.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+				require.True(t, strings.EqualFold(entry.ID, id))
+				return &store.Object{
+					Metadata: store.Metadata{ID: id},
+					Data:     entry,
+				}, nil
+			})
+
+		ctl, err := NewGetOperationHistory(ctrl.Options{
+			StorageClient: mStorageClient,
+		})
+		require.NoError(t, err)
+
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+		actualOutput := &v1.OperationHistoryEntry{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), actualOutput))
+		require.Equal(t, entry, actualOutput)
+	})
+}
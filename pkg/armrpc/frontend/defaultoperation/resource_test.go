@@ -38,7 +38,11 @@ import (
 const (
 	resourceTestHeaderFile        = "resource_requestheaders.json"
 	operationStatusTestHeaderFile = "operationstatus_requestheaders.json"
+	cancelOperationTestHeaderFile = "canceloperation_requestheaders.json"
 	testAPIVersion                = "2023-10-01-preview"
+
+	operationHistoryTestHeaderFile     = "operationhistory_requestheaders.json"
+	operationHistoryListTestHeaderFile = "operationhistory_list_requestheaders.json"
 )
 
 // TestResourceDataModel represents test resource.
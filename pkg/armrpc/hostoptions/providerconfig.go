@@ -43,6 +43,7 @@ type ProviderConfig struct {
 	Logging          ucplog.LoggingOptions                    `yaml:"logging"`
 	Bicep            BicepOptions                             `yaml:"bicep,omitempty"`
 	Terraform        TerraformOptions                         `yaml:"terraform,omitempty"`
+	DriftDetection   *DriftDetectionOptions                   `yaml:"driftDetection,omitempty"`
 
 	// FeatureFlags includes the list of feature flags.
 	FeatureFlags []string `yaml:"featureFlags"`
@@ -83,4 +84,31 @@ type BicepOptions struct {
 type TerraformOptions struct {
 	// Path is the path to the directory mounted to the container where terraform can be installed and executed.
 	Path string `yaml:"path,omitempty"`
+	// Version is the version of Terraform to install. The latest version is installed if it is not set.
+	Version string `yaml:"version,omitempty"`
+	// Cache is the configuration of the cache of Terraform, modules and providers shared across executions.
+	Cache *TerraformCacheOptions `yaml:"cache,omitempty"`
+}
+
+// TerraformCacheOptions includes options for the cache of Terraform, modules and providers.
+type TerraformCacheOptions struct {
+	// Enabled is the flag to enable the cache.
+	Enabled bool `yaml:"enabled"`
+	// Path is the path to the cache directory. Defaults to the cache subdirectory of the terraform path.
+	Path string `yaml:"path,omitempty"`
+	// MaxSizeMB is the maximum size of the cached modules, and separately of the cached providers, in megabytes. The
+	// size is not limited if it is not set.
+	MaxSizeMB int64 `yaml:"maxSizeMB,omitempty"`
+}
+
+// DriftDetectionOptions includes options for the periodic drift detection of recipe-provisioned resources.
+type DriftDetectionOptions struct {
+	// Enabled is the flag to enable the periodic drift detection.
+	Enabled bool `yaml:"enabled"`
+	// IntervalSeconds is the interval between drift detection runs in seconds.
+	IntervalSeconds *int `yaml:"intervalSeconds,omitempty"`
+	// LeaseNamespace is the namespace of the lease electing the replica which runs the drift detection.
+	LeaseNamespace string `yaml:"leaseNamespace,omitempty"`
+	// RootScopes are the scopes of the resources checked for drift, e.g. the radius planes. Defaults to /planes/radius/local.
+	RootScopes []string `yaml:"rootScopes,omitempty"`
 }
@@ -21,9 +21,11 @@ import (
 	"io"
 	"os"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	ucp_v20231001preview "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/frontend/controller/deadletters"
 	ucpresources "github.com/radius-project/radius/pkg/ucp/resources"
 )
 
@@ -97,10 +99,26 @@ type DiagnosticsClient interface {
 	GetPublicEndpoint(ctx context.Context, options EndpointOptions) (*string, error)
 }
 
+//go:generate mockgen -destination=./mock_deadletterclient.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients DeadLetterClient
+
+// DeadLetterClient is used to inspect and manage the async operations in the dead-letter queue of a Radius queue.
+type DeadLetterClient interface {
+	// ListDeadLetters lists the messages in the dead-letter queue of the queue.
+	ListDeadLetters(ctx context.Context, queueName string) ([]deadletters.DeadLetterMessage, error)
+	// ShowDeadLetter gets the message with the given id from the dead-letter queue of the queue.
+	ShowDeadLetter(ctx context.Context, queueName string, id string) (deadletters.DeadLetterMessage, error)
+	// RequeueDeadLetter moves the message with the given id from the dead-letter queue back to the queue.
+	RequeueDeadLetter(ctx context.Context, queueName string, id string) error
+	// PurgeDeadLetters deletes the message with the given id from the dead-letter queue, or all the messages
+	// if id is empty.
+	PurgeDeadLetters(ctx context.Context, queueName string, id string) error
+}
+
 type ApplicationStatus struct {
-	Name          string
-	ResourceCount int
-	Gateways      []GatewayStatus
+	Name             string
+	ResourceCount    int
+	Gateways         []GatewayStatus
+	DriftedResources []ResourceDriftStatus
 }
 
 type GatewayStatus struct {
@@ -144,6 +162,13 @@ type ApplicationsManagementClient interface {
 	ListAllResourcesByEnvironment(ctx context.Context, environmentName string) ([]generated.GenericResource, error)
 	ShowResource(ctx context.Context, resourceType string, resourceName string) (generated.GenericResource, error)
 	DeleteResource(ctx context.Context, resourceType string, resourceName string) (bool, error)
+
+	// CancelOperation cancels the in-flight async operation with the given id which was started for a resource of the given type.
+	CancelOperation(ctx context.Context, resourceType string, operationID string) error
+
+	// ListOperationHistory lists the operation history of the resource with the given type and name.
+	ListOperationHistory(ctx context.Context, resourceType string, resourceName string) ([]v1.OperationHistoryEntry, error)
+
 	ListApplications(ctx context.Context) ([]corerp.ApplicationResource, error)
 	ShowApplication(ctx context.Context, applicationName string) (corerp.ApplicationResource, error)
 
@@ -170,6 +195,9 @@ type ApplicationsManagementClient interface {
 
 	// ShowRecipe shows recipe details including list of all parameters for a given recipe registered to an environment
 	ShowRecipe(ctx context.Context, environmentName string, recipe corerp.RecipeGetMetadata) (corerp.RecipeGetMetadataResponse, error)
+
+	// PlanRecipe gets the changes the deployment of a recipe registered to an environment would make, without deploying it.
+	PlanRecipe(ctx context.Context, environmentName string, plan corerp.RecipePlan) (corerp.RecipePlanResponse, error)
 }
 
 // ShallowCopy creates a shallow copy of the DeploymentParameters object by iterating through the original object and
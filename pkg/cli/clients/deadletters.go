@@ -0,0 +1,103 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/frontend/controller/deadletters"
+)
+
+var _ DeadLetterClient = (*UCPDeadLetterClient)(nil)
+
+// UCPDeadLetterClient is the DeadLetterClient implementation which uses the UCP admin API.
+type UCPDeadLetterClient struct {
+	Connection sdk.Connection
+}
+
+// ListDeadLetters lists the messages in the dead-letter queue of the queue.
+func (c *UCPDeadLetterClient) ListDeadLetters(ctx context.Context, queueName string) ([]deadletters.DeadLetterMessage, error) {
+	result := deadletters.DeadLetterMessageList{}
+	if err := c.do(ctx, http.MethodGet, c.path(queueName, ""), &result); err != nil {
+		return nil, err
+	}
+
+	return result.Value, nil
+}
+
+// ShowDeadLetter gets the message with the given id from the dead-letter queue of the queue.
+func (c *UCPDeadLetterClient) ShowDeadLetter(ctx context.Context, queueName string, id string) (deadletters.DeadLetterMessage, error) {
+	result := deadletters.DeadLetterMessage{}
+	if err := c.do(ctx, http.MethodGet, c.path(queueName, id), &result); err != nil {
+		return deadletters.DeadLetterMessage{}, err
+	}
+
+	return result, nil
+}
+
+// RequeueDeadLetter moves the message with the given id from the dead-letter queue back to the queue.
+func (c *UCPDeadLetterClient) RequeueDeadLetter(ctx context.Context, queueName string, id string) error {
+	return c.do(ctx, http.MethodPost, c.path(queueName, id)+"/requeue", nil)
+}
+
+// PurgeDeadLetters deletes the message with the given id from the dead-letter queue, or all the messages
+// if id is empty.
+func (c *UCPDeadLetterClient) PurgeDeadLetters(ctx context.Context, queueName string, id string) error {
+	return c.do(ctx, http.MethodDelete, c.path(queueName, id), nil)
+}
+
+func (c *UCPDeadLetterClient) path(queueName string, id string) string {
+	p := c.Connection.Endpoint() + "/admin/queues/" + url.PathEscape(queueName) + "/deadletters"
+	if id != "" {
+		p += "/" + url.PathEscape(id)
+	}
+	return p
+}
+
+// do sends the request and decodes the response body into out if it is not nil. The error for the unsuccessful response
+// is an *azcore.ResponseError so that it can be checked with Is404Error.
+func (c *UCPDeadLetterClient) do(ctx context.Context, method string, url string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.Connection.Client().Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return runtime.NewResponseError(resp)
+	}
+
+	if out == nil {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/frontend/controller/deadletters"
+)
+
+func Test_UCPDeadLetterClient(t *testing.T) {
+	requests := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		switch r.Method + " " + r.URL.Path {
+		case "GET /admin/queues/radius/deadletters":
+			_ = json.NewEncoder(w).Encode(deadletters.DeadLetterMessageList{
+				Value: []deadletters.DeadLetterMessage{{ID: "msg1", LastError: "failed"}},
+			})
+		case "GET /admin/queues/radius/deadletters/msg1":
+			_ = json.NewEncoder(w).Encode(deadletters.DeadLetterMessage{ID: "msg1", LastError: "failed"})
+		case "POST /admin/queues/radius/deadletters/msg1/requeue", "DELETE /admin/queues/radius/deadletters/msg1", "DELETE /admin/queues/radius/deadletters":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":"NotFound","message":"not found"}}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	connection, err := sdk.NewDirectConnection(server.URL)
+	require.NoError(t, err)
+	client := &UCPDeadLetterClient{Connection: connection}
+	ctx := context.Background()
+
+	msgs, err := client.ListDeadLetters(ctx, "radius")
+	require.NoError(t, err)
+	require.Equal(t, []deadletters.DeadLetterMessage{{ID: "msg1", LastError: "failed"}}, msgs)
+
+	msg, err := client.ShowDeadLetter(ctx, "radius", "msg1")
+	require.NoError(t, err)
+	require.Equal(t, "msg1", msg.ID)
+
+	_, err = client.ShowDeadLetter(ctx, "radius", "msg2")
+	require.Error(t, err)
+	require.True(t, Is404Error(err))
+
+	require.NoError(t, client.RequeueDeadLetter(ctx, "radius", "msg1"))
+	require.NoError(t, client.PurgeDeadLetters(ctx, "radius", "msg1"))
+	require.NoError(t, client.PurgeDeadLetters(ctx, "radius", ""))
+
+	require.Equal(t, []string{
+		"GET /admin/queues/radius/deadletters",
+		"GET /admin/queues/radius/deadletters/msg1",
+		"GET /admin/queues/radius/deadletters/msg2",
+		"POST /admin/queues/radius/deadletters/msg1/requeue",
+		"DELETE /admin/queues/radius/deadletters/msg1",
+		"DELETE /admin/queues/radius/deadletters",
+	}, requests)
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+// ResourceDriftStatus is the drift status of the resources deployed by the recipe of a resource.
+type ResourceDriftStatus struct {
+	Name               string
+	Type               string
+	Detected           bool
+	LastCheckedTime    string
+	LastRemediatedTime string
+	Resources          []DriftedResource
+}
+
+// DriftedResource is a resource deployed by a recipe which no longer matches what was deployed.
+type DriftedResource struct {
+	ResourceID string
+	Address    string
+	Reason     string
+}
+
+// GetResourceDriftStatus returns the drift status recorded on the recipe status of the resource, or nil if the
+// resource wasn't checked for drift.
+func GetResourceDriftStatus(resource generated.GenericResource) (*ResourceDriftStatus, error) {
+	obj, found := resource.Properties["status"]
+	if !found {
+		return nil, nil
+	}
+
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	status := struct {
+		Recipe *corerp.RecipeStatus `json:"recipe"`
+	}{}
+	if err := json.Unmarshal(b, &status); err != nil {
+		return nil, err
+	}
+
+	if status.Recipe == nil || status.Recipe.Drift == nil {
+		return nil, nil
+	}
+
+	drift := status.Recipe.Drift
+	result := &ResourceDriftStatus{
+		Name:      to.String(resource.Name),
+		Type:      to.String(resource.Type),
+		Detected:  to.Bool(drift.Detected),
+		Resources: []DriftedResource{},
+	}
+	if drift.LastCheckedTime != nil {
+		result.LastCheckedTime = drift.LastCheckedTime.Format(time.RFC3339)
+	}
+	if drift.LastRemediatedTime != nil {
+		result.LastRemediatedTime = drift.LastRemediatedTime.Format(time.RFC3339)
+	}
+
+	for _, r := range drift.Resources {
+		if r == nil {
+			continue
+		}
+		driftedResource := DriftedResource{
+			ResourceID: to.String(r.ResourceID),
+			Address:    to.String(r.Address),
+		}
+		if r.Reason != nil {
+			driftedResource.Reason = string(*r.Reason)
+		}
+		result.Resources = append(result.Resources, driftedResource)
+	}
+
+	return result, nil
+}
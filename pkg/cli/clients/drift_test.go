@@ -0,0 +1,100 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+func Test_GetResourceDriftStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		properties map[string]any
+		expected   *ResourceDriftStatus
+	}{
+		{
+			name:       "no status",
+			properties: map[string]any{},
+			expected:   nil,
+		},
+		{
+			name: "no drift status",
+			properties: map[string]any{
+				"status": map[string]any{
+					"recipe": map[string]any{
+						"templateKind": "terraform",
+					},
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "drift detected",
+			properties: map[string]any{
+				"status": map[string]any{
+					"recipe": map[string]any{
+						"drift": map[string]any{
+							"detected":           true,
+							"lastCheckedTime":    "2023-10-01T10:00:00Z",
+							"lastRemediatedTime": "2023-09-01T10:00:00Z",
+							"resources": []any{
+								map[string]any{
+									"resourceId": "/planes/kubernetes/local/namespaces/test-ns/providers/core/Service/redis",
+									"address":    "kubernetes_service.redis",
+									"reason":     "Modified",
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &ResourceDriftStatus{
+				Name:               "redis",
+				Type:               "Applications.Datastores/redisCaches",
+				Detected:           true,
+				LastCheckedTime:    "2023-10-01T10:00:00Z",
+				LastRemediatedTime: "2023-09-01T10:00:00Z",
+				Resources: []DriftedResource{
+					{
+						ResourceID: "/planes/kubernetes/local/namespaces/test-ns/providers/core/Service/redis",
+						Address:    "kubernetes_service.redis",
+						Reason:     "Modified",
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := generated.GenericResource{
+				Name:       to.Ptr("redis"),
+				Type:       to.Ptr("Applications.Datastores/redisCaches"),
+				Properties: tt.properties,
+			}
+
+			drift, err := GetResourceDriftStatus(resource)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, drift)
+		})
+	}
+}
@@ -19,12 +19,15 @@ package clients
 import (
 	"context"
 	"net/http"
+	"net/url"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
 	"golang.org/x/sync/errgroup"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/azure/clientv2"
 	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
@@ -210,6 +213,91 @@ func (amc *UCPApplicationsManagementClient) DeleteResource(ctx context.Context,
 	return respFromCtx.StatusCode != 204, nil
 }
 
+// CancelOperation sends a request to cancel the in-flight async operation with the given id which was started for a
+// resource of the given type. It returns an error if the operation is not found or has already completed.
+func (amc *UCPApplicationsManagementClient) CancelOperation(ctx context.Context, resourceType string, operationID string) error {
+	scope, err := resources.ParseScope("/" + amc.RootScope)
+	if err != nil {
+		return err
+	}
+
+	endpoint := ""
+	if c, ok := amc.ClientOptions.Cloud.Services[cloud.ResourceManager]; ok {
+		endpoint = c.Endpoint
+	}
+
+	providerNamespace := strings.ToLower(strings.Split(resourceType, "/")[0])
+	urlPath := runtime.JoinPaths(endpoint, scope.PlaneScope(), "providers", providerNamespace, "locations", "global", "operationstatuses", url.PathEscape(operationID), "cancel")
+
+	pipeline := runtime.NewPipeline("radius", "v0.0.1", runtime.PipelineOptions{}, &amc.ClientOptions.ClientOptions)
+	req, err := runtime.NewRequest(ctx, http.MethodPost, urlPath)
+	if err != nil {
+		return err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+
+	resp, err := pipeline.Do(req)
+	if err != nil {
+		return err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK) {
+		return runtime.NewResponseError(resp)
+	}
+
+	return nil
+}
+
+// ListOperationHistory retrieves the entries of the operation history of the resource with the given type and name,
+// following the next links of the paginated list, or returns an error if one occurs.
+func (amc *UCPApplicationsManagementClient) ListOperationHistory(ctx context.Context, resourceType string, resourceName string) ([]v1.OperationHistoryEntry, error) {
+	endpoint := ""
+	if c, ok := amc.ClientOptions.Cloud.Services[cloud.ResourceManager]; ok {
+		endpoint = c.Endpoint
+	}
+
+	nextLink := runtime.JoinPaths(endpoint, amc.RootScope, "providers", resourceType, url.PathEscape(resourceName), "operations")
+	pipeline := runtime.NewPipeline("radius", "v0.0.1", runtime.PipelineOptions{}, &amc.ClientOptions.ClientOptions)
+
+	results := []v1.OperationHistoryEntry{}
+	for nextLink != "" {
+		req, err := runtime.NewRequest(ctx, http.MethodGet, nextLink)
+		if err != nil {
+			return nil, err
+		}
+		// The next link already includes the query parameters of the first request.
+		reqQP := req.Raw().URL.Query()
+		if !reqQP.Has("api-version") {
+			reqQP.Set("api-version", "2023-10-01-preview")
+			req.Raw().URL.RawQuery = reqQP.Encode()
+		}
+		req.Raw().Header["Accept"] = []string{"application/json"}
+
+		resp, err := pipeline.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		if !runtime.HasStatusCode(resp, http.StatusOK) {
+			return nil, runtime.NewResponseError(resp)
+		}
+
+		page := struct {
+			Value    []v1.OperationHistoryEntry `json:"value"`
+			NextLink string                     `json:"nextLink,omitempty"`
+		}{}
+		if err := runtime.UnmarshalAsJSON(resp, &page); err != nil {
+			return nil, err
+		}
+
+		results = append(results, page.Value...)
+		nextLink = page.NextLink
+	}
+
+	return results, nil
+}
+
 // ListApplications() retrieves a list of ApplicationResource objects from the Azure API
 // and returns them in a slice, or an error if one occurs.
 func (amc *UCPApplicationsManagementClient) ListApplications(ctx context.Context) ([]corerpv20231001.ApplicationResource, error) {
@@ -634,3 +722,19 @@ func (amc *UCPApplicationsManagementClient) ShowRecipe(ctx context.Context, envi
 
 	return corerpv20231001.RecipeGetMetadataResponse(resp.RecipeGetMetadataResponse), nil
 }
+
+// PlanRecipe creates a new EnvironmentsClient and gets the resources the deployment of the recipe would create, update
+// or delete. It returns an error if the client cannot be created or the plan action fails.
+func (amc *UCPApplicationsManagementClient) PlanRecipe(ctx context.Context, environmentName string, plan corerpv20231001.RecipePlan) (corerpv20231001.RecipePlanResponse, error) {
+	client, err := corerpv20231001.NewEnvironmentsClient(amc.RootScope, &aztoken.AnonymousCredential{}, amc.ClientOptions)
+	if err != nil {
+		return corerpv20231001.RecipePlanResponse{}, err
+	}
+
+	resp, err := client.Plan(ctx, environmentName, plan, &corerpv20231001.EnvironmentsClientPlanOptions{})
+	if err != nil {
+		return corerpv20231001.RecipePlanResponse{}, err
+	}
+
+	return resp.RecipePlanResponse, nil
+}
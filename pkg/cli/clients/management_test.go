@@ -0,0 +1,103 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/sdk"
+)
+
+func Test_CancelOperation(t *testing.T) {
+	requests := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		switch r.Method + " " + r.URL.Path {
+		case "POST /planes/radius/local/providers/applications.core/locations/global/operationstatuses/op1/cancel":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"status":"Canceled"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":"NotFound","message":"not found"}}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	connection, err := sdk.NewDirectConnection(server.URL)
+	require.NoError(t, err)
+	client := &UCPApplicationsManagementClient{
+		RootScope:     "planes/radius/local/resourceGroups/test-group",
+		ClientOptions: sdk.NewClientOptions(connection),
+	}
+	ctx := context.Background()
+
+	err = client.CancelOperation(ctx, "Applications.Core/containers", "op1")
+	require.NoError(t, err)
+
+	err = client.CancelOperation(ctx, "Applications.Core/containers", "op2")
+	require.Error(t, err)
+	require.True(t, Is404Error(err))
+
+	require.Equal(t, []string{
+		"POST /planes/radius/local/providers/applications.core/locations/global/operationstatuses/op1/cancel",
+		"POST /planes/radius/local/providers/applications.core/locations/global/operationstatuses/op2/cancel",
+	}, requests)
+}
+
+func Test_ListOperationHistory(t *testing.T) {
+	var serverURL string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path + "?" + r.URL.RawQuery {
+		case "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/ctr0/operations?api-version=2023-10-01-preview":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"value":[{"name":"op1","status":"Succeeded"}],"nextLink":"` + serverURL + `/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/ctr0/operations?api-version=2023-10-01-preview&skipToken=page2"}`))
+		case "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/ctr0/operations?api-version=2023-10-01-preview&skipToken=page2":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"value":[{"name":"op2","status":"Failed"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":"NotFound","message":"not found"}}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+	serverURL = server.URL
+
+	connection, err := sdk.NewDirectConnection(server.URL)
+	require.NoError(t, err)
+	client := &UCPApplicationsManagementClient{
+		RootScope:     "planes/radius/local/resourceGroups/test-group",
+		ClientOptions: sdk.NewClientOptions(connection),
+	}
+	ctx := context.Background()
+
+	entries, err := client.ListOperationHistory(ctx, "Applications.Core/containers", "ctr0")
+	require.NoError(t, err)
+	require.Equal(t, []v1.OperationHistoryEntry{
+		{Name: "op1", Status: v1.ProvisioningStateSucceeded},
+		{Name: "op2", Status: v1.ProvisioningStateFailed},
+	}, entries)
+
+	_, err = client.ListOperationHistory(ctx, "Applications.Core/containers", "ctr1")
+	require.Error(t, err)
+	require.True(t, Is404Error(err))
+}
@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	generated "github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	v20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	v20231001preview0 "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
@@ -37,6 +38,20 @@ func (m *MockApplicationsManagementClient) EXPECT() *MockApplicationsManagementC
 	return m.recorder
 }
 
+// CancelOperation mocks base method.
+func (m *MockApplicationsManagementClient) CancelOperation(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelOperation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelOperation indicates an expected call of CancelOperation.
+func (mr *MockApplicationsManagementClientMockRecorder) CancelOperation(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOperation", reflect.TypeOf((*MockApplicationsManagementClient)(nil).CancelOperation), arg0, arg1, arg2)
+}
+
 // CreateApplicationIfNotFound mocks base method.
 func (m *MockApplicationsManagementClient) CreateApplicationIfNotFound(arg0 context.Context, arg1 string, arg2 v20231001preview.ApplicationResource) error {
 	m.ctrl.T.Helper()
@@ -288,6 +303,21 @@ func (mr *MockApplicationsManagementClientMockRecorder) ListEnvironmentsInResour
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnvironmentsInResourceGroup", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListEnvironmentsInResourceGroup), arg0)
 }
 
+// ListOperationHistory mocks base method.
+func (m *MockApplicationsManagementClient) ListOperationHistory(arg0 context.Context, arg1, arg2 string) ([]v1.OperationHistoryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOperationHistory", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]v1.OperationHistoryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOperationHistory indicates an expected call of ListOperationHistory.
+func (mr *MockApplicationsManagementClientMockRecorder) ListOperationHistory(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOperationHistory", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListOperationHistory), arg0, arg1, arg2)
+}
+
 // ListUCPGroup mocks base method.
 func (m *MockApplicationsManagementClient) ListUCPGroup(arg0 context.Context, arg1, arg2 string) ([]v20231001preview0.ResourceGroupResource, error) {
 	m.ctrl.T.Helper()
@@ -303,6 +333,21 @@ func (mr *MockApplicationsManagementClientMockRecorder) ListUCPGroup(arg0, arg1,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUCPGroup", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListUCPGroup), arg0, arg1, arg2)
 }
 
+// PlanRecipe mocks base method.
+func (m *MockApplicationsManagementClient) PlanRecipe(arg0 context.Context, arg1 string, arg2 v20231001preview.RecipePlan) (v20231001preview.RecipePlanResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlanRecipe", arg0, arg1, arg2)
+	ret0, _ := ret[0].(v20231001preview.RecipePlanResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlanRecipe indicates an expected call of PlanRecipe.
+func (mr *MockApplicationsManagementClientMockRecorder) PlanRecipe(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlanRecipe", reflect.TypeOf((*MockApplicationsManagementClient)(nil).PlanRecipe), arg0, arg1, arg2)
+}
+
 // ShowApplication mocks base method.
 func (m *MockApplicationsManagementClient) ShowApplication(arg0 context.Context, arg1 string) (v20231001preview.ApplicationResource, error) {
 	m.ctrl.T.Helper()
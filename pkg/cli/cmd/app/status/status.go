@@ -168,6 +168,15 @@ func (r *Runner) Run(ctx context.Context) error {
 				Endpoint: *publicEndpoint,
 			})
 		}
+
+		drift, err := clients.GetResourceDriftStatus(resource)
+		if err != nil {
+			return err
+		}
+
+		if drift != nil && drift.Detected {
+			applicationStatus.DriftedResources = append(applicationStatus.DriftedResources, *drift)
+		}
 	}
 
 	err = r.Output.WriteFormatted(r.Format, applicationStatus, objectformats.GetApplicationStatusTableFormat())
@@ -185,5 +194,15 @@ func (r *Runner) Run(ctx context.Context) error {
 		}
 	}
 
+	if r.Format == output.FormatTable && len(applicationStatus.DriftedResources) > 0 {
+		// Print newline for readability
+		r.Output.LogInfo("")
+
+		err = r.Output.WriteFormatted(r.Format, applicationStatus.DriftedResources, objectformats.GetApplicationDriftTableFormat())
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -196,6 +196,131 @@ func Test_Run(t *testing.T) {
 		require.Equal(t, expected, outputSink.Writes)
 	})
 
+	t.Run("Success: Drifted Resources", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		application := v20231001preview.ApplicationResource{
+			Name: to.Ptr("test-app"),
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ShowApplication(gomock.Any(), "test-app").
+			Return(application, nil).
+			Times(1)
+
+		resourceList := []generated.GenericResource{
+			{
+				Name: to.Ptr("test-redis"),
+				Type: to.Ptr("Applications.Datastores/redisCaches"),
+				ID:   to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/test-redis"),
+				Properties: map[string]any{
+					"status": map[string]any{
+						"recipe": map[string]any{
+							"drift": map[string]any{
+								"detected":        true,
+								"lastCheckedTime": "2023-10-01T10:00:00Z",
+								"resources": []any{
+									map[string]any{
+										"resourceId": "/planes/kubernetes/local/namespaces/test-ns/providers/apps/Deployment/redis",
+										"reason":     "Modified",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				Name: to.Ptr("test-mongo"),
+				Type: to.Ptr("Applications.Datastores/mongoDatabases"),
+				ID:   to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/mongoDatabases/test-mongo"),
+				Properties: map[string]any{
+					"status": map[string]any{
+						"recipe": map[string]any{
+							"drift": map[string]any{
+								"detected":        false,
+								"lastCheckedTime": "2023-10-01T10:00:00Z",
+							},
+						},
+					},
+				},
+			},
+		}
+
+		appManagementClient.EXPECT().
+			ListAllResourcesByApplication(gomock.Any(), "test-app").
+			Return(resourceList, nil).
+			Times(1)
+
+		diagnosticsClient := clients.NewMockDiagnosticsClient(ctrl)
+		diagnosticsClient.EXPECT().
+			GetPublicEndpoint(gomock.Any(), gomock.Any()).
+			Return(nil, nil).
+			Times(2)
+
+		workspace := &workspaces.Workspace{
+			Connection: map[string]any{
+				"kind":    "kubernetes",
+				"context": "kind-kind",
+			},
+			Name:  "kind-kind",
+			Scope: "/planes/radius/local/resourceGroups/test-group",
+		}
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{
+				ApplicationsManagementClient: appManagementClient,
+				DiagnosticsClient:            diagnosticsClient,
+			},
+			Workspace:       workspace,
+			Format:          "table",
+			Output:          outputSink,
+			ApplicationName: "test-app",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		applicationStatus := clients.ApplicationStatus{
+			Name:          "test-app",
+			ResourceCount: 2,
+			DriftedResources: []clients.ResourceDriftStatus{
+				{
+					Name:            "test-redis",
+					Type:            "Applications.Datastores/redisCaches",
+					Detected:        true,
+					LastCheckedTime: "2023-10-01T10:00:00Z",
+					Resources: []clients.DriftedResource{
+						{
+							ResourceID: "/planes/kubernetes/local/namespaces/test-ns/providers/apps/Deployment/redis",
+							Reason:     "Modified",
+						},
+					},
+				},
+			},
+		}
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     applicationStatus,
+				Options: objectformats.GetApplicationStatusTableFormat(),
+			},
+			output.LogOutput{
+				Format: "",
+			},
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     applicationStatus.DriftedResources,
+				Options: objectformats.GetApplicationDriftTableFormat(),
+			},
+		}
+
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
 	t.Run("Error: Application Not Found", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
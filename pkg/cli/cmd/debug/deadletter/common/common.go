@@ -0,0 +1,46 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// DefaultQueueName is the name of the async operation queue of Applications.Core resource provider.
+	DefaultQueueName = "radius"
+)
+
+// AddQueueFlag adds a flag to the given command that allows the user to specify the async operation queue name.
+func AddQueueFlag(cmd *cobra.Command) {
+	cmd.Flags().StringP("queue", "q", DefaultQueueName, "The name of the async operation queue, for example 'radius', 'radiusportable' or 'ucp'")
+}
+
+// RequireQueueName returns the async operation queue name from the command flags.
+func RequireQueueName(cmd *cobra.Command) (string, error) {
+	queueName, err := cmd.Flags().GetString("queue")
+	if err != nil {
+		return "", err
+	}
+
+	if queueName == "" {
+		return "", clierrors.Message("The queue name must not be empty.")
+	}
+
+	return queueName, nil
+}
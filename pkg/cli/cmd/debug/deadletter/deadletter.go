@@ -0,0 +1,61 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadletter
+
+import (
+	deadletter_list "github.com/radius-project/radius/pkg/cli/cmd/debug/deadletter/list"
+	deadletter_purge "github.com/radius-project/radius/pkg/cli/cmd/debug/deadletter/purge"
+	deadletter_requeue "github.com/radius-project/radius/pkg/cli/cmd/debug/deadletter/requeue"
+	deadletter_show "github.com/radius-project/radius/pkg/cli/cmd/debug/deadletter/show"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates a new cobra command for managing the dead-lettered async operations, with subcommands for listing,
+// showing, requeueing and purging them.
+func NewCommand(factory framework.Factory) *cobra.Command {
+	// This command is not runnable, and thus has no runner.
+	cmd := &cobra.Command{
+		Use:   "deadletter",
+		Short: "Manage dead-lettered async operations",
+		Long: `Manage dead-lettered async operations
+
+Async operations which exceed the maximum retry count are marked as failed and moved to the dead-letter queue. Dead-lettered operations can be inspected, requeued to be processed again, or purged.
+`,
+		Example: `
+# List dead-lettered operations
+rad debug deadletter list
+
+# Requeue a dead-lettered operation
+rad debug deadletter requeue radius.1656452659.70a6f0f8003943a6abe3319c5a4f1b9d
+`,
+	}
+
+	list, _ := deadletter_list.NewCommand(factory)
+	cmd.AddCommand(list)
+
+	show, _ := deadletter_show.NewCommand(factory)
+	cmd.AddCommand(show)
+
+	requeue, _ := deadletter_requeue.NewCommand(factory)
+	cmd.AddCommand(requeue)
+
+	purge, _ := deadletter_purge.NewCommand(factory)
+	cmd.AddCommand(purge)
+
+	return cmd
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package list
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/ucp/frontend/controller/deadletters"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "List Command with default queue",
+			Input:         []string{},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, "radius", runner.(*Runner).QueueName)
+			},
+		},
+		{
+			Name:          "List Command with queue",
+			Input:         []string{"--queue", "radiusportable"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, "radiusportable", runner.(*Runner).QueueName)
+			},
+		},
+		{
+			Name:          "List Command with empty queue",
+			Input:         []string{"--queue", ""},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "List Command with too many args",
+			Input:         []string{"foo"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	msgs := []deadletters.DeadLetterMessage{
+		{ID: "radius.1", DequeueCount: 6, LastError: "exceeded max retry count"},
+	}
+
+	client := clients.NewMockDeadLetterClient(ctrl)
+	client.EXPECT().
+		ListDeadLetters(gomock.Any(), "radius").
+		Return(msgs, nil).
+		Times(1)
+
+	outputSink := &output.MockOutput{}
+
+	runner := &Runner{
+		ConnectionFactory: &connections.MockFactory{DeadLetterClient: client},
+		Output:            outputSink,
+		Workspace:         &workspaces.Workspace{},
+		QueueName:         "radius",
+		Format:            "table",
+	}
+
+	err := runner.Run(context.Background())
+	require.NoError(t, err)
+
+	expected := []any{
+		output.FormattedOutput{
+			Format:  "table",
+			Obj:     msgs,
+			Options: objectformats.GetDeadLetterTableFormat(),
+		},
+	}
+	require.Equal(t, expected, outputSink.Writes)
+}
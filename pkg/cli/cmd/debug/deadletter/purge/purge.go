@@ -0,0 +1,161 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package purge
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/cmd/debug/deadletter/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/prompt"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+const (
+	purgeConfirmation    = "Are you sure you want to purge the dead-lettered operation %v from queue %v?"
+	purgeAllConfirmation = "Are you sure you want to purge all the dead-lettered operations from queue %v?"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad debug deadletter purge` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "purge [messageId]",
+		Short: "Purge dead-lettered async operations",
+		Long:  "Delete a dead-lettered async operation, or all the dead-lettered async operations of the queue with --all",
+		Example: `
+# Purge a dead-lettered operation
+rad debug deadletter purge radius.1656452659.70a6f0f8003943a6abe3319c5a4f1b9d
+
+# Purge all the dead-lettered operations without prompting
+rad debug deadletter purge --all --yes`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	common.AddQueueFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddConfirmationFlag(cmd)
+	cmd.Flags().Bool("all", false, "Purge all the dead-lettered operations of the queue")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad debug deadletter purge` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	InputPrompter     prompt.Interface
+	Workspace         *workspaces.Workspace
+	QueueName         string
+	MessageID         string
+	Confirm           bool
+}
+
+// NewRunner creates a new instance of the `rad debug deadletter purge` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+		InputPrompter:     factory.GetPrompter(),
+	}
+}
+
+// Validate runs validation for the `rad debug deadletter purge` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	queueName, err := common.RequireQueueName(cmd)
+	if err != nil {
+		return err
+	}
+	r.QueueName = queueName
+
+	all, err := cmd.Flags().GetBool("all")
+	if err != nil {
+		return err
+	}
+
+	if all && len(args) > 0 {
+		return clierrors.Message("Specify either a message id or --all, not both.")
+	} else if !all && len(args) == 0 {
+		return clierrors.Message("Specify a message id, or --all to purge all the dead-lettered operations.")
+	} else if !all {
+		r.MessageID = args[0]
+	}
+
+	yes, err := cmd.Flags().GetBool("yes")
+	if err != nil {
+		return err
+	}
+	r.Confirm = yes
+
+	return nil
+}
+
+// Run runs the `rad debug deadletter purge` command.
+func (r *Runner) Run(ctx context.Context) error {
+	if !r.Confirm {
+		message := fmt.Sprintf(purgeAllConfirmation, r.QueueName)
+		if r.MessageID != "" {
+			message = fmt.Sprintf(purgeConfirmation, r.MessageID, r.QueueName)
+		}
+
+		confirmed, err := prompt.YesOrNoPrompt(message, prompt.ConfirmNo, r.InputPrompter)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return nil
+		}
+	}
+
+	client, err := r.ConnectionFactory.CreateDeadLetterClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	err = client.PurgeDeadLetters(ctx, r.QueueName, r.MessageID)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The dead-lettered operation %q was not found in queue %q.", r.MessageID, r.QueueName)
+	} else if err != nil {
+		return err
+	}
+
+	if r.MessageID != "" {
+		r.Output.LogInfo("Operation %q purged", r.MessageID)
+	} else {
+		r.Output.LogInfo("All the dead-lettered operations purged from queue %q", r.QueueName)
+	}
+
+	return nil
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package purge
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/prompt"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Purge Command with message id",
+			Input:         []string{"radius.1", "--yes"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, "radius.1", runner.(*Runner).MessageID)
+				require.True(t, runner.(*Runner).Confirm)
+			},
+		},
+		{
+			Name:          "Purge Command with --all",
+			Input:         []string{"--all"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Empty(t, runner.(*Runner).MessageID)
+			},
+		},
+		{
+			Name:          "Purge Command with message id and --all",
+			Input:         []string{"radius.1", "--all"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Purge Command without message id or --all",
+			Input:         []string{},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	t.Run("Purge message without prompt", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		client := clients.NewMockDeadLetterClient(ctrl)
+		client.EXPECT().
+			PurgeDeadLetters(gomock.Any(), "radius", "radius.1").
+			Return(nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{DeadLetterClient: client},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{},
+			QueueName:         "radius",
+			MessageID:         "radius.1",
+			Confirm:           true,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Operation %q purged",
+				Params: []any{"radius.1"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Purge all with prompt confirmed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		promptMock := prompt.NewMockInterface(ctrl)
+		promptMock.EXPECT().
+			GetListInput([]string{prompt.ConfirmNo, prompt.ConfirmYes}, fmt.Sprintf(purgeAllConfirmation, "radius")).
+			Return(prompt.ConfirmYes, nil).
+			Times(1)
+
+		client := clients.NewMockDeadLetterClient(ctrl)
+		client.EXPECT().
+			PurgeDeadLetters(gomock.Any(), "radius", "").
+			Return(nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{DeadLetterClient: client},
+			Output:            outputSink,
+			InputPrompter:     promptMock,
+			Workspace:         &workspaces.Workspace{},
+			QueueName:         "radius",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "All the dead-lettered operations purged from queue %q",
+				Params: []any{"radius"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Purge message with prompt cancelled", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		promptMock := prompt.NewMockInterface(ctrl)
+		promptMock.EXPECT().
+			GetListInput([]string{prompt.ConfirmNo, prompt.ConfirmYes}, fmt.Sprintf(purgeConfirmation, "radius.1", "radius")).
+			Return(prompt.ConfirmNo, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			Output:        outputSink,
+			InputPrompter: promptMock,
+			Workspace:     &workspaces.Workspace{},
+			QueueName:     "radius",
+			MessageID:     "radius.1",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+		require.Empty(t, outputSink.Writes)
+	})
+}
@@ -0,0 +1,253 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/spf13/cobra"
+)
+
+const (
+	resourceNameFlag = "resource-name"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad recipe plan` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "plan [recipe-name]",
+		Short: "Preview the changes a recipe would make",
+		Long: `Preview the changes a recipe would make
+
+The recipe plan command outputs the resources the deployment of a recipe would create, update or delete, without deploying it.
+Terraform recipes are planned with 'terraform plan', Bicep recipes with an ARM what-if operation and Helm recipes with a dry-run of the release.
+
+The changes are computed against the resources previously deployed by the recipe for the resource specified with the resource-name flag.
+
+You can specify parameters using the '--parameters' flag ('-p' for short). Parameters override the parameters set by the environment and can be passed as:
+  - A JSON file, prefixed with '@': '--parameters @myfile.json'
+  - A single value: '--parameters name=value'
+
+By default, the command is scoped to the resource group and environment defined in your rad.yaml workspace file. You can optionally override these values through the environment and group flags.
+
+By default, the command outputs a human-readable table. You can customize the output format with the output flag.`,
+		Example: `
+# preview the changes of deploying a recipe for the redis cache named 'cache'
+rad recipe plan redis-prod --resource-type Applications.Datastores/redisCaches --resource-name cache
+
+# preview the changes of deploying a recipe with parameters, with a JSON output
+rad recipe plan redis-prod --resource-type Applications.Datastores/redisCaches --resource-name cache --parameters size=large --output json
+
+# preview the changes of deploying a recipe for a resource consumed by an application
+rad recipe plan redis-prod --resource-type Applications.Datastores/redisCaches --resource-name cache --application my-app --group dev --environment dev`,
+		RunE: framework.RunCommand(runner),
+		Args: cobra.ExactArgs(1),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddEnvironmentNameFlag(cmd)
+	commonflags.AddApplicationNameFlag(cmd)
+	commonflags.AddParameterFlag(cmd)
+	commonflags.AddResourceTypeFlag(cmd)
+	_ = cmd.MarkFlagRequired(cli.ResourceTypeFlag)
+	cmd.Flags().String(resourceNameFlag, "", "The name of the resource the recipe is deployed for")
+	_ = cmd.MarkFlagRequired(resourceNameFlag)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad recipe plan` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	RecipeName        string
+	ResourceType      string
+	ResourceName      string
+	ApplicationName   string
+	Parameters        map[string]map[string]any
+	Format            string
+}
+
+// NewRunner creates a new instance of the `rad recipe plan` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad recipe plan` command.
+//
+
+// Validate validates the command line arguments and sets the workspace, environment, recipe name, portable resource
+// type and name, application, parameters and output format in the Runner struct.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	if !r.Workspace.IsNamedWorkspace() {
+		return workspaces.ErrNamedWorkspaceRequired
+	}
+
+	environment, err := cli.RequireEnvironmentName(cmd, args, *workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Environment = environment
+
+	recipeName, err := cli.RequireRecipeNameArgs(cmd, args)
+	if err != nil {
+		return err
+	}
+	r.RecipeName = recipeName
+
+	resourceType, err := cli.GetResourceType(cmd)
+	if err != nil {
+		return err
+	}
+	r.ResourceType = resourceType
+
+	resourceName, err := cmd.Flags().GetString(resourceNameFlag)
+	if err != nil {
+		return err
+	}
+	r.ResourceName = resourceName
+
+	applicationName, err := cli.ReadApplicationName(cmd, *workspace)
+	if err != nil {
+		return err
+	}
+	r.ApplicationName = applicationName
+
+	parameterArgs, err := cmd.Flags().GetStringArray("parameters")
+	if err != nil {
+		return err
+	}
+
+	parser := bicep.ParameterParser{FileSystem: bicep.OSFileSystem{}}
+	r.Parameters, err = parser.Parse(parameterArgs...)
+	if err != nil {
+		return err
+	}
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	if format == "" {
+		format = "table"
+	}
+	r.Format = format
+
+	return nil
+}
+
+// Run runs the `rad recipe plan` command.
+//
+
+// Run gets the changes the deployment of the recipe would make from the Applications Management service and prints
+// them in the specified format. It returns an error if one occurs.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	request := v20231001preview.RecipePlan{
+		Name:         to.Ptr(r.RecipeName),
+		ResourceType: to.Ptr(r.ResourceType),
+		ResourceName: to.Ptr(r.ResourceName),
+		Parameters:   bicep.ConvertToMapStringInterface(r.Parameters),
+	}
+	if r.ApplicationName != "" {
+		request.Application = to.Ptr(r.Workspace.Scope + "/providers/Applications.Core/applications/" + r.ApplicationName)
+	}
+
+	plan, err := client.PlanRecipe(ctx, r.Workspace.Environment, request)
+	if err != nil {
+		return err
+	}
+
+	changes := []ResourceChange{}
+	for _, change := range plan.Changes {
+		if change == nil || change.ChangeType == nil {
+			continue
+		}
+
+		changes = append(changes, ResourceChange{
+			ChangeType: string(*change.ChangeType),
+			ResourceID: to.String(change.ResourceID),
+			Address:    to.String(change.Address),
+		})
+	}
+
+	if len(changes) == 0 {
+		r.Output.LogInfo("No changes. The resources deployed by recipe %q for resource %q are up to date.", r.RecipeName, r.ResourceName)
+		return nil
+	}
+
+	err = r.Output.WriteFormatted(r.Format, changes, objectformats.GetRecipePlanTableFormat())
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("")
+	r.Output.LogInfo(summarize(changes))
+
+	return nil
+}
+
+// summarize returns a one line summary of the number of resources created, updated and deleted.
+func summarize(changes []ResourceChange) string {
+	counts := map[string]int{}
+	for _, change := range changes {
+		counts[change.ChangeType]++
+	}
+
+	return fmt.Sprintf("Plan: %d to create, %d to update, %d to delete.",
+		counts[string(v20231001preview.RecipeResourceChangeTypeCreate)],
+		counts[string(v20231001preview.RecipeResourceChangeTypeUpdate)],
+		counts[string(v20231001preview.RecipeResourceChangeTypeDelete)])
+}
+
+// ResourceChange is a change the deployment of a recipe would make to a resource.
+type ResourceChange struct {
+	ChangeType string `json:"changeType"`
+	ResourceID string `json:"resourceId,omitempty"`
+	Address    string `json:"address,omitempty"`
+}
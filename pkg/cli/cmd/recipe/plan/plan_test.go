@@ -0,0 +1,253 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	datastoresrp "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid Plan Command",
+			Input:         []string{"recipeName", "--resource-type", datastoresrp.RedisCachesResourceType, "--resource-name", "cache"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "recipeName", r.RecipeName)
+				require.Equal(t, datastoresrp.RedisCachesResourceType, r.ResourceType)
+				require.Equal(t, "cache", r.ResourceName)
+				require.Equal(t, "table", r.Format)
+			},
+		},
+		{
+			Name:          "Valid Plan Command with parameters and application",
+			Input:         []string{"recipeName", "--resource-type", datastoresrp.RedisCachesResourceType, "--resource-name", "cache", "-a", "my-app", "-p", "size=large"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "my-app", r.ApplicationName)
+				require.Equal(t, map[string]map[string]any{"size": {"value": "large"}}, r.Parameters)
+			},
+		},
+		{
+			Name:          "Plan Command with incorrect fallback workspace",
+			Input:         []string{"-e", "my-env", "-g", "my-env", "recipeName", "--resource-type", datastoresrp.RedisCachesResourceType, "--resource-name", "cache"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         radcli.LoadEmptyConfig(t),
+			},
+		},
+		{
+			Name:          "Plan Command with too many positional args",
+			Input:         []string{"recipeName", "arg2", "--resource-type", datastoresrp.RedisCachesResourceType, "--resource-name", "cache"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Plan Command without ResourceType",
+			Input:         []string{"recipeName", "--resource-name", "cache"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Plan Command without ResourceName",
+			Input:         []string{"recipeName", "--resource-type", datastoresrp.RedisCachesResourceType},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	t.Run("Plan recipe - Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		plan := v20231001preview.RecipePlanResponse{
+			Changes: []*v20231001preview.RecipeResourceChange{
+				{
+					ChangeType: to.Ptr(v20231001preview.RecipeResourceChangeTypeCreate),
+					ResourceID: to.Ptr("/planes/kubernetes/local/namespaces/default/providers/core/Service/redis"),
+					Address:    to.Ptr("kubernetes_service.redis"),
+				},
+				{
+					ChangeType: to.Ptr(v20231001preview.RecipeResourceChangeTypeUpdate),
+					ResourceID: to.Ptr("/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/redis"),
+					Address:    to.Ptr("kubernetes_deployment.redis"),
+				},
+				{
+					ChangeType: to.Ptr(v20231001preview.RecipeResourceChangeTypeCreate),
+					Address:    to.Ptr("random_password.redis"),
+				},
+			},
+		}
+		expectedRequest := v20231001preview.RecipePlan{
+			Name:         to.Ptr("redis-prod"),
+			ResourceType: to.Ptr(datastoresrp.RedisCachesResourceType),
+			ResourceName: to.Ptr("cache"),
+			Application:  to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/my-app"),
+			Parameters:   map[string]any{"size": "large"},
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			PlanRecipe(gomock.Any(), "test-env", expectedRequest).
+			Return(plan, nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace: &workspaces.Workspace{
+				Scope:       "/planes/radius/local/resourceGroups/test-group",
+				Environment: "test-env",
+			},
+			Format:          "table",
+			RecipeName:      "redis-prod",
+			ResourceType:    datastoresrp.RedisCachesResourceType,
+			ResourceName:    "cache",
+			ApplicationName: "my-app",
+			Parameters:      map[string]map[string]any{"size": {"value": "large"}},
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format: "table",
+				Obj: []ResourceChange{
+					{
+						ChangeType: "Create",
+						ResourceID: "/planes/kubernetes/local/namespaces/default/providers/core/Service/redis",
+						Address:    "kubernetes_service.redis",
+					},
+					{
+						ChangeType: "Update",
+						ResourceID: "/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/redis",
+						Address:    "kubernetes_deployment.redis",
+					},
+					{
+						ChangeType: "Create",
+						Address:    "random_password.redis",
+					},
+				},
+				Options: objectformats.GetRecipePlanTableFormat(),
+			},
+			output.LogOutput{
+				Format: "",
+			},
+			output.LogOutput{
+				Format: "Plan: 2 to create, 1 to update, 0 to delete.",
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Plan recipe - No changes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			PlanRecipe(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(v20231001preview.RecipePlanResponse{Changes: []*v20231001preview.RecipeResourceChange{}}, nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{},
+			Format:            "table",
+			RecipeName:        "redis-prod",
+			ResourceType:      datastoresrp.RedisCachesResourceType,
+			ResourceName:      "cache",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "No changes. The resources deployed by recipe %q for resource %q are up to date.",
+				Params: []any{"redis-prod", "cache"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Plan recipe - Failure", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		expectedErr := errors.New("failed to plan recipe")
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			PlanRecipe(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(v20231001preview.RecipePlanResponse{}, expectedErr).Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            &output.MockOutput{},
+			Workspace:         &workspaces.Workspace{},
+			Format:            "table",
+			RecipeName:        "redis-prod",
+			ResourceType:      datastoresrp.RedisCachesResourceType,
+			ResourceName:      "cache",
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, expectedErr, err)
+	})
+}
@@ -63,6 +63,15 @@ func Test_Validate(t *testing.T) {
 				Config:         configWithWorkspace,
 			},
 		},
+		{
+			Name:          "Valid Register Command for helm recipe",
+			Input:         []string{"test_recipe", "--template-kind", recipes.TemplateKindHelm, "--template-path", "oci://test_registry/charts/test_chart", "--resource-type", ds_ctrl.RedisCachesResourceType, "--template-version", "1.0.0"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
 		{
 			Name:          "Valid Register Command with parameters passed as file",
 			Input:         []string{"test_recipe", "--template-kind", recipes.TemplateKindBicep, "--template-path", "test_template", "--resource-type", ds_ctrl.MongoDatabasesResourceType, "--parameters", "@testdata/recipeparam.json"},
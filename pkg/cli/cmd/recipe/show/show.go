@@ -30,6 +30,8 @@ import (
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/rp/portableresources"
 	"github.com/spf13/cobra"
 )
 
@@ -46,7 +48,7 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 		Short: "Show recipe details",
 		Long: `Show recipe details
 
-The recipe show command outputs details about a recipe. This includes the name, resource type, parameters, parameter details and template path.
+The recipe show command outputs details about a recipe. This includes the name, resource type, parameters, parameter details, template path and the outputs the recipe is expected to return for the resource type.
 	
 By default, the command is scoped to the resource group and environment defined in your rad.yaml workspace file. You can optionally override these values through the environment and group flags.
 	
@@ -216,7 +218,33 @@ func (r *Runner) Run(ctx context.Context) error {
 		r.Output.LogInfo("No parameters available")
 	}
 
-	return nil
+	r.Output.LogInfo("")
+
+	schema, ok := portableresources.GetRecipeOutputSchema(r.ResourceType)
+	if !ok {
+		r.Output.LogInfo("No output contract defined for resource type %s", r.ResourceType)
+		return nil
+	}
+
+	recipeOutputs := []RecipeOutput{}
+	for _, field := range schema.Values {
+		recipeOutputs = append(recipeOutputs, newRecipeOutput(field, false))
+	}
+	for _, field := range schema.Secrets {
+		recipeOutputs = append(recipeOutputs, newRecipeOutput(field, true))
+	}
+
+	return r.Output.WriteFormatted(r.Format, recipeOutputs, objectformats.GetRecipeOutputsTableFormat())
+}
+
+func newRecipeOutput(field recipes.OutputField, secret bool) RecipeOutput {
+	return RecipeOutput{
+		Name:        field.Name,
+		Type:        field.Type,
+		Required:    field.Required,
+		Secret:      secret,
+		Description: field.Description,
+	}
 }
 
 type RecipeParameter struct {
@@ -226,3 +254,12 @@ type RecipeParameter struct {
 	MaxValue     string      `json:"maxValue,omitempty"`
 	MinValue     string      `json:"minValue,omitempty"`
 }
+
+// RecipeOutput represents a value or secret the recipe is expected to return for the resource type.
+type RecipeOutput struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Required    bool   `json:"required"`
+	Secret      bool   `json:"secret"`
+	Description string `json:"description,omitempty"`
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	datastoresrp "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
 	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/rp/portableresources"
 	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/test/radcli"
 	"github.com/stretchr/testify/require"
@@ -94,6 +95,15 @@ func Test_Validate(t *testing.T) {
 }
 
 func Test_Run(t *testing.T) {
+	mongoOutputs := []RecipeOutput{
+		{Name: "host", Type: "string", Required: true, Description: "The host name of the MongoDB server."},
+		{Name: "port", Type: "int", Required: true, Description: "The port of the MongoDB server."},
+		{Name: "database", Type: "string", Required: true, Description: "The name of the database."},
+		{Name: "username", Type: "string", Description: "The username of the database."},
+		{Name: "password", Type: "string", Secret: true, Description: "The password of the database."},
+		{Name: "connectionString", Type: "string", Secret: true, Description: "The connection string of the database. Computed if not provided."},
+	}
+
 	t.Run("Show bicep recipe details - Success", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		envRecipe := v20231001preview.RecipeGetMetadataResponse{
@@ -165,6 +175,14 @@ func Test_Run(t *testing.T) {
 				Obj:     recipeParams,
 				Options: objectformats.GetRecipeParamsTableFormat(),
 			},
+			output.LogOutput{
+				Format: "",
+			},
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     mongoOutputs,
+				Options: objectformats.GetRecipeOutputsTableFormat(),
+			},
 		}
 		require.Equal(t, expected, outputSink.Writes)
 	})
@@ -242,6 +260,74 @@ func Test_Run(t *testing.T) {
 				Obj:     recipeParams,
 				Options: objectformats.GetRecipeParamsTableFormat(),
 			},
+			output.LogOutput{
+				Format: "",
+			},
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     mongoOutputs,
+				Options: objectformats.GetRecipeOutputsTableFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+	t.Run("Show recipe details without output contract - Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		envRecipe := v20231001preview.RecipeGetMetadataResponse{
+			TemplateKind: to.Ptr(recipes.TemplateKindBicep),
+			TemplatePath: to.Ptr("ghcr.io/testpublicrecipe/bicep/modules/extender:v1"),
+			Parameters:   map[string]any{},
+		}
+		recipe := types.EnvironmentRecipe{
+			Name:         "extender",
+			ResourceType: portableresources.ExtendersResourceType,
+			TemplateKind: recipes.TemplateKindBicep,
+			TemplatePath: "ghcr.io/testpublicrecipe/bicep/modules/extender:v1",
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ShowRecipe(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(envRecipe, nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{},
+			Format:            "table",
+			RecipeName:        "extender",
+			ResourceType:      portableresources.ExtendersResourceType,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     recipe,
+				Options: objectformats.GetEnvironmentRecipesTableFormat(),
+			},
+			output.LogOutput{
+				Format: "",
+			},
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     []RecipeParameter(nil),
+				Options: objectformats.GetRecipeParamsTableFormat(),
+			},
+			output.LogOutput{
+				Format: "No parameters available",
+			},
+			output.LogOutput{
+				Format: "",
+			},
+			output.LogOutput{
+				Format: "No output contract defined for resource type %s",
+				Params: []any{portableresources.ExtendersResourceType},
+			},
 		}
 		require.Equal(t, expected, outputSink.Writes)
 	})
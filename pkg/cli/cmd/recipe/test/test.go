@@ -0,0 +1,472 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package test
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/azure/armauth"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/kubernetes"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/kubeutil"
+	"github.com/radius-project/radius/pkg/portableresources/processors"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/configloader"
+	"github.com/radius-project/radius/pkg/recipes/driver"
+	"github.com/radius-project/radius/pkg/recipes/recipecontext"
+	"github.com/radius-project/radius/pkg/rp/portableresources"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/sdk/clients"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	ucp_provider "github.com/radius-project/radius/pkg/ucp/secret/provider"
+	"github.com/spf13/cobra"
+)
+
+const (
+	templateKindFlag    = "template-kind"
+	templateVersionFlag = "template-version"
+	resourceNameFlag    = "resource-name"
+	teardownFlag        = "teardown"
+	showSecretsFlag     = "show-secrets"
+
+	// redactedSecretValue replaces the value of the secrets output by the recipe unless they are requested.
+	redactedSecretValue = "<redacted>"
+
+	// testRecipeName is the name given to the recipe under test, it is not registered to the environment.
+	testRecipeName = "recipe-test"
+
+	// The deletion of the resources deployed by a Bicep recipe is retried, as resources can depend on each other.
+	bicepDeleteRetryCount        = 5
+	bicepDeleteRetryDelaySeconds = 10
+)
+
+// NewCommand creates an instance of the command and runner for the `rad recipe test` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "test [template-path]",
+		Short: "Execute a recipe template in isolation",
+		Long: `Execute a recipe template in isolation
+
+The recipe test command deploys a Bicep, Terraform or Helm recipe template for a scratch portable resource in an environment, without registering the recipe to the environment
+or creating the portable resource. It outputs the recipe context passed to the template, the raw 'result' output of the deployment and the recipe output parsed from it.
+The parsed output is validated against the output contract of the resource type.
+
+The values of the secrets output by the recipe are redacted unless the show-secrets flag is set.
+
+The resources deployed by the recipe are left in place unless the teardown flag is set. The same resources can be deleted later by running the command again
+for the same resource name with the teardown flag.
+
+You can specify parameters using the '--parameters' flag ('-p' for short). Parameters override the parameters set by the environment and can be passed as:
+  - A JSON file, prefixed with '@': '--parameters @myfile.json'
+  - A single value: '--parameters name=value'
+
+By default, the command is scoped to the resource group and environment defined in your rad.yaml workspace file. You can optionally override these values through the environment and group flags.`,
+		Example: `
+# execute a Bicep recipe template for a scratch redis cache and delete the deployed resources
+rad recipe test ghcr.io/myregistry/recipes/redis:1.0 --template-kind bicep --resource-type Applications.Datastores/redisCaches --teardown
+
+# execute a Terraform module with parameters
+rad recipe test Azure/redis/azurerm --template-kind terraform --template-version 1.0.0 --resource-type Applications.Datastores/redisCaches --parameters size=large
+
+# execute a Helm chart recipe
+rad recipe test oci://ghcr.io/myregistry/charts/redis --template-kind helm --template-version 1.0.0 --resource-type Applications.Datastores/redisCaches
+
+# execute a recipe template for a scratch resource with a fixed name consumed by an application
+rad recipe test ghcr.io/myregistry/recipes/redis:1.0 --template-kind bicep --resource-type Applications.Datastores/redisCaches --resource-name cache --application my-app --group dev --environment dev`,
+		RunE: framework.RunCommand(runner),
+		Args: cobra.ExactArgs(1),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddEnvironmentNameFlag(cmd)
+	commonflags.AddApplicationNameFlag(cmd)
+	commonflags.AddParameterFlag(cmd)
+	commonflags.AddResourceTypeFlag(cmd)
+	_ = cmd.MarkFlagRequired(cli.ResourceTypeFlag)
+	cmd.Flags().String(templateKindFlag, "", "specify the kind for the template provided by the recipe, bicep, terraform or helm.")
+	_ = cmd.MarkFlagRequired(templateKindFlag)
+	cmd.Flags().String(templateVersionFlag, "", "specify the version for the terraform module or the helm chart.")
+	cmd.Flags().String(resourceNameFlag, "", "The name of the scratch resource the recipe is executed for. A random name is used if it is not set")
+	cmd.Flags().Bool(teardownFlag, false, "Delete the resources deployed by the recipe after it is executed")
+	cmd.Flags().Bool(showSecretsFlag, false, "Print the values of the secrets output by the recipe instead of redacting them")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad recipe test` command.
+type Runner struct {
+	ConfigHolder    *framework.ConfigHolder
+	Output          output.Interface
+	Workspace       *workspaces.Workspace
+	TemplateKind    string
+	TemplatePath    string
+	TemplateVersion string
+	ResourceType    string
+	ResourceName    string
+	ApplicationName string
+	Parameters      map[string]map[string]any
+	Teardown        bool
+	ShowSecrets     bool
+
+	// ConfigurationLoader loads the configuration of the environment. It is created from the workspace connection if nil.
+	ConfigurationLoader configloader.ConfigurationLoader
+
+	// Drivers are the recipe drivers keyed by template kind. They are created from the workspace connection if nil.
+	Drivers map[string]driver.Driver
+}
+
+// NewRunner creates a new instance of the `rad recipe test` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder: factory.GetConfigHolder(),
+		Output:       factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad recipe test` command.
+//
+
+// Validate validates the command line arguments and sets the workspace, environment, template, portable resource
+// type and name, application, parameters, teardown and show secrets options in the Runner struct.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	scope, err := cli.RequireScope(cmd, *workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	environment, err := cli.RequireEnvironmentName(cmd, args, *workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Environment = environment
+
+	r.TemplatePath = args[0]
+
+	templateKind, err := cmd.Flags().GetString(templateKindFlag)
+	if err != nil {
+		return err
+	}
+	if templateKind != recipes.TemplateKindBicep && templateKind != recipes.TemplateKindTerraform && templateKind != recipes.TemplateKindHelm {
+		return clierrors.Message("Template kind %q is not supported. Supported kinds are %q, %q and %q.", templateKind, recipes.TemplateKindBicep, recipes.TemplateKindTerraform, recipes.TemplateKindHelm)
+	}
+	r.TemplateKind = templateKind
+
+	r.TemplateVersion, err = cmd.Flags().GetString(templateVersionFlag)
+	if err != nil {
+		return err
+	}
+
+	resourceType, err := cli.GetResourceType(cmd)
+	if err != nil {
+		return err
+	}
+	r.ResourceType = resourceType
+
+	resourceName, err := cmd.Flags().GetString(resourceNameFlag)
+	if err != nil {
+		return err
+	}
+	if resourceName == "" {
+		resourceName = testRecipeName + "-" + uuid.NewString()[:8]
+	}
+	r.ResourceName = resourceName
+
+	applicationName, err := cli.ReadApplicationName(cmd, *workspace)
+	if err != nil {
+		return err
+	}
+	r.ApplicationName = applicationName
+
+	parameterArgs, err := cmd.Flags().GetStringArray("parameters")
+	if err != nil {
+		return err
+	}
+
+	parser := bicep.ParameterParser{FileSystem: bicep.OSFileSystem{}}
+	r.Parameters, err = parser.Parse(parameterArgs...)
+	if err != nil {
+		return err
+	}
+
+	r.Teardown, err = cmd.Flags().GetBool(teardownFlag)
+	if err != nil {
+		return err
+	}
+
+	r.ShowSecrets, err = cmd.Flags().GetBool(showSecretsFlag)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run runs the `rad recipe test` command.
+//
+
+// Run executes the recipe template with the driver of its template kind for a scratch resource in the environment,
+// prints the recipe context, the raw outputs and the parsed recipe output with the values of the secrets redacted
+// unless they are requested, and deletes the deployed resources if teardown is requested. It returns an error if the
+// recipe fails or its outputs do not match the output contract of the resource type.
+func (r *Runner) Run(ctx context.Context) error {
+	// The drivers expect to run as part of an asynchronous operation of the resource provider.
+	ctx = v1.WithARMRequestContext(ctx, &v1.ARMRequestContext{OperationID: uuid.New()})
+
+	if r.ConfigurationLoader == nil || r.Drivers == nil {
+		cleanup, err := r.initialize()
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+	}
+
+	recipeDriver, ok := r.Drivers[r.TemplateKind]
+	if !ok {
+		return clierrors.Message("Template kind %q is not supported.", r.TemplateKind)
+	}
+
+	metadata, err := r.resourceMetadata()
+	if err != nil {
+		return err
+	}
+
+	configuration, err := r.ConfigurationLoader.LoadConfiguration(ctx, metadata)
+	if err != nil {
+		return err
+	}
+
+	recipeContext, err := recipecontext.New(&metadata, configuration)
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Recipe context:")
+	err = r.Output.WriteFormatted(output.FormatJson, recipeContext, output.FormatterOptions{})
+	if err != nil {
+		return err
+	}
+
+	definition := recipes.EnvironmentDefinition{
+		Name:            testRecipeName,
+		Driver:          r.TemplateKind,
+		ResourceType:    r.ResourceType,
+		TemplatePath:    r.TemplatePath,
+		TemplateVersion: r.TemplateVersion,
+	}
+	options := driver.BaseOptions{
+		Configuration: *configuration,
+		Recipe:        metadata,
+		Definition:    definition,
+	}
+
+	r.Output.LogInfo("Executing %s recipe %q for resource %q in environment %q...", r.TemplateKind, r.TemplatePath, r.ResourceName, r.Workspace.Environment)
+	recipeOutput, err := recipeDriver.Execute(ctx, driver.ExecuteOptions{BaseOptions: options})
+	if err != nil {
+		return err
+	}
+
+	rawOutput, secrets := recipeOutput.RawOutput, recipeOutput.Secrets
+	if !r.ShowSecrets {
+		rawOutput, secrets = redactRawOutput(rawOutput), redactSecrets(secrets)
+	}
+
+	r.Output.LogInfo("Raw outputs:")
+	err = r.Output.WriteFormatted(output.FormatJson, rawOutput, output.FormatterOptions{})
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Recipe output:")
+	err = r.Output.WriteFormatted(output.FormatJson, ParsedOutput{
+		Resources: recipeOutput.Resources,
+		Values:    recipeOutput.Values,
+		Secrets:   secrets,
+	}, output.FormatterOptions{})
+	if err != nil {
+		return err
+	}
+
+	// The contract is validated before the teardown, the resources are deleted either way when requested.
+	var contractErr error
+	if schema, ok := portableresources.GetRecipeOutputSchema(r.ResourceType); ok {
+		contractErr = schema.Validate(recipeOutput)
+	}
+
+	if r.Teardown {
+		outputResources, err := processors.GetOutputResourcesFromRecipe(recipeOutput)
+		if err != nil {
+			return err
+		}
+
+		r.Output.LogInfo("Deleting the resources deployed by the recipe...")
+		err = recipeDriver.Delete(ctx, driver.DeleteOptions{
+			BaseOptions:     options,
+			OutputResources: outputResources,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if contractErr != nil {
+		return contractErr
+	}
+
+	r.Output.LogInfo("")
+	r.Output.LogInfo("Recipe %q executed successfully.", r.TemplatePath)
+
+	return nil
+}
+
+// redactRawOutput returns a copy of the raw output of the recipe with the values of its secrets redacted.
+func redactRawOutput(rawOutput map[string]any) map[string]any {
+	if rawOutput == nil {
+		return nil
+	}
+
+	redacted := map[string]any{}
+	for k, v := range rawOutput {
+		redacted[k] = v
+	}
+
+	if secrets, ok := rawOutput["secrets"]; ok {
+		if m, ok := secrets.(map[string]any); ok {
+			redacted["secrets"] = redactSecrets(m)
+		} else {
+			redacted["secrets"] = redactedSecretValue
+		}
+	}
+
+	return redacted
+}
+
+// redactSecrets returns a copy of the given secrets with their values redacted. The names of the secrets are kept.
+func redactSecrets(secrets map[string]any) map[string]any {
+	if secrets == nil {
+		return nil
+	}
+
+	redacted := map[string]any{}
+	for k := range secrets {
+		redacted[k] = redactedSecretValue
+	}
+
+	return redacted
+}
+
+// resourceMetadata builds the metadata of the scratch resource the recipe is executed for.
+func (r *Runner) resourceMetadata() (recipes.ResourceMetadata, error) {
+	scope, err := resources.ParseScope(r.Workspace.Scope)
+	if err != nil {
+		return recipes.ResourceMetadata{}, err
+	}
+
+	metadata := recipes.ResourceMetadata{
+		Name:          testRecipeName,
+		EnvironmentID: scope.Append(resources.TypeSegment{Type: "Applications.Core/environments", Name: r.Workspace.Environment}).String(),
+		ResourceID:    scope.Append(resources.TypeSegment{Type: r.ResourceType, Name: r.ResourceName}).String(),
+		Parameters:    bicep.ConvertToMapStringInterface(r.Parameters),
+	}
+	if r.ApplicationName != "" {
+		metadata.ApplicationID = scope.Append(resources.TypeSegment{Type: "Applications.Core/applications", Name: r.ApplicationName}).String()
+	}
+
+	return metadata, nil
+}
+
+// initialize creates the configuration loader and the recipe drivers from the workspace connection, the same way
+// the resource providers create them. The returned function removes the Terraform working directory.
+func (r *Runner) initialize() (func(), error) {
+	connection, err := r.Workspace.Connect()
+	if err != nil {
+		return nil, err
+	}
+
+	kubeContext, _ := r.Workspace.KubernetesContext()
+	k8sConfig, err := kubernetes.NewCLIClientConfig(kubeContext)
+	if err != nil {
+		return nil, err
+	}
+
+	k8sClients, err := kubeutil.NewClients(k8sConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	arm, err := armauth.NewArmConfig(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	clientOptions := sdk.NewClientOptions(connection)
+	deploymentClient, err := clients.NewResourceDeploymentsClient(&clients.Options{
+		Cred:             &aztoken.AnonymousCredential{},
+		BaseURI:          connection.Endpoint(),
+		ARMClientOptions: clientOptions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	terraformDir, err := os.MkdirTemp("", "rad-recipe-test-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the Terraform working directory: %w", err)
+	}
+
+	resourceClient := processors.NewResourceClient(arm, connection, k8sClients.RuntimeClient, k8sClients.DiscoveryClient)
+	r.ConfigurationLoader = configloader.NewEnvironmentLoader(clientOptions)
+	r.Drivers = map[string]driver.Driver{
+		recipes.TemplateKindBicep: driver.NewBicepDriver(clientOptions, deploymentClient, resourceClient, driver.BicepOptions{
+			DeleteRetryCount:        bicepDeleteRetryCount,
+			DeleteRetryDelaySeconds: bicepDeleteRetryDelaySeconds,
+		}),
+		recipes.TemplateKindTerraform: driver.NewTerraformDriver(connection,
+			ucp_provider.NewSecretProvider(ucp_provider.SecretProviderOptions{Provider: ucp_provider.TypeKubernetesSecret}),
+			configloader.NewSecretStoreLoader(clientOptions), driver.TerraformOptions{Path: terraformDir}, k8sClients.ClientSet),
+		recipes.TemplateKindHelm: driver.NewHelmDriver(k8sConfig, k8sClients.ClientSet, resourceClient, driver.HelmOptions{}),
+	}
+
+	return func() { _ = os.RemoveAll(terraformDir) }, nil
+}
+
+// ParsedOutput is the recipe output parsed from the 'result' output of the recipe.
+type ParsedOutput struct {
+	Resources []string       `json:"resources"`
+	Values    map[string]any `json:"values"`
+	Secrets   map[string]any `json:"secrets"`
+}
@@ -0,0 +1,325 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	datastoresrp "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/configloader"
+	"github.com/radius-project/radius/pkg/recipes/driver"
+	"github.com/radius-project/radius/pkg/recipes/recipecontext"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid Test Command",
+			Input:         []string{"ghcr.io/recipes/redis:1.0", "--template-kind", "bicep", "--resource-type", datastoresrp.RedisCachesResourceType},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "ghcr.io/recipes/redis:1.0", r.TemplatePath)
+				require.Equal(t, recipes.TemplateKindBicep, r.TemplateKind)
+				require.Equal(t, datastoresrp.RedisCachesResourceType, r.ResourceType)
+				require.Regexp(t, "^recipe-test-[0-9a-f]{8}$", r.ResourceName)
+				require.False(t, r.Teardown)
+				require.False(t, r.ShowSecrets)
+			},
+		},
+		{
+			Name: "Valid Test Command with all options",
+			Input: []string{"Azure/redis/azurerm", "--template-kind", "terraform", "--template-version", "1.0.0", "--resource-type", datastoresrp.RedisCachesResourceType,
+				"--resource-name", "cache", "-a", "my-app", "-p", "size=large", "--teardown", "--show-secrets"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, recipes.TemplateKindTerraform, r.TemplateKind)
+				require.Equal(t, "1.0.0", r.TemplateVersion)
+				require.Equal(t, "cache", r.ResourceName)
+				require.Equal(t, "my-app", r.ApplicationName)
+				require.Equal(t, map[string]map[string]any{"size": {"value": "large"}}, r.Parameters)
+				require.True(t, r.Teardown)
+				require.True(t, r.ShowSecrets)
+			},
+		},
+		{
+			Name:          "Test Command with helm template kind",
+			Input:         []string{"oci://registry/charts/redis", "--template-kind", "helm", "--template-version", "1.0.0", "--resource-type", datastoresrp.RedisCachesResourceType},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, recipes.TemplateKindHelm, r.TemplateKind)
+				require.Equal(t, "1.0.0", r.TemplateVersion)
+			},
+		},
+		{
+			Name:          "Test Command with unsupported template kind",
+			Input:         []string{"oci://registry/charts/redis", "--template-kind", "kustomize", "--resource-type", datastoresrp.RedisCachesResourceType},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Test Command without template kind",
+			Input:         []string{"ghcr.io/recipes/redis:1.0", "--resource-type", datastoresrp.RedisCachesResourceType},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Test Command without ResourceType",
+			Input:         []string{"ghcr.io/recipes/redis:1.0", "--template-kind", "bicep"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Test Command without template path",
+			Input:         []string{"--template-kind", "bicep", "--resource-type", datastoresrp.RedisCachesResourceType},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	scope := "/planes/radius/local/resourceGroups/test-group"
+	metadata := recipes.ResourceMetadata{
+		Name:          testRecipeName,
+		EnvironmentID: scope + "/providers/Applications.Core/environments/test-env",
+		ApplicationID: scope + "/providers/Applications.Core/applications/my-app",
+		ResourceID:    scope + "/providers/Applications.Datastores/redisCaches/cache",
+		Parameters:    map[string]any{"size": "large"},
+	}
+	configuration := &recipes.Configuration{
+		Runtime: recipes.RuntimeConfiguration{
+			Kubernetes: &recipes.KubernetesRuntime{
+				Namespace:            "default-my-app",
+				EnvironmentNamespace: "default",
+			},
+		},
+	}
+	definition := recipes.EnvironmentDefinition{
+		Name:            testRecipeName,
+		Driver:          recipes.TemplateKindTerraform,
+		ResourceType:    datastoresrp.RedisCachesResourceType,
+		TemplatePath:    "Azure/redis/azurerm",
+		TemplateVersion: "1.0.0",
+	}
+	baseOptions := driver.BaseOptions{
+		Configuration: *configuration,
+		Recipe:        metadata,
+		Definition:    definition,
+	}
+	rawOutput := map[string]any{
+		"resources": []any{"/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/redis"},
+		"values":    map[string]any{"host": "redis.default.svc.cluster.local", "port": float64(6379)},
+		"secrets":   map[string]any{"password": "p@ssw0rd"},
+	}
+
+	newRunner := func(loader configloader.ConfigurationLoader, recipeDriver driver.Driver, teardown bool) (*Runner, *output.MockOutput) {
+		outputSink := &output.MockOutput{}
+		return &Runner{
+			Output: outputSink,
+			Workspace: &workspaces.Workspace{
+				Scope:       scope,
+				Environment: "test-env",
+			},
+			TemplateKind:        recipes.TemplateKindTerraform,
+			TemplatePath:        "Azure/redis/azurerm",
+			TemplateVersion:     "1.0.0",
+			ResourceType:        datastoresrp.RedisCachesResourceType,
+			ResourceName:        "cache",
+			ApplicationName:     "my-app",
+			Parameters:          map[string]map[string]any{"size": {"value": "large"}},
+			Teardown:            teardown,
+			ConfigurationLoader: loader,
+			Drivers:             map[string]driver.Driver{recipes.TemplateKindTerraform: recipeDriver},
+		}, outputSink
+	}
+
+	t.Run("Success with teardown", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		recipeOutput := &recipes.RecipeOutput{
+			Resources: []string{"/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/redis"},
+			Values:    map[string]any{"host": "redis.default.svc.cluster.local", "port": float64(6379)},
+			Secrets:   map[string]any{"password": "p@ssw0rd"},
+			RawOutput: rawOutput,
+		}
+
+		loader := configloader.NewMockConfigurationLoader(ctrl)
+		loader.EXPECT().LoadConfiguration(gomock.Any(), metadata).Return(configuration, nil).Times(1)
+
+		recipeDriver := driver.NewMockDriver(ctrl)
+		recipeDriver.EXPECT().Execute(gomock.Any(), driver.ExecuteOptions{BaseOptions: baseOptions}).Return(recipeOutput, nil).Times(1)
+		recipeDriver.EXPECT().Delete(gomock.Any(), driver.DeleteOptions{
+			BaseOptions: baseOptions,
+			OutputResources: []rpv1.OutputResource{
+				{
+					ID:            resources.MustParse("/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/redis"),
+					RadiusManaged: to.Ptr(true),
+				},
+			},
+		}).Return(nil).Times(1)
+
+		runner, outputSink := newRunner(loader, recipeDriver, true)
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expectedContext, err := recipecontext.New(&metadata, configuration)
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{Format: "Recipe context:"},
+			output.FormattedOutput{Format: output.FormatJson, Obj: expectedContext},
+			output.LogOutput{
+				Format: "Executing %s recipe %q for resource %q in environment %q...",
+				Params: []any{recipes.TemplateKindTerraform, "Azure/redis/azurerm", "cache", "test-env"},
+			},
+			output.LogOutput{Format: "Raw outputs:"},
+			output.FormattedOutput{
+				Format: output.FormatJson,
+				Obj: map[string]any{
+					"resources": rawOutput["resources"],
+					"values":    rawOutput["values"],
+					"secrets":   map[string]any{"password": "<redacted>"},
+				},
+			},
+			output.LogOutput{Format: "Recipe output:"},
+			output.FormattedOutput{
+				Format: output.FormatJson,
+				Obj: ParsedOutput{
+					Resources: recipeOutput.Resources,
+					Values:    recipeOutput.Values,
+					Secrets:   map[string]any{"password": "<redacted>"},
+				},
+			},
+			output.LogOutput{Format: "Deleting the resources deployed by the recipe..."},
+			output.LogOutput{Format: ""},
+			output.LogOutput{Format: "Recipe %q executed successfully.", Params: []any{"Azure/redis/azurerm"}},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Success with secrets shown", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		recipeOutput := &recipes.RecipeOutput{
+			Resources: []string{"/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/redis"},
+			Values:    map[string]any{"host": "redis.default.svc.cluster.local", "port": float64(6379)},
+			Secrets:   map[string]any{"password": "p@ssw0rd"},
+			RawOutput: rawOutput,
+		}
+
+		loader := configloader.NewMockConfigurationLoader(ctrl)
+		loader.EXPECT().LoadConfiguration(gomock.Any(), metadata).Return(configuration, nil).Times(1)
+
+		recipeDriver := driver.NewMockDriver(ctrl)
+		recipeDriver.EXPECT().Execute(gomock.Any(), driver.ExecuteOptions{BaseOptions: baseOptions}).Return(recipeOutput, nil).Times(1)
+
+		runner, outputSink := newRunner(loader, recipeDriver, false)
+		runner.ShowSecrets = true
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		require.Contains(t, outputSink.Writes, output.FormattedOutput{Format: output.FormatJson, Obj: rawOutput})
+		require.Contains(t, outputSink.Writes, output.FormattedOutput{
+			Format: output.FormatJson,
+			Obj: ParsedOutput{
+				Resources: recipeOutput.Resources,
+				Values:    recipeOutput.Values,
+				Secrets:   recipeOutput.Secrets,
+			},
+		})
+	})
+
+	t.Run("Outputs do not match the contract", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		recipeOutput := &recipes.RecipeOutput{
+			Resources: []string{},
+			Values:    map[string]any{"port": float64(6379)},
+			Secrets:   map[string]any{},
+			RawOutput: map[string]any{"values": map[string]any{"port": float64(6379)}},
+		}
+
+		loader := configloader.NewMockConfigurationLoader(ctrl)
+		loader.EXPECT().LoadConfiguration(gomock.Any(), metadata).Return(configuration, nil).Times(1)
+
+		recipeDriver := driver.NewMockDriver(ctrl)
+		recipeDriver.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(recipeOutput, nil).Times(1)
+
+		runner, _ := newRunner(loader, recipeDriver, false)
+		err := runner.Run(context.Background())
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `missing required value "host"`)
+	})
+
+	t.Run("Recipe execution fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		loader := configloader.NewMockConfigurationLoader(ctrl)
+		loader.EXPECT().LoadConfiguration(gomock.Any(), metadata).Return(configuration, nil).Times(1)
+
+		recipeDriver := driver.NewMockDriver(ctrl)
+		recipeDriver.EXPECT().Execute(gomock.Any(), gomock.Any()).
+			Return(nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, "failed to deploy recipe", "", nil)).Times(1)
+
+		runner, _ := newRunner(loader, recipeDriver, true)
+		err := runner.Run(context.Background())
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to deploy recipe")
+	})
+}
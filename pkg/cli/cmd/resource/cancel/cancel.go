@@ -0,0 +1,163 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cancel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/google/uuid"
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/prompt"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+const (
+	cancelConfirmation = "Are you sure you want to cancel operation %v of resource type %v?"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad resource cancel` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "cancel [resourceType] [operationId]",
+		Short: "Cancel an in-flight operation of a Radius resource",
+		Long: `Cancels an in-flight operation of a Radius resource, such as a deployment or a deletion.
+
+The operation is stopped by the Radius control plane and completed with the Canceled status. The resources which are
+already deployed by the operation are not rolled back. The operation id is the last segment of the Azure-AsyncOperation
+URL returned when the operation is started.`,
+		Example: `
+sample list of resourceType: containers, gateways, httpRoutes, daprPubSubBrokers, extenders, mongoDatabases, rabbitMQMessageQueues, redisCaches, sqlDatabases, daprStateStores, daprSecretStores
+
+# Cancel an operation of a container
+rad resource cancel containers 6f8c1ec2-5a5e-4a53-8ec5-44e0f2a5d4f1`,
+		Args: cobra.ExactArgs(2),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddConfirmationFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad resource cancel` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	ResourceType      string
+	OperationID       string
+
+	InputPrompter prompt.Interface
+	Confirm       bool
+}
+
+// NewRunner creates a new instance of the `rad resource cancel` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+		InputPrompter:     factory.GetPrompter(),
+	}
+}
+
+// Validate runs validation for the `rad resource cancel` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	resourceType, err := cli.RequireResourceType(args)
+	if err != nil {
+		return err
+	}
+	r.ResourceType = resourceType
+
+	if _, err := uuid.Parse(args[1]); err != nil {
+		return clierrors.Message("The operation id %q is invalid. The operation id must be a UUID.", args[1])
+	}
+	r.OperationID = args[1]
+
+	yes, err := cmd.Flags().GetBool("yes")
+	if err != nil {
+		return err
+	}
+	r.Confirm = yes
+
+	return nil
+}
+
+// Run runs the `rad resource cancel` command.
+func (r *Runner) Run(ctx context.Context) error {
+	// Prompt user to confirm cancellation
+	if !r.Confirm {
+		confirmed, err := prompt.YesOrNoPrompt(fmt.Sprintf(cancelConfirmation, r.OperationID, r.ResourceType), prompt.ConfirmNo, r.InputPrompter)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			r.Output.LogInfo("operation %q NOT canceled", r.OperationID)
+			return nil
+		}
+	}
+
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	err = client.CancelOperation(ctx, r.ResourceType, r.OperationID)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The operation %q was not found.", r.OperationID)
+	} else if isConflictError(err) {
+		return clierrors.Message("The operation %q has already completed.", r.OperationID)
+	} else if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Operation %q canceled", r.OperationID)
+	return nil
+}
+
+func isConflictError(err error) bool {
+	responseError := &azcore.ResponseError{}
+	return errors.As(err, &responseError) && responseError.StatusCode == http.StatusConflict
+}
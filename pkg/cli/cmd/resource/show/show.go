@@ -20,6 +20,7 @@ import (
 	"context"
 
 	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/framework"
@@ -143,5 +144,26 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 
+	// The drift status is part of the resource for the other output formats.
+	if r.Format != output.FormatTable {
+		return nil
+	}
+
+	drift, err := clients.GetResourceDriftStatus(resourceDetails)
+	if err != nil {
+		return err
+	}
+
+	if drift != nil && drift.Detected {
+		// Print newline for readability
+		r.Output.LogInfo("")
+		r.Output.LogInfo("Drift detected in the resources deployed by the recipe (last checked %s):", drift.LastCheckedTime)
+
+		err = r.Output.WriteFormatted(r.Format, drift.Resources, objectformats.GetDriftedResourceTableFormat())
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
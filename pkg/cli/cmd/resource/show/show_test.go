@@ -127,4 +127,73 @@ func Test_Run(t *testing.T) {
 		}
 		require.Equal(t, expected, outputSink.Writes)
 	})
+
+	t.Run("Validate rad resource show with drifted recipe resources", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		resource := radcli.CreateResource("redisCaches", "foo")
+		resource.Properties = map[string]any{
+			"status": map[string]any{
+				"recipe": map[string]any{
+					"drift": map[string]any{
+						"detected":        true,
+						"lastCheckedTime": "2023-10-01T10:00:00Z",
+						"resources": []any{
+							map[string]any{
+								"resourceId": "/planes/aws/aws/accounts/000/regions/us-west-2/providers/AWS.MemoryDB/Cluster/redis",
+								"address":    "aws_memorydb_cluster.redis",
+								"reason":     "Deleted",
+							},
+						},
+					},
+				},
+			},
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ShowResource(gomock.Any(), "redisCaches", "foo").
+			Return(resource, nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{},
+			ResourceType:      "redisCaches",
+			ResourceName:      "foo",
+			Format:            "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     resource,
+				Options: objectformats.GetResourceTableFormat(),
+			},
+			output.LogOutput{
+				Format: "",
+			},
+			output.LogOutput{
+				Format: "Drift detected in the resources deployed by the recipe (last checked %s):",
+				Params: []any{"2023-10-01T10:00:00Z"},
+			},
+			output.FormattedOutput{
+				Format: "table",
+				Obj: []clients.DriftedResource{
+					{
+						ResourceID: "/planes/aws/aws/accounts/000/regions/us-west-2/providers/AWS.MemoryDB/Cluster/redis",
+						Address:    "aws_memorydb_cluster.redis",
+						Reason:     "Deleted",
+					},
+				},
+				Options: objectformats.GetDriftedResourceTableFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
 }
@@ -46,6 +46,7 @@ type Factory interface {
 	CreateDiagnosticsClient(ctx context.Context, workspace workspaces.Workspace) (clients.DiagnosticsClient, error)
 	CreateApplicationsManagementClient(ctx context.Context, workspace workspaces.Workspace) (clients.ApplicationsManagementClient, error)
 	CreateCredentialManagementClient(ctx context.Context, workspace workspaces.Workspace) (cli_credential.CredentialManagementClient, error)
+	CreateDeadLetterClient(ctx context.Context, workspace workspaces.Workspace) (clients.DeadLetterClient, error)
 }
 
 var _ Factory = (*impl)(nil)
@@ -233,3 +234,21 @@ func (*impl) CreateCredentialManagementClient(ctx context.Context, workspace wor
 
 	return cpClient, nil
 }
+
+// CreateDeadLetterClient connects to a workspace, tests the connection, and creates a DeadLetterClient which uses the
+// UCP admin API. It returns an error if any of the steps fail.
+func (i *impl) CreateDeadLetterClient(ctx context.Context, workspace workspaces.Workspace) (clients.DeadLetterClient, error) {
+	connection, err := workspace.Connect()
+	if err != nil {
+		return nil, err
+	}
+
+	err = sdk.TestConnection(ctx, connection)
+	if errors.Is(err, &sdk.ErrRadiusNotInstalled{}) {
+		return nil, clierrors.MessageWithCause(err, "Could not connect to Radius.")
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &clients.UCPDeadLetterClient{Connection: connection}, nil
+}
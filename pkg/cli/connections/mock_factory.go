@@ -30,6 +30,7 @@ type MockFactory struct {
 	ApplicationsManagementClient clients.ApplicationsManagementClient
 	CredentialManagementClient   cli_credential.CredentialManagementClient
 	DiagnosticsClient            clients.DiagnosticsClient
+	DeadLetterClient             clients.DeadLetterClient
 }
 
 // CreateDeploymentClient function takes in a context and a workspace and returns a DeploymentClient and an error, if any.
@@ -52,3 +53,8 @@ func (f *MockFactory) CreateApplicationsManagementClient(ctx context.Context, wo
 func (f *MockFactory) CreateCredentialManagementClient(ctx context.Context, workspace workspaces.Workspace) (cli_credential.CredentialManagementClient, error) {
 	return f.CredentialManagementClient, nil
 }
+
+// CreateDeadLetterClient function takes in a context and a workspace and returns a DeadLetterClient and does not return an error.
+func (f *MockFactory) CreateDeadLetterClient(ctx context.Context, workspace workspaces.Workspace) (clients.DeadLetterClient, error) {
+	return f.DeadLetterClient, nil
+}
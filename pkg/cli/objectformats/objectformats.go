@@ -55,6 +55,27 @@ func GetApplicationGatewaysTableFormat() output.FormatterOptions {
 	}
 }
 
+// GetApplicationDriftTableFormat returns a FormatterOptions object which contains a list of columns to be used for
+// displaying the resources of an application whose recipe-provisioned resources have drifted.
+func GetApplicationDriftTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "DRIFTED RESOURCE",
+				JSONPath: "{ .Name }",
+			},
+			{
+				Heading:  "TYPE",
+				JSONPath: "{ .Type }",
+			},
+			{
+				Heading:  "LAST CHECKED",
+				JSONPath: "{ .LastCheckedTime }",
+			},
+		},
+	}
+}
+
 // GetResourceTableFormat() returns a FormatterOptions struct containing two columns, one for the resource name and one for
 // the resource type.
 func GetResourceTableFormat() output.FormatterOptions {
@@ -292,3 +313,132 @@ func GetRecipeParamsTableFormat() output.FormatterOptions {
 		},
 	}
 }
+
+// GetRecipeOutputsTableFormat returns a FormatterOptions struct containing the column headings and JSONPaths for the
+// outputs a recipe is expected to return.
+func GetRecipeOutputsTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "OUTPUT NAME",
+				JSONPath: "{ .Name }",
+			},
+			{
+				Heading:  "TYPE",
+				JSONPath: "{ .Type }",
+			},
+			{
+				Heading:  "REQUIRED",
+				JSONPath: "{ .Required }",
+			},
+			{
+				Heading:  "SECRET",
+				JSONPath: "{ .Secret }",
+			},
+			{
+				Heading:  "DESCRIPTION",
+				JSONPath: "{ .Description }",
+			},
+		},
+	}
+}
+
+// GetRecipePlanTableFormat returns a FormatterOptions struct containing the column headings and JSONPaths for the
+// changes the deployment of a recipe would make.
+func GetRecipePlanTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "CHANGE",
+				JSONPath: "{ .ChangeType }",
+			},
+			{
+				Heading:  "RESOURCE",
+				JSONPath: "{ .ResourceID }",
+			},
+			{
+				Heading:  "ADDRESS",
+				JSONPath: "{ .Address }",
+			},
+		},
+	}
+}
+
+// GetDeadLetterTableFormat returns a FormatterOptions object which contains a list of columns to be used for displaying
+// the dead-lettered async operation messages.
+func GetDeadLetterTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "ID",
+				JSONPath: "{ .ID }",
+			},
+			{
+				Heading:  "DEQUEUE COUNT",
+				JSONPath: "{ .DequeueCount }",
+			},
+			{
+				Heading:  "DEAD-LETTERED AT",
+				JSONPath: "{ .DeadLetteredAt }",
+			},
+			{
+				Heading:  "LAST ERROR",
+				JSONPath: "{ .LastError }",
+			},
+		},
+	}
+}
+
+// GetOperationHistoryTableFormat returns a FormatterOptions object which contains a list of columns to be used for
+// displaying the operation history of a resource.
+func GetOperationHistoryTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "OPERATION",
+				JSONPath: "{ .Name }",
+			},
+			{
+				Heading:  "TYPE",
+				JSONPath: "{ .OperationType }",
+			},
+			{
+				Heading:  "STATUS",
+				JSONPath: "{ .Status }",
+			},
+			{
+				Heading:  "START TIME",
+				JSONPath: "{ .StartTime }",
+			},
+			{
+				Heading:  "END TIME",
+				JSONPath: "{ .EndTime }",
+			},
+			{
+				Heading:  "CALLER",
+				JSONPath: "{ .ClientObjectID }",
+			},
+		},
+	}
+}
+
+// GetDriftedResourceTableFormat returns a FormatterOptions object which contains a list of columns to be used for
+// displaying the recipe-provisioned resources of a resource which have drifted.
+func GetDriftedResourceTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "DRIFT",
+				JSONPath: "{ .Reason }",
+			},
+			{
+				Heading:  "RESOURCE",
+				JSONPath: "{ .ResourceID }",
+			},
+			{
+				Heading:  "ADDRESS",
+				JSONPath: "{ .Address }",
+			},
+		},
+	}
+}
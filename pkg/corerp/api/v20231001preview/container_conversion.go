@@ -58,43 +58,6 @@ func (src *ContainerResource) ConvertTo() (v1.DataModelInterface, error) {
 		}
 	}
 
-	var livenessProbe datamodel.HealthProbeProperties
-	if src.Properties.Container.LivenessProbe != nil {
-		livenessProbe = toHealthProbePropertiesDataModel(src.Properties.Container.LivenessProbe)
-	}
-
-	var readinessProbe datamodel.HealthProbeProperties
-	if src.Properties.Container.ReadinessProbe != nil {
-		readinessProbe = toHealthProbePropertiesDataModel(src.Properties.Container.ReadinessProbe)
-	}
-
-	ports := make(map[string]datamodel.ContainerPort)
-	for key, val := range src.Properties.Container.Ports {
-		port := datamodel.ContainerPort{
-			ContainerPort: to.Int32(val.ContainerPort),
-			Protocol:      toPortProtocolDataModel(val.Protocol),
-			Provides:      to.String(val.Provides),
-		}
-
-		if val.Port != nil {
-			port.Port = to.Int32(val.Port)
-		}
-
-		if val.Scheme != nil {
-			port.Scheme = to.String(val.Scheme)
-		}
-
-		ports[key] = port
-	}
-
-	var volumes map[string]datamodel.VolumeProperties
-	if src.Properties.Container.Volumes != nil {
-		volumes = make(map[string]datamodel.VolumeProperties)
-		for key, val := range src.Properties.Container.Volumes {
-			volumes[key] = toVolumePropertiesDataModel(val)
-		}
-	}
-
 	var extensions []datamodel.Extension
 	if src.Properties.Extensions != nil {
 		for _, e := range src.Properties.Extensions {
@@ -120,24 +83,15 @@ func (src *ContainerResource) ConvertTo() (v1.DataModelInterface, error) {
 			BasicResourceProperties: rpv1.BasicResourceProperties{
 				Application: to.String(src.Properties.Application),
 			},
-			Connections: connections,
-			Container: datamodel.Container{
-				Image:           to.String(src.Properties.Container.Image),
-				ImagePullPolicy: toImagePullPolicyDataModel(src.Properties.Container.ImagePullPolicy),
-				Env:             to.StringMap(src.Properties.Container.Env),
-				LivenessProbe:   livenessProbe,
-				Ports:           ports,
-				ReadinessProbe:  readinessProbe,
-				Volumes:         volumes,
-				Command:         stringSlice(src.Properties.Container.Command),
-				Args:            stringSlice(src.Properties.Container.Args),
-				WorkingDir:      to.String(src.Properties.Container.WorkingDir),
-			},
+			Connections:          connections,
+			Container:            toContainerDataModel(src.Properties.Container),
 			Extensions:           extensions,
+			InitContainers:       toNamedContainersDataModel(src.Properties.InitContainers),
 			Runtimes:             toRuntimePropertiesDataModel(src.Properties.Runtimes),
 			ResourceProvisioning: toContainerResourceProvisioningDataModel(src.Properties.ResourceProvisioning),
 			Resources:            toResourceReferencesDataModel(src.Properties.Resources),
 			RestartPolicy:        toRestartPolicyDataModel(src.Properties.RestartPolicy),
+			Sidecars:             toNamedContainersDataModel(src.Properties.Sidecars),
 		},
 	}
 
@@ -185,18 +139,119 @@ func (dst *ContainerResource) ConvertFrom(src v1.DataModelInterface) error {
 		}
 	}
 
+	var extensions []ExtensionClassification
+	if c.Properties.Extensions != nil {
+		for _, e := range c.Properties.Extensions {
+			extensions = append(extensions, fromExtensionClassificationDataModel(e))
+		}
+	}
+
+	var identity *IdentitySettings
+	if c.Properties.Identity != nil {
+		identity = &IdentitySettings{
+			Kind:       fromIdentityKind(c.Properties.Identity.Kind),
+			Resource:   to.Ptr(c.Properties.Identity.Resource),
+			OidcIssuer: to.Ptr(c.Properties.Identity.OIDCIssuer),
+		}
+	}
+
+	dst.ID = to.Ptr(c.ID)
+	dst.Name = to.Ptr(c.Name)
+	dst.Type = to.Ptr(c.Type)
+	dst.SystemData = fromSystemDataModel(c.SystemData)
+	dst.Location = to.Ptr(c.Location)
+	dst.Tags = *to.StringMapPtr(c.Tags)
+	dst.Properties = &ContainerProperties{
+		Status: &ResourceStatus{
+			OutputResources: toOutputResourcesDataModel(c.Properties.Status.OutputResources),
+		},
+		ProvisioningState:    fromProvisioningStateDataModel(c.InternalMetadata.AsyncProvisioningState),
+		Application:          to.Ptr(c.Properties.Application),
+		Connections:          connections,
+		Container:            fromContainerDataModel(c.Properties.Container),
+		Extensions:           extensions,
+		Identity:             identity,
+		InitContainers:       fromNamedContainersDataModel(c.Properties.InitContainers),
+		Runtimes:             fromRuntimePropertiesDataModel(c.Properties.Runtimes),
+		Resources:            fromResourceReferencesDataModel(c.Properties.Resources),
+		ResourceProvisioning: fromContainerResourceProvisioningDataModel(c.Properties.ResourceProvisioning),
+		RestartPolicy:        fromRestartPolicyDataModel(c.Properties.RestartPolicy),
+		Sidecars:             fromNamedContainersDataModel(c.Properties.Sidecars),
+	}
+
+	return nil
+}
+
+func toContainerDataModel(c *Container) datamodel.Container {
+	if c == nil {
+		return datamodel.Container{}
+	}
+
+	var livenessProbe datamodel.HealthProbeProperties
+	if c.LivenessProbe != nil {
+		livenessProbe = toHealthProbePropertiesDataModel(c.LivenessProbe)
+	}
+
+	var readinessProbe datamodel.HealthProbeProperties
+	if c.ReadinessProbe != nil {
+		readinessProbe = toHealthProbePropertiesDataModel(c.ReadinessProbe)
+	}
+
+	ports := make(map[string]datamodel.ContainerPort)
+	for key, val := range c.Ports {
+		port := datamodel.ContainerPort{
+			ContainerPort: to.Int32(val.ContainerPort),
+			Protocol:      toPortProtocolDataModel(val.Protocol),
+			Provides:      to.String(val.Provides),
+		}
+
+		if val.Port != nil {
+			port.Port = to.Int32(val.Port)
+		}
+
+		if val.Scheme != nil {
+			port.Scheme = to.String(val.Scheme)
+		}
+
+		ports[key] = port
+	}
+
+	var volumes map[string]datamodel.VolumeProperties
+	if c.Volumes != nil {
+		volumes = make(map[string]datamodel.VolumeProperties)
+		for key, val := range c.Volumes {
+			volumes[key] = toVolumePropertiesDataModel(val)
+		}
+	}
+
+	return datamodel.Container{
+		Image:           to.String(c.Image),
+		ImagePullPolicy: toImagePullPolicyDataModel(c.ImagePullPolicy),
+		Env:             toEnvironmentVariablesDataModel(c.Env),
+		LivenessProbe:   livenessProbe,
+		Ports:           ports,
+		ReadinessProbe:  readinessProbe,
+		Volumes:         volumes,
+		Command:         stringSlice(c.Command),
+		Args:            stringSlice(c.Args),
+		WorkingDir:      to.String(c.WorkingDir),
+		Resources:       toContainerResourceRequirementsDataModel(c.Resources),
+	}
+}
+
+func fromContainerDataModel(c datamodel.Container) *Container {
 	var livenessProbe HealthProbePropertiesClassification
-	if !c.Properties.Container.LivenessProbe.IsEmpty() {
-		livenessProbe = fromHealthProbePropertiesDataModel(c.Properties.Container.LivenessProbe)
+	if !c.LivenessProbe.IsEmpty() {
+		livenessProbe = fromHealthProbePropertiesDataModel(c.LivenessProbe)
 	}
 
 	var readinessProbe HealthProbePropertiesClassification
-	if !c.Properties.Container.ReadinessProbe.IsEmpty() {
-		readinessProbe = fromHealthProbePropertiesDataModel(c.Properties.Container.ReadinessProbe)
+	if !c.ReadinessProbe.IsEmpty() {
+		readinessProbe = fromHealthProbePropertiesDataModel(c.ReadinessProbe)
 	}
 
 	ports := make(map[string]*ContainerPortProperties)
-	for key, val := range c.Properties.Container.Ports {
+	for key, val := range c.Ports {
 		ports[key] = &ContainerPortProperties{
 			ContainerPort: to.Ptr(val.ContainerPort),
 			Protocol:      fromPortProtocolDataModel(val.Protocol),
@@ -213,63 +268,208 @@ func (dst *ContainerResource) ConvertFrom(src v1.DataModelInterface) error {
 	}
 
 	var volumes map[string]VolumeClassification
-	if c.Properties.Container.Volumes != nil {
+	if c.Volumes != nil {
 		volumes = make(map[string]VolumeClassification)
-		for key, val := range c.Properties.Container.Volumes {
+		for key, val := range c.Volumes {
 			volumes[key] = fromVolumePropertiesDataModel(val)
 		}
 	}
 
-	var extensions []ExtensionClassification
-	if c.Properties.Extensions != nil {
-		for _, e := range c.Properties.Extensions {
-			extensions = append(extensions, fromExtensionClassificationDataModel(e))
+	return &Container{
+		Image:           to.Ptr(c.Image),
+		ImagePullPolicy: fromImagePullPolicyDataModel(c.ImagePullPolicy),
+		Env:             fromEnvironmentVariablesDataModel(c.Env),
+		LivenessProbe:   livenessProbe,
+		Ports:           ports,
+		ReadinessProbe:  readinessProbe,
+		Volumes:         volumes,
+		Command:         to.SliceOfPtrs(c.Command...),
+		Args:            to.SliceOfPtrs(c.Args...),
+		WorkingDir:      to.Ptr(c.WorkingDir),
+		Resources:       fromContainerResourceRequirementsDataModel(c.Resources),
+	}
+}
+
+func toNamedContainersDataModel(containers []*NamedContainer) []datamodel.NamedContainer {
+	if containers == nil {
+		return nil
+	}
+
+	result := []datamodel.NamedContainer{}
+	for _, c := range containers {
+		if c == nil {
+			continue
 		}
+
+		result = append(result, datamodel.NamedContainer{
+			Name: to.String(c.Name),
+			Container: toContainerDataModel(&Container{
+				Image:           c.Image,
+				ImagePullPolicy: c.ImagePullPolicy,
+				Env:             c.Env,
+				LivenessProbe:   c.LivenessProbe,
+				Ports:           c.Ports,
+				ReadinessProbe:  c.ReadinessProbe,
+				Volumes:         c.Volumes,
+				Command:         c.Command,
+				Args:            c.Args,
+				WorkingDir:      c.WorkingDir,
+				Resources:       c.Resources,
+			}),
+		})
 	}
 
-	var identity *IdentitySettings
-	if c.Properties.Identity != nil {
-		identity = &IdentitySettings{
-			Kind:       fromIdentityKind(c.Properties.Identity.Kind),
-			Resource:   to.Ptr(c.Properties.Identity.Resource),
-			OidcIssuer: to.Ptr(c.Properties.Identity.OIDCIssuer),
+	return result
+}
+
+func fromNamedContainersDataModel(containers []datamodel.NamedContainer) []*NamedContainer {
+	if containers == nil {
+		return nil
+	}
+
+	result := []*NamedContainer{}
+	for _, c := range containers {
+		container := fromContainerDataModel(c.Container)
+		result = append(result, &NamedContainer{
+			Name:            to.Ptr(c.Name),
+			Image:           container.Image,
+			ImagePullPolicy: container.ImagePullPolicy,
+			Env:             container.Env,
+			LivenessProbe:   container.LivenessProbe,
+			Ports:           container.Ports,
+			ReadinessProbe:  container.ReadinessProbe,
+			Volumes:         container.Volumes,
+			Command:         container.Command,
+			Args:            container.Args,
+			WorkingDir:      container.WorkingDir,
+			Resources:       container.Resources,
+		})
+	}
+
+	return result
+}
+
+func toEnvironmentVariablesDataModel(env map[string]*EnvironmentVariable) map[string]datamodel.EnvironmentVariable {
+	if env == nil {
+		return nil
+	}
+
+	result := map[string]datamodel.EnvironmentVariable{}
+	for name, e := range env {
+		if e == nil {
+			continue
 		}
+
+		v := datamodel.EnvironmentVariable{Value: e.Value}
+		if e.ValueFrom != nil {
+			v.ValueFrom = &datamodel.EnvironmentVariableReference{}
+			if ref := e.ValueFrom.SecretRef; ref != nil {
+				v.ValueFrom.SecretRef = &datamodel.EnvironmentVariableSecretReference{
+					Source: to.String(ref.Source),
+					Key:    to.String(ref.Key),
+				}
+			}
+			if ref := e.ValueFrom.ConnectionRef; ref != nil {
+				v.ValueFrom.ConnectionRef = &datamodel.EnvironmentVariableConnectionReference{
+					Name: to.String(ref.Name),
+					Key:  to.String(ref.Key),
+				}
+			}
+		}
+		result[name] = v
 	}
 
-	dst.ID = to.Ptr(c.ID)
-	dst.Name = to.Ptr(c.Name)
-	dst.Type = to.Ptr(c.Type)
-	dst.SystemData = fromSystemDataModel(c.SystemData)
-	dst.Location = to.Ptr(c.Location)
-	dst.Tags = *to.StringMapPtr(c.Tags)
-	dst.Properties = &ContainerProperties{
-		Status: &ResourceStatus{
-			OutputResources: toOutputResourcesDataModel(c.Properties.Status.OutputResources),
-		},
-		ProvisioningState: fromProvisioningStateDataModel(c.InternalMetadata.AsyncProvisioningState),
-		Application:       to.Ptr(c.Properties.Application),
-		Connections:       connections,
-		Container: &Container{
-			Image:           to.Ptr(c.Properties.Container.Image),
-			ImagePullPolicy: fromImagePullPolicyDataModel(c.Properties.Container.ImagePullPolicy),
-			Env:             *to.StringMapPtr(c.Properties.Container.Env),
-			LivenessProbe:   livenessProbe,
-			Ports:           ports,
-			ReadinessProbe:  readinessProbe,
-			Volumes:         volumes,
-			Command:         to.SliceOfPtrs(c.Properties.Container.Command...),
-			Args:            to.SliceOfPtrs(c.Properties.Container.Args...),
-			WorkingDir:      to.Ptr(c.Properties.Container.WorkingDir),
-		},
-		Extensions:           extensions,
-		Identity:             identity,
-		Runtimes:             fromRuntimePropertiesDataModel(c.Properties.Runtimes),
-		Resources:            fromResourceReferencesDataModel(c.Properties.Resources),
-		ResourceProvisioning: fromContainerResourceProvisioningDataModel(c.Properties.ResourceProvisioning),
-		RestartPolicy:        fromRestartPolicyDataModel(c.Properties.RestartPolicy),
+	return result
+}
+
+func fromEnvironmentVariablesDataModel(env map[string]datamodel.EnvironmentVariable) map[string]*EnvironmentVariable {
+	if env == nil {
+		return nil
 	}
 
-	return nil
+	result := map[string]*EnvironmentVariable{}
+	for name, e := range env {
+		v := &EnvironmentVariable{Value: e.Value}
+		if e.ValueFrom != nil {
+			v.ValueFrom = &EnvironmentVariableReference{}
+			if ref := e.ValueFrom.SecretRef; ref != nil {
+				v.ValueFrom.SecretRef = &EnvironmentVariableSecretReference{
+					Source: to.Ptr(ref.Source),
+					Key:    to.Ptr(ref.Key),
+				}
+			}
+			if ref := e.ValueFrom.ConnectionRef; ref != nil {
+				v.ValueFrom.ConnectionRef = &EnvironmentVariableConnectionReference{
+					Name: to.Ptr(ref.Name),
+					Key:  to.Ptr(ref.Key),
+				}
+			}
+		}
+		result[name] = v
+	}
+
+	return result
+}
+
+func toContainerResourceRequirementsDataModel(r *ContainerResourceRequirements) *datamodel.ContainerResourceRequirements {
+	if r == nil {
+		return nil
+	}
+
+	return &datamodel.ContainerResourceRequirements{
+		Requests: toContainerResourceListDataModel(r.Requests),
+		Limits:   toContainerResourceListDataModel(r.Limits),
+	}
+}
+
+func fromContainerResourceRequirementsDataModel(r *datamodel.ContainerResourceRequirements) *ContainerResourceRequirements {
+	if r == nil {
+		return nil
+	}
+
+	return &ContainerResourceRequirements{
+		Requests: fromContainerResourceListDataModel(r.Requests),
+		Limits:   fromContainerResourceListDataModel(r.Limits),
+	}
+}
+
+func toContainerResourceListDataModel(l *ContainerResourceList) *datamodel.ContainerResourceList {
+	if l == nil {
+		return nil
+	}
+
+	result := &datamodel.ContainerResourceList{
+		CPU:              to.String(l.CPU),
+		Memory:           to.String(l.Memory),
+		EphemeralStorage: to.String(l.EphemeralStorage),
+	}
+	if len(l.Extended) > 0 {
+		result.Extended = to.StringMap(l.Extended)
+	}
+
+	return result
+}
+
+func fromContainerResourceListDataModel(l *datamodel.ContainerResourceList) *ContainerResourceList {
+	if l == nil {
+		return nil
+	}
+
+	result := &ContainerResourceList{}
+	if l.CPU != "" {
+		result.CPU = to.Ptr(l.CPU)
+	}
+	if l.Memory != "" {
+		result.Memory = to.Ptr(l.Memory)
+	}
+	if l.EphemeralStorage != "" {
+		result.EphemeralStorage = to.Ptr(l.EphemeralStorage)
+	}
+	if len(l.Extended) > 0 {
+		result.Extended = *to.StringMapPtr(l.Extended)
+	}
+
+	return result
 }
 
 func toImagePullPolicyDataModel(pullPolicy *ImagePullPolicy) string {
@@ -666,6 +866,18 @@ func toExtensionDataModel(e ExtensionClassification) datamodel.Extension {
 				Replicas: c.Replicas,
 			},
 		}
+	case *AutoScalingExtension:
+		return datamodel.Extension{
+			Kind: datamodel.AutoScaling,
+			AutoScaling: &datamodel.AutoScalingExtension{
+				MinReplicas:                       c.MinReplicas,
+				MaxReplicas:                       to.Int32(c.MaxReplicas),
+				TargetCPUUtilizationPercentage:    c.TargetCPUUtilizationPercentage,
+				TargetMemoryUtilizationPercentage: c.TargetMemoryUtilizationPercentage,
+				Metrics:                           toAutoScalingMetricsDataModel(c.Metrics),
+				Triggers:                          toAutoScalingTriggersDataModel(c.Triggers),
+			},
+		}
 	case *DaprSidecarExtension:
 		return datamodel.Extension{
 			Kind: datamodel.DaprSidecar,
@@ -697,6 +909,16 @@ func fromExtensionClassificationDataModel(e datamodel.Extension) ExtensionClassi
 			Kind:     to.Ptr(string(e.Kind)),
 			Replicas: e.ManualScaling.Replicas,
 		}
+	case datamodel.AutoScaling:
+		return &AutoScalingExtension{
+			Kind:                              to.Ptr(string(e.Kind)),
+			MinReplicas:                       e.AutoScaling.MinReplicas,
+			MaxReplicas:                       to.Ptr(e.AutoScaling.MaxReplicas),
+			TargetCPUUtilizationPercentage:    e.AutoScaling.TargetCPUUtilizationPercentage,
+			TargetMemoryUtilizationPercentage: e.AutoScaling.TargetMemoryUtilizationPercentage,
+			Metrics:                           fromAutoScalingMetricsDataModel(e.AutoScaling.Metrics),
+			Triggers:                          fromAutoScalingTriggersDataModel(e.AutoScaling.Triggers),
+		}
 	case datamodel.DaprSidecar:
 		return &DaprSidecarExtension{
 			Kind:     to.Ptr(string(e.Kind)),
@@ -717,6 +939,78 @@ func fromExtensionClassificationDataModel(e datamodel.Extension) ExtensionClassi
 	return nil
 }
 
+func toAutoScalingMetricsDataModel(metrics []*AutoScalingMetric) []datamodel.AutoScalingMetric {
+	if metrics == nil {
+		return nil
+	}
+
+	converted := []datamodel.AutoScalingMetric{}
+	for _, metric := range metrics {
+		if metric == nil {
+			continue
+		}
+		converted = append(converted, datamodel.AutoScalingMetric{
+			Name:               to.String(metric.Name),
+			TargetAverageValue: to.String(metric.TargetAverageValue),
+		})
+	}
+
+	return converted
+}
+
+func fromAutoScalingMetricsDataModel(metrics []datamodel.AutoScalingMetric) []*AutoScalingMetric {
+	if metrics == nil {
+		return nil
+	}
+
+	converted := []*AutoScalingMetric{}
+	for _, metric := range metrics {
+		converted = append(converted, &AutoScalingMetric{
+			Name:               to.Ptr(metric.Name),
+			TargetAverageValue: to.Ptr(metric.TargetAverageValue),
+		})
+	}
+
+	return converted
+}
+
+func toAutoScalingTriggersDataModel(triggers []*AutoScalingTrigger) []datamodel.AutoScalingTrigger {
+	if triggers == nil {
+		return nil
+	}
+
+	converted := []datamodel.AutoScalingTrigger{}
+	for _, trigger := range triggers {
+		if trigger == nil {
+			continue
+		}
+		converted = append(converted, datamodel.AutoScalingTrigger{
+			Type:        to.String(trigger.Type),
+			QueueLength: to.Int32(trigger.QueueLength),
+			Metadata:    to.StringMap(trigger.Metadata),
+		})
+	}
+
+	return converted
+}
+
+func fromAutoScalingTriggersDataModel(triggers []datamodel.AutoScalingTrigger) []*AutoScalingTrigger {
+	if triggers == nil {
+		return nil
+	}
+
+	converted := []*AutoScalingTrigger{}
+	for _, trigger := range triggers {
+		converted = append(converted, &AutoScalingTrigger{
+			Type:        to.Ptr(trigger.Type),
+			QueueLength: to.Ptr(trigger.QueueLength),
+			Metadata:    *to.StringMapPtr(trigger.Metadata),
+		})
+	}
+
+	return converted
+}
+
 func toHealthProbeBase(h HealthProbeProperties) datamodel.HealthProbeBase {
 	return datamodel.HealthProbeBase{
 		FailureThreshold:    h.FailureThreshold,
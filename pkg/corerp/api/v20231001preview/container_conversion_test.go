@@ -61,6 +61,22 @@ func TestContainerConvertVersionedToDataModel(t *testing.T) {
 			err:      nil,
 			emptyExt: true,
 		},
+		{
+			filename: "containerresource-autoscaling.json",
+			err:      nil,
+		},
+		{
+			filename: "containerresource-sidecars.json",
+			err:      nil,
+		},
+		{
+			filename: "containerresource-resources.json",
+			err:      nil,
+		},
+		{
+			filename: "containerresource-env.json",
+			err:      nil,
+		},
 	}
 
 	for _, tt := range conversionTests {
@@ -91,6 +107,128 @@ func TestContainerConvertVersionedToDataModel(t *testing.T) {
 					return
 				}
 
+				if tt.filename == "containerresource-autoscaling.json" {
+					require.Equal(t, []datamodel.Extension{
+						{
+							Kind: datamodel.AutoScaling,
+							AutoScaling: &datamodel.AutoScalingExtension{
+								MinReplicas:                       to.Ptr[int32](2),
+								MaxReplicas:                       10,
+								TargetCPUUtilizationPercentage:    to.Ptr[int32](70),
+								TargetMemoryUtilizationPercentage: to.Ptr[int32](80),
+								Metrics: []datamodel.AutoScalingMetric{
+									{Name: "http_requests_per_second", TargetAverageValue: "100"},
+								},
+								Triggers: []datamodel.AutoScalingTrigger{
+									{
+										Type:        "rabbitmq",
+										QueueLength: 20,
+										Metadata:    map[string]string{"queueName": "orders", "hostFromEnv": "RABBITMQ_HOST"},
+									},
+								},
+							},
+						},
+					}, ct.Properties.Extensions)
+
+					// Convert back to make sure the extension round-trips.
+					versioned := &ContainerResource{}
+					err = versioned.ConvertFrom(ct)
+					require.NoError(t, err)
+					require.Equal(t, r.Properties.Extensions, versioned.Properties.Extensions)
+					return
+				}
+
+				if tt.filename == "containerresource-resources.json" {
+					require.Equal(t, &datamodel.ContainerResourceRequirements{
+						Requests: &datamodel.ContainerResourceList{
+							CPU:              "500m",
+							Memory:           "512Mi",
+							EphemeralStorage: "1Gi",
+						},
+						Limits: &datamodel.ContainerResourceList{
+							CPU:      "1",
+							Memory:   "1Gi",
+							Extended: map[string]string{"nvidia.com/gpu": "1"},
+						},
+					}, ct.Properties.Container.Resources)
+					require.Equal(t, &datamodel.ContainerResourceRequirements{
+						Limits: &datamodel.ContainerResourceList{Memory: "64Mi"},
+					}, ct.Properties.Sidecars[0].Resources)
+
+					// Convert back to make sure the resource requirements round-trip.
+					versioned := &ContainerResource{}
+					err = versioned.ConvertFrom(ct)
+					require.NoError(t, err)
+					require.Equal(t, r.Properties.Container.Resources, versioned.Properties.Container.Resources)
+					require.Equal(t, r.Properties.Sidecars[0].Resources, versioned.Properties.Sidecars[0].Resources)
+					return
+				}
+
+				if tt.filename == "containerresource-env.json" {
+					expected := map[string]datamodel.EnvironmentVariable{
+						"LOG_LEVEL": {Value: to.Ptr("debug")},
+						"DB_PASSWORD": {
+							ValueFrom: &datamodel.EnvironmentVariableReference{
+								SecretRef: &datamodel.EnvironmentVariableSecretReference{
+									Source: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Applications.Core/secretStores/dbsecret",
+									Key:    "password",
+								},
+							},
+						},
+						"DB_CONNECTION": {
+							ValueFrom: &datamodel.EnvironmentVariableReference{
+								ConnectionRef: &datamodel.EnvironmentVariableConnectionReference{
+									Name: "db",
+									Key:  "connectionString",
+								},
+							},
+						},
+					}
+					require.Equal(t, expected, ct.Properties.Container.Env)
+
+					// Convert back to make sure the environment variables round-trip.
+					versioned := &ContainerResource{}
+					err = versioned.ConvertFrom(ct)
+					require.NoError(t, err)
+					require.Equal(t, r.Properties.Container.Env, versioned.Properties.Container.Env)
+					return
+				}
+
+				if tt.filename == "containerresource-sidecars.json" {
+					require.Len(t, ct.Properties.InitContainers, 1)
+					initContainer := ct.Properties.InitContainers[0]
+					require.Equal(t, "migrate", initContainer.Name)
+					require.Equal(t, "ghcr.io/radius-project/migrate:latest", initContainer.Image)
+					require.Equal(t, []string{"/bin/migrate"}, initContainer.Command)
+					require.Equal(t, []string{"up"}, initContainer.Args)
+					require.Equal(t, map[string]datamodel.EnvironmentVariable{"DB_HOST": {Value: to.Ptr("db")}}, initContainer.Env)
+
+					require.Len(t, ct.Properties.Sidecars, 1)
+					sidecar := ct.Properties.Sidecars[0]
+					require.Equal(t, "proxy", sidecar.Name)
+					require.Equal(t, "envoyproxy/envoy:v1.28.0", sidecar.Image)
+					require.Equal(t, map[string]datamodel.ContainerPort{"admin": {ContainerPort: 9901, Protocol: datamodel.ProtocolTCP}}, sidecar.Ports)
+					require.Equal(t, datamodel.HTTPGetHealthProbe, sidecar.ReadinessProbe.Kind)
+					require.Equal(t, int32(9901), sidecar.ReadinessProbe.HTTPGet.ContainerPort)
+					require.Equal(t, "/ready", sidecar.ReadinessProbe.HTTPGet.Path)
+					require.Equal(t, datamodel.Ephemeral, sidecar.Volumes["config"].Kind)
+					require.Equal(t, "/etc/envoy", sidecar.Volumes["config"].Ephemeral.MountPath)
+					require.Equal(t, datamodel.ManagedStoreMemory, sidecar.Volumes["config"].Ephemeral.ManagedStore)
+
+					// Convert back to make sure the containers round-trip.
+					versioned := &ContainerResource{}
+					err = versioned.ConvertFrom(ct)
+					require.NoError(t, err)
+					require.Len(t, versioned.Properties.InitContainers, 1)
+					require.Equal(t, "migrate", to.String(versioned.Properties.InitContainers[0].Name))
+					require.Equal(t, r.Properties.InitContainers[0].Command, versioned.Properties.InitContainers[0].Command)
+					require.Len(t, versioned.Properties.Sidecars, 1)
+					require.Equal(t, "proxy", to.String(versioned.Properties.Sidecars[0].Name))
+					require.Equal(t, int32(9901), to.Int32(versioned.Properties.Sidecars[0].Ports["admin"].ContainerPort))
+					require.Equal(t, "httpGet", to.String(versioned.Properties.Sidecars[0].ReadinessProbe.GetHealthProbeProperties().Kind))
+					return
+				}
+
 				val, ok := ct.Properties.Connections["inventory"]
 				require.True(t, ok)
 				require.Equal(t, "inventory_route_id", val.Source)
@@ -189,6 +327,21 @@ func TestContainerConvertDataModelToVersioned(t *testing.T) {
 				require.Equal(t, to.SliceOfPtrs([]string{"-c", "while true; do echo hello; sleep 10;done"}...), versioned.Properties.Container.Args)
 				require.Equal(t, to.Ptr("/app"), versioned.Properties.Container.WorkingDir)
 
+				if tt.filename == "containerresourcedatamodel.json" {
+					// Environment variables stored as plain strings are read as literal values.
+					require.Equal(t, map[string]*EnvironmentVariable{
+						"LOG_LEVEL": {Value: to.Ptr("debug")},
+						"DB_PASSWORD": {
+							ValueFrom: &EnvironmentVariableReference{
+								SecretRef: &EnvironmentVariableSecretReference{
+									Source: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Applications.Core/secretStores/dbsecret"),
+									Key:    to.Ptr("password"),
+								},
+							},
+						},
+					}, versioned.Properties.Container.Env)
+				}
+
 				if r.Properties.Runtimes != nil {
 					require.NotNil(t, versioned.Properties.Runtimes)
 					require.NotEmpty(t, *versioned.Properties.Runtimes.Kubernetes.Base)
@@ -28,6 +28,8 @@ import (
 	rp_util "github.com/radius-project/radius/pkg/rp/portableresources"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"golang.org/x/exp/slices"
 )
 
 const (
@@ -103,10 +105,21 @@ func (src *EnvironmentResource) ConvertTo() (v1.DataModelInterface, error) {
 		}
 	}
 
+	if src.Properties.RecipeConfig != nil {
+		recipeConfig, err := toRecipeConfigDatamodel(src.Properties.RecipeConfig)
+		if err != nil {
+			return nil, err
+		}
+		converted.Properties.RecipeConfig = recipeConfig
+	}
+
 	if src.Properties.Simulated != nil && *src.Properties.Simulated {
 		converted.Properties.Simulated = true
 	}
 
+	converted.Properties.DefaultContainerResources = toContainerResourceRequirementsDataModel(src.Properties.DefaultContainerResources)
+	converted.Properties.Gateway = toEnvironmentGatewayDataModel(src.Properties.Gateway)
+
 	var extensions []datamodel.Extension
 	if src.Properties.Extensions != nil {
 		for _, e := range src.Properties.Extensions {
@@ -165,10 +178,15 @@ func (dst *EnvironmentResource) ConvertFrom(src v1.DataModelInterface) error {
 		}
 	}
 
+	dst.Properties.RecipeConfig = fromRecipeConfigDatamodel(env.Properties.RecipeConfig)
+
 	if env.Properties.Simulated {
 		dst.Properties.Simulated = to.Ptr(env.Properties.Simulated)
 	}
 
+	dst.Properties.DefaultContainerResources = fromContainerResourceRequirementsDataModel(env.Properties.DefaultContainerResources)
+	dst.Properties.Gateway = fromEnvironmentGatewayDataModel(env.Properties.Gateway)
+
 	var extensions []ExtensionClassification
 	if env.Properties.Extensions != nil {
 		for _, e := range env.Properties.Extensions {
@@ -180,6 +198,37 @@ func (dst *EnvironmentResource) ConvertFrom(src v1.DataModelInterface) error {
 	return nil
 }
 
+func toEnvironmentGatewayDataModel(gateway *EnvironmentGateway) *datamodel.EnvironmentGateway {
+	if gateway == nil {
+		return nil
+	}
+
+	converted := &datamodel.EnvironmentGateway{
+		GatewayClassName: to.String(gateway.GatewayClassName),
+	}
+	if gateway.Kind != nil {
+		converted.Kind = datamodel.GatewayKind(*gateway.Kind)
+	}
+
+	return converted
+}
+
+func fromEnvironmentGatewayDataModel(gateway *datamodel.EnvironmentGateway) *EnvironmentGateway {
+	if gateway == nil {
+		return nil
+	}
+
+	converted := &EnvironmentGateway{}
+	if gateway.Kind != "" {
+		converted.Kind = to.Ptr(GatewayKind(gateway.Kind))
+	}
+	if gateway.GatewayClassName != "" {
+		converted.GatewayClassName = to.Ptr(gateway.GatewayClassName)
+	}
+
+	return converted
+}
+
 func toEnvironmentComputeDataModel(h EnvironmentComputeClassification) (*rpv1.EnvironmentCompute, error) {
 	switch v := h.(type) {
 	case *KubernetesCompute:
@@ -316,6 +365,13 @@ func toEnvironmentRecipeProperties(e RecipePropertiesClassification) (datamodel.
 			TemplatePath: to.String(c.TemplatePath),
 			Parameters:   c.Parameters,
 		}, nil
+	case *HelmRecipeProperties:
+		return datamodel.EnvironmentRecipeProperties{
+			TemplateKind:    types.TemplateKindHelm,
+			TemplateVersion: to.String(c.TemplateVersion),
+			TemplatePath:    to.String(c.TemplatePath),
+			Parameters:      c.Parameters,
+		}, nil
 	}
 	return datamodel.EnvironmentRecipeProperties{}, nil
 }
@@ -335,6 +391,296 @@ func fromRecipePropertiesClassificationDatamodel(e datamodel.EnvironmentRecipePr
 			TemplatePath: to.Ptr(e.TemplatePath),
 			Parameters:   e.Parameters,
 		}
+	case types.TemplateKindHelm:
+		return &HelmRecipeProperties{
+			TemplateKind:    to.Ptr(e.TemplateKind),
+			TemplateVersion: to.Ptr(e.TemplateVersion),
+			TemplatePath:    to.Ptr(e.TemplatePath),
+			Parameters:      e.Parameters,
+		}
 	}
 	return nil
 }
+
+func toRecipeConfigDatamodel(config *RecipeConfigProperties) (datamodel.RecipeConfigProperties, error) {
+	recipeConfig := datamodel.RecipeConfigProperties{}
+	if config.Drift != nil && config.Drift.AutoRemediate != nil {
+		recipeConfig.Drift.AutoRemediate = *config.Drift.AutoRemediate
+	}
+
+	if config.Terraform == nil {
+		return recipeConfig, nil
+	}
+
+	recipeConfig.Terraform.ProviderMirrorPath = to.String(config.Terraform.ProviderMirrorPath)
+
+	authentication, err := toTerraformAuthenticationDatamodel(config.Terraform.Authentication)
+	if err != nil {
+		return recipeConfig, err
+	}
+	recipeConfig.Terraform.Authentication = authentication
+
+	providers, err := toTerraformProvidersDatamodel(config.Terraform.Providers)
+	if err != nil {
+		return recipeConfig, err
+	}
+	recipeConfig.Terraform.Providers = providers
+
+	if config.Terraform.Backend == nil {
+		return recipeConfig, nil
+	}
+
+	kind := to.String(config.Terraform.Backend.Kind)
+	if !slices.Contains(types.SupportedTerraformBackends, kind) {
+		return recipeConfig, &v1.ErrModelConversion{
+			PropertyName: "$.properties.recipeConfig.terraform.backend.kind",
+			ValidValue:   strings.Join(types.SupportedTerraformBackends, ", "),
+		}
+	}
+
+	backendConfig := to.StringMap(config.Terraform.Backend.Config)
+	for _, key := range types.TerraformBackendSecretConfig[kind] {
+		if _, ok := backendConfig[key]; ok {
+			return recipeConfig, v1.NewClientErrInvalidRequest(fmt.Sprintf("%q holds credentials and can't be set in the configuration of the %s Terraform backend", key, kind))
+		}
+	}
+
+	secret := to.String(config.Terraform.Backend.Secret)
+	if secret != "" {
+		id, err := resources.ParseResource(secret)
+		if err != nil || !strings.EqualFold(id.Type(), datamodel.SecretStoreResourceType) {
+			return recipeConfig, &v1.ErrModelConversion{
+				PropertyName: "$.properties.recipeConfig.terraform.backend.secret",
+				ValidValue:   "a valid resource id of an Applications.Core/secretStores resource",
+			}
+		}
+	}
+
+	recipeConfig.Terraform.Backend = &datamodel.TerraformBackend{
+		Kind:   kind,
+		Config: backendConfig,
+		Secret: secret,
+	}
+
+	return recipeConfig, nil
+}
+
+func fromRecipeConfigDatamodel(config datamodel.RecipeConfigProperties) *RecipeConfigProperties {
+	authentication := fromTerraformAuthenticationDatamodel(config.Terraform.Authentication)
+	providers := fromTerraformProvidersDatamodel(config.Terraform.Providers)
+	if config.Terraform.Backend == nil && config.Terraform.ProviderMirrorPath == "" && authentication == nil && providers == nil && !config.Drift.AutoRemediate {
+		return nil
+	}
+
+	recipeConfig := &RecipeConfigProperties{}
+	if config.Terraform.Backend != nil || config.Terraform.ProviderMirrorPath != "" || authentication != nil || providers != nil {
+		recipeConfig.Terraform = &TerraformConfigProperties{
+			Authentication: authentication,
+			Providers:      providers,
+		}
+	}
+
+	if config.Terraform.Backend != nil {
+		recipeConfig.Terraform.Backend = &TerraformBackendProperties{
+			Kind:   to.Ptr(config.Terraform.Backend.Kind),
+			Config: *to.StringMapPtr(config.Terraform.Backend.Config),
+		}
+		if config.Terraform.Backend.Secret != "" {
+			recipeConfig.Terraform.Backend.Secret = to.Ptr(config.Terraform.Backend.Secret)
+		}
+	}
+
+	if config.Terraform.ProviderMirrorPath != "" {
+		recipeConfig.Terraform.ProviderMirrorPath = to.Ptr(config.Terraform.ProviderMirrorPath)
+	}
+
+	if config.Drift.AutoRemediate {
+		recipeConfig.Drift = &RecipeDriftConfigProperties{
+			AutoRemediate: to.Ptr(true),
+		}
+	}
+
+	return recipeConfig
+}
+
+func toTerraformAuthenticationDatamodel(auth *TerraformAuthenticationProperties) (datamodel.TerraformAuthentication, error) {
+	authentication := datamodel.TerraformAuthentication{}
+	if auth == nil {
+		return authentication, nil
+	}
+
+	var err error
+	if auth.Git != nil {
+		authentication.Git.PAT, err = toSecretReferencesDatamodel(auth.Git.Pat, "$.properties.recipeConfig.terraform.authentication.git.pat")
+		if err != nil {
+			return authentication, err
+		}
+
+		authentication.Git.SSH, err = toSecretReferencesDatamodel(auth.Git.SSH, "$.properties.recipeConfig.terraform.authentication.git.ssh")
+		if err != nil {
+			return authentication, err
+		}
+	}
+
+	authentication.Registry, err = toSecretReferencesDatamodel(auth.Registry, "$.properties.recipeConfig.terraform.authentication.registry")
+	if err != nil {
+		return authentication, err
+	}
+
+	return authentication, nil
+}
+
+func toSecretReferencesDatamodel(refs map[string]*TerraformSecretReference, propertyName string) (map[string]datamodel.SecretReference, error) {
+	if len(refs) == 0 {
+		return nil, nil
+	}
+
+	secretReferences := map[string]datamodel.SecretReference{}
+	for host, ref := range refs {
+		secret := ""
+		if ref != nil {
+			secret = to.String(ref.Secret)
+		}
+
+		id, err := resources.ParseResource(secret)
+		if err != nil || !strings.EqualFold(id.Type(), datamodel.SecretStoreResourceType) {
+			return nil, &v1.ErrModelConversion{
+				PropertyName: fmt.Sprintf("%s[%s].secret", propertyName, host),
+				ValidValue:   "a valid resource id of an Applications.Core/secretStores resource",
+			}
+		}
+
+		secretReferences[host] = datamodel.SecretReference{Secret: secret}
+	}
+
+	return secretReferences, nil
+}
+
+func fromTerraformAuthenticationDatamodel(auth datamodel.TerraformAuthentication) *TerraformAuthenticationProperties {
+	if len(auth.Git.PAT) == 0 && len(auth.Git.SSH) == 0 && len(auth.Registry) == 0 {
+		return nil
+	}
+
+	authentication := &TerraformAuthenticationProperties{
+		Registry: fromSecretReferencesDatamodel(auth.Registry),
+	}
+	if len(auth.Git.PAT) > 0 || len(auth.Git.SSH) > 0 {
+		authentication.Git = &TerraformGitAuthenticationProperties{
+			Pat: fromSecretReferencesDatamodel(auth.Git.PAT),
+			SSH: fromSecretReferencesDatamodel(auth.Git.SSH),
+		}
+	}
+
+	return authentication
+}
+
+func fromSecretReferencesDatamodel(refs map[string]datamodel.SecretReference) map[string]*TerraformSecretReference {
+	if len(refs) == 0 {
+		return nil
+	}
+
+	secretReferences := map[string]*TerraformSecretReference{}
+	for host, ref := range refs {
+		secretReferences[host] = &TerraformSecretReference{
+			Secret: to.Ptr(ref.Secret),
+		}
+	}
+
+	return secretReferences
+}
+
+func toTerraformProvidersDatamodel(providers map[string][]*TerraformProviderConfigProperties) (map[string][]datamodel.TerraformProviderConfig, error) {
+	if len(providers) == 0 {
+		return nil, nil
+	}
+
+	providerConfigs := map[string][]datamodel.TerraformProviderConfig{}
+	for name, configs := range providers {
+		propertyName := fmt.Sprintf("$.properties.recipeConfig.terraform.providers[%s]", name)
+		aliases := map[string]bool{}
+		for i, config := range configs {
+			if config == nil {
+				continue
+			}
+
+			// Terraform requires every configuration of a provider except the default one to set a unique alias.
+			alias := ""
+			if value, ok := config.Values["alias"]; ok {
+				alias, ok = value.(string)
+				if !ok || alias == "" {
+					return nil, &v1.ErrModelConversion{
+						PropertyName: fmt.Sprintf("%s[%d].values.alias", propertyName, i),
+						ValidValue:   "a non-empty string",
+					}
+				}
+			}
+			if aliases[alias] {
+				return nil, &v1.ErrModelConversion{
+					PropertyName: fmt.Sprintf("%s[%d].values.alias", propertyName, i),
+					ValidValue:   "an alias which is unique across the configurations of the provider",
+				}
+			}
+			aliases[alias] = true
+
+			providerConfig := datamodel.TerraformProviderConfig{
+				Values: config.Values,
+			}
+			for key, ref := range config.Secrets {
+				if ref == nil || to.String(ref.Key) == "" {
+					return nil, &v1.ErrModelConversion{
+						PropertyName: fmt.Sprintf("%s[%d].secrets[%s].key", propertyName, i, key),
+						ValidValue:   "the name of a secret of the secret store",
+					}
+				}
+
+				id, err := resources.ParseResource(to.String(ref.Source))
+				if err != nil || !strings.EqualFold(id.Type(), datamodel.SecretStoreResourceType) {
+					return nil, &v1.ErrModelConversion{
+						PropertyName: fmt.Sprintf("%s[%d].secrets[%s].source", propertyName, i, key),
+						ValidValue:   "a valid resource id of an Applications.Core/secretStores resource",
+					}
+				}
+
+				if providerConfig.Secrets == nil {
+					providerConfig.Secrets = map[string]datamodel.ProviderSecretReference{}
+				}
+				providerConfig.Secrets[key] = datamodel.ProviderSecretReference{
+					Source: to.String(ref.Source),
+					Key:    to.String(ref.Key),
+				}
+			}
+
+			providerConfigs[name] = append(providerConfigs[name], providerConfig)
+		}
+	}
+
+	return providerConfigs, nil
+}
+
+func fromTerraformProvidersDatamodel(providers map[string][]datamodel.TerraformProviderConfig) map[string][]*TerraformProviderConfigProperties {
+	if len(providers) == 0 {
+		return nil
+	}
+
+	providerConfigs := map[string][]*TerraformProviderConfigProperties{}
+	for name, configs := range providers {
+		for _, config := range configs {
+			providerConfig := &TerraformProviderConfigProperties{
+				Values: config.Values,
+			}
+			if len(config.Secrets) > 0 {
+				providerConfig.Secrets = map[string]*TerraformProviderSecretReference{}
+				for key, ref := range config.Secrets {
+					providerConfig.Secrets[key] = &TerraformProviderSecretReference{
+						Source: to.Ptr(ref.Source),
+						Key:    to.Ptr(ref.Key),
+					}
+				}
+			}
+
+			providerConfigs[name] = append(providerConfigs[name], providerConfig)
+		}
+	}
+
+	return providerConfigs
+}
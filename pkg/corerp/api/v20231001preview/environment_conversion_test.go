@@ -141,6 +141,14 @@ func TestConvertVersionedToDataModel(t *testing.T) {
 								TemplateKind: recipes.TemplateKindBicep,
 								TemplatePath: "br:ghcr.io/sampleregistry/radius/recipes/rediscaches",
 							},
+							"helm-recipe": datamodel.EnvironmentRecipeProperties{
+								TemplateKind:    recipes.TemplateKindHelm,
+								TemplatePath:    "oci://ghcr.io/sampleregistry/charts/redis",
+								TemplateVersion: "1.0.0",
+								Parameters: map[string]any{
+									"replicas": float64(2),
+								},
+							},
 						},
 						dapr_ctrl.DaprStateStoresResourceType: {
 							"statestore-recipe": datamodel.EnvironmentRecipeProperties{
@@ -268,6 +276,294 @@ func TestConvertVersionedToDataModel(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			filename: "environmentresource-with-default-container-resources.json",
+			expected: &datamodel.Environment{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0",
+						Name: "env0",
+						Type: "Applications.Core/environments",
+						Tags: map[string]string{},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						CreatedAPIVersion:      "2023-10-01-preview",
+						UpdatedAPIVersion:      "2023-10-01-preview",
+						AsyncProvisioningState: v1.ProvisioningStateAccepted,
+					},
+				},
+				Properties: datamodel.EnvironmentProperties{
+					Compute: rpv1.EnvironmentCompute{
+						Kind: "kubernetes",
+						KubernetesCompute: rpv1.KubernetesComputeProperties{
+							ResourceID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Microsoft.ContainerService/managedClusters/radiusTestCluster",
+							Namespace:  "default",
+						},
+					},
+					DefaultContainerResources: &datamodel.ContainerResourceRequirements{
+						Requests: &datamodel.ContainerResourceList{
+							CPU:    "100m",
+							Memory: "128Mi",
+						},
+						Limits: &datamodel.ContainerResourceList{
+							Memory: "256Mi",
+						},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			filename: "environmentresource-with-gateway-api.json",
+			expected: &datamodel.Environment{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0",
+						Name: "env0",
+						Type: "Applications.Core/environments",
+						Tags: map[string]string{},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						CreatedAPIVersion:      "2023-10-01-preview",
+						UpdatedAPIVersion:      "2023-10-01-preview",
+						AsyncProvisioningState: v1.ProvisioningStateAccepted,
+					},
+				},
+				Properties: datamodel.EnvironmentProperties{
+					Compute: rpv1.EnvironmentCompute{
+						Kind: "kubernetes",
+						KubernetesCompute: rpv1.KubernetesComputeProperties{
+							ResourceID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Microsoft.ContainerService/managedClusters/radiusTestCluster",
+							Namespace:  "default",
+						},
+					},
+					Gateway: &datamodel.EnvironmentGateway{
+						Kind:             datamodel.GatewayKindGatewayAPI,
+						GatewayClassName: "istio",
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			filename: "environmentresource-with-terraform-backend.json",
+			expected: &datamodel.Environment{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0",
+						Name: "env0",
+						Type: "Applications.Core/environments",
+						Tags: map[string]string{},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						CreatedAPIVersion:      "2023-10-01-preview",
+						UpdatedAPIVersion:      "2023-10-01-preview",
+						AsyncProvisioningState: v1.ProvisioningStateAccepted,
+					},
+				},
+				Properties: datamodel.EnvironmentProperties{
+					Compute: rpv1.EnvironmentCompute{
+						Kind: "kubernetes",
+						KubernetesCompute: rpv1.KubernetesComputeProperties{
+							ResourceID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Microsoft.ContainerService/managedClusters/radiusTestCluster",
+							Namespace:  "default",
+						},
+					},
+					RecipeConfig: datamodel.RecipeConfigProperties{
+						Terraform: datamodel.TerraformConfigProperties{
+							Backend: &datamodel.TerraformBackend{
+								Kind: "s3",
+								Config: map[string]string{
+									"bucket": "tfstate",
+									"region": "us-west-2",
+								},
+							},
+						},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			filename: "environmentresource-with-drift-autoremediate.json",
+			expected: &datamodel.Environment{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0",
+						Name: "env0",
+						Type: "Applications.Core/environments",
+						Tags: map[string]string{},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						CreatedAPIVersion:      "2023-10-01-preview",
+						UpdatedAPIVersion:      "2023-10-01-preview",
+						AsyncProvisioningState: v1.ProvisioningStateAccepted,
+					},
+				},
+				Properties: datamodel.EnvironmentProperties{
+					Compute: rpv1.EnvironmentCompute{
+						Kind: "kubernetes",
+						KubernetesCompute: rpv1.KubernetesComputeProperties{
+							ResourceID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Microsoft.ContainerService/managedClusters/radiusTestCluster",
+							Namespace:  "default",
+						},
+					},
+					RecipeConfig: datamodel.RecipeConfigProperties{
+						Drift: datamodel.RecipeDriftConfig{
+							AutoRemediate: true,
+						},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			filename: "environmentresource-with-terraform-provider-mirror.json",
+			expected: &datamodel.Environment{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0",
+						Name: "env0",
+						Type: "Applications.Core/environments",
+						Tags: map[string]string{},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						CreatedAPIVersion:      "2023-10-01-preview",
+						UpdatedAPIVersion:      "2023-10-01-preview",
+						AsyncProvisioningState: v1.ProvisioningStateAccepted,
+					},
+				},
+				Properties: datamodel.EnvironmentProperties{
+					Compute: rpv1.EnvironmentCompute{
+						Kind: "kubernetes",
+						KubernetesCompute: rpv1.KubernetesComputeProperties{
+							ResourceID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Microsoft.ContainerService/managedClusters/radiusTestCluster",
+							Namespace:  "default",
+						},
+					},
+					RecipeConfig: datamodel.RecipeConfigProperties{
+						Terraform: datamodel.TerraformConfigProperties{
+							ProviderMirrorPath: "/terraform/mirror",
+						},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			filename: "environmentresource-with-terraform-authentication.json",
+			expected: &datamodel.Environment{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0",
+						Name: "env0",
+						Type: "Applications.Core/environments",
+						Tags: map[string]string{},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						CreatedAPIVersion:      "2023-10-01-preview",
+						UpdatedAPIVersion:      "2023-10-01-preview",
+						AsyncProvisioningState: v1.ProvisioningStateAccepted,
+					},
+				},
+				Properties: datamodel.EnvironmentProperties{
+					Compute: rpv1.EnvironmentCompute{
+						Kind: "kubernetes",
+						KubernetesCompute: rpv1.KubernetesComputeProperties{
+							ResourceID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Microsoft.ContainerService/managedClusters/radiusTestCluster",
+							Namespace:  "default",
+						},
+					},
+					RecipeConfig: datamodel.RecipeConfigProperties{
+						Terraform: datamodel.TerraformConfigProperties{
+							Authentication: datamodel.TerraformAuthentication{
+								Git: datamodel.GitAuthentication{
+									PAT: map[string]datamodel.SecretReference{
+										"github.com": {Secret: "/planes/radius/local/resourcegroups/test-rg/providers/Applications.Core/secretStores/github"},
+									},
+									SSH: map[string]datamodel.SecretReference{
+										"dev.azure.com": {Secret: "/planes/radius/local/resourcegroups/test-rg/providers/Applications.Core/secretStores/azure-devops"},
+									},
+								},
+								Registry: map[string]datamodel.SecretReference{
+									"app.terraform.io": {Secret: "/planes/radius/local/resourcegroups/test-rg/providers/Applications.Core/secretStores/terraform-cloud"},
+								},
+							},
+						},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			filename: "environmentresource-with-terraform-providers.json",
+			expected: &datamodel.Environment{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0",
+						Name: "env0",
+						Type: "Applications.Core/environments",
+						Tags: map[string]string{},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						CreatedAPIVersion:      "2023-10-01-preview",
+						UpdatedAPIVersion:      "2023-10-01-preview",
+						AsyncProvisioningState: v1.ProvisioningStateAccepted,
+					},
+				},
+				Properties: datamodel.EnvironmentProperties{
+					Compute: rpv1.EnvironmentCompute{
+						Kind: "kubernetes",
+						KubernetesCompute: rpv1.KubernetesComputeProperties{
+							ResourceID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Microsoft.ContainerService/managedClusters/radiusTestCluster",
+							Namespace:  "default",
+						},
+					},
+					RecipeConfig: datamodel.RecipeConfigProperties{
+						Terraform: datamodel.TerraformConfigProperties{
+							Providers: map[string][]datamodel.TerraformProviderConfig{
+								"postgresql": {
+									{
+										Values: map[string]any{
+											"host":    "postgres.default.svc.cluster.local",
+											"port":    float64(5432),
+											"sslmode": "disable",
+										},
+										Secrets: map[string]datamodel.ProviderSecretReference{
+											"password": {
+												Source: "/planes/radius/local/resourcegroups/test-rg/providers/Applications.Core/secretStores/postgres",
+												Key:    "password",
+											},
+										},
+									},
+								},
+								"aws": {
+									{Values: map[string]any{"alias": "east", "region": "us-east-1"}},
+									{Values: map[string]any{"alias": "west", "region": "us-west-2"}},
+								},
+							},
+						},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			filename: "environmentresource-invalid-terraform-providers.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.recipeConfig.terraform.providers[aws][1].values.alias", ValidValue: "an alias which is unique across the configurations of the provider"},
+		},
+		{
+			filename: "environmentresource-invalid-terraform-authentication.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.recipeConfig.terraform.authentication.registry[app.terraform.io].secret", ValidValue: "a valid resource id of an Applications.Core/secretStores resource"},
+		},
+		{
+			filename: "environmentresource-invalid-terraform-backend.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.recipeConfig.terraform.backend.kind", ValidValue: "kubernetes, s3, azurerm, pg, local"},
+		},
+		{
+			filename: "environmentresource-invalid-terraform-backend-credentials.json",
+			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "\"secret_key\" holds credentials and can't be set in the configuration of the s3 Terraform backend"},
+		},
 		{
 			filename: "environmentresource-invalid-missing-namespace.json",
 			err:      &v1.ErrModelConversion{PropertyName: "$.properties.compute.namespace", ValidValue: "63 characters or less"},
@@ -282,11 +578,11 @@ func TestConvertVersionedToDataModel(t *testing.T) {
 		},
 		{
 			filename: "environmentresource-invalid-templatekind.json",
-			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\""},
+			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\", \"helm\""},
 		},
 		{
 			filename: "environmentresource-missing-templatekind.json",
-			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\""},
+			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\", \"helm\""},
 		},
 		{
 			filename: "environmentresource-terraformrecipe-localpath.json",
@@ -386,6 +682,34 @@ func TestConvertDataModelToVersioned(t *testing.T) {
 	}
 }
 
+func TestConvertDataModelToVersioned_RecipeConfig(t *testing.T) {
+	filenames := []string{
+		"environmentresource-with-terraform-backend.json",
+		"environmentresource-with-terraform-pg-backend.json",
+		"environmentresource-with-drift-autoremediate.json",
+		"environmentresource-with-terraform-provider-mirror.json",
+		"environmentresource-with-terraform-authentication.json",
+		"environmentresource-with-terraform-providers.json",
+	}
+
+	for _, filename := range filenames {
+		t.Run(filename, func(t *testing.T) {
+			rawPayload := testutil.ReadFixture(filename)
+			r := &EnvironmentResource{}
+			err := json.Unmarshal(rawPayload, r)
+			require.NoError(t, err)
+
+			dm, err := r.ConvertTo()
+			require.NoError(t, err)
+
+			versioned := &EnvironmentResource{}
+			err = versioned.ConvertFrom(dm)
+			require.NoError(t, err)
+			require.Equal(t, r.Properties.RecipeConfig, versioned.Properties.RecipeConfig)
+		})
+	}
+}
+
 func TestConvertDataModelToVersioned_EmptyTemplateKind(t *testing.T) {
 	rawPayload := testutil.ReadFixture("environmentresourcedatamodelemptytemplatekind.json")
 	r := &datamodel.Environment{}
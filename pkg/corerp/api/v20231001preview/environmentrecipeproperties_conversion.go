@@ -38,7 +38,7 @@ func (dst *RecipeGetMetadataResponse) ConvertFrom(src v1.DataModelInterface) err
 	}
 	dst.TemplateKind = to.Ptr(recipe.TemplateKind)
 	dst.TemplatePath = to.Ptr(recipe.TemplatePath)
-	if recipe.TemplateKind == types.TemplateKindTerraform {
+	if recipe.TemplateKind == types.TemplateKindTerraform || recipe.TemplateKind == types.TemplateKindHelm {
 		dst.TemplateVersion = to.Ptr(recipe.TemplateVersion)
 	}
 	dst.Parameters = recipe.Parameters
@@ -52,3 +52,42 @@ func (src *RecipeGetMetadata) ConvertTo() (v1.DataModelInterface, error) {
 		ResourceType: to.String(src.ResourceType),
 	}, nil
 }
+
+// ConvertTo converts from the versioned recipe plan request to version-agnostic datamodel.
+func (src *RecipePlan) ConvertTo() (v1.DataModelInterface, error) {
+	return &datamodel.RecipePlanRequest{
+		Name:          to.String(src.Name),
+		ResourceType:  to.String(src.ResourceType),
+		ResourceName:  to.String(src.ResourceName),
+		ApplicationID: to.String(src.Application),
+		Parameters:    src.Parameters,
+	}, nil
+}
+
+// ConvertTo returns an error as it does not support converting the recipe plan response to a version-agnostic object.
+func (src *RecipePlanResponse) ConvertTo() (v1.DataModelInterface, error) {
+	return nil, fmt.Errorf("converting Recipe Plan Response to a version-agnostic object is not supported")
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned recipe plan response.
+func (dst *RecipePlanResponse) ConvertFrom(src v1.DataModelInterface) error {
+	plan, ok := src.(*datamodel.RecipePlan)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	dst.Changes = []*RecipeResourceChange{}
+	for _, change := range plan.Changes {
+		converted := &RecipeResourceChange{
+			ChangeType: to.Ptr(RecipeResourceChangeType(change.ChangeType)),
+		}
+		if change.ResourceID != "" {
+			converted.ResourceID = to.Ptr(change.ResourceID)
+		}
+		if change.Address != "" {
+			converted.Address = to.Ptr(change.Address)
+		}
+		dst.Changes = append(dst.Changes, converted)
+	}
+	return nil
+}
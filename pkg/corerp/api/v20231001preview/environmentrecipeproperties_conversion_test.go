@@ -23,6 +23,7 @@ import (
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	ds_ctrl "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
 	types "github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/test/testutil"
 	"github.com/stretchr/testify/require"
 )
@@ -102,3 +103,63 @@ func TestRecipeConvertVersionedToDataModel(t *testing.T) {
 		require.Equal(t, expected, ct)
 	})
 }
+
+func TestRecipePlanConvertVersionedToDataModel(t *testing.T) {
+	t.Run("Convert to Data Model", func(t *testing.T) {
+		filename := "recipeplanresource.json"
+		expected := &datamodel.RecipePlanRequest{
+			ResourceType:  ds_ctrl.MongoDatabasesResourceType,
+			Name:          "mongo-azure",
+			ResourceName:  "mongo",
+			ApplicationID: "/planes/radius/local/resourcegroups/radius-test-rg/providers/Applications.Core/applications/test-app",
+			Parameters: map[string]any{
+				"size": "small",
+			},
+		}
+		rawPayload := testutil.ReadFixture(filename)
+		r := &RecipePlan{}
+		err := json.Unmarshal(rawPayload, r)
+		require.NoError(t, err)
+		// act
+		dm, err := r.ConvertTo()
+		require.NoError(t, err)
+		ct := dm.(*datamodel.RecipePlanRequest)
+		require.Equal(t, expected, ct)
+	})
+}
+
+func TestRecipePlanResponseConvertVersionedToDataModel(t *testing.T) {
+	r := &RecipePlanResponse{}
+	_, err := r.ConvertTo()
+	require.ErrorContains(t, err, "converting Recipe Plan Response to a version-agnostic object is not supported")
+}
+
+func TestRecipePlanResponseConvertDataModelToVersioned(t *testing.T) {
+	rawPayload := testutil.ReadFixture("recipeplandatamodel.json")
+	r := &datamodel.RecipePlan{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	versioned := &RecipePlanResponse{}
+	err = versioned.ConvertFrom(r)
+
+	// assert
+	require.NoError(t, err)
+	expected := []*RecipeResourceChange{
+		{
+			ChangeType: to.Ptr(RecipeResourceChangeTypeCreate),
+			ResourceID: to.Ptr("/planes/kubernetes/local/namespaces/default/providers/core/Service/mongo"),
+			Address:    to.Ptr("kubernetes_service.mongo"),
+		},
+		{
+			ChangeType: to.Ptr(RecipeResourceChangeTypeDelete),
+			ResourceID: to.Ptr("/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/mongo"),
+		},
+		{
+			ChangeType: to.Ptr(RecipeResourceChangeTypeUpdate),
+			Address:    to.Ptr("random_password.mongo"),
+		},
+	}
+	require.Equal(t, expected, versioned.Changes)
+}
@@ -142,6 +142,42 @@ func PossibleDirectionValues() []Direction {
 	}
 }
 
+// DriftReason - The reason a resource deployed by a recipe is reported as drifted.
+type DriftReason string
+
+const (
+	// DriftReasonDeleted - The resource was deleted outside of the recipe.
+	DriftReasonDeleted DriftReason = "Deleted"
+	// DriftReasonModified - The resource was modified outside of the recipe.
+	DriftReasonModified DriftReason = "Modified"
+)
+
+// PossibleDriftReasonValues returns the possible values for the DriftReason const type.
+func PossibleDriftReasonValues() []DriftReason {
+	return []DriftReason{	
+		DriftReasonDeleted,
+		DriftReasonModified,
+	}
+}
+
+// GatewayKind - The gateway implementation used to render the Applications.Core/gateways resources
+type GatewayKind string
+
+const (
+	// GatewayKindContour - Contour HTTPProxy resources
+	GatewayKindContour GatewayKind = "contour"
+	// GatewayKindGatewayAPI - Kubernetes Gateway API Gateway and route resources
+	GatewayKindGatewayAPI GatewayKind = "gatewayAPI"
+)
+
+// PossibleGatewayKindValues returns the possible values for the GatewayKind const type.
+func PossibleGatewayKindValues() []GatewayKind {
+	return []GatewayKind{	
+		GatewayKindContour,
+		GatewayKindGatewayAPI,
+	}
+}
+
 // IAMKind - The kind of IAM provider to configure
 type IAMKind string
 
@@ -284,6 +320,27 @@ func PossibleProvisioningStateValues() []ProvisioningState {
 	}
 }
 
+// RecipeResourceChangeType - The type of change the deployment of a recipe would make to a resource.
+type RecipeResourceChangeType string
+
+const (
+	// RecipeResourceChangeTypeCreate - The resource would be created.
+	RecipeResourceChangeTypeCreate RecipeResourceChangeType = "Create"
+	// RecipeResourceChangeTypeDelete - The resource would be deleted.
+	RecipeResourceChangeTypeDelete RecipeResourceChangeType = "Delete"
+	// RecipeResourceChangeTypeUpdate - The resource would be updated.
+	RecipeResourceChangeTypeUpdate RecipeResourceChangeType = "Update"
+)
+
+// PossibleRecipeResourceChangeTypeValues returns the possible values for the RecipeResourceChangeType const type.
+func PossibleRecipeResourceChangeTypeValues() []RecipeResourceChangeType {
+	return []RecipeResourceChangeType{	
+		RecipeResourceChangeTypeCreate,
+		RecipeResourceChangeTypeDelete,
+		RecipeResourceChangeTypeUpdate,
+	}
+}
+
 // ResourceProvisioning - Specifies how the underlying service/resource is provisioned and managed. Available values are 'recipe',
 // where Radius manages the lifecycle of the resource through a Recipe, and 'manual', where a user
 // manages the resource and provides the values.
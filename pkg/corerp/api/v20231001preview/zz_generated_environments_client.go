@@ -309,6 +309,62 @@ func (client *EnvironmentsClient) listByScopeHandleResponse(resp *http.Response)
 	return result, nil
 }
 
+// Plan - Gets the changes the deployment of a recipe would make, without deploying it.
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - environmentName - environment name
+//   - body - The content of the action request
+//   - options - EnvironmentsClientPlanOptions contains the optional parameters for the EnvironmentsClient.Plan method.
+func (client *EnvironmentsClient) Plan(ctx context.Context, environmentName string, body RecipePlan, options *EnvironmentsClientPlanOptions) (EnvironmentsClientPlanResponse, error) {
+	var err error
+	req, err := client.planCreateRequest(ctx, environmentName, body, options)
+	if err != nil {
+		return EnvironmentsClientPlanResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return EnvironmentsClientPlanResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return EnvironmentsClientPlanResponse{}, err
+	}
+	resp, err := client.planHandleResponse(httpResp)
+	return resp, err
+}
+
+// planCreateRequest creates the Plan request.
+func (client *EnvironmentsClient) planCreateRequest(ctx context.Context, environmentName string, body RecipePlan, options *EnvironmentsClientPlanOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Core/environments/{environmentName}/plan"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if environmentName == "" {
+		return nil, errors.New("parameter environmentName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{environmentName}", url.PathEscape(environmentName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
+// planHandleResponse handles the Plan response.
+func (client *EnvironmentsClient) planHandleResponse(resp *http.Response) (EnvironmentsClientPlanResponse, error) {
+	result := EnvironmentsClientPlanResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.RecipePlanResponse); err != nil {
+		return EnvironmentsClientPlanResponse{}, err
+	}
+	return result, nil
+}
+
 // Update - Update a EnvironmentResource
 // If the operation fails it returns an *azcore.ResponseError type.
 //
@@ -133,6 +133,58 @@ type ApplicationResourceUpdateProperties struct {
 	Simulated *bool
 }
 
+// AutoScalingExtension - AutoScaling Extension
+type AutoScalingExtension struct {
+	// REQUIRED; Discriminator property for Extension.
+	Kind *string
+
+	// REQUIRED; Maximum replica count.
+	MaxReplicas *int32
+
+	// Custom pod metrics to scale on.
+	Metrics []*AutoScalingMetric
+
+	// Minimum replica count. Defaults to 1.
+	MinReplicas *int32
+
+	// Target average CPU utilization of the replicas, in percent of the requested CPU.
+	TargetCPUUtilizationPercentage *int32
+
+	// Target average memory utilization of the replicas, in percent of the requested memory.
+	TargetMemoryUtilizationPercentage *int32
+
+	// Event-driven triggers scaling on the length of a queue. Event-driven scaling requires KEDA to be installed in the cluster.
+	Triggers []*AutoScalingTrigger
+}
+
+// GetExtension implements the ExtensionClassification interface for type AutoScalingExtension.
+func (a *AutoScalingExtension) GetExtension() *Extension {
+	return &Extension{
+		Kind: a.Kind,
+	}
+}
+
+// AutoScalingMetric - A custom pod metric to scale on.
+type AutoScalingMetric struct {
+	// REQUIRED; The name of the metric.
+	Name *string
+
+	// REQUIRED; The target average value of the metric across the replicas, as a Kubernetes quantity.
+	TargetAverageValue *string
+}
+
+// AutoScalingTrigger - An event-driven trigger scaling on the length of a queue.
+type AutoScalingTrigger struct {
+	// REQUIRED; The target number of messages per replica.
+	QueueLength *int32
+
+	// REQUIRED; The type of the KEDA scaler, for example rabbitmq or azure-servicebus.
+	Type *string
+
+	// The configuration of the scaler.
+	Metadata map[string]*string
+}
+
 // AzureKeyVaultVolumeProperties - Represents Azure Key Vault Volume properties
 type AzureKeyVaultVolumeProperties struct {
 	// REQUIRED; Fully qualified resource ID for the application that the portable resource is consumed by
@@ -273,7 +325,7 @@ type Container struct {
 	Command []*string
 
 	// environment
-	Env map[string]*string
+	Env map[string]*EnvironmentVariable
 
 	// The pull policy for the container image
 	ImagePullPolicy *ImagePullPolicy
@@ -287,6 +339,9 @@ type Container struct {
 	// readiness probe properties
 	ReadinessProbe HealthProbePropertiesClassification
 
+	// compute resource requirements
+	Resources *ContainerResourceRequirements
+
 	// container volumes
 	Volumes map[string]VolumeClassification
 
@@ -352,6 +407,9 @@ type ContainerProperties struct {
 	// Configuration for supported external identity providers
 	Identity *IdentitySettings
 
+	// Containers which run to completion before the main container is started, in the order they are declared
+	InitContainers []*NamedContainer
+
 	// Specifies how the underlying container resource is provisioned and managed.
 	ResourceProvisioning *ContainerResourceProvisioning
 
@@ -364,6 +422,9 @@ type ContainerProperties struct {
 	// Specifies Runtime-specific functionality
 	Runtimes *RuntimesProperties
 
+	// Containers which run alongside the main container for the lifetime of the pod
+	Sidecars []*NamedContainer
+
 	// READ-ONLY; The status of the asynchronous operation.
 	ProvisioningState *ProvisioningState
 
@@ -395,6 +456,21 @@ type ContainerResource struct {
 	Type *string
 }
 
+// ContainerResourceList - Quantities of compute resources in the Kubernetes quantity format, for example '500m' or '1Gi'
+type ContainerResourceList struct {
+	// Amount of CPU
+	CPU *string
+
+	// Amount of local ephemeral storage
+	EphemeralStorage *string
+
+	// Extended resources keyed by their domain-qualified name, for example 'nvidia.com/gpu'
+	Extended map[string]*string
+
+	// Amount of memory
+	Memory *string
+}
+
 // ContainerResourceListResult - The response of a ContainerResource list operation.
 type ContainerResourceListResult struct {
 	// REQUIRED; The ContainerResource items on this page
@@ -404,6 +480,15 @@ type ContainerResourceListResult struct {
 	NextLink *string
 }
 
+// ContainerResourceRequirements - Compute resource requirements of a container
+type ContainerResourceRequirements struct {
+	// Maximum amount of compute resources the container is allowed to use
+	Limits *ContainerResourceList
+
+	// Amount of compute resources reserved for the container
+	Requests *ContainerResourceList
+}
+
 // ContainerResourceUpdate - The type used for update operations of the ContainerResource.
 type ContainerResourceUpdate struct {
 	// The updatable properties of the ContainerResource.
@@ -433,6 +518,9 @@ type ContainerResourceUpdateProperties struct {
 	// Configuration for supported external identity providers
 	Identity *IdentitySettingsUpdate
 
+	// Containers which run to completion before the main container is started, in the order they are declared
+	InitContainers []*NamedContainer
+
 	// Specifies how the underlying container resource is provisioned and managed.
 	ResourceProvisioning *ContainerResourceProvisioning
 
@@ -444,6 +532,9 @@ type ContainerResourceUpdateProperties struct {
 
 	// Specifies Runtime-specific functionality
 	Runtimes *RuntimesProperties
+
+	// Containers which run alongside the main container for the lifetime of the pod
+	Sidecars []*NamedContainer
 }
 
 // ContainerUpdate - Definition of a container
@@ -455,7 +546,7 @@ type ContainerUpdate struct {
 	Command []*string
 
 	// environment
-	Env map[string]*string
+	Env map[string]*EnvironmentVariable
 
 	// The registry and image to download and run in your container
 	Image *string
@@ -472,6 +563,9 @@ type ContainerUpdate struct {
 	// readiness probe properties
 	ReadinessProbe HealthProbePropertiesClassification
 
+	// compute resource requirements
+	Resources *ContainerResourceRequirements
+
 	// container volumes
 	Volumes map[string]VolumeClassification
 
@@ -504,6 +598,18 @@ func (d *DaprSidecarExtension) GetExtension() *Extension {
 	}
 }
 
+// DriftedResource - A resource deployed by a recipe which no longer matches what was deployed.
+type DriftedResource struct {
+	// REQUIRED; The reason the resource is reported as drifted.
+	Reason *DriftReason
+
+	// The address of the resource in the recipe template, such as the address of a Terraform resource.
+	Address *string
+
+	// Fully qualified resource ID of the resource. Omitted if the ID of the resource is not known.
+	ResourceID *string
+}
+
 // EnvironmentCompute - Represents backing compute resource
 type EnvironmentCompute struct {
 	// REQUIRED; Discriminator property for EnvironmentCompute.
@@ -534,17 +640,35 @@ type EnvironmentComputeUpdate struct {
 // GetEnvironmentComputeUpdate implements the EnvironmentComputeUpdateClassification interface for type EnvironmentComputeUpdate.
 func (e *EnvironmentComputeUpdate) GetEnvironmentComputeUpdate() *EnvironmentComputeUpdate { return e }
 
+// EnvironmentGateway - Configuration of the gateway implementation used by the environment
+type EnvironmentGateway struct {
+	// The name of the GatewayClass of the Gateway resources. Required when kind is 'gatewayAPI'
+	GatewayClassName *string
+
+	// The gateway implementation used to render the Applications.Core/gateways resources of the environment
+	Kind *GatewayKind
+}
+
 // EnvironmentProperties - Environment properties
 type EnvironmentProperties struct {
 	// REQUIRED; The compute resource used by application environment.
 	Compute EnvironmentComputeClassification
 
+	// Default compute resource requirements applied to the containers in the environment which don't specify their own
+	DefaultContainerResources *ContainerResourceRequirements
+
 	// The environment extension.
 	Extensions []ExtensionClassification
 
+	// Configuration of the gateway implementation used by the environment.
+	Gateway *EnvironmentGateway
+
 	// Cloud providers configuration for the environment.
 	Providers *Providers
 
+	// Configuration for Recipes. Defines how each type of Recipe should be configured and run.
+	RecipeConfig *RecipeConfigProperties
+
 	// Specifies Recipes linked to the Environment.
 	Recipes map[string]map[string]RecipePropertiesClassification
 
@@ -602,12 +726,21 @@ type EnvironmentResourceUpdateProperties struct {
 	// The compute resource used by application environment.
 	Compute EnvironmentComputeUpdateClassification
 
+	// Default compute resource requirements applied to the containers in the environment which don't specify their own
+	DefaultContainerResources *ContainerResourceRequirements
+
 	// The environment extension.
 	Extensions []ExtensionClassification
 
+	// Configuration of the gateway implementation used by the environment.
+	Gateway *EnvironmentGateway
+
 	// Cloud providers configuration for the environment.
 	Providers *ProvidersUpdate
 
+	// Configuration for Recipes. Defines how each type of Recipe should be configured and run.
+	RecipeConfig *RecipeConfigPropertiesUpdate
+
 	// Specifies Recipes linked to the Environment.
 	Recipes map[string]map[string]RecipePropertiesUpdateClassification
 
@@ -615,6 +748,43 @@ type EnvironmentResourceUpdateProperties struct {
 	Simulated *bool
 }
 
+// EnvironmentVariable - Environment variable of a container. Exactly one of value and valueFrom must be specified.
+type EnvironmentVariable struct {
+	// The literal value of the environment variable
+	Value *string
+
+	// The source of the value of the environment variable
+	ValueFrom *EnvironmentVariableReference
+}
+
+// EnvironmentVariableConnectionReference - Reference to a single value of a connection of the container
+type EnvironmentVariableConnectionReference struct {
+	// REQUIRED; The name of the computed value or secret of the connected resource, for example 'connectionString'
+	Key *string
+
+	// REQUIRED; The name of the connection
+	Name *string
+}
+
+// EnvironmentVariableReference - Source of the value of an environment variable. Exactly one of secretRef and connectionRef
+// must be specified.
+type EnvironmentVariableReference struct {
+	// Reference to a single value of a connection of the container
+	ConnectionRef *EnvironmentVariableConnectionReference
+
+	// Reference to a key of an Applications.Core/secretStores resource
+	SecretRef *EnvironmentVariableSecretReference
+}
+
+// EnvironmentVariableSecretReference - Reference to a key of an Applications.Core/secretStores resource
+type EnvironmentVariableSecretReference struct {
+	// REQUIRED; The key of the secret in the secret store
+	Key *string
+
+	// REQUIRED; The resource id of the Applications.Core/secretStores resource
+	Source *string
+}
+
 // EphemeralVolume - Specifies an ephemeral volume for a container
 type EphemeralVolume struct {
 	// REQUIRED; Discriminator property for Volume.
@@ -1069,6 +1239,56 @@ type HealthProbeProperties struct {
 // GetHealthProbeProperties implements the HealthProbePropertiesClassification interface for type HealthProbeProperties.
 func (h *HealthProbeProperties) GetHealthProbeProperties() *HealthProbeProperties { return h }
 
+// HelmRecipeProperties - Represents Helm chart recipe properties.
+type HelmRecipeProperties struct {
+	// REQUIRED; Discriminator property for RecipeProperties.
+	TemplateKind *string
+
+	// REQUIRED; Path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
+	TemplatePath *string
+
+	// Key/value parameters to pass to the recipe template at deployment
+	Parameters map[string]any
+
+	// Version of the Helm chart to install. This is required for charts in a Helm repository, and is the tag of the chart for
+// OCI registries.
+	TemplateVersion *string
+}
+
+// GetRecipeProperties implements the RecipePropertiesClassification interface for type HelmRecipeProperties.
+func (h *HelmRecipeProperties) GetRecipeProperties() *RecipeProperties {
+	return &RecipeProperties{
+		Parameters: h.Parameters,
+		TemplateKind: h.TemplateKind,
+		TemplatePath: h.TemplatePath,
+	}
+}
+
+// HelmRecipePropertiesUpdate - Represents Helm chart recipe properties.
+type HelmRecipePropertiesUpdate struct {
+	// REQUIRED; Discriminator property for RecipeProperties.
+	TemplateKind *string
+
+	// Key/value parameters to pass to the recipe template at deployment
+	Parameters map[string]any
+
+	// Path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
+	TemplatePath *string
+
+	// Version of the Helm chart to install. This is required for charts in a Helm repository, and is the tag of the chart for
+// OCI registries.
+	TemplateVersion *string
+}
+
+// GetRecipePropertiesUpdate implements the RecipePropertiesUpdateClassification interface for type HelmRecipePropertiesUpdate.
+func (h *HelmRecipePropertiesUpdate) GetRecipePropertiesUpdate() *RecipePropertiesUpdate {
+	return &RecipePropertiesUpdate{
+		Parameters: h.Parameters,
+		TemplateKind: h.TemplateKind,
+		TemplatePath: h.TemplatePath,
+	}
+}
+
 // IamProperties - IAM properties
 type IamProperties struct {
 	// REQUIRED; The kind of IAM provider to configure
@@ -1232,6 +1452,45 @@ func (m *ManualScalingExtension) GetExtension() *Extension {
 	}
 }
 
+// NamedContainer - Definition of an init or sidecar container.
+type NamedContainer struct {
+	// REQUIRED; The registry and image to download and run in your container
+	Image *string
+
+	// REQUIRED; The name of the container. Must be unique within the container resource
+	Name *string
+
+	// Arguments to the entrypoint. Overrides the container image's CMD
+	Args []*string
+
+	// Entrypoint array. Overrides the container image's ENTRYPOINT
+	Command []*string
+
+	// environment
+	Env map[string]*EnvironmentVariable
+
+	// The pull policy for the container image
+	ImagePullPolicy *ImagePullPolicy
+
+	// liveness probe properties
+	LivenessProbe HealthProbePropertiesClassification
+
+	// container ports
+	Ports map[string]*ContainerPortProperties
+
+	// readiness probe properties
+	ReadinessProbe HealthProbePropertiesClassification
+
+	// compute resource requirements
+	Resources *ContainerResourceRequirements
+
+	// container volumes
+	Volumes map[string]VolumeClassification
+
+	// Working directory for the container
+	WorkingDir *string
+}
+
 // Operation - Details of a REST API operation, returned from the Resource Provider Operations API
 type Operation struct {
 	// Localized display information for this particular operation.
@@ -1369,6 +1628,51 @@ type Recipe struct {
 	Parameters map[string]any
 }
 
+// RecipeConfigProperties - Configuration for Recipes. Defines how each type of Recipe should be configured and run.
+type RecipeConfigProperties struct {
+	// Configuration for the drift detection of the resources deployed by Recipes.
+	Drift *RecipeDriftConfigProperties
+
+	// Configuration for Terraform Recipes. Controls how Terraform plans and applies a set of resources.
+	Terraform *TerraformConfigProperties
+}
+
+// RecipeConfigPropertiesUpdate - Configuration for Recipes. Defines how each type of Recipe should be configured and run.
+type RecipeConfigPropertiesUpdate struct {
+	// Configuration for the drift detection of the resources deployed by Recipes.
+	Drift *RecipeDriftConfigPropertiesUpdate
+
+	// Configuration for Terraform Recipes. Controls how Terraform plans and applies a set of resources.
+	Terraform *TerraformConfigPropertiesUpdate
+}
+
+// RecipeDriftConfigProperties - Configuration for the drift detection of the resources deployed by Recipes.
+type RecipeDriftConfigProperties struct {
+	// Redeploy the Recipe when the resources deployed by the Recipe have drifted. Defaults to false.
+	AutoRemediate *bool
+}
+
+// RecipeDriftConfigPropertiesUpdate - Configuration for the drift detection of the resources deployed by Recipes.
+type RecipeDriftConfigPropertiesUpdate struct {
+	// Redeploy the Recipe when the resources deployed by the Recipe have drifted. Defaults to false.
+	AutoRemediate *bool
+}
+
+// RecipeDriftStatus - The result of the drift check of the resources deployed by a recipe.
+type RecipeDriftStatus struct {
+	// REQUIRED; Indicates whether any of the resources deployed by the recipe have drifted.
+	Detected *bool
+
+	// The time of the last drift check.
+	LastCheckedTime *time.Time
+
+	// The time the recipe was last redeployed to remediate drift.
+	LastRemediatedTime *time.Time
+
+	// The resources deployed by the recipe which have drifted.
+	Resources []*DriftedResource
+}
+
 // RecipeGetMetadata - Represents the request body of the getmetadata action.
 type RecipeGetMetadata struct {
 	// REQUIRED; The name of the recipe registered to the environment
@@ -1383,7 +1687,7 @@ type RecipeGetMetadataResponse struct {
 	// REQUIRED; The key/value parameters to pass to the recipe template at deployment.
 	Parameters map[string]any
 
-	// REQUIRED; The format of the template provided by the recipe. Allowed values: bicep, terraform.
+	// REQUIRED; The format of the template provided by the recipe. Allowed values: bicep, terraform, helm.
 	TemplateKind *string
 
 	// REQUIRED; The path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
@@ -1394,7 +1698,32 @@ type RecipeGetMetadataResponse struct {
 	TemplateVersion *string
 }
 
-// RecipeProperties - Format of the template provided by the recipe. Allowed values: bicep, terraform.
+// RecipePlan - Represents the request body of the plan action.
+type RecipePlan struct {
+	// REQUIRED; The name of the recipe registered to the environment
+	Name *string
+
+	// REQUIRED; The name of the resource the recipe is deployed for. The changes are computed against the resources previously
+// deployed by the recipe for this resource.
+	ResourceName *string
+
+	// REQUIRED; Type of the resource this recipe can be consumed by. For example: 'Applications.Datastores/mongoDatabases'
+	ResourceType *string
+
+	// Fully qualified resource ID for the application that the resource is consumed by
+	Application *string
+
+	// Key/value parameters to pass to the recipe template at deployment. Overrides the parameters set by the environment.
+	Parameters map[string]any
+}
+
+// RecipePlanResponse - The changes the deployment of a recipe would make.
+type RecipePlanResponse struct {
+	// REQUIRED; The resources which would be created, updated or deleted by the deployment of the recipe.
+	Changes []*RecipeResourceChange
+}
+
+// RecipeProperties - Format of the template provided by the recipe. Allowed values: bicep, terraform, helm.
 type RecipeProperties struct {
 	// REQUIRED; Discriminator property for RecipeProperties.
 	TemplateKind *string
@@ -1409,7 +1738,7 @@ type RecipeProperties struct {
 // GetRecipeProperties implements the RecipePropertiesClassification interface for type RecipeProperties.
 func (r *RecipeProperties) GetRecipeProperties() *RecipeProperties { return r }
 
-// RecipePropertiesUpdate - Format of the template provided by the recipe. Allowed values: bicep, terraform.
+// RecipePropertiesUpdate - Format of the template provided by the recipe. Allowed values: bicep, terraform, helm.
 type RecipePropertiesUpdate struct {
 	// REQUIRED; Discriminator property for RecipeProperties.
 	TemplateKind *string
@@ -1424,6 +1753,18 @@ type RecipePropertiesUpdate struct {
 // GetRecipePropertiesUpdate implements the RecipePropertiesUpdateClassification interface for type RecipePropertiesUpdate.
 func (r *RecipePropertiesUpdate) GetRecipePropertiesUpdate() *RecipePropertiesUpdate { return r }
 
+// RecipeResourceChange - The change the deployment of a recipe would make to a resource.
+type RecipeResourceChange struct {
+	// REQUIRED; The type of change made to the resource.
+	ChangeType *RecipeResourceChangeType
+
+	// The address of the resource in the recipe template, such as the address of a Terraform resource.
+	Address *string
+
+	// Fully qualified resource ID of the resource. Omitted if the ID is only known after the resource is created.
+	ResourceID *string
+}
+
 // RecipeStatus - Recipe status at deployment time for a resource.
 type RecipeStatus struct {
 	// REQUIRED; TemplateKind is the kind of the recipe template used by the portable resource upon deployment.
@@ -1432,6 +1773,9 @@ type RecipeStatus struct {
 	// REQUIRED; TemplatePath is the path of the recipe consumed by the portable resource upon deployment.
 	TemplatePath *string
 
+	// The result of the last drift check of the resources deployed by the recipe.
+	Drift *RecipeDriftStatus
+
 	// TemplateVersion is the version number of the template.
 	TemplateVersion *string
 }
@@ -1657,6 +2001,152 @@ func (t *TCPHealthProbeProperties) GetHealthProbeProperties() *HealthProbeProper
 	}
 }
 
+// TerraformAuthenticationProperties - The credentials used to download Terraform modules from private sources.
+type TerraformAuthenticationProperties struct {
+	// The credentials used to download Terraform modules from private Git repositories.
+	Git *TerraformGitAuthenticationProperties
+
+	// The tokens used to download Terraform modules from private module registries, keyed by the host of the registry. The token
+// is read from the 'token' secret of the secret store.
+	Registry map[string]*TerraformSecretReference
+}
+
+// TerraformAuthenticationPropertiesUpdate - The credentials used to download Terraform modules from private sources.
+type TerraformAuthenticationPropertiesUpdate struct {
+	// The credentials used to download Terraform modules from private Git repositories.
+	Git *TerraformGitAuthenticationPropertiesUpdate
+
+	// The tokens used to download Terraform modules from private module registries, keyed by the host of the registry. The token
+// is read from the 'token' secret of the secret store.
+	Registry map[string]*TerraformSecretReferenceUpdate
+}
+
+// TerraformBackendProperties - The Terraform backend used to store the Terraform state of the Recipes.
+type TerraformBackendProperties struct {
+	// REQUIRED; The kind of the Terraform backend. Allowed values: kubernetes, s3, azurerm, pg, local.
+	Kind *string
+
+	// The configuration of the Terraform backend, such as the bucket and the region of the s3 backend. Credentials can't be
+// set in the configuration, the credentials of the s3 and azurerm backends are fetched from the UCP credential providers.
+	Config map[string]*string
+
+	// The resource ID of the Applications.Core/secretStores resource holding the credentials of the backend. The connection
+// string of the pg backend is read from the 'connStr' secret of the secret store.
+	Secret *string
+}
+
+// TerraformBackendPropertiesUpdate - The Terraform backend used to store the Terraform state of the Recipes.
+type TerraformBackendPropertiesUpdate struct {
+	// The configuration of the Terraform backend, such as the bucket and the region of the s3 backend. Credentials can't be
+// set in the configuration, the credentials of the s3 and azurerm backends are fetched from the UCP credential providers.
+	Config map[string]*string
+
+	// The kind of the Terraform backend. Allowed values: kubernetes, s3, azurerm, pg, local.
+	Kind *string
+
+	// The resource ID of the Applications.Core/secretStores resource holding the credentials of the backend. The connection
+// string of the pg backend is read from the 'connStr' secret of the secret store.
+	Secret *string
+}
+
+// TerraformConfigProperties - Configuration for Terraform Recipes. Controls how Terraform plans and applies a set of
+// resources.
+type TerraformConfigProperties struct {
+	// The credentials used to download Terraform modules from private sources.
+	Authentication *TerraformAuthenticationProperties
+
+	// The backend used to store the Terraform state of the Recipes. The Kubernetes backend is used if it is not set.
+	Backend *TerraformBackendProperties
+
+	// The path to a directory containing an offline mirror of the Terraform providers. When it is set, the providers are only
+	// installed from the mirror, and the Terraform executable in the mirror is used instead of downloading Terraform if there
+	// is one.
+	ProviderMirrorPath *string
+
+	// The configurations of additional Terraform providers, keyed by the provider name. Multiple configurations of a provider
+// must set distinct aliases. The configurations are merged with the provider configurations generated by Radius.
+	Providers map[string][]*TerraformProviderConfigProperties
+}
+
+// TerraformConfigPropertiesUpdate - Configuration for Terraform Recipes. Controls how Terraform plans and applies a set of
+// resources.
+type TerraformConfigPropertiesUpdate struct {
+	// The credentials used to download Terraform modules from private sources.
+	Authentication *TerraformAuthenticationPropertiesUpdate
+
+	// The backend used to store the Terraform state of the Recipes. The Kubernetes backend is used if it is not set.
+	Backend *TerraformBackendPropertiesUpdate
+
+	// The path to a directory containing an offline mirror of the Terraform providers. When it is set, the providers are only
+	// installed from the mirror, and the Terraform executable in the mirror is used instead of downloading Terraform if there
+	// is one.
+	ProviderMirrorPath *string
+
+	// The configurations of additional Terraform providers, keyed by the provider name. Multiple configurations of a provider
+// must set distinct aliases. The configurations are merged with the provider configurations generated by Radius.
+	Providers map[string][]*TerraformProviderConfigPropertiesUpdate
+}
+
+// TerraformGitAuthenticationProperties - The credentials used to download Terraform modules from private Git repositories.
+type TerraformGitAuthenticationProperties struct {
+	// The personal access tokens used to clone repositories over HTTPS, keyed by the host of the repositories. The token is read
+// from the 'pat' secret and the optional user name from the 'username' secret of the secret store.
+	Pat map[string]*TerraformSecretReference
+
+	// The SSH private keys used to clone repositories over SSH, keyed by the host of the repositories. The private key is read
+// from the 'privateKey' secret and the optional known hosts from the 'knownHosts' secret of the secret store. The known
+// hosts of the system are used if they are not set.
+	SSH map[string]*TerraformSecretReference
+}
+
+// TerraformGitAuthenticationPropertiesUpdate - The credentials used to download Terraform modules from private Git repositories.
+type TerraformGitAuthenticationPropertiesUpdate struct {
+	// The personal access tokens used to clone repositories over HTTPS, keyed by the host of the repositories. The token is read
+// from the 'pat' secret and the optional user name from the 'username' secret of the secret store.
+	Pat map[string]*TerraformSecretReferenceUpdate
+
+	// The SSH private keys used to clone repositories over SSH, keyed by the host of the repositories. The private key is read
+// from the 'privateKey' secret and the optional known hosts from the 'knownHosts' secret of the secret store. The known
+// hosts of the system are used if they are not set.
+	SSH map[string]*TerraformSecretReferenceUpdate
+}
+
+// TerraformProviderConfigProperties - The configuration of a Terraform provider block.
+type TerraformProviderConfigProperties struct {
+	// The secrets used as arguments of the provider block, keyed by the argument name.
+	Secrets map[string]*TerraformProviderSecretReference
+
+	// The arguments of the provider block, including the optional 'alias' argument.
+	Values map[string]any
+}
+
+// TerraformProviderConfigPropertiesUpdate - The configuration of a Terraform provider block.
+type TerraformProviderConfigPropertiesUpdate struct {
+	// The secrets used as arguments of the provider block, keyed by the argument name.
+	Secrets map[string]*TerraformProviderSecretReferenceUpdate
+
+	// The arguments of the provider block, including the optional 'alias' argument.
+	Values map[string]any
+}
+
+// TerraformProviderSecretReference - A reference to a secret of a secret store.
+type TerraformProviderSecretReference struct {
+	// REQUIRED; The name of the secret in the secret store.
+	Key *string
+
+	// REQUIRED; The resource ID of the Applications.Core/secretStores resource holding the secret.
+	Source *string
+}
+
+// TerraformProviderSecretReferenceUpdate - A reference to a secret of a secret store.
+type TerraformProviderSecretReferenceUpdate struct {
+	// The name of the secret in the secret store.
+	Key *string
+
+	// The resource ID of the Applications.Core/secretStores resource holding the secret.
+	Source *string
+}
+
 // TerraformRecipeProperties - Represents Terraform recipe properties.
 type TerraformRecipeProperties struct {
 	// REQUIRED; Discriminator property for RecipeProperties.
@@ -1707,6 +2197,18 @@ func (t *TerraformRecipePropertiesUpdate) GetRecipePropertiesUpdate() *RecipePro
 	}
 }
 
+// TerraformSecretReference - A reference to the secret store holding a credential.
+type TerraformSecretReference struct {
+	// REQUIRED; The resource ID of the Applications.Core/secretStores resource holding the credential.
+	Secret *string
+}
+
+// TerraformSecretReferenceUpdate - A reference to the secret store holding a credential.
+type TerraformSecretReferenceUpdate struct {
+	// The resource ID of the Applications.Core/secretStores resource holding the credential.
+	Secret *string
+}
+
 // TrackedResource - The resource model definition for an Azure Resource Manager tracked top level resource which has 'tags'
 // and a 'location'
 type TrackedResource struct {
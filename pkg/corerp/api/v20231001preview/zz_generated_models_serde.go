@@ -360,6 +360,123 @@ func (a *ApplicationResourceUpdateProperties) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type AutoScalingExtension.
+func (a AutoScalingExtension) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	objectMap["kind"] = "autoScaling"
+	populate(objectMap, "maxReplicas", a.MaxReplicas)
+	populate(objectMap, "metrics", a.Metrics)
+	populate(objectMap, "minReplicas", a.MinReplicas)
+	populate(objectMap, "targetCpuUtilizationPercentage", a.TargetCPUUtilizationPercentage)
+	populate(objectMap, "targetMemoryUtilizationPercentage", a.TargetMemoryUtilizationPercentage)
+	populate(objectMap, "triggers", a.Triggers)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type AutoScalingExtension.
+func (a *AutoScalingExtension) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", a, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "kind":
+				err = unpopulate(val, "Kind", &a.Kind)
+			delete(rawMsg, key)
+		case "maxReplicas":
+				err = unpopulate(val, "MaxReplicas", &a.MaxReplicas)
+			delete(rawMsg, key)
+		case "metrics":
+				err = unpopulate(val, "Metrics", &a.Metrics)
+			delete(rawMsg, key)
+		case "minReplicas":
+				err = unpopulate(val, "MinReplicas", &a.MinReplicas)
+			delete(rawMsg, key)
+		case "targetCpuUtilizationPercentage":
+				err = unpopulate(val, "TargetCPUUtilizationPercentage", &a.TargetCPUUtilizationPercentage)
+			delete(rawMsg, key)
+		case "targetMemoryUtilizationPercentage":
+				err = unpopulate(val, "TargetMemoryUtilizationPercentage", &a.TargetMemoryUtilizationPercentage)
+			delete(rawMsg, key)
+		case "triggers":
+				err = unpopulate(val, "Triggers", &a.Triggers)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", a, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type AutoScalingMetric.
+func (a AutoScalingMetric) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "name", a.Name)
+	populate(objectMap, "targetAverageValue", a.TargetAverageValue)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type AutoScalingMetric.
+func (a *AutoScalingMetric) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", a, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "name":
+				err = unpopulate(val, "Name", &a.Name)
+			delete(rawMsg, key)
+		case "targetAverageValue":
+				err = unpopulate(val, "TargetAverageValue", &a.TargetAverageValue)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", a, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type AutoScalingTrigger.
+func (a AutoScalingTrigger) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "metadata", a.Metadata)
+	populate(objectMap, "queueLength", a.QueueLength)
+	populate(objectMap, "type", a.Type)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type AutoScalingTrigger.
+func (a *AutoScalingTrigger) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", a, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "metadata":
+				err = unpopulate(val, "Metadata", &a.Metadata)
+			delete(rawMsg, key)
+		case "queueLength":
+				err = unpopulate(val, "QueueLength", &a.QueueLength)
+			delete(rawMsg, key)
+		case "type":
+				err = unpopulate(val, "Type", &a.Type)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", a, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type AzureKeyVaultVolumeProperties.
 func (a AzureKeyVaultVolumeProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -617,6 +734,7 @@ func (c Container) MarshalJSON() ([]byte, error) {
 	populate(objectMap, "livenessProbe", c.LivenessProbe)
 	populate(objectMap, "ports", c.Ports)
 	populate(objectMap, "readinessProbe", c.ReadinessProbe)
+	populate(objectMap, "resources", c.Resources)
 	populate(objectMap, "volumes", c.Volumes)
 	populate(objectMap, "workingDir", c.WorkingDir)
 	return json.Marshal(objectMap)
@@ -655,6 +773,9 @@ func (c *Container) UnmarshalJSON(data []byte) error {
 		case "readinessProbe":
 			c.ReadinessProbe, err = unmarshalHealthProbePropertiesClassification(val)
 			delete(rawMsg, key)
+		case "resources":
+				err = unpopulate(val, "Resources", &c.Resources)
+			delete(rawMsg, key)
 		case "volumes":
 			c.Volumes, err = unmarshalVolumeClassificationMap(val)
 			delete(rawMsg, key)
@@ -764,11 +885,13 @@ func (c ContainerProperties) MarshalJSON() ([]byte, error) {
 	populate(objectMap, "environment", c.Environment)
 	populate(objectMap, "extensions", c.Extensions)
 	populate(objectMap, "identity", c.Identity)
+	populate(objectMap, "initContainers", c.InitContainers)
 	populate(objectMap, "provisioningState", c.ProvisioningState)
 	populate(objectMap, "resourceProvisioning", c.ResourceProvisioning)
 	populate(objectMap, "resources", c.Resources)
 	populate(objectMap, "restartPolicy", c.RestartPolicy)
 	populate(objectMap, "runtimes", c.Runtimes)
+	populate(objectMap, "sidecars", c.Sidecars)
 	populate(objectMap, "status", c.Status)
 	return json.Marshal(objectMap)
 }
@@ -800,6 +923,9 @@ func (c *ContainerProperties) UnmarshalJSON(data []byte) error {
 		case "identity":
 				err = unpopulate(val, "Identity", &c.Identity)
 			delete(rawMsg, key)
+		case "initContainers":
+				err = unpopulate(val, "InitContainers", &c.InitContainers)
+			delete(rawMsg, key)
 		case "provisioningState":
 				err = unpopulate(val, "ProvisioningState", &c.ProvisioningState)
 			delete(rawMsg, key)
@@ -815,6 +941,9 @@ func (c *ContainerProperties) UnmarshalJSON(data []byte) error {
 		case "runtimes":
 				err = unpopulate(val, "Runtimes", &c.Runtimes)
 			delete(rawMsg, key)
+		case "sidecars":
+				err = unpopulate(val, "Sidecars", &c.Sidecars)
+			delete(rawMsg, key)
 		case "status":
 				err = unpopulate(val, "Status", &c.Status)
 			delete(rawMsg, key)
@@ -877,6 +1006,45 @@ func (c *ContainerResource) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type ContainerResourceList.
+func (c ContainerResourceList) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "cpu", c.CPU)
+	populate(objectMap, "ephemeralStorage", c.EphemeralStorage)
+	populate(objectMap, "extended", c.Extended)
+	populate(objectMap, "memory", c.Memory)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type ContainerResourceList.
+func (c *ContainerResourceList) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", c, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "cpu":
+				err = unpopulate(val, "CPU", &c.CPU)
+			delete(rawMsg, key)
+		case "ephemeralStorage":
+				err = unpopulate(val, "EphemeralStorage", &c.EphemeralStorage)
+			delete(rawMsg, key)
+		case "extended":
+				err = unpopulate(val, "Extended", &c.Extended)
+			delete(rawMsg, key)
+		case "memory":
+				err = unpopulate(val, "Memory", &c.Memory)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", c, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type ContainerResourceListResult.
 func (c ContainerResourceListResult) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -908,6 +1076,37 @@ func (c *ContainerResourceListResult) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type ContainerResourceRequirements.
+func (c ContainerResourceRequirements) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "limits", c.Limits)
+	populate(objectMap, "requests", c.Requests)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type ContainerResourceRequirements.
+func (c *ContainerResourceRequirements) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", c, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "limits":
+				err = unpopulate(val, "Limits", &c.Limits)
+			delete(rawMsg, key)
+		case "requests":
+				err = unpopulate(val, "Requests", &c.Requests)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", c, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type ContainerResourceUpdate.
 func (c ContainerResourceUpdate) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -948,10 +1147,12 @@ func (c ContainerResourceUpdateProperties) MarshalJSON() ([]byte, error) {
 	populate(objectMap, "environment", c.Environment)
 	populate(objectMap, "extensions", c.Extensions)
 	populate(objectMap, "identity", c.Identity)
+	populate(objectMap, "initContainers", c.InitContainers)
 	populate(objectMap, "resourceProvisioning", c.ResourceProvisioning)
 	populate(objectMap, "resources", c.Resources)
 	populate(objectMap, "restartPolicy", c.RestartPolicy)
 	populate(objectMap, "runtimes", c.Runtimes)
+	populate(objectMap, "sidecars", c.Sidecars)
 	return json.Marshal(objectMap)
 }
 
@@ -982,6 +1183,9 @@ func (c *ContainerResourceUpdateProperties) UnmarshalJSON(data []byte) error {
 		case "identity":
 				err = unpopulate(val, "Identity", &c.Identity)
 			delete(rawMsg, key)
+		case "initContainers":
+				err = unpopulate(val, "InitContainers", &c.InitContainers)
+			delete(rawMsg, key)
 		case "resourceProvisioning":
 				err = unpopulate(val, "ResourceProvisioning", &c.ResourceProvisioning)
 			delete(rawMsg, key)
@@ -994,6 +1198,9 @@ func (c *ContainerResourceUpdateProperties) UnmarshalJSON(data []byte) error {
 		case "runtimes":
 				err = unpopulate(val, "Runtimes", &c.Runtimes)
 			delete(rawMsg, key)
+		case "sidecars":
+				err = unpopulate(val, "Sidecars", &c.Sidecars)
+			delete(rawMsg, key)
 		}
 		if err != nil {
 			return fmt.Errorf("unmarshalling type %T: %v", c, err)
@@ -1013,6 +1220,7 @@ func (c ContainerUpdate) MarshalJSON() ([]byte, error) {
 	populate(objectMap, "livenessProbe", c.LivenessProbe)
 	populate(objectMap, "ports", c.Ports)
 	populate(objectMap, "readinessProbe", c.ReadinessProbe)
+	populate(objectMap, "resources", c.Resources)
 	populate(objectMap, "volumes", c.Volumes)
 	populate(objectMap, "workingDir", c.WorkingDir)
 	return json.Marshal(objectMap)
@@ -1051,6 +1259,9 @@ func (c *ContainerUpdate) UnmarshalJSON(data []byte) error {
 		case "readinessProbe":
 			c.ReadinessProbe, err = unmarshalHealthProbePropertiesClassification(val)
 			delete(rawMsg, key)
+		case "resources":
+				err = unpopulate(val, "Resources", &c.Resources)
+			delete(rawMsg, key)
 		case "volumes":
 			c.Volumes, err = unmarshalVolumeClassificationMap(val)
 			delete(rawMsg, key)
@@ -1108,6 +1319,41 @@ func (d *DaprSidecarExtension) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type DriftedResource.
+func (d DriftedResource) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "address", d.Address)
+	populate(objectMap, "reason", d.Reason)
+	populate(objectMap, "resourceId", d.ResourceID)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type DriftedResource.
+func (d *DriftedResource) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", d, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "address":
+				err = unpopulate(val, "Address", &d.Address)
+			delete(rawMsg, key)
+		case "reason":
+				err = unpopulate(val, "Reason", &d.Reason)
+			delete(rawMsg, key)
+		case "resourceId":
+				err = unpopulate(val, "ResourceID", &d.ResourceID)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", d, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type EnvironmentCompute.
 func (e EnvironmentCompute) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -1178,13 +1424,47 @@ func (e *EnvironmentComputeUpdate) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type EnvironmentGateway.
+func (e EnvironmentGateway) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "gatewayClassName", e.GatewayClassName)
+	populate(objectMap, "kind", e.Kind)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type EnvironmentGateway.
+func (e *EnvironmentGateway) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", e, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "gatewayClassName":
+				err = unpopulate(val, "GatewayClassName", &e.GatewayClassName)
+			delete(rawMsg, key)
+		case "kind":
+				err = unpopulate(val, "Kind", &e.Kind)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", e, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type EnvironmentProperties.
 func (e EnvironmentProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "compute", e.Compute)
+	populate(objectMap, "defaultContainerResources", e.DefaultContainerResources)
 	populate(objectMap, "extensions", e.Extensions)
+	populate(objectMap, "gateway", e.Gateway)
 	populate(objectMap, "providers", e.Providers)
 	populate(objectMap, "provisioningState", e.ProvisioningState)
+	populate(objectMap, "recipeConfig", e.RecipeConfig)
 	populate(objectMap, "recipes", e.Recipes)
 	populate(objectMap, "simulated", e.Simulated)
 	return json.Marshal(objectMap)
@@ -1202,15 +1482,24 @@ func (e *EnvironmentProperties) UnmarshalJSON(data []byte) error {
 		case "compute":
 			e.Compute, err = unmarshalEnvironmentComputeClassification(val)
 			delete(rawMsg, key)
+		case "defaultContainerResources":
+				err = unpopulate(val, "DefaultContainerResources", &e.DefaultContainerResources)
+			delete(rawMsg, key)
 		case "extensions":
 			e.Extensions, err = unmarshalExtensionClassificationArray(val)
 			delete(rawMsg, key)
+		case "gateway":
+				err = unpopulate(val, "Gateway", &e.Gateway)
+			delete(rawMsg, key)
 		case "providers":
 				err = unpopulate(val, "Providers", &e.Providers)
 			delete(rawMsg, key)
 		case "provisioningState":
 				err = unpopulate(val, "ProvisioningState", &e.ProvisioningState)
 			delete(rawMsg, key)
+		case "recipeConfig":
+				err = unpopulate(val, "RecipeConfig", &e.RecipeConfig)
+			delete(rawMsg, key)
 		case "recipes":
 			var recipesRaw map[string]json.RawMessage
 			if err = json.Unmarshal(val, &recipesRaw); err != nil {
@@ -1353,8 +1642,11 @@ func (e *EnvironmentResourceUpdate) UnmarshalJSON(data []byte) error {
 func (e EnvironmentResourceUpdateProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "compute", e.Compute)
+	populate(objectMap, "defaultContainerResources", e.DefaultContainerResources)
 	populate(objectMap, "extensions", e.Extensions)
+	populate(objectMap, "gateway", e.Gateway)
 	populate(objectMap, "providers", e.Providers)
+	populate(objectMap, "recipeConfig", e.RecipeConfig)
 	populate(objectMap, "recipes", e.Recipes)
 	populate(objectMap, "simulated", e.Simulated)
 	return json.Marshal(objectMap)
@@ -1372,12 +1664,21 @@ func (e *EnvironmentResourceUpdateProperties) UnmarshalJSON(data []byte) error {
 		case "compute":
 			e.Compute, err = unmarshalEnvironmentComputeUpdateClassification(val)
 			delete(rawMsg, key)
+		case "defaultContainerResources":
+				err = unpopulate(val, "DefaultContainerResources", &e.DefaultContainerResources)
+			delete(rawMsg, key)
 		case "extensions":
 			e.Extensions, err = unmarshalExtensionClassificationArray(val)
 			delete(rawMsg, key)
+		case "gateway":
+				err = unpopulate(val, "Gateway", &e.Gateway)
+			delete(rawMsg, key)
 		case "providers":
 				err = unpopulate(val, "Providers", &e.Providers)
 			delete(rawMsg, key)
+		case "recipeConfig":
+				err = unpopulate(val, "RecipeConfig", &e.RecipeConfig)
+			delete(rawMsg, key)
 		case "recipes":
 			var recipesRaw map[string]json.RawMessage
 			if err = json.Unmarshal(val, &recipesRaw); err != nil {
@@ -1403,17 +1704,16 @@ func (e *EnvironmentResourceUpdateProperties) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// MarshalJSON implements the json.Marshaller interface for type EphemeralVolume.
-func (e EphemeralVolume) MarshalJSON() ([]byte, error) {
+// MarshalJSON implements the json.Marshaller interface for type EnvironmentVariable.
+func (e EnvironmentVariable) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
-	objectMap["kind"] = "ephemeral"
-	populate(objectMap, "managedStore", e.ManagedStore)
-	populate(objectMap, "mountPath", e.MountPath)
+	populate(objectMap, "value", e.Value)
+	populate(objectMap, "valueFrom", e.ValueFrom)
 	return json.Marshal(objectMap)
 }
 
-// UnmarshalJSON implements the json.Unmarshaller interface for type EphemeralVolume.
-func (e *EphemeralVolume) UnmarshalJSON(data []byte) error {
+// UnmarshalJSON implements the json.Unmarshaller interface for type EnvironmentVariable.
+func (e *EnvironmentVariable) UnmarshalJSON(data []byte) error {
 	var rawMsg map[string]json.RawMessage
 	if err := json.Unmarshal(data, &rawMsg); err != nil {
 		return fmt.Errorf("unmarshalling type %T: %v", e, err)
@@ -1421,14 +1721,11 @@ func (e *EphemeralVolume) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
-		case "kind":
-				err = unpopulate(val, "Kind", &e.Kind)
-			delete(rawMsg, key)
-		case "managedStore":
-				err = unpopulate(val, "ManagedStore", &e.ManagedStore)
+		case "value":
+				err = unpopulate(val, "Value", &e.Value)
 			delete(rawMsg, key)
-		case "mountPath":
-				err = unpopulate(val, "MountPath", &e.MountPath)
+		case "valueFrom":
+				err = unpopulate(val, "ValueFrom", &e.ValueFrom)
 			delete(rawMsg, key)
 		}
 		if err != nil {
@@ -1438,16 +1735,16 @@ func (e *EphemeralVolume) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// MarshalJSON implements the json.Marshaller interface for type ErrorAdditionalInfo.
-func (e ErrorAdditionalInfo) MarshalJSON() ([]byte, error) {
+// MarshalJSON implements the json.Marshaller interface for type EnvironmentVariableConnectionReference.
+func (e EnvironmentVariableConnectionReference) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
-	populate(objectMap, "info", e.Info)
-	populate(objectMap, "type", e.Type)
+	populate(objectMap, "key", e.Key)
+	populate(objectMap, "name", e.Name)
 	return json.Marshal(objectMap)
 }
 
-// UnmarshalJSON implements the json.Unmarshaller interface for type ErrorAdditionalInfo.
-func (e *ErrorAdditionalInfo) UnmarshalJSON(data []byte) error {
+// UnmarshalJSON implements the json.Unmarshaller interface for type EnvironmentVariableConnectionReference.
+func (e *EnvironmentVariableConnectionReference) UnmarshalJSON(data []byte) error {
 	var rawMsg map[string]json.RawMessage
 	if err := json.Unmarshal(data, &rawMsg); err != nil {
 		return fmt.Errorf("unmarshalling type %T: %v", e, err)
@@ -1455,11 +1752,11 @@ func (e *ErrorAdditionalInfo) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
-		case "info":
-				err = unpopulate(val, "Info", &e.Info)
+		case "key":
+				err = unpopulate(val, "Key", &e.Key)
 			delete(rawMsg, key)
-		case "type":
-				err = unpopulate(val, "Type", &e.Type)
+		case "name":
+				err = unpopulate(val, "Name", &e.Name)
 			delete(rawMsg, key)
 		}
 		if err != nil {
@@ -1469,19 +1766,16 @@ func (e *ErrorAdditionalInfo) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// MarshalJSON implements the json.Marshaller interface for type ErrorDetail.
-func (e ErrorDetail) MarshalJSON() ([]byte, error) {
+// MarshalJSON implements the json.Marshaller interface for type EnvironmentVariableReference.
+func (e EnvironmentVariableReference) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
-	populate(objectMap, "additionalInfo", e.AdditionalInfo)
-	populate(objectMap, "code", e.Code)
-	populate(objectMap, "details", e.Details)
-	populate(objectMap, "message", e.Message)
-	populate(objectMap, "target", e.Target)
+	populate(objectMap, "connectionRef", e.ConnectionRef)
+	populate(objectMap, "secretRef", e.SecretRef)
 	return json.Marshal(objectMap)
 }
 
-// UnmarshalJSON implements the json.Unmarshaller interface for type ErrorDetail.
-func (e *ErrorDetail) UnmarshalJSON(data []byte) error {
+// UnmarshalJSON implements the json.Unmarshaller interface for type EnvironmentVariableReference.
+func (e *EnvironmentVariableReference) UnmarshalJSON(data []byte) error {
 	var rawMsg map[string]json.RawMessage
 	if err := json.Unmarshal(data, &rawMsg); err != nil {
 		return fmt.Errorf("unmarshalling type %T: %v", e, err)
@@ -1489,20 +1783,11 @@ func (e *ErrorDetail) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
-		case "additionalInfo":
-				err = unpopulate(val, "AdditionalInfo", &e.AdditionalInfo)
-			delete(rawMsg, key)
-		case "code":
-				err = unpopulate(val, "Code", &e.Code)
-			delete(rawMsg, key)
-		case "details":
-				err = unpopulate(val, "Details", &e.Details)
-			delete(rawMsg, key)
-		case "message":
-				err = unpopulate(val, "Message", &e.Message)
+		case "connectionRef":
+				err = unpopulate(val, "ConnectionRef", &e.ConnectionRef)
 			delete(rawMsg, key)
-		case "target":
-				err = unpopulate(val, "Target", &e.Target)
+		case "secretRef":
+				err = unpopulate(val, "SecretRef", &e.SecretRef)
 			delete(rawMsg, key)
 		}
 		if err != nil {
@@ -1512,15 +1797,16 @@ func (e *ErrorDetail) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// MarshalJSON implements the json.Marshaller interface for type ErrorResponse.
-func (e ErrorResponse) MarshalJSON() ([]byte, error) {
+// MarshalJSON implements the json.Marshaller interface for type EnvironmentVariableSecretReference.
+func (e EnvironmentVariableSecretReference) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
-	populate(objectMap, "error", e.Error)
+	populate(objectMap, "key", e.Key)
+	populate(objectMap, "source", e.Source)
 	return json.Marshal(objectMap)
 }
 
-// UnmarshalJSON implements the json.Unmarshaller interface for type ErrorResponse.
-func (e *ErrorResponse) UnmarshalJSON(data []byte) error {
+// UnmarshalJSON implements the json.Unmarshaller interface for type EnvironmentVariableSecretReference.
+func (e *EnvironmentVariableSecretReference) UnmarshalJSON(data []byte) error {
 	var rawMsg map[string]json.RawMessage
 	if err := json.Unmarshal(data, &rawMsg); err != nil {
 		return fmt.Errorf("unmarshalling type %T: %v", e, err)
@@ -1528,8 +1814,11 @@ func (e *ErrorResponse) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
-		case "error":
-				err = unpopulate(val, "Error", &e.Error)
+		case "key":
+				err = unpopulate(val, "Key", &e.Key)
+			delete(rawMsg, key)
+		case "source":
+				err = unpopulate(val, "Source", &e.Source)
 			delete(rawMsg, key)
 		}
 		if err != nil {
@@ -1539,14 +1828,150 @@ func (e *ErrorResponse) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// MarshalJSON implements the json.Marshaller interface for type ExecHealthProbeProperties.
-func (e ExecHealthProbeProperties) MarshalJSON() ([]byte, error) {
+// MarshalJSON implements the json.Marshaller interface for type EphemeralVolume.
+func (e EphemeralVolume) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
-	populate(objectMap, "command", e.Command)
-	populate(objectMap, "failureThreshold", e.FailureThreshold)
-	populate(objectMap, "initialDelaySeconds", e.InitialDelaySeconds)
-	objectMap["kind"] = "exec"
-	populate(objectMap, "periodSeconds", e.PeriodSeconds)
+	objectMap["kind"] = "ephemeral"
+	populate(objectMap, "managedStore", e.ManagedStore)
+	populate(objectMap, "mountPath", e.MountPath)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type EphemeralVolume.
+func (e *EphemeralVolume) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", e, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "kind":
+				err = unpopulate(val, "Kind", &e.Kind)
+			delete(rawMsg, key)
+		case "managedStore":
+				err = unpopulate(val, "ManagedStore", &e.ManagedStore)
+			delete(rawMsg, key)
+		case "mountPath":
+				err = unpopulate(val, "MountPath", &e.MountPath)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", e, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type ErrorAdditionalInfo.
+func (e ErrorAdditionalInfo) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "info", e.Info)
+	populate(objectMap, "type", e.Type)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type ErrorAdditionalInfo.
+func (e *ErrorAdditionalInfo) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", e, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "info":
+				err = unpopulate(val, "Info", &e.Info)
+			delete(rawMsg, key)
+		case "type":
+				err = unpopulate(val, "Type", &e.Type)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", e, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type ErrorDetail.
+func (e ErrorDetail) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "additionalInfo", e.AdditionalInfo)
+	populate(objectMap, "code", e.Code)
+	populate(objectMap, "details", e.Details)
+	populate(objectMap, "message", e.Message)
+	populate(objectMap, "target", e.Target)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type ErrorDetail.
+func (e *ErrorDetail) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", e, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "additionalInfo":
+				err = unpopulate(val, "AdditionalInfo", &e.AdditionalInfo)
+			delete(rawMsg, key)
+		case "code":
+				err = unpopulate(val, "Code", &e.Code)
+			delete(rawMsg, key)
+		case "details":
+				err = unpopulate(val, "Details", &e.Details)
+			delete(rawMsg, key)
+		case "message":
+				err = unpopulate(val, "Message", &e.Message)
+			delete(rawMsg, key)
+		case "target":
+				err = unpopulate(val, "Target", &e.Target)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", e, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type ErrorResponse.
+func (e ErrorResponse) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "error", e.Error)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type ErrorResponse.
+func (e *ErrorResponse) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", e, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "error":
+				err = unpopulate(val, "Error", &e.Error)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", e, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type ExecHealthProbeProperties.
+func (e ExecHealthProbeProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "command", e.Command)
+	populate(objectMap, "failureThreshold", e.FailureThreshold)
+	populate(objectMap, "initialDelaySeconds", e.InitialDelaySeconds)
+	objectMap["kind"] = "exec"
+	populate(objectMap, "periodSeconds", e.PeriodSeconds)
 	populate(objectMap, "timeoutSeconds", e.TimeoutSeconds)
 	return json.Marshal(objectMap)
 }
@@ -2460,6 +2885,84 @@ func (h *HealthProbeProperties) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type HelmRecipeProperties.
+func (h HelmRecipeProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "parameters", h.Parameters)
+	objectMap["templateKind"] = "helm"
+	populate(objectMap, "templatePath", h.TemplatePath)
+	populate(objectMap, "templateVersion", h.TemplateVersion)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type HelmRecipeProperties.
+func (h *HelmRecipeProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", h, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "parameters":
+				err = unpopulate(val, "Parameters", &h.Parameters)
+			delete(rawMsg, key)
+		case "templateKind":
+				err = unpopulate(val, "TemplateKind", &h.TemplateKind)
+			delete(rawMsg, key)
+		case "templatePath":
+				err = unpopulate(val, "TemplatePath", &h.TemplatePath)
+			delete(rawMsg, key)
+		case "templateVersion":
+				err = unpopulate(val, "TemplateVersion", &h.TemplateVersion)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", h, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type HelmRecipePropertiesUpdate.
+func (h HelmRecipePropertiesUpdate) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "parameters", h.Parameters)
+	objectMap["templateKind"] = "helm"
+	populate(objectMap, "templatePath", h.TemplatePath)
+	populate(objectMap, "templateVersion", h.TemplateVersion)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type HelmRecipePropertiesUpdate.
+func (h *HelmRecipePropertiesUpdate) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", h, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "parameters":
+				err = unpopulate(val, "Parameters", &h.Parameters)
+			delete(rawMsg, key)
+		case "templateKind":
+				err = unpopulate(val, "TemplateKind", &h.TemplateKind)
+			delete(rawMsg, key)
+		case "templatePath":
+				err = unpopulate(val, "TemplatePath", &h.TemplatePath)
+			delete(rawMsg, key)
+		case "templateVersion":
+				err = unpopulate(val, "TemplateVersion", &h.TemplateVersion)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", h, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type IamProperties.
 func (i IamProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -2833,6 +3336,77 @@ func (m *ManualScalingExtension) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type NamedContainer.
+func (n NamedContainer) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "args", n.Args)
+	populate(objectMap, "command", n.Command)
+	populate(objectMap, "env", n.Env)
+	populate(objectMap, "image", n.Image)
+	populate(objectMap, "imagePullPolicy", n.ImagePullPolicy)
+	populate(objectMap, "livenessProbe", n.LivenessProbe)
+	populate(objectMap, "name", n.Name)
+	populate(objectMap, "ports", n.Ports)
+	populate(objectMap, "readinessProbe", n.ReadinessProbe)
+	populate(objectMap, "resources", n.Resources)
+	populate(objectMap, "volumes", n.Volumes)
+	populate(objectMap, "workingDir", n.WorkingDir)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type NamedContainer.
+func (n *NamedContainer) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", n, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "args":
+				err = unpopulate(val, "Args", &n.Args)
+			delete(rawMsg, key)
+		case "command":
+				err = unpopulate(val, "Command", &n.Command)
+			delete(rawMsg, key)
+		case "env":
+				err = unpopulate(val, "Env", &n.Env)
+			delete(rawMsg, key)
+		case "image":
+				err = unpopulate(val, "Image", &n.Image)
+			delete(rawMsg, key)
+		case "imagePullPolicy":
+				err = unpopulate(val, "ImagePullPolicy", &n.ImagePullPolicy)
+			delete(rawMsg, key)
+		case "livenessProbe":
+			n.LivenessProbe, err = unmarshalHealthProbePropertiesClassification(val)
+			delete(rawMsg, key)
+		case "name":
+				err = unpopulate(val, "Name", &n.Name)
+			delete(rawMsg, key)
+		case "ports":
+				err = unpopulate(val, "Ports", &n.Ports)
+			delete(rawMsg, key)
+		case "readinessProbe":
+			n.ReadinessProbe, err = unmarshalHealthProbePropertiesClassification(val)
+			delete(rawMsg, key)
+		case "resources":
+				err = unpopulate(val, "Resources", &n.Resources)
+			delete(rawMsg, key)
+		case "volumes":
+			n.Volumes, err = unmarshalVolumeClassificationMap(val)
+			delete(rawMsg, key)
+		case "workingDir":
+				err = unpopulate(val, "WorkingDir", &n.WorkingDir)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", n, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type Operation.
 func (o Operation) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -3221,16 +3795,16 @@ func (r *Recipe) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// MarshalJSON implements the json.Marshaller interface for type RecipeGetMetadata.
-func (r RecipeGetMetadata) MarshalJSON() ([]byte, error) {
+// MarshalJSON implements the json.Marshaller interface for type RecipeConfigProperties.
+func (r RecipeConfigProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
-	populate(objectMap, "name", r.Name)
-	populate(objectMap, "resourceType", r.ResourceType)
+	populate(objectMap, "drift", r.Drift)
+	populate(objectMap, "terraform", r.Terraform)
 	return json.Marshal(objectMap)
 }
 
-// UnmarshalJSON implements the json.Unmarshaller interface for type RecipeGetMetadata.
-func (r *RecipeGetMetadata) UnmarshalJSON(data []byte) error {
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipeConfigProperties.
+func (r *RecipeConfigProperties) UnmarshalJSON(data []byte) error {
 	var rawMsg map[string]json.RawMessage
 	if err := json.Unmarshal(data, &rawMsg); err != nil {
 		return fmt.Errorf("unmarshalling type %T: %v", r, err)
@@ -3238,11 +3812,11 @@ func (r *RecipeGetMetadata) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
-		case "name":
-				err = unpopulate(val, "Name", &r.Name)
+		case "drift":
+				err = unpopulate(val, "Drift", &r.Drift)
 			delete(rawMsg, key)
-		case "resourceType":
-				err = unpopulate(val, "ResourceType", &r.ResourceType)
+		case "terraform":
+				err = unpopulate(val, "Terraform", &r.Terraform)
 			delete(rawMsg, key)
 		}
 		if err != nil {
@@ -3252,18 +3826,16 @@ func (r *RecipeGetMetadata) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// MarshalJSON implements the json.Marshaller interface for type RecipeGetMetadataResponse.
-func (r RecipeGetMetadataResponse) MarshalJSON() ([]byte, error) {
+// MarshalJSON implements the json.Marshaller interface for type RecipeConfigPropertiesUpdate.
+func (r RecipeConfigPropertiesUpdate) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
-	populate(objectMap, "parameters", r.Parameters)
-	populate(objectMap, "templateKind", r.TemplateKind)
-	populate(objectMap, "templatePath", r.TemplatePath)
-	populate(objectMap, "templateVersion", r.TemplateVersion)
+	populate(objectMap, "drift", r.Drift)
+	populate(objectMap, "terraform", r.Terraform)
 	return json.Marshal(objectMap)
 }
 
-// UnmarshalJSON implements the json.Unmarshaller interface for type RecipeGetMetadataResponse.
-func (r *RecipeGetMetadataResponse) UnmarshalJSON(data []byte) error {
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipeConfigPropertiesUpdate.
+func (r *RecipeConfigPropertiesUpdate) UnmarshalJSON(data []byte) error {
 	var rawMsg map[string]json.RawMessage
 	if err := json.Unmarshal(data, &rawMsg); err != nil {
 		return fmt.Errorf("unmarshalling type %T: %v", r, err)
@@ -3271,8 +3843,165 @@ func (r *RecipeGetMetadataResponse) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
-		case "parameters":
-				err = unpopulate(val, "Parameters", &r.Parameters)
+		case "drift":
+				err = unpopulate(val, "Drift", &r.Drift)
+			delete(rawMsg, key)
+		case "terraform":
+				err = unpopulate(val, "Terraform", &r.Terraform)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type RecipeDriftConfigProperties.
+func (r RecipeDriftConfigProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "autoRemediate", r.AutoRemediate)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipeDriftConfigProperties.
+func (r *RecipeDriftConfigProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "autoRemediate":
+				err = unpopulate(val, "AutoRemediate", &r.AutoRemediate)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type RecipeDriftConfigPropertiesUpdate.
+func (r RecipeDriftConfigPropertiesUpdate) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "autoRemediate", r.AutoRemediate)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipeDriftConfigPropertiesUpdate.
+func (r *RecipeDriftConfigPropertiesUpdate) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "autoRemediate":
+				err = unpopulate(val, "AutoRemediate", &r.AutoRemediate)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type RecipeDriftStatus.
+func (r RecipeDriftStatus) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "detected", r.Detected)
+	populateTimeRFC3339(objectMap, "lastCheckedTime", r.LastCheckedTime)
+	populateTimeRFC3339(objectMap, "lastRemediatedTime", r.LastRemediatedTime)
+	populate(objectMap, "resources", r.Resources)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipeDriftStatus.
+func (r *RecipeDriftStatus) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "detected":
+				err = unpopulate(val, "Detected", &r.Detected)
+			delete(rawMsg, key)
+		case "lastCheckedTime":
+				err = unpopulateTimeRFC3339(val, "LastCheckedTime", &r.LastCheckedTime)
+			delete(rawMsg, key)
+		case "lastRemediatedTime":
+				err = unpopulateTimeRFC3339(val, "LastRemediatedTime", &r.LastRemediatedTime)
+			delete(rawMsg, key)
+		case "resources":
+				err = unpopulate(val, "Resources", &r.Resources)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type RecipeGetMetadata.
+func (r RecipeGetMetadata) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "name", r.Name)
+	populate(objectMap, "resourceType", r.ResourceType)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipeGetMetadata.
+func (r *RecipeGetMetadata) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "name":
+				err = unpopulate(val, "Name", &r.Name)
+			delete(rawMsg, key)
+		case "resourceType":
+				err = unpopulate(val, "ResourceType", &r.ResourceType)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type RecipeGetMetadataResponse.
+func (r RecipeGetMetadataResponse) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "parameters", r.Parameters)
+	populate(objectMap, "templateKind", r.TemplateKind)
+	populate(objectMap, "templatePath", r.TemplatePath)
+	populate(objectMap, "templateVersion", r.TemplateVersion)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipeGetMetadataResponse.
+func (r *RecipeGetMetadataResponse) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "parameters":
+				err = unpopulate(val, "Parameters", &r.Parameters)
 			delete(rawMsg, key)
 		case "templateKind":
 				err = unpopulate(val, "TemplateKind", &r.TemplateKind)
@@ -3291,6 +4020,76 @@ func (r *RecipeGetMetadataResponse) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type RecipePlan.
+func (r RecipePlan) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "application", r.Application)
+	populate(objectMap, "name", r.Name)
+	populate(objectMap, "parameters", r.Parameters)
+	populate(objectMap, "resourceName", r.ResourceName)
+	populate(objectMap, "resourceType", r.ResourceType)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipePlan.
+func (r *RecipePlan) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "application":
+				err = unpopulate(val, "Application", &r.Application)
+			delete(rawMsg, key)
+		case "name":
+				err = unpopulate(val, "Name", &r.Name)
+			delete(rawMsg, key)
+		case "parameters":
+				err = unpopulate(val, "Parameters", &r.Parameters)
+			delete(rawMsg, key)
+		case "resourceName":
+				err = unpopulate(val, "ResourceName", &r.ResourceName)
+			delete(rawMsg, key)
+		case "resourceType":
+				err = unpopulate(val, "ResourceType", &r.ResourceType)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type RecipePlanResponse.
+func (r RecipePlanResponse) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "changes", r.Changes)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipePlanResponse.
+func (r *RecipePlanResponse) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "changes":
+				err = unpopulate(val, "Changes", &r.Changes)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type RecipeProperties.
 func (r RecipeProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -3361,9 +4160,45 @@ func (r *RecipePropertiesUpdate) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type RecipeResourceChange.
+func (r RecipeResourceChange) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "address", r.Address)
+	populate(objectMap, "changeType", r.ChangeType)
+	populate(objectMap, "resourceId", r.ResourceID)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipeResourceChange.
+func (r *RecipeResourceChange) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "address":
+				err = unpopulate(val, "Address", &r.Address)
+			delete(rawMsg, key)
+		case "changeType":
+				err = unpopulate(val, "ChangeType", &r.ChangeType)
+			delete(rawMsg, key)
+		case "resourceId":
+				err = unpopulate(val, "ResourceID", &r.ResourceID)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type RecipeStatus.
 func (r RecipeStatus) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
+	populate(objectMap, "drift", r.Drift)
 	populate(objectMap, "templateKind", r.TemplateKind)
 	populate(objectMap, "templatePath", r.TemplatePath)
 	populate(objectMap, "templateVersion", r.TemplateVersion)
@@ -3379,6 +4214,9 @@ func (r *RecipeStatus) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
+		case "drift":
+				err = unpopulate(val, "Drift", &r.Drift)
+			delete(rawMsg, key)
 		case "templateKind":
 				err = unpopulate(val, "TemplateKind", &r.TemplateKind)
 			delete(rawMsg, key)
@@ -3961,18 +4799,16 @@ func (t *TCPHealthProbeProperties) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// MarshalJSON implements the json.Marshaller interface for type TerraformRecipeProperties.
-func (t TerraformRecipeProperties) MarshalJSON() ([]byte, error) {
+// MarshalJSON implements the json.Marshaller interface for type TerraformAuthenticationProperties.
+func (t TerraformAuthenticationProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
-	populate(objectMap, "parameters", t.Parameters)
-	objectMap["templateKind"] = "terraform"
-	populate(objectMap, "templatePath", t.TemplatePath)
-	populate(objectMap, "templateVersion", t.TemplateVersion)
+	populate(objectMap, "git", t.Git)
+	populate(objectMap, "registry", t.Registry)
 	return json.Marshal(objectMap)
 }
 
-// UnmarshalJSON implements the json.Unmarshaller interface for type TerraformRecipeProperties.
-func (t *TerraformRecipeProperties) UnmarshalJSON(data []byte) error {
+// UnmarshalJSON implements the json.Unmarshaller interface for type TerraformAuthenticationProperties.
+func (t *TerraformAuthenticationProperties) UnmarshalJSON(data []byte) error {
 	var rawMsg map[string]json.RawMessage
 	if err := json.Unmarshal(data, &rawMsg); err != nil {
 		return fmt.Errorf("unmarshalling type %T: %v", t, err)
@@ -3980,17 +4816,42 @@ func (t *TerraformRecipeProperties) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
-		case "parameters":
-				err = unpopulate(val, "Parameters", &t.Parameters)
+		case "git":
+				err = unpopulate(val, "Git", &t.Git)
 			delete(rawMsg, key)
-		case "templateKind":
-				err = unpopulate(val, "TemplateKind", &t.TemplateKind)
+		case "registry":
+				err = unpopulate(val, "Registry", &t.Registry)
 			delete(rawMsg, key)
-		case "templatePath":
-				err = unpopulate(val, "TemplatePath", &t.TemplatePath)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", t, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type TerraformAuthenticationPropertiesUpdate.
+func (t TerraformAuthenticationPropertiesUpdate) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "git", t.Git)
+	populate(objectMap, "registry", t.Registry)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type TerraformAuthenticationPropertiesUpdate.
+func (t *TerraformAuthenticationPropertiesUpdate) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", t, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "git":
+				err = unpopulate(val, "Git", &t.Git)
 			delete(rawMsg, key)
-		case "templateVersion":
-				err = unpopulate(val, "TemplateVersion", &t.TemplateVersion)
+		case "registry":
+				err = unpopulate(val, "Registry", &t.Registry)
 			delete(rawMsg, key)
 		}
 		if err != nil {
@@ -4000,18 +4861,17 @@ func (t *TerraformRecipeProperties) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// MarshalJSON implements the json.Marshaller interface for type TerraformRecipePropertiesUpdate.
-func (t TerraformRecipePropertiesUpdate) MarshalJSON() ([]byte, error) {
+// MarshalJSON implements the json.Marshaller interface for type TerraformBackendProperties.
+func (t TerraformBackendProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
-	populate(objectMap, "parameters", t.Parameters)
-	objectMap["templateKind"] = "terraform"
-	populate(objectMap, "templatePath", t.TemplatePath)
-	populate(objectMap, "templateVersion", t.TemplateVersion)
+	populate(objectMap, "config", t.Config)
+	populate(objectMap, "kind", t.Kind)
+	populate(objectMap, "secret", t.Secret)
 	return json.Marshal(objectMap)
 }
 
-// UnmarshalJSON implements the json.Unmarshaller interface for type TerraformRecipePropertiesUpdate.
-func (t *TerraformRecipePropertiesUpdate) UnmarshalJSON(data []byte) error {
+// UnmarshalJSON implements the json.Unmarshaller interface for type TerraformBackendProperties.
+func (t *TerraformBackendProperties) UnmarshalJSON(data []byte) error {
 	var rawMsg map[string]json.RawMessage
 	if err := json.Unmarshal(data, &rawMsg); err != nil {
 		return fmt.Errorf("unmarshalling type %T: %v", t, err)
@@ -4019,14 +4879,349 @@ func (t *TerraformRecipePropertiesUpdate) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
-		case "parameters":
-				err = unpopulate(val, "Parameters", &t.Parameters)
+		case "config":
+				err = unpopulate(val, "Config", &t.Config)
 			delete(rawMsg, key)
-		case "templateKind":
-				err = unpopulate(val, "TemplateKind", &t.TemplateKind)
+		case "kind":
+				err = unpopulate(val, "Kind", &t.Kind)
 			delete(rawMsg, key)
-		case "templatePath":
-				err = unpopulate(val, "TemplatePath", &t.TemplatePath)
+		case "secret":
+				err = unpopulate(val, "Secret", &t.Secret)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", t, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type TerraformBackendPropertiesUpdate.
+func (t TerraformBackendPropertiesUpdate) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "config", t.Config)
+	populate(objectMap, "kind", t.Kind)
+	populate(objectMap, "secret", t.Secret)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type TerraformBackendPropertiesUpdate.
+func (t *TerraformBackendPropertiesUpdate) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", t, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "config":
+				err = unpopulate(val, "Config", &t.Config)
+			delete(rawMsg, key)
+		case "kind":
+				err = unpopulate(val, "Kind", &t.Kind)
+			delete(rawMsg, key)
+		case "secret":
+				err = unpopulate(val, "Secret", &t.Secret)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", t, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type TerraformConfigProperties.
+func (t TerraformConfigProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "authentication", t.Authentication)
+	populate(objectMap, "backend", t.Backend)
+	populate(objectMap, "providerMirrorPath", t.ProviderMirrorPath)
+	populate(objectMap, "providers", t.Providers)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type TerraformConfigProperties.
+func (t *TerraformConfigProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", t, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "authentication":
+				err = unpopulate(val, "Authentication", &t.Authentication)
+			delete(rawMsg, key)
+		case "backend":
+				err = unpopulate(val, "Backend", &t.Backend)
+			delete(rawMsg, key)
+		case "providerMirrorPath":
+				err = unpopulate(val, "ProviderMirrorPath", &t.ProviderMirrorPath)
+			delete(rawMsg, key)
+		case "providers":
+				err = unpopulate(val, "Providers", &t.Providers)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", t, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type TerraformConfigPropertiesUpdate.
+func (t TerraformConfigPropertiesUpdate) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "authentication", t.Authentication)
+	populate(objectMap, "backend", t.Backend)
+	populate(objectMap, "providerMirrorPath", t.ProviderMirrorPath)
+	populate(objectMap, "providers", t.Providers)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type TerraformConfigPropertiesUpdate.
+func (t *TerraformConfigPropertiesUpdate) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", t, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "authentication":
+				err = unpopulate(val, "Authentication", &t.Authentication)
+			delete(rawMsg, key)
+		case "backend":
+				err = unpopulate(val, "Backend", &t.Backend)
+			delete(rawMsg, key)
+		case "providerMirrorPath":
+				err = unpopulate(val, "ProviderMirrorPath", &t.ProviderMirrorPath)
+			delete(rawMsg, key)
+		case "providers":
+				err = unpopulate(val, "Providers", &t.Providers)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", t, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type TerraformGitAuthenticationProperties.
+func (t TerraformGitAuthenticationProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "pat", t.Pat)
+	populate(objectMap, "ssh", t.SSH)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type TerraformGitAuthenticationProperties.
+func (t *TerraformGitAuthenticationProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", t, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "pat":
+				err = unpopulate(val, "Pat", &t.Pat)
+			delete(rawMsg, key)
+		case "ssh":
+				err = unpopulate(val, "SSH", &t.SSH)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", t, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type TerraformGitAuthenticationPropertiesUpdate.
+func (t TerraformGitAuthenticationPropertiesUpdate) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "pat", t.Pat)
+	populate(objectMap, "ssh", t.SSH)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type TerraformGitAuthenticationPropertiesUpdate.
+func (t *TerraformGitAuthenticationPropertiesUpdate) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", t, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "pat":
+				err = unpopulate(val, "Pat", &t.Pat)
+			delete(rawMsg, key)
+		case "ssh":
+				err = unpopulate(val, "SSH", &t.SSH)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", t, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type TerraformProviderConfigProperties.
+func (t TerraformProviderConfigProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "secrets", t.Secrets)
+	populate(objectMap, "values", t.Values)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type TerraformProviderConfigProperties.
+func (t *TerraformProviderConfigProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", t, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "secrets":
+				err = unpopulate(val, "Secrets", &t.Secrets)
+			delete(rawMsg, key)
+		case "values":
+				err = unpopulate(val, "Values", &t.Values)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", t, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type TerraformProviderConfigPropertiesUpdate.
+func (t TerraformProviderConfigPropertiesUpdate) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "secrets", t.Secrets)
+	populate(objectMap, "values", t.Values)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type TerraformProviderConfigPropertiesUpdate.
+func (t *TerraformProviderConfigPropertiesUpdate) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", t, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "secrets":
+				err = unpopulate(val, "Secrets", &t.Secrets)
+			delete(rawMsg, key)
+		case "values":
+				err = unpopulate(val, "Values", &t.Values)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", t, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type TerraformProviderSecretReference.
+func (t TerraformProviderSecretReference) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "key", t.Key)
+	populate(objectMap, "source", t.Source)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type TerraformProviderSecretReference.
+func (t *TerraformProviderSecretReference) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", t, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "key":
+				err = unpopulate(val, "Key", &t.Key)
+			delete(rawMsg, key)
+		case "source":
+				err = unpopulate(val, "Source", &t.Source)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", t, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type TerraformProviderSecretReferenceUpdate.
+func (t TerraformProviderSecretReferenceUpdate) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "key", t.Key)
+	populate(objectMap, "source", t.Source)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type TerraformProviderSecretReferenceUpdate.
+func (t *TerraformProviderSecretReferenceUpdate) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", t, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "key":
+				err = unpopulate(val, "Key", &t.Key)
+			delete(rawMsg, key)
+		case "source":
+				err = unpopulate(val, "Source", &t.Source)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", t, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type TerraformRecipeProperties.
+func (t TerraformRecipeProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "parameters", t.Parameters)
+	objectMap["templateKind"] = "terraform"
+	populate(objectMap, "templatePath", t.TemplatePath)
+	populate(objectMap, "templateVersion", t.TemplateVersion)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type TerraformRecipeProperties.
+func (t *TerraformRecipeProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", t, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "parameters":
+				err = unpopulate(val, "Parameters", &t.Parameters)
+			delete(rawMsg, key)
+		case "templateKind":
+				err = unpopulate(val, "TemplateKind", &t.TemplateKind)
+			delete(rawMsg, key)
+		case "templatePath":
+				err = unpopulate(val, "TemplatePath", &t.TemplatePath)
 			delete(rawMsg, key)
 		case "templateVersion":
 				err = unpopulate(val, "TemplateVersion", &t.TemplateVersion)
@@ -4039,6 +5234,99 @@ func (t *TerraformRecipePropertiesUpdate) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type TerraformRecipePropertiesUpdate.
+func (t TerraformRecipePropertiesUpdate) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "parameters", t.Parameters)
+	objectMap["templateKind"] = "terraform"
+	populate(objectMap, "templatePath", t.TemplatePath)
+	populate(objectMap, "templateVersion", t.TemplateVersion)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type TerraformRecipePropertiesUpdate.
+func (t *TerraformRecipePropertiesUpdate) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", t, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "parameters":
+				err = unpopulate(val, "Parameters", &t.Parameters)
+			delete(rawMsg, key)
+		case "templateKind":
+				err = unpopulate(val, "TemplateKind", &t.TemplateKind)
+			delete(rawMsg, key)
+		case "templatePath":
+				err = unpopulate(val, "TemplatePath", &t.TemplatePath)
+			delete(rawMsg, key)
+		case "templateVersion":
+				err = unpopulate(val, "TemplateVersion", &t.TemplateVersion)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", t, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type TerraformSecretReference.
+func (t TerraformSecretReference) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "secret", t.Secret)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type TerraformSecretReference.
+func (t *TerraformSecretReference) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", t, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "secret":
+				err = unpopulate(val, "Secret", &t.Secret)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", t, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type TerraformSecretReferenceUpdate.
+func (t TerraformSecretReferenceUpdate) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "secret", t.Secret)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type TerraformSecretReferenceUpdate.
+func (t *TerraformSecretReferenceUpdate) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", t, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "secret":
+				err = unpopulate(val, "Secret", &t.Secret)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", t, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type TrackedResource.
 func (t TrackedResource) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -91,6 +91,11 @@ type EnvironmentsClientListByScopeOptions struct {
 	// placeholder for future optional parameters
 }
 
+// EnvironmentsClientPlanOptions contains the optional parameters for the EnvironmentsClient.Plan method.
+type EnvironmentsClientPlanOptions struct {
+	// placeholder for future optional parameters
+}
+
 // EnvironmentsClientUpdateOptions contains the optional parameters for the EnvironmentsClient.Update method.
 type EnvironmentsClientUpdateOptions struct {
 	// placeholder for future optional parameters
@@ -61,6 +61,8 @@ func unmarshalExtensionClassification(rawMsg json.RawMessage) (ExtensionClassifi
 	}
 	var b ExtensionClassification
 	switch m["kind"] {
+	case "autoScaling":
+		b = &AutoScalingExtension{}
 	case "daprSidecar":
 		b = &DaprSidecarExtension{}
 	case "kubernetesMetadata":
@@ -134,6 +136,8 @@ func unmarshalRecipePropertiesClassification(rawMsg json.RawMessage) (RecipeProp
 	switch m["templateKind"] {
 	case "bicep":
 		b = &BicepRecipeProperties{}
+	case "helm":
+		b = &HelmRecipeProperties{}
 	case "terraform":
 		b = &TerraformRecipeProperties{}
 	default:
@@ -176,6 +180,8 @@ func unmarshalRecipePropertiesUpdateClassification(rawMsg json.RawMessage) (Reci
 	switch m["templateKind"] {
 	case "bicep":
 		b = &BicepRecipePropertiesUpdate{}
+	case "helm":
+		b = &HelmRecipePropertiesUpdate{}
 	case "terraform":
 		b = &TerraformRecipePropertiesUpdate{}
 	default:
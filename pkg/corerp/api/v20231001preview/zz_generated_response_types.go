@@ -100,6 +100,12 @@ type EnvironmentsClientListByScopeResponse struct {
 	EnvironmentResourceListResult
 }
 
+// EnvironmentsClientPlanResponse contains the response from method EnvironmentsClient.Plan.
+type EnvironmentsClientPlanResponse struct {
+	// The changes the deployment of a recipe would make.
+	RecipePlanResponse
+}
+
 // EnvironmentsClientUpdateResponse contains the response from method EnvironmentsClient.Update.
 type EnvironmentsClientUpdateResponse struct {
 	// The environment resource
@@ -37,11 +37,13 @@ import (
 	dapr_ctrl "github.com/radius-project/radius/pkg/daprrp/frontend/controller"
 	dsrp_dm "github.com/radius-project/radius/pkg/datastoresrp/datamodel"
 	ds_ctrl "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
+	kubernetes_util "github.com/radius-project/radius/pkg/kubernetes"
 	msg_dm "github.com/radius-project/radius/pkg/messagingrp/datamodel"
 	msg_ctrl "github.com/radius-project/radius/pkg/messagingrp/frontend/controller"
 	"github.com/radius-project/radius/pkg/portableresources"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
 	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
 	"github.com/radius-project/radius/pkg/ucp/store"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 
@@ -412,11 +414,18 @@ func (dp *deploymentProcessor) getEnvOptions(ctx context.Context, env *corerp_dm
 		logger.V(ucplog.LevelDebug).Info("environment is a simulated environment.")
 	}
 
+	envOpts.DefaultContainerResources = env.Properties.DefaultContainerResources
+
 	// Get Environment KubernetesMetadata Info
 	if envExt := corerp_dm.FindExtension(env.Properties.Extensions, corerp_dm.KubernetesMetadata); envExt != nil && envExt.KubernetesMetadata != nil {
 		envOpts.KubernetesMetadata = envExt.KubernetesMetadata
 	}
 
+	if gateway := env.Properties.Gateway; gateway != nil {
+		envOpts.Gateway.Kind = gateway.Kind
+		envOpts.Gateway.GatewayClassName = gateway.GatewayClassName
+	}
+
 	if publicEndpointOverride != "" {
 		// Check if publicEndpointOverride contains a scheme,
 		// and if so, throw an error to the user
@@ -432,15 +441,19 @@ func (dp *deploymentProcessor) getEnvOptions(ctx context.Context, env *corerp_dm
 			port = ""
 		}
 
-		envOpts.Gateway = renderers.GatewayOptions{
-			PublicEndpointOverride: true,
-			Hostname:               hostname,
-			Port:                   port,
-		}
+		envOpts.Gateway.PublicEndpointOverride = true
+		envOpts.Gateway.Hostname = hostname
+		envOpts.Gateway.Port = port
 
 		return envOpts, nil
 	}
 
+	// The public endpoint of a Gateway API Gateway is the address assigned to the Gateway itself, which is read from
+	// its status once it is deployed.
+	if envOpts.Gateway.Kind == corerp_dm.GatewayKindGatewayAPI {
+		return envOpts, nil
+	}
+
 	if dp.k8sClient != nil {
 		// Find the public endpoint of the cluster (External IP or hostname of the contour-envoy service)
 		var services corev1.ServiceList
@@ -452,11 +465,9 @@ func (dp *deploymentProcessor) getEnvOptions(ctx context.Context, env *corerp_dm
 		for _, service := range services.Items {
 			if service.Name == "contour-envoy" {
 				for _, in := range service.Status.LoadBalancer.Ingress {
-					envOpts.Gateway = renderers.GatewayOptions{
-						PublicEndpointOverride: false,
-						Hostname:               in.Hostname,
-						ExternalIP:             in.IP,
-					}
+					envOpts.Gateway.PublicEndpointOverride = false
+					envOpts.Gateway.Hostname = in.Hostname
+					envOpts.Gateway.ExternalIP = in.IP
 					return envOpts, nil
 				}
 			}
@@ -637,5 +648,31 @@ func (dp *deploymentProcessor) getRendererDependency(ctx context.Context, depend
 		OutputResources: outputResourceIDs,
 	}
 
+	// Secret stores keep their data in a Kubernetes secret rather than in the data store. The hash of the secret data
+	// lets the renderers redeploy the workloads which reference the secret store when the data changes.
+	if _, ok := dependency.Resource.(*corerp_dm.SecretStore); ok {
+		hash, err := dp.getSecretDataHash(ctx, outputResourceIDs[rpv1.LocalIDSecret])
+		if err != nil {
+			return renderers.RendererDependency{}, err
+		}
+		rendererDependency.SecretDataHash = hash
+	}
+
 	return rendererDependency, nil
 }
+
+// getSecretDataHash returns the hash of the data of the Kubernetes secret with the given resource ID. It returns an empty
+// string if the ID is empty or if no Kubernetes client is configured.
+func (dp *deploymentProcessor) getSecretDataHash(ctx context.Context, id resources.ID) (string, error) {
+	if dp.k8sClient == nil || id.IsEmpty() {
+		return "", nil
+	}
+
+	secret := &corev1.Secret{}
+	key := controller_runtime.ObjectKey{Namespace: id.FindScope(resources_kubernetes.ScopeNamespaces), Name: id.Name()}
+	if err := dp.k8sClient.Get(ctx, key, secret); err != nil {
+		return "", fmt.Errorf("failed to get secret %q: %w", key.String(), err)
+	}
+
+	return kubernetes_util.HashSecretData(secret.Data), nil
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/radius-project/radius/pkg/corerp/renderers"
 	"github.com/radius-project/radius/pkg/corerp/renderers/container"
 	dsrp_dm "github.com/radius-project/radius/pkg/datastoresrp/datamodel"
+	kubernetes_util "github.com/radius-project/radius/pkg/kubernetes"
 	"github.com/radius-project/radius/pkg/portableresources"
 	pr_dm "github.com/radius-project/radius/pkg/portableresources/datamodel"
 	pr_renderers "github.com/radius-project/radius/pkg/portableresources/renderers"
@@ -47,6 +48,9 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 type SharedMocks struct {
@@ -1158,6 +1162,57 @@ func Test_getEnvOptions_PublicEndpointOverride(t *testing.T) {
 	})
 }
 
+func Test_getEnvOptions_GatewayAPI(t *testing.T) {
+	ctx := testcontext.New(t)
+	mocks := setup(t)
+
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "contour-envoy",
+			Namespace: "radius-system",
+		},
+		Status: corev1.ServiceStatus{
+			LoadBalancer: corev1.LoadBalancerStatus{
+				Ingress: []corev1.LoadBalancerIngress{{IP: "10.0.0.1"}},
+			},
+		},
+	}
+	dp := deploymentProcessor{mocks.model, nil, fake.NewClientBuilder().WithObjects(service).Build(), nil}
+
+	env := &datamodel.Environment{
+		Properties: datamodel.EnvironmentProperties{
+			Compute: rpv1.EnvironmentCompute{
+				Kind: rpv1.KubernetesComputeKind,
+				KubernetesCompute: rpv1.KubernetesComputeProperties{
+					Namespace: "radius-system",
+				},
+			},
+			Gateway: &datamodel.EnvironmentGateway{
+				Kind:             datamodel.GatewayKindGatewayAPI,
+				GatewayClassName: "test-gateway-class",
+			},
+		},
+	}
+
+	t.Run("Verify getEnvOptions doesn't use the contour-envoy service", func(t *testing.T) {
+		options, err := dp.getEnvOptions(ctx, env)
+		require.NoError(t, err)
+
+		require.Equal(t, datamodel.GatewayKindGatewayAPI, options.Gateway.Kind)
+		require.Equal(t, "test-gateway-class", options.Gateway.GatewayClassName)
+		require.False(t, options.Gateway.PublicEndpointOverride)
+		require.Empty(t, options.Gateway.Hostname)
+		require.Empty(t, options.Gateway.ExternalIP)
+	})
+
+	t.Run("Verify getEnvOptions uses the contour-envoy service with Contour", func(t *testing.T) {
+		options, err := dp.getEnvOptions(ctx, &datamodel.Environment{Properties: datamodel.EnvironmentProperties{Compute: env.Properties.Compute}})
+		require.NoError(t, err)
+
+		require.Equal(t, "10.0.0.1", options.Gateway.ExternalIP)
+	})
+}
+
 func Test_getResourceDataByID(t *testing.T) {
 	ctx := testcontext.New(t)
 	mocks := setup(t)
@@ -1201,3 +1256,50 @@ func Test_fetchSecrets(t *testing.T) {
 		require.Equal(t, secret, secretValues[pr_renderers.ConnectionStringValue])
 	})
 }
+
+func Test_getRendererDependency_SecretStore(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	secretStoreID := resources.MustParse("/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/secretStores/test-secret")
+	secretID := resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, "", "Secret", "default", "test-secret")
+	secretData := map[string][]byte{"password": []byte("p@ssw0rd")}
+
+	dependency := ResourceData{
+		ID: secretStoreID,
+		Resource: &datamodel.SecretStore{
+			Properties: &datamodel.SecretStoreProperties{
+				Data: map[string]*datamodel.SecretStoreDataValue{"password": {}},
+			},
+		},
+		OutputResources: []rpv1.OutputResource{
+			{LocalID: rpv1.LocalIDSecret, ID: secretID},
+		},
+	}
+
+	t.Run("secret data is hashed", func(t *testing.T) {
+		client := fake.NewClientBuilder().WithObjects(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-secret", Namespace: "default"},
+			Data:       secretData,
+		}).Build()
+		dp := deploymentProcessor{k8sClient: client}
+
+		rendererDependency, err := dp.getRendererDependency(ctx, dependency)
+		require.NoError(t, err)
+		require.Equal(t, kubernetes_util.HashSecretData(secretData), rendererDependency.SecretDataHash)
+	})
+
+	t.Run("secret not found", func(t *testing.T) {
+		dp := deploymentProcessor{k8sClient: fake.NewClientBuilder().Build()}
+
+		_, err := dp.getRendererDependency(ctx, dependency)
+		require.Error(t, err)
+	})
+
+	t.Run("no kubernetes client", func(t *testing.T) {
+		dp := deploymentProcessor{}
+
+		rendererDependency, err := dp.getRendererDependency(ctx, dependency)
+		require.NoError(t, err)
+		require.Empty(t, rendererDependency.SecretDataHash)
+	})
+}
@@ -17,6 +17,13 @@ limitations under the License.
 package datamodel
 
 import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 )
@@ -75,10 +82,12 @@ type ContainerProperties struct {
 	Container            Container                       `json:"container,omitempty"`
 	Extensions           []Extension                     `json:"extensions,omitempty"`
 	Identity             *rpv1.IdentitySettings          `json:"identity,omitempty"`
+	InitContainers       []NamedContainer                `json:"initContainers,omitempty"`
 	Runtimes             *RuntimeProperties              `json:"runtimes,omitempty"`
 	Resources            []ResourceReference             `json:"resources,omitempty"`
 	ResourceProvisioning ContainerResourceProvisioning   `json:"resourceProvisioning,omitempty"`
 	RestartPolicy        string                          `json:"restartPolicy,omitempty"`
+	Sidecars             []NamedContainer                `json:"sidecars,omitempty"`
 }
 
 // ContainerResourceProvisioning specifies how resources should be created for the container.
@@ -116,16 +125,199 @@ type ConnectionProperties struct {
 
 // Container - Definition of a container.
 type Container struct {
-	Image           string                      `json:"image,omitempty"`
-	ImagePullPolicy string                      `json:"imagePullPolicy,omitempty"`
-	Env             map[string]string           `json:"env,omitempty"`
-	LivenessProbe   HealthProbeProperties       `json:"livenessProbe,omitempty"`
-	Ports           map[string]ContainerPort    `json:"ports,omitempty"`
-	ReadinessProbe  HealthProbeProperties       `json:"readinessProbe,omitempty"`
-	Volumes         map[string]VolumeProperties `json:"volumes,omitempty"`
-	Command         []string                    `json:"command,omitempty"`
-	Args            []string                    `json:"args,omitempty"`
-	WorkingDir      string                      `json:"workingDir,omitempty"`
+	Image           string                         `json:"image,omitempty"`
+	ImagePullPolicy string                         `json:"imagePullPolicy,omitempty"`
+	Env             map[string]EnvironmentVariable `json:"env,omitempty"`
+	LivenessProbe   HealthProbeProperties          `json:"livenessProbe,omitempty"`
+	Ports           map[string]ContainerPort       `json:"ports,omitempty"`
+	ReadinessProbe  HealthProbeProperties          `json:"readinessProbe,omitempty"`
+	Volumes         map[string]VolumeProperties    `json:"volumes,omitempty"`
+	Command         []string                       `json:"command,omitempty"`
+	Args            []string                       `json:"args,omitempty"`
+	WorkingDir      string                         `json:"workingDir,omitempty"`
+	Resources       *ContainerResourceRequirements `json:"resources,omitempty"`
+}
+
+// EnvironmentVariable - Environment variable of a container. Exactly one of Value and ValueFrom is set.
+type EnvironmentVariable struct {
+	// Value is the literal value of the environment variable.
+	Value *string `json:"value,omitempty"`
+
+	// ValueFrom references the source of the value of the environment variable.
+	ValueFrom *EnvironmentVariableReference `json:"valueFrom,omitempty"`
+}
+
+// UnmarshalJSON unmarshals an environment variable. Environment variables used to be stored as plain strings, so a
+// string is accepted as the literal value of the environment variable.
+func (e *EnvironmentVariable) UnmarshalJSON(b []byte) error {
+	var value string
+	if err := json.Unmarshal(b, &value); err == nil {
+		*e = EnvironmentVariable{Value: &value}
+		return nil
+	}
+
+	type environmentVariable EnvironmentVariable
+	return json.Unmarshal(b, (*environmentVariable)(e))
+}
+
+// EnvironmentVariableReference - Reference to the source of the value of an environment variable. Exactly one of
+// SecretRef and ConnectionRef is set.
+type EnvironmentVariableReference struct {
+	// SecretRef references a key of an Applications.Core/secretStores resource.
+	SecretRef *EnvironmentVariableSecretReference `json:"secretRef,omitempty"`
+
+	// ConnectionRef references a single value of a connection of the container.
+	ConnectionRef *EnvironmentVariableConnectionReference `json:"connectionRef,omitempty"`
+}
+
+// EnvironmentVariableSecretReference - Reference to a key of an Applications.Core/secretStores resource.
+type EnvironmentVariableSecretReference struct {
+	// Source is the resource ID of the secret store.
+	Source string `json:"source"`
+
+	// Key is the key of the secret in the secret store.
+	Key string `json:"key"`
+}
+
+// EnvironmentVariableConnectionReference - Reference to a value of a connection of the container.
+type EnvironmentVariableConnectionReference struct {
+	// Name is the name of the connection.
+	Name string `json:"name"`
+
+	// Key is the name of the computed value or secret of the connected resource, for example "connectionString".
+	Key string `json:"key"`
+}
+
+// ContainerResourceRequirements - Compute resources required by a container.
+type ContainerResourceRequirements struct {
+	// Requests is the amount of compute resources reserved for the container.
+	Requests *ContainerResourceList `json:"requests,omitempty"`
+
+	// Limits is the maximum amount of compute resources the container is allowed to use.
+	Limits *ContainerResourceList `json:"limits,omitempty"`
+}
+
+// ContainerResourceList - Quantities of compute resources in the Kubernetes quantity format, for example "500m" or "1Gi".
+type ContainerResourceList struct {
+	CPU              string `json:"cpu,omitempty"`
+	Memory           string `json:"memory,omitempty"`
+	EphemeralStorage string `json:"ephemeralStorage,omitempty"`
+
+	// Extended maps the name of an extended resource, such as "nvidia.com/gpu", to its quantity.
+	Extended map[string]string `json:"extended,omitempty"`
+}
+
+// IsEmpty returns true if no requests or limits are specified.
+func (r *ContainerResourceRequirements) IsEmpty() bool {
+	return r == nil || (len(r.Requests.Quantities()) == 0 && len(r.Limits.Quantities()) == 0)
+}
+
+// Quantities returns the quantities of the resource list keyed by their Kubernetes resource names.
+func (l *ContainerResourceList) Quantities() map[string]string {
+	quantities := map[string]string{}
+	if l == nil {
+		return quantities
+	}
+
+	if l.CPU != "" {
+		quantities["cpu"] = l.CPU
+	}
+	if l.Memory != "" {
+		quantities["memory"] = l.Memory
+	}
+	if l.EphemeralStorage != "" {
+		quantities["ephemeral-storage"] = l.EphemeralStorage
+	}
+	for name, quantity := range l.Extended {
+		quantities[name] = quantity
+	}
+
+	return quantities
+}
+
+// Validate checks that the quantities are valid, that extended resource names are domain-qualified and that requests
+// do not exceed limits. Extended resources can't be overcommitted, so a request for an extended resource requires a
+// limit equal to it.
+func (r *ContainerResourceRequirements) Validate() error {
+	if r == nil {
+		return nil
+	}
+
+	requests, err := parseQuantities("requests", r.Requests)
+	if err != nil {
+		return err
+	}
+
+	limits, err := parseQuantities("limits", r.Limits)
+	if err != nil {
+		return err
+	}
+
+	names := []string{}
+	for name := range requests {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		limit, ok := limits[name]
+		if !ok {
+			if isExtendedResource(name) {
+				return fmt.Errorf("limits.%s must be set and equal to requests.%s for extended resources", name, name)
+			}
+			continue
+		}
+
+		request := requests[name]
+		if isExtendedResource(name) && request.Cmp(limit) != 0 {
+			return fmt.Errorf("requests.%s must be equal to limits.%s for extended resources", name, name)
+		}
+
+		if request.Cmp(limit) > 0 {
+			return fmt.Errorf("requests.%s (%s) must be less than or equal to limits.%s (%s)", name, request.String(), name, limit.String())
+		}
+	}
+
+	return nil
+}
+
+func parseQuantities(field string, l *ContainerResourceList) (map[string]resource.Quantity, error) {
+	if l == nil {
+		return nil, nil
+	}
+
+	for name := range l.Extended {
+		if !isExtendedResource(name) {
+			return nil, fmt.Errorf("%s.extended.%s is not a valid extended resource name, it must be domain-qualified, for example nvidia.com/gpu", field, name)
+		}
+	}
+
+	result := map[string]resource.Quantity{}
+	for name, value := range l.Quantities() {
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s has an invalid quantity %q: %w", field, name, value, err)
+		}
+
+		if quantity.Sign() < 0 {
+			return nil, fmt.Errorf("%s.%s must not be negative", field, name)
+		}
+
+		result[name] = quantity
+	}
+
+	return result, nil
+}
+
+func isExtendedResource(name string) bool {
+	return strings.Contains(name, "/") && !strings.HasPrefix(name, "kubernetes.io/")
+}
+
+// NamedContainer - Definition of an init or sidecar container which runs alongside the main container.
+type NamedContainer struct {
+	// Name is the name of the container. It must be unique within the pod.
+	Name string `json:"name,omitempty"`
+	Container
 }
 
 // ContainerPort - Specifies a listening port for the container
@@ -253,6 +445,40 @@ type ManualScalingExtension struct {
 	Replicas *int32 `json:"replicas,omitempty"`
 }
 
+// AutoScalingExtension - AutoScaling Extension
+type AutoScalingExtension struct {
+	// MinReplicas is the minimum replica count. Defaults to 1.
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// MaxReplicas is the maximum replica count.
+	MaxReplicas int32 `json:"maxReplicas,omitempty"`
+	// TargetCPUUtilizationPercentage is the target average CPU utilization of the replicas, in percent of the requested CPU.
+	TargetCPUUtilizationPercentage *int32 `json:"targetCpuUtilizationPercentage,omitempty"`
+	// TargetMemoryUtilizationPercentage is the target average memory utilization of the replicas, in percent of the requested memory.
+	TargetMemoryUtilizationPercentage *int32 `json:"targetMemoryUtilizationPercentage,omitempty"`
+	// Metrics are the custom pod metrics to scale on.
+	Metrics []AutoScalingMetric `json:"metrics,omitempty"`
+	// Triggers are the event-driven triggers to scale on. Event-driven scaling requires KEDA to be installed in the cluster.
+	Triggers []AutoScalingTrigger `json:"triggers,omitempty"`
+}
+
+// AutoScalingMetric - A custom pod metric to scale on.
+type AutoScalingMetric struct {
+	// Name is the name of the metric.
+	Name string `json:"name,omitempty"`
+	// TargetAverageValue is the target average value of the metric across the replicas, as a Kubernetes quantity.
+	TargetAverageValue string `json:"targetAverageValue,omitempty"`
+}
+
+// AutoScalingTrigger - An event-driven trigger scaling on the length of a queue.
+type AutoScalingTrigger struct {
+	// Type is the type of the KEDA scaler, for example rabbitmq or azure-servicebus.
+	Type string `json:"type,omitempty"`
+	// QueueLength is the target number of messages per replica.
+	QueueLength int32 `json:"queueLength,omitempty"`
+	// Metadata is the configuration of the scaler.
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
 // DaprSidecarExtension - Specifies the resource should have a Dapr sidecar injected
 type DaprSidecarExtension struct {
 	AppID    string   `json:"appId,omitempty"`
@@ -98,3 +98,71 @@ func TestRecipeDatamodelFromVersioned(t *testing.T) {
 		})
 	}
 }
+
+func TestRecipePlanRequestDatamodelFromVersioned(t *testing.T) {
+	testset := []struct {
+		versionedModelFile string
+		apiVersion         string
+		err                error
+	}{
+		{
+			"../../api/v20231001preview/testdata/recipeplanresource.json",
+			"2023-10-01-preview",
+			nil,
+		},
+		{
+			"",
+			"unsupported",
+			v1.ErrUnsupportedAPIVersion,
+		},
+	}
+
+	for _, tc := range testset {
+		t.Run(tc.apiVersion, func(t *testing.T) {
+			c := loadTestData(tc.versionedModelFile)
+			_, err := RecipePlanRequestDataModelFromVersioned(c, tc.apiVersion)
+			if tc.err != nil {
+				require.ErrorAs(t, tc.err, &err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRecipePlanDataModelToVersioned(t *testing.T) {
+	testset := []struct {
+		dataModelFile string
+		apiVersion    string
+		apiModelType  any
+		err           error
+	}{
+		{
+			"../../api/v20231001preview/testdata/recipeplandatamodel.json",
+			"2023-10-01-preview",
+			&v20231001preview.RecipePlanResponse{},
+			nil,
+		},
+		{
+			"",
+			"unsupported",
+			nil,
+			v1.ErrUnsupportedAPIVersion,
+		},
+	}
+
+	for _, tc := range testset {
+		t.Run(tc.apiVersion, func(t *testing.T) {
+			c := loadTestData(tc.dataModelFile)
+			dm := &datamodel.RecipePlan{}
+			_ = json.Unmarshal(c, dm)
+			am, err := RecipePlanDataModelToVersioned(dm, tc.apiVersion)
+			if tc.err != nil {
+				require.ErrorAs(t, tc.err, &err)
+			} else {
+				require.NoError(t, err)
+				require.IsType(t, tc.apiModelType, am)
+			}
+		})
+	}
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package datamodel
 
 import (
+	"fmt"
+
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 )
@@ -38,11 +40,59 @@ func (e *Environment) ResourceTypeName() string {
 
 // EnvironmentProperties represents the properties of Environment.
 type EnvironmentProperties struct {
-	Compute    rpv1.EnvironmentCompute                           `json:"compute,omitempty"`
-	Recipes    map[string]map[string]EnvironmentRecipeProperties `json:"recipes,omitempty"`
-	Providers  Providers                                         `json:"providers,omitempty"`
-	Extensions []Extension                                       `json:"extensions,omitempty"`
-	Simulated  bool                                              `json:"simulated,omitempty"`
+	Compute      rpv1.EnvironmentCompute                           `json:"compute,omitempty"`
+	Recipes      map[string]map[string]EnvironmentRecipeProperties `json:"recipes,omitempty"`
+	Providers    Providers                                         `json:"providers,omitempty"`
+	RecipeConfig RecipeConfigProperties                            `json:"recipeConfig,omitempty"`
+	Extensions   []Extension                                       `json:"extensions,omitempty"`
+	Simulated    bool                                              `json:"simulated,omitempty"`
+
+	// DefaultContainerResources are the compute resource requirements applied to the containers in the environment
+	// which don't specify their own.
+	DefaultContainerResources *ContainerResourceRequirements `json:"defaultContainerResources,omitempty"`
+
+	// Gateway is the configuration of the gateway implementation used to render the Applications.Core/gateways
+	// resources of the environment. Contour is used if it is not set.
+	Gateway *EnvironmentGateway `json:"gateway,omitempty"`
+}
+
+// GatewayKind represents the implementation used to render Applications.Core/gateways resources.
+type GatewayKind string
+
+const (
+	// GatewayKindContour renders gateways as Contour HTTPProxy resources.
+	GatewayKindContour GatewayKind = "contour"
+
+	// GatewayKindGatewayAPI renders gateways as Kubernetes Gateway API Gateway and route resources.
+	GatewayKindGatewayAPI GatewayKind = "gatewayAPI"
+)
+
+// EnvironmentGateway represents the configuration of the gateway implementation used by the environment.
+type EnvironmentGateway struct {
+	// Kind is the gateway implementation. Contour is used if it is not set.
+	Kind GatewayKind `json:"kind,omitempty"`
+
+	// GatewayClassName is the name of the GatewayClass of the Gateway resources. It is required when Kind is gatewayAPI.
+	GatewayClassName string `json:"gatewayClassName,omitempty"`
+}
+
+// Validate validates the gateway configuration. A nil configuration is valid.
+func (g *EnvironmentGateway) Validate() error {
+	if g == nil {
+		return nil
+	}
+
+	switch g.Kind {
+	case "", GatewayKindContour:
+		return nil
+	case GatewayKindGatewayAPI:
+		if g.GatewayClassName == "" {
+			return fmt.Errorf("gatewayClassName is required when kind is %q", GatewayKindGatewayAPI)
+		}
+		return nil
+	default:
+		return fmt.Errorf("kind %q is not supported, supported kinds are %q and %q", g.Kind, GatewayKindContour, GatewayKindGatewayAPI)
+	}
 }
 
 // EnvironmentRecipeProperties represents the properties of environment's recipe.
@@ -67,6 +117,52 @@ func (e *Recipe) ResourceTypeName() string {
 	return "Applications.Core/environments"
 }
 
+// RecipePlanRequest represents input properties for recipe plan api.
+type RecipePlanRequest struct {
+	// Type of the portable resource this recipe can be consumed by. For example: 'Applications.Datastores/mongoDatabases'
+	ResourceType string `json:"resourceType,omitempty"`
+
+	// Name of the recipe registered to the environment.
+	Name string `json:"recipeName,omitempty"`
+
+	// ResourceName is the name of the portable resource the recipe is deployed for.
+	ResourceName string `json:"resourceName,omitempty"`
+
+	// ApplicationID is the resource ID of the application the portable resource is consumed by.
+	ApplicationID string `json:"applicationId,omitempty"`
+
+	// Parameters are the key/value parameters which override the parameters set by the environment.
+	Parameters map[string]any `json:"parameters,omitempty"`
+}
+
+// ResourceTypeName returns the resource type of the RecipePlanRequest instance.
+func (e *RecipePlanRequest) ResourceTypeName() string {
+	return "Applications.Core/environments"
+}
+
+// RecipePlan represents the changes the deployment of a recipe would make.
+type RecipePlan struct {
+	// Changes are the resources which would be created, updated or deleted.
+	Changes []RecipeResourceChange `json:"changes"`
+}
+
+// ResourceTypeName returns the resource type of the RecipePlan instance.
+func (e *RecipePlan) ResourceTypeName() string {
+	return "Applications.Core/environments"
+}
+
+// RecipeResourceChange represents the change the deployment of a recipe would make to a resource.
+type RecipeResourceChange struct {
+	// ChangeType is the type of change made to the resource: Create, Update or Delete.
+	ChangeType string `json:"changeType"`
+
+	// ResourceID is the resource ID of the resource. It is empty if the ID is only known after the resource is created.
+	ResourceID string `json:"resourceId,omitempty"`
+
+	// Address is the address of the resource in the recipe template.
+	Address string `json:"address,omitempty"`
+}
+
 // ResourceTypeName returns the resource type of the EnvironmentRecipeProperties instance.
 func (e *EnvironmentRecipeProperties) ResourceTypeName() string {
 	return "Applications.Core/environments"
@@ -91,3 +187,98 @@ type ProvidersAWS struct {
 	// Scope is the target level for deploying the aws resources
 	Scope string `json:"scope,omitempty"`
 }
+
+// RecipeConfigProperties represents the configuration for Recipes.
+type RecipeConfigProperties struct {
+	// Terraform represents the configuration for Terraform Recipes.
+	Terraform TerraformConfigProperties `json:"terraform,omitempty"`
+
+	// Drift represents the configuration for the drift detection of the resources deployed by Recipes.
+	Drift RecipeDriftConfig `json:"drift,omitempty"`
+}
+
+// RecipeDriftConfig represents the configuration for the drift detection of the resources deployed by Recipes.
+type RecipeDriftConfig struct {
+	// AutoRemediate determines whether the Recipe is redeployed when the resources deployed by the Recipe have drifted.
+	AutoRemediate bool `json:"autoRemediate,omitempty"`
+}
+
+// TerraformConfigProperties represents the configuration for Terraform Recipes.
+type TerraformConfigProperties struct {
+	// Backend represents the backend used to store the Terraform state of the Recipes. The Kubernetes backend is used if it is not set.
+	Backend *TerraformBackend `json:"backend,omitempty"`
+
+	// ProviderMirrorPath represents the path to a directory containing an offline mirror of the Terraform providers.
+	// When it is set, the providers are only installed from the mirror, and the Terraform executable in the mirror is
+	// used instead of downloading Terraform if there is one.
+	ProviderMirrorPath string `json:"providerMirrorPath,omitempty"`
+
+	// Authentication represents the credentials used to download Terraform modules from private sources.
+	Authentication TerraformAuthentication `json:"authentication,omitempty"`
+
+	// Providers represents the configurations of additional Terraform providers, keyed by the provider name. Each
+	// configuration generates a provider block, multiple configurations of a provider must set distinct aliases.
+	// The configurations are merged with the provider configurations generated by Radius, and only the providers required
+	// by the recipe module are configured.
+	Providers map[string][]TerraformProviderConfig `json:"providers,omitempty"`
+}
+
+// TerraformProviderConfig represents the configuration of a Terraform provider block.
+type TerraformProviderConfig struct {
+	// Values represents the arguments of the provider block, including the optional "alias" argument.
+	Values map[string]any `json:"values,omitempty"`
+
+	// Secrets represents the arguments of the provider block whose values are read from secret stores, keyed by the
+	// argument name.
+	Secrets map[string]ProviderSecretReference `json:"secrets,omitempty"`
+}
+
+// ProviderSecretReference represents a reference to a secret of a secret store.
+type ProviderSecretReference struct {
+	// Source is the resource ID of the Applications.Core/secretStores resource holding the secret.
+	Source string `json:"source"`
+
+	// Key is the name of the secret in the secret store.
+	Key string `json:"key"`
+}
+
+// TerraformAuthentication represents the credentials used to download Terraform modules from private sources.
+type TerraformAuthentication struct {
+	// Git represents the credentials used to download Terraform modules from private Git repositories.
+	Git GitAuthentication `json:"git,omitempty"`
+
+	// Registry represents the tokens used to download Terraform modules from private module registries, keyed by the
+	// host of the registry. The token is read from the "token" key of the secret store.
+	Registry map[string]SecretReference `json:"registry,omitempty"`
+}
+
+// GitAuthentication represents the credentials used to download Terraform modules from private Git repositories.
+type GitAuthentication struct {
+	// PAT represents the personal access tokens used to clone repositories over HTTPS, keyed by the host of the
+	// repositories. The token is read from the "pat" key and the optional user name from the "username" key of the
+	// secret store.
+	PAT map[string]SecretReference `json:"pat,omitempty"`
+
+	// SSH represents the SSH private keys used to clone repositories over SSH, keyed by the host of the repositories.
+	// The private key is read from the "privateKey" key and the optional known hosts from the "knownHosts" key of the
+	// secret store. The known hosts of the system are used if they are not set.
+	SSH map[string]SecretReference `json:"ssh,omitempty"`
+}
+
+// SecretReference represents a reference to the secret store holding a credential.
+type SecretReference struct {
+	// Secret is the resource ID of the Applications.Core/secretStores resource holding the credential.
+	Secret string `json:"secret"`
+}
+
+// TerraformBackend represents the Terraform backend used to store the Terraform state of the Recipes.
+type TerraformBackend struct {
+	// Kind is the kind of the Terraform backend, for example s3.
+	Kind string `json:"kind"`
+	// Config is the configuration of the Terraform backend, for example the bucket and the region of the s3 backend.
+	// It doesn't hold credentials.
+	Config map[string]string `json:"config,omitempty"`
+	// Secret is the resource ID of the Applications.Core/secretStores resource holding the credentials of the backend.
+	// The connection string of the pg backend is read from the "connStr" key of the secret store.
+	Secret string `json:"secret,omitempty"`
+}
@@ -21,6 +21,7 @@ type ExtensionKind string
 
 const (
 	ManualScaling                ExtensionKind = "manualScaling"
+	AutoScaling                  ExtensionKind = "autoScaling"
 	DaprSidecar                  ExtensionKind = "daprSidecar"
 	KubernetesMetadata           ExtensionKind = "kubernetesMetadata"
 	KubernetesNamespaceExtension ExtensionKind = "kubernetesNamespace"
@@ -30,6 +31,7 @@ const (
 type Extension struct {
 	Kind                ExtensionKind           `json:"kind,omitempty"`
 	ManualScaling       *ManualScalingExtension `json:"manualScaling,omitempty"`
+	AutoScaling         *AutoScalingExtension   `json:"autoScaling,omitempty"`
 	DaprSidecar         *DaprSidecarExtension   `json:"daprSidecar,omitempty"`
 	KubernetesMetadata  *KubeMetadataExtension  `json:"kubernetesMetadata,omitempty"`
 	KubernetesNamespace *KubeNamespaceExtension `json:"kubernetesNamespace,omitempty"`
@@ -20,22 +20,31 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/kubernetes"
 	"github.com/radius-project/radius/pkg/kubeutil"
+	"github.com/radius-project/radius/pkg/ucp/resources"
 )
 
 const (
-	manifestTargetProperty = "$.properties.runtimes.kubernetes.base"
-	podTargetProperty      = "$.properties.runtimes.kubernetes.pod"
+	manifestTargetProperty       = "$.properties.runtimes.kubernetes.base"
+	podTargetProperty            = "$.properties.runtimes.kubernetes.pod"
+	containerTargetProperty      = "$.properties.container"
+	initContainersTargetProperty = "$.properties.initContainers"
+	sidecarsTargetProperty       = "$.properties.sidecars"
 )
 
 // ValidateAndMutateRequest checks if the newResource has a user-defined identity and if so, returns a bad request
@@ -52,6 +61,21 @@ func ValidateAndMutateRequest(ctx context.Context, newResource, oldResource *dat
 		newResource.Properties.Identity = oldResource.Properties.Identity
 	}
 
+	if err := validateResourceRequirements(newResource); err != nil {
+		return rest.NewBadRequestARMResponse(v1.ErrorResponse{Error: err.(v1.ErrorDetails)}), nil
+	}
+
+	if err := validateEnvironmentVariables(newResource); err != nil {
+		return rest.NewBadRequestARMResponse(v1.ErrorResponse{Error: err.(v1.ErrorDetails)}), nil
+	}
+
+	if len(newResource.Properties.InitContainers) > 0 || len(newResource.Properties.Sidecars) > 0 {
+		err := validateContainers(newResource)
+		if err != nil {
+			return rest.NewBadRequestARMResponse(v1.ErrorResponse{Error: err.(v1.ErrorDetails)}), nil
+		}
+	}
+
 	runtimes := newResource.Properties.Runtimes
 	if runtimes != nil && runtimes.Kubernetes != nil {
 		if runtimes.Kubernetes.Base != "" {
@@ -88,6 +112,235 @@ func validatePodSpec(patch []byte) error {
 	return nil
 }
 
+// validateContainers validates the init containers and sidecars of the container resource. Containers in the same
+// pod share the network namespace, so container names, port names and port numbers must be unique across the main
+// container, the init containers and the sidecars. Init containers run to completion before the pod is ready, so they
+// cannot define health probes or provide routes.
+func validateContainers(newResource *datamodel.ContainerResource) error {
+	errDetails := []v1.ErrorDetails{}
+
+	type containerEntry struct {
+		target    string
+		container datamodel.Container
+	}
+
+	entries := []containerEntry{{target: containerTargetProperty, container: newResource.Properties.Container}}
+	names := map[string]string{}
+	if newResource.Name != "" {
+		names[kubernetes.NormalizeResourceName(newResource.Name)] = containerTargetProperty
+	}
+
+	addNamedContainers := func(targetProperty string, containers []datamodel.NamedContainer) {
+		for i, c := range containers {
+			target := fmt.Sprintf("%s[%d]", targetProperty, i)
+			entries = append(entries, containerEntry{target: target, container: c.Container})
+
+			if c.Name == "" {
+				errDetails = append(errDetails, v1.ErrorDetails{
+					Code:    v1.CodeInvalidRequestContent,
+					Target:  target + ".name",
+					Message: "container name is required.",
+				})
+				continue
+			}
+
+			name := strings.ToLower(c.Name)
+			if existing, ok := names[name]; ok {
+				errDetails = append(errDetails, v1.ErrorDetails{
+					Code:    v1.CodeInvalidRequestContent,
+					Target:  target + ".name",
+					Message: fmt.Sprintf("container name %s conflicts with the container at %s.", c.Name, existing),
+				})
+				continue
+			}
+			names[name] = target
+		}
+	}
+
+	addNamedContainers(initContainersTargetProperty, newResource.Properties.InitContainers)
+	addNamedContainers(sidecarsTargetProperty, newResource.Properties.Sidecars)
+
+	for i, c := range newResource.Properties.InitContainers {
+		target := fmt.Sprintf("%s[%d]", initContainersTargetProperty, i)
+		if !c.LivenessProbe.IsEmpty() || !c.ReadinessProbe.IsEmpty() {
+			errDetails = append(errDetails, v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  target,
+				Message: "init containers do not support liveness or readiness probes.",
+			})
+		}
+
+		for portName, port := range c.Ports {
+			if port.Provides != "" {
+				errDetails = append(errDetails, v1.ErrorDetails{
+					Code:    v1.CodeInvalidRequestContent,
+					Target:  fmt.Sprintf("%s.ports.%s", target, portName),
+					Message: "init containers cannot provide routes.",
+				})
+			}
+		}
+	}
+
+	portNames := map[string]string{}
+	portNumbers := map[int32]string{}
+	for _, entry := range entries {
+		keys := maps.Keys(entry.container.Ports)
+		slices.Sort(keys)
+
+		for _, portName := range keys {
+			port := entry.container.Ports[portName]
+			target := fmt.Sprintf("%s.ports.%s", entry.target, portName)
+
+			if existing, ok := portNames[portName]; ok {
+				errDetails = append(errDetails, v1.ErrorDetails{
+					Code:    v1.CodeInvalidRequestContent,
+					Target:  target,
+					Message: fmt.Sprintf("port name %s conflicts with the port at %s.", portName, existing),
+				})
+			} else {
+				portNames[portName] = target
+			}
+
+			if port.ContainerPort == 0 {
+				continue
+			}
+
+			if existing, ok := portNumbers[port.ContainerPort]; ok {
+				errDetails = append(errDetails, v1.ErrorDetails{
+					Code:    v1.CodeInvalidRequestContent,
+					Target:  target,
+					Message: fmt.Sprintf("container port %d conflicts with the port at %s.", port.ContainerPort, existing),
+				})
+			} else {
+				portNumbers[port.ContainerPort] = target
+			}
+		}
+	}
+
+	if len(errDetails) > 0 {
+		return v1.ErrorDetails{
+			Code:    v1.CodeInvalidRequestContent,
+			Target:  "$.properties",
+			Message: "The containers include conflicting or invalid definitions.",
+			Details: errDetails,
+		}
+	}
+
+	return nil
+}
+
+// validateResourceRequirements validates the compute resource requirements of every container of the container resource.
+func validateResourceRequirements(newResource *datamodel.ContainerResource) error {
+	targets := map[string]*datamodel.ContainerResourceRequirements{
+		containerTargetProperty: newResource.Properties.Container.Resources,
+	}
+	for i, c := range newResource.Properties.InitContainers {
+		targets[fmt.Sprintf("%s[%d]", initContainersTargetProperty, i)] = c.Resources
+	}
+	for i, c := range newResource.Properties.Sidecars {
+		targets[fmt.Sprintf("%s[%d]", sidecarsTargetProperty, i)] = c.Resources
+	}
+
+	keys := maps.Keys(targets)
+	slices.Sort(keys)
+
+	for _, target := range keys {
+		if err := targets[target].Validate(); err != nil {
+			return v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  target + ".resources",
+				Message: fmt.Sprintf("Invalid resources: %s.", err.Error()),
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateEnvironmentVariables validates the environment variables of every container of the container resource.
+// Each environment variable specifies either a literal value or a reference to a secret store key or to a value of
+// one of the connections of the container resource.
+func validateEnvironmentVariables(newResource *datamodel.ContainerResource) error {
+	errDetails := []v1.ErrorDetails{}
+
+	targets := map[string]map[string]datamodel.EnvironmentVariable{
+		containerTargetProperty: newResource.Properties.Container.Env,
+	}
+	for i, c := range newResource.Properties.InitContainers {
+		targets[fmt.Sprintf("%s[%d]", initContainersTargetProperty, i)] = c.Env
+	}
+	for i, c := range newResource.Properties.Sidecars {
+		targets[fmt.Sprintf("%s[%d]", sidecarsTargetProperty, i)] = c.Env
+	}
+
+	targetKeys := maps.Keys(targets)
+	slices.Sort(targetKeys)
+
+	for _, targetKey := range targetKeys {
+		env := targets[targetKey]
+		names := maps.Keys(env)
+		slices.Sort(names)
+
+		for _, name := range names {
+			if message := validateEnvironmentVariable(env[name], newResource.Properties.Connections); message != "" {
+				errDetails = append(errDetails, v1.ErrorDetails{
+					Code:    v1.CodeInvalidRequestContent,
+					Target:  fmt.Sprintf("%s.env.%s", targetKey, name),
+					Message: message,
+				})
+			}
+		}
+	}
+
+	if len(errDetails) > 0 {
+		return v1.ErrorDetails{
+			Code:    v1.CodeInvalidRequestContent,
+			Target:  "$.properties",
+			Message: "The environment variables include invalid definitions.",
+			Details: errDetails,
+		}
+	}
+
+	return nil
+}
+
+// validateEnvironmentVariable returns the validation error message of the given environment variable, or an empty
+// string if the environment variable is valid.
+func validateEnvironmentVariable(e datamodel.EnvironmentVariable, connections map[string]datamodel.ConnectionProperties) string {
+	if (e.Value == nil) == (e.ValueFrom == nil) {
+		return "exactly one of value or valueFrom must be specified."
+	}
+
+	if e.ValueFrom == nil {
+		return ""
+	}
+
+	secretRef, connectionRef := e.ValueFrom.SecretRef, e.ValueFrom.ConnectionRef
+	if (secretRef == nil) == (connectionRef == nil) {
+		return "exactly one of valueFrom.secretRef or valueFrom.connectionRef must be specified."
+	}
+
+	if secretRef != nil {
+		id, err := resources.ParseResource(secretRef.Source)
+		if err != nil || !strings.EqualFold(id.Type(), datamodel.SecretStoreResourceType) {
+			return fmt.Sprintf("valueFrom.secretRef.source must be the resource id of an %s resource.", datamodel.SecretStoreResourceType)
+		}
+		if secretRef.Key == "" {
+			return "valueFrom.secretRef.key is required."
+		}
+		return ""
+	}
+
+	if _, ok := connections[connectionRef.Name]; !ok {
+		return fmt.Sprintf("valueFrom.connectionRef.name must be the name of a connection, but connection %q is not defined.", connectionRef.Name)
+	}
+	if connectionRef.Key == "" {
+		return "valueFrom.connectionRef.key is required."
+	}
+
+	return ""
+}
+
 func errMultipleResources(typeName string, num int) v1.ErrorDetails {
 	return v1.ErrorDetails{
 		Code:    v1.CodeInvalidRequestContent,
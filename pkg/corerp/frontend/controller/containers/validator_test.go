@@ -26,6 +26,7 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/test/k8sutil"
 	"github.com/stretchr/testify/require"
 )
@@ -444,3 +445,419 @@ func TestValidatePodSpec(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateContainers(t *testing.T) {
+	newResource := func(container datamodel.Container, initContainers, sidecars []datamodel.NamedContainer) *datamodel.ContainerResource {
+		return &datamodel.ContainerResource{
+			BaseResource: v1.BaseResource{
+				TrackedResource: v1.TrackedResource{
+					Name: "magpie",
+				},
+			},
+			Properties: datamodel.ContainerProperties{
+				Container:      container,
+				InitContainers: initContainers,
+				Sidecars:       sidecars,
+			},
+		}
+	}
+
+	webPort := map[string]datamodel.ContainerPort{"web": {ContainerPort: 3000}}
+
+	containerTests := []struct {
+		name     string
+		resource *datamodel.ContainerResource
+		details  []v1.ErrorDetails
+	}{
+		{
+			name: "valid init containers and sidecars",
+			resource: newResource(
+				datamodel.Container{Image: "magpie:latest", Ports: webPort},
+				[]datamodel.NamedContainer{{Name: "migrate", Container: datamodel.Container{Image: "migrate:latest"}}},
+				[]datamodel.NamedContainer{{Name: "proxy", Container: datamodel.Container{Image: "proxy:latest", Ports: map[string]datamodel.ContainerPort{"proxy": {ContainerPort: 8080}}}}},
+			),
+		},
+		{
+			name: "missing and duplicate names",
+			resource: newResource(
+				datamodel.Container{Image: "magpie:latest"},
+				[]datamodel.NamedContainer{{Container: datamodel.Container{Image: "migrate:latest"}}},
+				[]datamodel.NamedContainer{
+					{Name: "Magpie", Container: datamodel.Container{Image: "proxy:latest"}},
+					{Name: "proxy", Container: datamodel.Container{Image: "proxy:latest"}},
+					{Name: "proxy", Container: datamodel.Container{Image: "proxy:latest"}},
+				},
+			),
+			details: []v1.ErrorDetails{
+				{
+					Code:    v1.CodeInvalidRequestContent,
+					Target:  "$.properties.initContainers[0].name",
+					Message: "container name is required.",
+				},
+				{
+					Code:    v1.CodeInvalidRequestContent,
+					Target:  "$.properties.sidecars[0].name",
+					Message: "container name Magpie conflicts with the container at $.properties.container.",
+				},
+				{
+					Code:    v1.CodeInvalidRequestContent,
+					Target:  "$.properties.sidecars[2].name",
+					Message: "container name proxy conflicts with the container at $.properties.sidecars[1].",
+				},
+			},
+		},
+		{
+			name: "port collisions",
+			resource: newResource(
+				datamodel.Container{Image: "magpie:latest", Ports: webPort},
+				nil,
+				[]datamodel.NamedContainer{
+					{Name: "proxy", Container: datamodel.Container{Image: "proxy:latest", Ports: map[string]datamodel.ContainerPort{"web": {ContainerPort: 8080}}}},
+					{Name: "metrics", Container: datamodel.Container{Image: "metrics:latest", Ports: map[string]datamodel.ContainerPort{"metrics": {ContainerPort: 3000}}}},
+				},
+			),
+			details: []v1.ErrorDetails{
+				{
+					Code:    v1.CodeInvalidRequestContent,
+					Target:  "$.properties.sidecars[0].ports.web",
+					Message: "port name web conflicts with the port at $.properties.container.ports.web.",
+				},
+				{
+					Code:    v1.CodeInvalidRequestContent,
+					Target:  "$.properties.sidecars[1].ports.metrics",
+					Message: "container port 3000 conflicts with the port at $.properties.container.ports.web.",
+				},
+			},
+		},
+		{
+			name: "init container with probes and routes",
+			resource: newResource(
+				datamodel.Container{Image: "magpie:latest"},
+				[]datamodel.NamedContainer{
+					{
+						Name: "migrate",
+						Container: datamodel.Container{
+							Image: "migrate:latest",
+							Ports: map[string]datamodel.ContainerPort{
+								"migrate": {ContainerPort: 9000, Provides: "/planes/radius/local/resourceGroups/rg/providers/Applications.Core/httpRoutes/route"},
+							},
+							ReadinessProbe: datamodel.HealthProbeProperties{
+								Kind: datamodel.TCPHealthProbe,
+								TCP:  &datamodel.TCPHealthProbeProperties{ContainerPort: 9000},
+							},
+						},
+					},
+				},
+				nil,
+			),
+			details: []v1.ErrorDetails{
+				{
+					Code:    v1.CodeInvalidRequestContent,
+					Target:  "$.properties.initContainers[0]",
+					Message: "init containers do not support liveness or readiness probes.",
+				},
+				{
+					Code:    v1.CodeInvalidRequestContent,
+					Target:  "$.properties.initContainers[0].ports.migrate",
+					Message: "init containers cannot provide routes.",
+				},
+			},
+		},
+	}
+
+	for _, tc := range containerTests {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := ValidateAndMutateRequest(context.Background(), tc.resource, nil, nil)
+			require.NoError(t, err)
+
+			if tc.details == nil {
+				require.Nil(t, resp)
+				return
+			}
+
+			expected := rest.NewBadRequestARMResponse(v1.ErrorResponse{
+				Error: v1.ErrorDetails{
+					Code:    v1.CodeInvalidRequestContent,
+					Target:  "$.properties",
+					Message: "The containers include conflicting or invalid definitions.",
+					Details: tc.details,
+				},
+			})
+			require.Equal(t, expected, resp)
+		})
+	}
+}
+
+func TestValidateResourceRequirements(t *testing.T) {
+	requirementTests := []struct {
+		name      string
+		container datamodel.Container
+		sidecars  []datamodel.NamedContainer
+		err       *v1.ErrorDetails
+	}{
+		{
+			name: "valid requests and limits",
+			container: datamodel.Container{
+				Image: "magpie:latest",
+				Resources: &datamodel.ContainerResourceRequirements{
+					Requests: &datamodel.ContainerResourceList{CPU: "250m", Memory: "64Mi", EphemeralStorage: "1Gi"},
+					Limits:   &datamodel.ContainerResourceList{CPU: "1", Memory: "128Mi", Extended: map[string]string{"nvidia.com/gpu": "1"}},
+				},
+			},
+		},
+		{
+			name: "invalid quantity",
+			container: datamodel.Container{
+				Image: "magpie:latest",
+				Resources: &datamodel.ContainerResourceRequirements{
+					Limits: &datamodel.ContainerResourceList{CPU: "lots"},
+				},
+			},
+			err: &v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  "$.properties.container.resources",
+				Message: "Invalid resources: limits.cpu has an invalid quantity \"lots\": quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'.",
+			},
+		},
+		{
+			name: "requests exceed limits",
+			container: datamodel.Container{
+				Image: "magpie:latest",
+				Resources: &datamodel.ContainerResourceRequirements{
+					Requests: &datamodel.ContainerResourceList{Memory: "1Gi"},
+					Limits:   &datamodel.ContainerResourceList{Memory: "512Mi"},
+				},
+			},
+			err: &v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  "$.properties.container.resources",
+				Message: "Invalid resources: requests.memory (1Gi) must be less than or equal to limits.memory (512Mi).",
+			},
+		},
+		{
+			name:      "extended resource on sidecar",
+			container: datamodel.Container{Image: "magpie:latest"},
+			sidecars: []datamodel.NamedContainer{
+				{
+					Name: "inference",
+					Container: datamodel.Container{
+						Image: "inference:latest",
+						Resources: &datamodel.ContainerResourceRequirements{
+							Limits: &datamodel.ContainerResourceList{Extended: map[string]string{"gpu": "1"}},
+						},
+					},
+				},
+			},
+			err: &v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  "$.properties.sidecars[0].resources",
+				Message: "Invalid resources: limits.extended.gpu is not a valid extended resource name, it must be domain-qualified, for example nvidia.com/gpu.",
+			},
+		},
+		{
+			name: "extended resource requests must equal limits",
+			container: datamodel.Container{
+				Image: "magpie:latest",
+				Resources: &datamodel.ContainerResourceRequirements{
+					Requests: &datamodel.ContainerResourceList{Extended: map[string]string{"nvidia.com/gpu": "1"}},
+					Limits:   &datamodel.ContainerResourceList{Extended: map[string]string{"nvidia.com/gpu": "2"}},
+				},
+			},
+			err: &v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  "$.properties.container.resources",
+				Message: "Invalid resources: requests.nvidia.com/gpu must be equal to limits.nvidia.com/gpu for extended resources.",
+			},
+		},
+		{
+			name: "extended resource request without limit",
+			container: datamodel.Container{
+				Image: "magpie:latest",
+				Resources: &datamodel.ContainerResourceRequirements{
+					Requests: &datamodel.ContainerResourceList{CPU: "1", Extended: map[string]string{"nvidia.com/gpu": "1"}},
+				},
+			},
+			err: &v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  "$.properties.container.resources",
+				Message: "Invalid resources: limits.nvidia.com/gpu must be set and equal to requests.nvidia.com/gpu for extended resources.",
+			},
+		},
+	}
+
+	for _, tc := range requirementTests {
+		t.Run(tc.name, func(t *testing.T) {
+			resource := &datamodel.ContainerResource{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						Name: "magpie",
+					},
+				},
+				Properties: datamodel.ContainerProperties{
+					Container: tc.container,
+					Sidecars:  tc.sidecars,
+				},
+			}
+
+			resp, err := ValidateAndMutateRequest(context.Background(), resource, nil, nil)
+			require.NoError(t, err)
+
+			if tc.err == nil {
+				require.Nil(t, resp)
+				return
+			}
+
+			require.Equal(t, rest.NewBadRequestARMResponse(v1.ErrorResponse{Error: *tc.err}), resp)
+		})
+	}
+}
+
+func TestValidateEnvironmentVariables(t *testing.T) {
+	secretStoreID := "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/secretStores/dbsecret"
+	connections := map[string]datamodel.ConnectionProperties{
+		"db": {Source: "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/sqlDatabases/db"},
+	}
+
+	envTests := []struct {
+		name     string
+		env      map[string]datamodel.EnvironmentVariable
+		sidecars []datamodel.NamedContainer
+		details  []v1.ErrorDetails
+	}{
+		{
+			name: "valid environment variables",
+			env: map[string]datamodel.EnvironmentVariable{
+				"LOG_LEVEL": {Value: to.Ptr("debug")},
+				"EMPTY":     {Value: to.Ptr("")},
+				"DB_PASSWORD": {
+					ValueFrom: &datamodel.EnvironmentVariableReference{
+						SecretRef: &datamodel.EnvironmentVariableSecretReference{Source: secretStoreID, Key: "password"},
+					},
+				},
+				"DB_CONNECTION": {
+					ValueFrom: &datamodel.EnvironmentVariableReference{
+						ConnectionRef: &datamodel.EnvironmentVariableConnectionReference{Name: "db", Key: "connectionString"},
+					},
+				},
+			},
+		},
+		{
+			name: "value and valueFrom",
+			env: map[string]datamodel.EnvironmentVariable{
+				"DB_PASSWORD": {
+					Value: to.Ptr("password"),
+					ValueFrom: &datamodel.EnvironmentVariableReference{
+						SecretRef: &datamodel.EnvironmentVariableSecretReference{Source: secretStoreID, Key: "password"},
+					},
+				},
+				"UNSET": {},
+			},
+			details: []v1.ErrorDetails{
+				{
+					Code:    v1.CodeInvalidRequestContent,
+					Target:  "$.properties.container.env.DB_PASSWORD",
+					Message: "exactly one of value or valueFrom must be specified.",
+				},
+				{
+					Code:    v1.CodeInvalidRequestContent,
+					Target:  "$.properties.container.env.UNSET",
+					Message: "exactly one of value or valueFrom must be specified.",
+				},
+			},
+		},
+		{
+			name: "invalid references",
+			env: map[string]datamodel.EnvironmentVariable{
+				"BOTH": {
+					ValueFrom: &datamodel.EnvironmentVariableReference{
+						SecretRef:     &datamodel.EnvironmentVariableSecretReference{Source: secretStoreID, Key: "password"},
+						ConnectionRef: &datamodel.EnvironmentVariableConnectionReference{Name: "db", Key: "connectionString"},
+					},
+				},
+				"NOT_A_SECRET_STORE": {
+					ValueFrom: &datamodel.EnvironmentVariableReference{
+						SecretRef: &datamodel.EnvironmentVariableSecretReference{Source: connections["db"].Source, Key: "password"},
+					},
+				},
+				"UNKNOWN_CONNECTION": {
+					ValueFrom: &datamodel.EnvironmentVariableReference{
+						ConnectionRef: &datamodel.EnvironmentVariableConnectionReference{Name: "cache", Key: "connectionString"},
+					},
+				},
+			},
+			sidecars: []datamodel.NamedContainer{
+				{
+					Name: "proxy",
+					Container: datamodel.Container{
+						Image: "proxy:latest",
+						Env: map[string]datamodel.EnvironmentVariable{
+							"DB_PASSWORD": {
+								ValueFrom: &datamodel.EnvironmentVariableReference{
+									SecretRef: &datamodel.EnvironmentVariableSecretReference{Source: secretStoreID},
+								},
+							},
+						},
+					},
+				},
+			},
+			details: []v1.ErrorDetails{
+				{
+					Code:    v1.CodeInvalidRequestContent,
+					Target:  "$.properties.container.env.BOTH",
+					Message: "exactly one of valueFrom.secretRef or valueFrom.connectionRef must be specified.",
+				},
+				{
+					Code:    v1.CodeInvalidRequestContent,
+					Target:  "$.properties.container.env.NOT_A_SECRET_STORE",
+					Message: "valueFrom.secretRef.source must be the resource id of an Applications.Core/secretStores resource.",
+				},
+				{
+					Code:    v1.CodeInvalidRequestContent,
+					Target:  "$.properties.container.env.UNKNOWN_CONNECTION",
+					Message: "valueFrom.connectionRef.name must be the name of a connection, but connection \"cache\" is not defined.",
+				},
+				{
+					Code:    v1.CodeInvalidRequestContent,
+					Target:  "$.properties.sidecars[0].env.DB_PASSWORD",
+					Message: "valueFrom.secretRef.key is required.",
+				},
+			},
+		},
+	}
+
+	for _, tc := range envTests {
+		t.Run(tc.name, func(t *testing.T) {
+			resource := &datamodel.ContainerResource{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						Name: "magpie",
+					},
+				},
+				Properties: datamodel.ContainerProperties{
+					Connections: connections,
+					Container: datamodel.Container{
+						Image: "magpie:latest",
+						Env:   tc.env,
+					},
+					Sidecars: tc.sidecars,
+				},
+			}
+
+			resp, err := ValidateAndMutateRequest(context.Background(), resource, nil, nil)
+			require.NoError(t, err)
+
+			if tc.details == nil {
+				require.Nil(t, resp)
+				return
+			}
+
+			expected := v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  "$.properties",
+				Message: "The environment variables include invalid definitions.",
+				Details: tc.details,
+			}
+			require.Equal(t, rest.NewBadRequestARMResponse(v1.ErrorResponse{Error: expected}), resp)
+		})
+	}
+}
@@ -20,6 +20,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"reflect"
+	"sync"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
@@ -27,6 +29,9 @@ import (
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/corerp/datamodel/converter"
 	"github.com/radius-project/radius/pkg/corerp/frontend/controller/util"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
 )
 
 var _ ctrl.Controller = (*CreateOrUpdateEnvironment)(nil)
@@ -34,10 +39,12 @@ var _ ctrl.Controller = (*CreateOrUpdateEnvironment)(nil)
 // CreateOrUpdateEnvironments is the controller implementation to create or update environment resource.
 type CreateOrUpdateEnvironment struct {
 	ctrl.Operation[*datamodel.Environment, datamodel.Environment]
+	metadataCache *engine.MetadataCache
 }
 
-// NewCreateOrUpdateEnvironment creates a new controller for creating or updating an environment resource.
-func NewCreateOrUpdateEnvironment(opts ctrl.Options) (ctrl.Controller, error) {
+// NewCreateOrUpdateEnvironment creates a new controller for creating or updating an environment resource. The metadata
+// cache is used to retrieve the parameters declared by the recipes to validate the recipe parameters of the environment.
+func NewCreateOrUpdateEnvironment(opts ctrl.Options, metadataCache *engine.MetadataCache) (ctrl.Controller, error) {
 	return &CreateOrUpdateEnvironment{
 		ctrl.NewOperation(opts,
 			ctrl.ResourceOptions[datamodel.Environment]{
@@ -45,6 +52,7 @@ func NewCreateOrUpdateEnvironment(opts ctrl.Options) (ctrl.Controller, error) {
 				ResponseConverter: converter.EnvironmentDataModelToVersioned,
 			},
 		),
+		metadataCache,
 	}, nil
 }
 
@@ -69,6 +77,18 @@ func (e *CreateOrUpdateEnvironment) Run(ctx context.Context, w http.ResponseWrit
 		return rest.NewBadRequestResponse(err.Error()), nil
 	}
 
+	if err := newResource.Properties.DefaultContainerResources.Validate(); err != nil {
+		return rest.NewBadRequestResponse(fmt.Sprintf("invalid defaultContainerResources: %s", err.Error())), nil
+	}
+
+	if err := newResource.Properties.Gateway.Validate(); err != nil {
+		return rest.NewBadRequestResponse(fmt.Sprintf("invalid gateway: %s", err.Error())), nil
+	}
+
+	if err := e.validateRecipeParameters(ctx, newResource, old); err != nil {
+		return rest.NewBadRequestResponse(err.Error()), nil
+	}
+
 	// Create Query filter to query kubernetes namespace used by the other environment resources.
 	namespace := newResource.Properties.Compute.KubernetesCompute.Namespace
 	result, err := util.FindResources(ctx, serviceCtx.ResourceID.RootScope(), serviceCtx.ResourceID.Type(), "properties.compute.kubernetes.namespace", namespace, e.StorageClient())
@@ -97,3 +117,72 @@ func (e *CreateOrUpdateEnvironment) Run(ctx context.Context, w http.ResponseWrit
 
 	return e.ConstructSyncResponse(ctx, req.Method, newEtag, newResource)
 }
+
+// validateRecipeParameters validates the parameters of the recipes registered to the environment against the
+// parameters declared by the recipe templates. Recipes without parameters, or which are not changed by the request,
+// are not validated. Required parameters are not validated since they can be set by the resources using the recipe.
+// The parameters of the recipes are retrieved concurrently under a single deadline. A recipe is not validated if its
+// parameters can't be retrieved in time, since the template may not be reachable yet.
+func (e *CreateOrUpdateEnvironment) validateRecipeParameters(ctx context.Context, newResource *datamodel.Environment, old *datamodel.Environment) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	definitions := []recipes.EnvironmentDefinition{}
+	for _, resourceType := range recipes.SortedKeys(newResource.Properties.Recipes) {
+		envRecipes := newResource.Properties.Recipes[resourceType]
+		for _, recipeName := range recipes.SortedKeys(envRecipes) {
+			recipe := envRecipes[recipeName]
+			if len(recipe.Parameters) == 0 {
+				continue
+			}
+
+			if old != nil {
+				if oldRecipe, ok := old.Properties.Recipes[resourceType][recipeName]; ok && reflect.DeepEqual(oldRecipe, recipe) {
+					continue
+				}
+			}
+
+			definitions = append(definitions, recipes.EnvironmentDefinition{
+				Name:            recipeName,
+				Driver:          recipe.TemplateKind,
+				Parameters:      recipe.Parameters,
+				TemplatePath:    recipe.TemplatePath,
+				TemplateVersion: recipe.TemplateVersion,
+				ResourceType:    resourceType,
+			})
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, e.metadataCache.Timeout())
+	defer cancel()
+
+	recipeData := make([]map[string]any, len(definitions))
+	errs := make([]error, len(definitions))
+	var wg sync.WaitGroup
+	for i := range definitions {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			recipeData[i], errs[i] = e.metadataCache.GetRecipeMetadata(ctx, definitions[i], newResource.Properties.RecipeConfig)
+		}(i)
+	}
+	wg.Wait()
+
+	for i, definition := range definitions {
+		if errs[i] != nil {
+			logger.Info(fmt.Sprintf("Skipping parameter validation of recipe %q for resource type %q: failed to retrieve the recipe parameters: %s", definition.Name, definition.ResourceType, errs[i].Error()))
+			continue
+		}
+
+		schema, err := recipes.NewParameterSchema(definition.Driver, recipeData[i])
+		if err != nil {
+			logger.Info(fmt.Sprintf("Skipping parameter validation of recipe %q for resource type %q: %s", definition.Name, definition.ResourceType, err.Error()))
+			continue
+		}
+
+		if err := schema.Validate(definition.Parameters); err != nil {
+			return fmt.Errorf("invalid parameters for recipe %q of resource type %q: %w", definition.Name, definition.ResourceType, err)
+		}
+	}
+
+	return nil
+}
@@ -19,14 +19,21 @@ package environments
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"sync"
 	"testing"
+	"time"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/store"
 
 	"github.com/golang/mock/gomock"
@@ -39,8 +46,14 @@ func TestCreateOrUpdateEnvironmentRun_20231001Preview(t *testing.T) {
 	defer mctrl.Finish()
 
 	mStorageClient := store.NewMockStorageClient(mctrl)
+	mEngine := engine.NewMockEngine(mctrl)
 	ctx := context.Background()
 
+	mEngine.EXPECT().
+		GetRecipeMetadata(gomock.Any(), gomock.Any()).
+		Return(map[string]any{"parameters": map[string]any{"throughput": map[string]any{"type": "int"}}}, nil).
+		AnyTimes()
+
 	createNewResourceCases := []struct {
 		desc               string
 		headerKey          string
@@ -101,7 +114,7 @@ func TestCreateOrUpdateEnvironmentRun_20231001Preview(t *testing.T) {
 				StorageClient: mStorageClient,
 			}
 
-			ctl, err := NewCreateOrUpdateEnvironment(opts)
+			ctl, err := NewCreateOrUpdateEnvironment(opts, engine.NewMetadataCache(mEngine, engine.DefaultMetadataTimeout, engine.DefaultMetadataTTL))
 			require.NoError(t, err)
 			resp, err := ctl.Run(ctx, w, req)
 			require.NoError(t, err)
@@ -178,7 +191,7 @@ func TestCreateOrUpdateEnvironmentRun_20231001Preview(t *testing.T) {
 				StorageClient: mStorageClient,
 			}
 
-			ctl, err := NewCreateOrUpdateEnvironment(opts)
+			ctl, err := NewCreateOrUpdateEnvironment(opts, engine.NewMetadataCache(mEngine, engine.DefaultMetadataTimeout, engine.DefaultMetadataTTL))
 			require.NoError(t, err)
 			resp, err := ctl.Run(ctx, w, req)
 			require.NoError(t, err)
@@ -241,7 +254,7 @@ func TestCreateOrUpdateEnvironmentRun_20231001Preview(t *testing.T) {
 				StorageClient: mStorageClient,
 			}
 
-			ctl, err := NewCreateOrUpdateEnvironment(opts)
+			ctl, err := NewCreateOrUpdateEnvironment(opts, engine.NewMetadataCache(mEngine, engine.DefaultMetadataTimeout, engine.DefaultMetadataTTL))
 			require.NoError(t, err)
 			resp, err := ctl.Run(ctx, w, req)
 			require.NoError(t, err)
@@ -310,7 +323,7 @@ func TestCreateOrUpdateEnvironmentRun_20231001Preview(t *testing.T) {
 				StorageClient: mStorageClient,
 			}
 
-			ctl, err := NewCreateOrUpdateEnvironment(opts)
+			ctl, err := NewCreateOrUpdateEnvironment(opts, engine.NewMetadataCache(mEngine, engine.DefaultMetadataTimeout, engine.DefaultMetadataTTL))
 			require.NoError(t, err)
 			resp, err := ctl.Run(ctx, w, req)
 			require.NoError(t, err)
@@ -399,7 +412,7 @@ func TestCreateOrUpdateEnvironmentRun_20231001Preview(t *testing.T) {
 				StorageClient: mStorageClient,
 			}
 
-			ctl, err := NewCreateOrUpdateEnvironment(opts)
+			ctl, err := NewCreateOrUpdateEnvironment(opts, engine.NewMetadataCache(mEngine, engine.DefaultMetadataTimeout, engine.DefaultMetadataTTL))
 			require.NoError(t, err)
 			resp, err := ctl.Run(ctx, w, req)
 			require.NoError(t, err)
@@ -409,3 +422,245 @@ func TestCreateOrUpdateEnvironmentRun_20231001Preview(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateOrUpdateEnvironmentRun_RecipeParameters(t *testing.T) {
+	parameterCases := []struct {
+		desc               string
+		recipeData         map[string]any
+		recipeDataErr      error
+		recipeDataHangs    bool
+		expectedStatusCode int
+		expectedMessage    string
+	}{
+		{
+			desc:               "valid-parameters",
+			recipeData:         map[string]any{"parameters": map[string]any{"throughput": map[string]any{"type": "int", "maxValue": float64(1000)}}},
+			expectedStatusCode: 200,
+		},
+		{
+			desc:               "invalid-parameters",
+			recipeData:         map[string]any{"parameters": map[string]any{"throughput": map[string]any{"type": "string"}, "location": map[string]any{"type": "string"}}},
+			expectedStatusCode: 400,
+			expectedMessage:    "invalid parameters for recipe \"mongo-azure\" of resource type \"Applications.Datastores/mongoDatabases\": parameter \"throughput\" must be of type string",
+		},
+		{
+			desc:               "undeclared-parameters",
+			recipeData:         map[string]any{"parameters": map[string]any{}},
+			expectedStatusCode: 400,
+			expectedMessage:    "invalid parameters for recipe \"mongo-azure\" of resource type \"Applications.Datastores/mongoDatabases\": parameter \"throughput\" is not declared by the recipe",
+		},
+		{
+			desc:               "metadata-not-available",
+			recipeDataErr:      errors.New("failed to pull the recipe"),
+			expectedStatusCode: 200,
+		},
+		{
+			desc:               "metadata-timeout",
+			recipeDataHangs:    true,
+			expectedStatusCode: 200,
+		},
+	}
+
+	for _, tt := range parameterCases {
+		t.Run(tt.desc, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			mStorageClient := store.NewMockStorageClient(mctrl)
+			mEngine := engine.NewMockEngine(mctrl)
+
+			envInput, envDataModel, _ := getTestModels20231001preview()
+			w := httptest.NewRecorder()
+			req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodPut, testHeaderfile, envInput)
+			require.NoError(t, err)
+			ctx := rpctest.NewARMRequestContext(req)
+
+			mStorageClient.
+				EXPECT().
+				Get(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+					return nil, &store.ErrNotFound{ID: id}
+				})
+
+			mEngine.EXPECT().
+				GetRecipeMetadata(gomock.Any(), recipes.EnvironmentDefinition{
+					Name:         "mongo-azure",
+					Driver:       recipes.TemplateKindBicep,
+					ResourceType: "Applications.Datastores/mongoDatabases",
+					Parameters:   map[string]any{"throughput": float64(400)},
+					TemplatePath: "ghcr.io/radius-project/dev/recipes/mongodatabases/azure:1.0",
+				}).
+				DoAndReturn(func(ctx context.Context, _ recipes.EnvironmentDefinition) (map[string]any, error) {
+					if tt.recipeDataHangs {
+						<-ctx.Done()
+						return nil, ctx.Err()
+					}
+					return tt.recipeData, tt.recipeDataErr
+				})
+
+			if tt.expectedStatusCode == 200 {
+				mStorageClient.
+					EXPECT().
+					Query(gomock.Any(), gomock.Any()).
+					Return(&store.ObjectQueryResult{Items: []store.Object{}}, nil)
+				mStorageClient.
+					EXPECT().
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, obj *store.Object, opts ...store.SaveOptions) error {
+						obj.ETag = "new-resource-etag"
+						obj.Data = envDataModel
+						return nil
+					})
+			}
+
+			metadataCache := engine.NewMetadataCache(mEngine, 100*time.Millisecond, time.Minute)
+			ctl, err := NewCreateOrUpdateEnvironment(ctrl.Options{StorageClient: mStorageClient}, metadataCache)
+			require.NoError(t, err)
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, tt.expectedStatusCode, w.Result().StatusCode)
+
+			if tt.expectedMessage != "" {
+				actualOutput := &v1.ErrorResponse{}
+				_ = json.Unmarshal(w.Body.Bytes(), actualOutput)
+				require.Equal(t, v1.CodeInvalid, actualOutput.Error.Code)
+				require.Equal(t, tt.expectedMessage, actualOutput.Error.Message)
+			}
+		})
+	}
+}
+
+func TestCreateOrUpdateEnvironmentRun_RecipeParametersConcurrent(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	mStorageClient := store.NewMockStorageClient(mctrl)
+	mEngine := engine.NewMockEngine(mctrl)
+
+	envInput, _, _ := getTestModels20231001preview()
+	envInput.Properties.Recipes["Applications.Datastores/redisCaches"] = map[string]v20231001preview.RecipePropertiesClassification{
+		"redis-azure": &v20231001preview.BicepRecipeProperties{
+			TemplateKind: to.Ptr(recipes.TemplateKindBicep),
+			TemplatePath: to.Ptr("ghcr.io/radius-project/dev/recipes/rediscaches/azure:1.0"),
+			Parameters:   map[string]any{"sku": "Basic"},
+		},
+	}
+
+	w := httptest.NewRecorder()
+	req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodPut, testHeaderfile, envInput)
+	require.NoError(t, err)
+	ctx := rpctest.NewARMRequestContext(req)
+
+	mStorageClient.
+		EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+			return nil, &store.ErrNotFound{ID: id}
+		})
+
+	// Each retrieval waits for the other one to start, so that the recipes are only validated if the metadata of
+	// the recipes is retrieved concurrently before the deadline.
+	var started sync.WaitGroup
+	started.Add(2)
+	mEngine.EXPECT().
+		GetRecipeMetadata(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, _ recipes.EnvironmentDefinition) (map[string]any, error) {
+			started.Done()
+			done := make(chan struct{})
+			go func() {
+				started.Wait()
+				close(done)
+			}()
+
+			select {
+			case <-done:
+				return map[string]any{"parameters": map[string]any{"throughput": map[string]any{"type": "string"}, "sku": map[string]any{"type": "string"}}}, nil
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}).
+		Times(2)
+
+	ctl, err := NewCreateOrUpdateEnvironment(ctrl.Options{StorageClient: mStorageClient}, engine.NewMetadataCache(mEngine, 5*time.Second, time.Minute))
+	require.NoError(t, err)
+	resp, err := ctl.Run(ctx, w, req)
+	require.NoError(t, err)
+	_ = resp.Apply(ctx, w, req)
+	require.Equal(t, 400, w.Result().StatusCode)
+
+	actualOutput := &v1.ErrorResponse{}
+	_ = json.Unmarshal(w.Body.Bytes(), actualOutput)
+	require.Equal(t, "invalid parameters for recipe \"mongo-azure\" of resource type \"Applications.Datastores/mongoDatabases\": parameter \"throughput\" must be of type string", actualOutput.Error.Message)
+}
+
+func TestCreateOrUpdateEnvironmentRun_InvalidDefaultContainerResources(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	mStorageClient := store.NewMockStorageClient(mctrl)
+	mEngine := engine.NewMockEngine(mctrl)
+
+	envInput, _, _ := getTestModels20231001preview()
+	envInput.Properties.DefaultContainerResources = &v20231001preview.ContainerResourceRequirements{
+		Requests: &v20231001preview.ContainerResourceList{
+			Memory: to.Ptr("1Gi"),
+		},
+		Limits: &v20231001preview.ContainerResourceList{
+			Memory: to.Ptr("512Mi"),
+		},
+	}
+
+	w := httptest.NewRecorder()
+	req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodPut, testHeaderfile, envInput)
+	require.NoError(t, err)
+	ctx := rpctest.NewARMRequestContext(req)
+
+	mStorageClient.
+		EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+			return nil, &store.ErrNotFound{ID: id}
+		})
+
+	ctl, err := NewCreateOrUpdateEnvironment(ctrl.Options{StorageClient: mStorageClient}, engine.NewMetadataCache(mEngine, engine.DefaultMetadataTimeout, engine.DefaultMetadataTTL))
+	require.NoError(t, err)
+	resp, err := ctl.Run(ctx, w, req)
+	require.NoError(t, err)
+	_ = resp.Apply(ctx, w, req)
+	require.Equal(t, 400, w.Result().StatusCode)
+
+	actualOutput := &v1.ErrorResponse{}
+	_ = json.Unmarshal(w.Body.Bytes(), actualOutput)
+	require.Equal(t, v1.CodeInvalid, actualOutput.Error.Code)
+	require.Equal(t, "invalid defaultContainerResources: requests.memory (1Gi) must be less than or equal to limits.memory (512Mi)", actualOutput.Error.Message)
+}
+
+func TestCreateOrUpdateEnvironmentRun_InvalidGateway(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	mStorageClient := store.NewMockStorageClient(mctrl)
+	mEngine := engine.NewMockEngine(mctrl)
+
+	envInput, _, _ := getTestModels20231001preview()
+	envInput.Properties.Gateway = &v20231001preview.EnvironmentGateway{
+		Kind: to.Ptr(v20231001preview.GatewayKindGatewayAPI),
+	}
+
+	w := httptest.NewRecorder()
+	req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodPut, testHeaderfile, envInput)
+	require.NoError(t, err)
+	ctx := rpctest.NewARMRequestContext(req)
+
+	mStorageClient.
+		EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+			return nil, &store.ErrNotFound{ID: id}
+		})
+
+	ctl, err := NewCreateOrUpdateEnvironment(ctrl.Options{StorageClient: mStorageClient}, engine.NewMetadataCache(mEngine, engine.DefaultMetadataTimeout, engine.DefaultMetadataTTL))
+	require.NoError(t, err)
+	resp, err := ctl.Run(ctx, w, req)
+	require.NoError(t, err)
+	_ = resp.Apply(ctx, w, req)
+	require.Equal(t, 400, w.Result().StatusCode)
+
+	actualOutput := &v1.ErrorResponse{}
+	_ = json.Unmarshal(w.Body.Bytes(), actualOutput)
+	require.Equal(t, v1.CodeInvalid, actualOutput.Error.Code)
+	require.Equal(t, "invalid gateway: gatewayClassName is required when kind is \"gatewayAPI\"", actualOutput.Error.Message)
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package environments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+)
+
+var _ ctrl.Controller = (*PlanRecipe)(nil)
+
+// PlanRecipe is the controller implementation to get the changes the deployment of a recipe would make, without deploying it.
+type PlanRecipe struct {
+	ctrl.Operation[*datamodel.Environment, datamodel.Environment]
+	engine.Engine
+}
+
+// NewPlanRecipe creates a new controller for planning the deployment of a recipe registered to an environment.
+func NewPlanRecipe(opts ctrl.Options, engine engine.Engine) (ctrl.Controller, error) {
+	return &PlanRecipe{
+		ctrl.NewOperation(opts,
+			ctrl.ResourceOptions[datamodel.Environment]{
+				RequestConverter:  converter.EnvironmentDataModelFromVersioned,
+				ResponseConverter: converter.EnvironmentDataModelToVersioned,
+			},
+		),
+		engine,
+	}, nil
+}
+
+// Run computes the resources the deployment of the requested recipe would create, update or delete for the given
+// resource name, and returns them as a change set. Returns a Bad Request response if the recipe can't be planned.
+func (r *PlanRecipe) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	resource, _, err := r.GetResource(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+	if resource == nil {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	}
+	content, err := ctrl.ReadJSONBody(req)
+	if err != nil {
+		return nil, err
+	}
+	planRequest, err := converter.RecipePlanRequestDataModelFromVersioned(content, serviceCtx.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+	if planRequest.ResourceName == "" {
+		return rest.NewBadRequestResponse("resourceName must be specified to plan a recipe"), nil
+	}
+
+	recipe, exists := resource.Properties.Recipes[planRequest.ResourceType]
+	if exists {
+		_, exists = recipe[planRequest.Name]
+	}
+	if !exists {
+		return rest.NewNotFoundMessageResponse(fmt.Sprintf("Either recipe with name %q or resource type %q not found on environment with id %q", planRequest.Name, planRequest.ResourceType, serviceCtx.ResourceID)), nil
+	}
+
+	plan, err := r.Engine.Plan(ctx, engine.ExecuteOptions{
+		BaseOptions: engine.BaseOptions{
+			Recipe: recipes.ResourceMetadata{
+				Name:          planRequest.Name,
+				ApplicationID: planRequest.ApplicationID,
+				EnvironmentID: serviceCtx.ResourceID.String(),
+				ResourceID:    fmt.Sprintf("%s/providers/%s/%s", serviceCtx.ResourceID.RootScope(), planRequest.ResourceType, planRequest.ResourceName),
+				Parameters:    planRequest.Parameters,
+			},
+		},
+	})
+	if err != nil {
+		// Recipe errors are caused by the recipe or its configuration, for example a recipe which fails to download.
+		recipeError := &recipes.RecipeError{}
+		if errors.As(err, &recipeError) {
+			return rest.NewBadRequestARMResponse(v1.ErrorResponse{Error: recipeError.ErrorDetails}), nil
+		}
+		return nil, err
+	}
+
+	ret := datamodel.RecipePlan{Changes: []datamodel.RecipeResourceChange{}}
+	for _, change := range plan.Changes {
+		ret.Changes = append(ret.Changes, datamodel.RecipeResourceChange{
+			ChangeType: string(change.ChangeType),
+			ResourceID: change.ResourceID,
+			Address:    change.Address,
+		})
+	}
+
+	versioned, err := converter.RecipePlanDataModelToVersioned(&ret, serviceCtx.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+	return rest.NewOKResponse(versioned), nil
+}
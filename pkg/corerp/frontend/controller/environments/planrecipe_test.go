@@ -0,0 +1,205 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package environments
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanRecipeRun_20231001Preview(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+	mStorageClient := store.NewMockStorageClient(mctrl)
+	mEngine := engine.NewMockEngine(mctrl)
+	ctx := context.Background()
+
+	setupEnvironment := func(t *testing.T) {
+		_, envDataModel, _ := getTestModelsPlanRecipe20231001preview()
+		mStorageClient.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+				return &store.Object{
+					Metadata: store.Metadata{ID: id, ETag: "etag"},
+					Data:     envDataModel,
+				}, nil
+			})
+	}
+
+	t.Run("plan recipe run", func(t *testing.T) {
+		envInput, _, expectedOutput := getTestModelsPlanRecipe20231001preview()
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(ctx, v1.OperationPost.HTTPMethod(), testHeaderfileplanrecipe, envInput)
+		require.NoError(t, err)
+		setupEnvironment(t)
+		ctx := rpctest.NewARMRequestContext(req)
+
+		expectedOptions := engine.ExecuteOptions{
+			BaseOptions: engine.BaseOptions{
+				Recipe: recipes.ResourceMetadata{
+					Name:          "mongo-parameters",
+					EnvironmentID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/applications.core/environments/env0",
+					ResourceID:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Datastores/mongoDatabases/mongo",
+					Parameters:    map[string]any{"mongodbName": "mongo"},
+				},
+			},
+		}
+		plan := &recipes.RecipePlan{
+			Changes: []recipes.ResourceChange{
+				{
+					ChangeType: recipes.ResourceChangeTypeCreate,
+					ResourceID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Microsoft.DocumentDB/databaseAccounts/mongo",
+				},
+				{
+					ChangeType: recipes.ResourceChangeTypeDelete,
+					ResourceID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Microsoft.DocumentDB/databaseAccounts/mongo-old",
+				},
+			},
+		}
+		mEngine.EXPECT().Plan(ctx, expectedOptions).Return(plan, nil)
+
+		ctl, err := NewPlanRecipe(ctrl.Options{StorageClient: mStorageClient}, mEngine)
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, 200, w.Result().StatusCode)
+
+		actualOutput := &v20231001preview.RecipePlanResponse{}
+		_ = json.Unmarshal(w.Body.Bytes(), actualOutput)
+		require.Equal(t, expectedOutput, actualOutput)
+	})
+
+	t.Run("plan recipe run non existing environment", func(t *testing.T) {
+		envInput, _, _ := getTestModelsPlanRecipe20231001preview()
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(ctx, v1.OperationPost.HTTPMethod(), testHeaderfileplanrecipe, envInput)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(req)
+
+		mStorageClient.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+				return nil, &store.ErrNotFound{ID: id}
+			})
+
+		ctl, err := NewPlanRecipe(ctrl.Options{StorageClient: mStorageClient}, mEngine)
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, 404, w.Result().StatusCode)
+	})
+
+	t.Run("plan recipe non existing recipe", func(t *testing.T) {
+		envInput, _, _ := getTestModelsPlanRecipe20231001preview()
+		envInput.Name = to.Ptr("mongodb")
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(ctx, v1.OperationPost.HTTPMethod(), testHeaderfileplanrecipe, envInput)
+		require.NoError(t, err)
+		setupEnvironment(t)
+		ctx := rpctest.NewARMRequestContext(req)
+
+		ctl, err := NewPlanRecipe(ctrl.Options{StorageClient: mStorageClient}, mEngine)
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, 404, w.Result().StatusCode)
+
+		armerr := v1.ErrorResponse{}
+		err = json.Unmarshal(w.Body.Bytes(), &armerr)
+		require.NoError(t, err)
+		require.Equal(t, v1.CodeNotFound, armerr.Error.Code)
+		require.Contains(t, armerr.Error.Message, "Either recipe with name \"mongodb\" or resource type \"Applications.Datastores/mongoDatabases\" not found on environment with id")
+	})
+
+	t.Run("plan recipe missing resource name", func(t *testing.T) {
+		envInput, _, _ := getTestModelsPlanRecipe20231001preview()
+		envInput.ResourceName = nil
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(ctx, v1.OperationPost.HTTPMethod(), testHeaderfileplanrecipe, envInput)
+		require.NoError(t, err)
+		setupEnvironment(t)
+		ctx := rpctest.NewARMRequestContext(req)
+
+		ctl, err := NewPlanRecipe(ctrl.Options{StorageClient: mStorageClient}, mEngine)
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, 400, w.Result().StatusCode)
+	})
+
+	t.Run("plan recipe engine failure", func(t *testing.T) {
+		envInput, _, _ := getTestModelsPlanRecipe20231001preview()
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(ctx, v1.OperationPost.HTTPMethod(), testHeaderfileplanrecipe, envInput)
+		require.NoError(t, err)
+		setupEnvironment(t)
+		ctx := rpctest.NewARMRequestContext(req)
+
+		engineErr := errors.New("failed to plan recipe")
+		mEngine.EXPECT().Plan(ctx, gomock.Any()).Return(nil, engineErr)
+
+		ctl, err := NewPlanRecipe(ctrl.Options{StorageClient: mStorageClient}, mEngine)
+		require.NoError(t, err)
+		_, err = ctl.Run(ctx, w, req)
+		require.Equal(t, engineErr, err)
+	})
+
+	t.Run("plan recipe recipe failure", func(t *testing.T) {
+		envInput, _, _ := getTestModelsPlanRecipe20231001preview()
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(ctx, v1.OperationPost.HTTPMethod(), testHeaderfileplanrecipe, envInput)
+		require.NoError(t, err)
+		setupEnvironment(t)
+		ctx := rpctest.NewARMRequestContext(req)
+
+		recipeErr := recipes.NewRecipeError(recipes.RecipeDownloadFailed, "failed to download recipe", "")
+		mEngine.EXPECT().Plan(ctx, gomock.Any()).Return(nil, recipeErr)
+
+		ctl, err := NewPlanRecipe(ctrl.Options{StorageClient: mStorageClient}, mEngine)
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, 400, w.Result().StatusCode)
+
+		armerr := v1.ErrorResponse{}
+		err = json.Unmarshal(w.Body.Bytes(), &armerr)
+		require.NoError(t, err)
+		require.Equal(t, recipes.RecipeDownloadFailed, armerr.Error.Code)
+		require.Equal(t, "failed to download recipe", armerr.Error.Message)
+	})
+}
@@ -42,6 +42,7 @@ import (
 	k8s "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 )
 
 const (
@@ -62,6 +63,7 @@ func NewKubernetesHandler(client client.Client, clientSet k8s.Interface, discove
 		client:             client,
 		k8sDiscoveryClient: discoveryClient,
 		httpProxyWaiter:    NewHTTPProxyWaiter(dynamicClientSet),
+		gatewayWaiter:      NewGatewayWaiter(dynamicClientSet),
 		deploymentWaiter:   NewDeploymentWaiter(clientSet),
 	}
 }
@@ -71,11 +73,13 @@ type kubernetesHandler struct {
 	// k8sDiscoveryClient is the Kubernetes client to used for API version lookups on Kubernetes resources. Override this for testing.
 	k8sDiscoveryClient discovery.ServerResourcesInterface
 	httpProxyWaiter    ResourceWaiter
+	gatewayWaiter      ResourceWaiter
 	deploymentWaiter   ResourceWaiter
 }
 
 // Put stores the Kubernetes resource in the cluster and returns the properties of the resource. If the resource is a
-// deployment, it also waits until the deployment is ready.
+// deployment, it also waits until the deployment is ready. The properties of a Gateway API Gateway also include the
+// address of the Gateway.
 func (handler *kubernetesHandler) Put(ctx context.Context, options *PutOptions) (map[string]string, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
@@ -138,6 +142,30 @@ func (handler *kubernetesHandler) Put(ctx context.Context, options *PutOptions)
 		logger.Info(fmt.Sprintf("HTTP Proxy %s in namespace %s is ready", item.GetName(), item.GetNamespace()))
 		return properties, nil
 	default:
+		// Gateway API kinds are also used by other API groups, such as Istio Gateways, so they are matched by group.
+		if groupVersion.Group == gatewayv1beta1.GroupName {
+			err = handler.gatewayWaiter.waitUntilReady(ctx, &item)
+			if err != nil {
+				return nil, err
+			}
+			logger.Info(fmt.Sprintf("%s %s in namespace %s is ready", item.GetKind(), item.GetName(), item.GetNamespace()))
+
+			// The public endpoint of a Gateway is only known once the Gateway is ready.
+			if item.GetKind() == resources_kubernetes.KindGatewayAPIGateway {
+				err = handler.client.Get(ctx, client.ObjectKeyFromObject(&item), &item)
+				if err != nil {
+					return nil, err
+				}
+
+				address, err := getGatewayAddress(&item)
+				if err != nil {
+					return nil, err
+				}
+				properties[GatewayAddressKey] = address
+			}
+			return properties, nil
+		}
+
 		// We do not monitor the other resource types.
 		return properties, nil
 	}
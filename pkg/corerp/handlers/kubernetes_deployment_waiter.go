@@ -260,6 +260,11 @@ func (handler *deploymentWaiter) getPodsInDeployment(ctx context.Context, inform
 		if !metav1.IsControlledBy(p, deploymentReplicaSet) {
 			continue
 		}
+		// The replica count of a deployment can change while we wait for it, for example when it is scaled by an
+		// autoscaler. Pods being terminated by a scale in are no longer part of the deployment.
+		if p.DeletionTimestamp != nil {
+			continue
+		}
 		pods = append(pods, *p)
 	}
 
@@ -332,6 +332,86 @@ func TestGetPodsInDeployment(t *testing.T) {
 	require.Equal(t, pod1.Name, pods[0].Name)
 }
 
+func TestGetPodsInDeployment_TerminatingPods(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset()
+
+	deployment := &v1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test-deployment",
+			Namespace:   "test-namespace",
+			Annotations: map[string]string{"deployment.kubernetes.io/revision": "1"},
+		},
+		Spec: v1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"app": "test-app",
+				},
+			},
+		},
+	}
+
+	replicaset := &v1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test-replicaset",
+			Namespace:   "test-namespace",
+			Labels:      map[string]string{"app": "test-app"},
+			Annotations: map[string]string{"deployment.kubernetes.io/revision": "1"},
+			UID:         "1234",
+		},
+	}
+
+	ownerReferences := []metav1.OwnerReference{
+		{
+			Kind:       "ReplicaSet",
+			Name:       replicaset.Name,
+			Controller: to.Ptr(true),
+			UID:        "1234",
+		},
+	}
+
+	// The running pod is part of the deployment.
+	runningPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "test-pod-running",
+			Namespace:       "test-namespace",
+			Labels:          map[string]string{"app": "test-app"},
+			OwnerReferences: ownerReferences,
+		},
+	}
+
+	// The terminating pod is being removed by a scale in, e.g. by an autoscaler.
+	terminatingPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "test-pod-terminating",
+			Namespace:         "test-namespace",
+			Labels:            map[string]string{"app": "test-app"},
+			OwnerReferences:   ownerReferences,
+			DeletionTimestamp: &metav1.Time{Time: time.Now()},
+		},
+	}
+
+	_, err := fakeClient.CoreV1().Pods(runningPod.Namespace).Create(context.Background(), runningPod, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	_, err = fakeClient.CoreV1().Pods(terminatingPod.Namespace).Create(context.Background(), terminatingPod, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	deploymentWaiter := &deploymentWaiter{
+		clientSet: fakeClient,
+	}
+	handler := &kubernetesHandler{
+		deploymentWaiter: deploymentWaiter,
+	}
+
+	ctx := context.Background()
+	informerFactory := startInformers(ctx, fakeClient, handler)
+
+	pods, err := deploymentWaiter.getPodsInDeployment(ctx, informerFactory, deployment, replicaset)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(pods))
+	require.Equal(t, runningPod.Name, pods[0].Name)
+}
+
 func TestGetCurrentReplicaSetForDeployment(t *testing.T) {
 	// Create a fake Kubernetes clientset
 	fakeClient := fake.NewSimpleClientset()
@@ -0,0 +1,302 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const (
+	MaxGatewayDeploymentTimeout = time.Minute * time.Duration(10)
+
+	// GatewayConditionReasonPending is the reason of the conditions of Gateway API resources which have not been
+	// reconciled yet.
+	GatewayConditionReasonPending = "Pending"
+
+	// GatewayAddressKey is the key of the property holding the first address reported in the status of a Gateway API
+	// Gateway.
+	GatewayAddressKey = "gatewayaddress"
+)
+
+var (
+	// GatewayAPIGatewayGVR is the GroupVersionResource of Gateway API Gateways.
+	GatewayAPIGatewayGVR = gatewayv1beta1.SchemeGroupVersion.WithResource("gateways")
+	// GatewayAPIHTTPRouteGVR is the GroupVersionResource of Gateway API HTTPRoutes.
+	GatewayAPIHTTPRouteGVR = gatewayv1beta1.SchemeGroupVersion.WithResource("httproutes")
+	// GatewayAPITLSRouteGVR is the GroupVersionResource of Gateway API TLSRoutes.
+	GatewayAPITLSRouteGVR = gatewayv1alpha2.SchemeGroupVersion.WithResource("tlsroutes")
+)
+
+// gatewayWaiter waits for Gateway API resources to be ready. A Gateway is ready when its Accepted and Programmed
+// conditions are true, and a route is ready when its Accepted and ResolvedRefs conditions are true for all of its parents.
+type gatewayWaiter struct {
+	dynamicClientSet         dynamic.Interface
+	gatewayDeploymentTimeout time.Duration
+	cacheResyncInterval      time.Duration
+}
+
+// NewGatewayWaiter returns a new instance of GatewayWaiter
+func NewGatewayWaiter(dynamicClientSet dynamic.Interface) *gatewayWaiter {
+	return &gatewayWaiter{
+		dynamicClientSet:         dynamicClientSet,
+		gatewayDeploymentTimeout: MaxGatewayDeploymentTimeout,
+		cacheResyncInterval:      DefaultCacheResyncInterval,
+	}
+}
+
+func (handler *gatewayWaiter) addDynamicEventHandler(ctx context.Context, informerFactory dynamicinformer.DynamicSharedInformerFactory, informer cache.SharedIndexInformer, item client.Object, doneCh chan<- error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			handler.checkGatewayStatus(ctx, informerFactory, item, doneCh)
+		},
+		UpdateFunc: func(_, newObj any) {
+			handler.checkGatewayStatus(ctx, informerFactory, item, doneCh)
+		},
+	})
+
+	if err != nil {
+		logger.Error(err, "failed to add event handler")
+	}
+}
+
+// addEventHandler is not implemented for GatewayWaiter
+func (handler *gatewayWaiter) addEventHandler(ctx context.Context, informerFactory informers.SharedInformerFactory, informer cache.SharedIndexInformer, item client.Object, doneCh chan<- error) {
+}
+
+func (handler *gatewayWaiter) waitUntilReady(ctx context.Context, obj client.Object) error {
+	logger := ucplog.FromContextOrDiscard(ctx).WithValues("kind", obj.GetObjectKind().GroupVersionKind().Kind, "name", obj.GetName(), "namespace", obj.GetNamespace())
+
+	gvr, err := getGatewayAPIResource(obj)
+	if err != nil {
+		return err
+	}
+
+	doneCh := make(chan error, 1)
+
+	ctx, cancel := context.WithTimeout(ctx, handler.gatewayDeploymentTimeout)
+	// This ensures that the informer is stopped when this function is returned.
+	defer cancel()
+
+	// Create dynamic informer for the Gateway API resource
+	dynamicInformerFactory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(handler.dynamicClientSet, 0, obj.GetNamespace(), nil)
+	informer := dynamicInformerFactory.ForResource(gvr)
+	handler.addDynamicEventHandler(ctx, dynamicInformerFactory, informer.Informer(), obj, doneCh)
+
+	// Start the informers
+	dynamicInformerFactory.Start(ctx.Done())
+
+	// Wait for the cache to be synced.
+	dynamicInformerFactory.WaitForCacheSync(ctx.Done())
+
+	kind := obj.GetObjectKind().GroupVersionKind().Kind
+	select {
+	case <-ctx.Done():
+		// Get the final status
+		item, err := informer.Lister().ByNamespace(obj.GetNamespace()).Get(obj.GetName())
+		if err != nil {
+			return fmt.Errorf("%s deployment timed out, name: %s, namespace %s, error occurred while fetching latest status: %w", kind, obj.GetName(), obj.GetNamespace(), err)
+		}
+
+		conditions, err := getGatewayAPIConditions(item.(*unstructured.Unstructured))
+		if err != nil {
+			return fmt.Errorf("%s deployment timed out, name: %s, namespace %s, error occurred while fetching latest status: %w", kind, obj.GetName(), obj.GetNamespace(), err)
+		}
+
+		status := metav1.Condition{}
+		if len(conditions) > 0 {
+			status = conditions[len(conditions)-1]
+		}
+		return fmt.Errorf("%s deployment timed out, name: %s, namespace %s, status: %s, reason: %s", kind, obj.GetName(), obj.GetNamespace(), status.Message, status.Reason)
+	case err := <-doneCh:
+		if err == nil {
+			logger.Info(fmt.Sprintf("Marking %s deployment %s in namespace %s as complete", kind, obj.GetName(), obj.GetNamespace()))
+		}
+		return err
+	}
+}
+
+func (handler *gatewayWaiter) checkGatewayStatus(ctx context.Context, dynamicInformerFactory dynamicinformer.DynamicSharedInformerFactory, obj client.Object, doneCh chan<- error) bool {
+	logger := ucplog.FromContextOrDiscard(ctx).WithValues("kind", obj.GetObjectKind().GroupVersionKind().Kind, "name", obj.GetName(), "namespace", obj.GetNamespace())
+
+	gvr, err := getGatewayAPIResource(obj)
+	if err != nil {
+		doneCh <- err
+		return false
+	}
+
+	item, err := dynamicInformerFactory.ForResource(gvr).Lister().ByNamespace(obj.GetNamespace()).Get(obj.GetName())
+	if err != nil {
+		logger.Info(fmt.Sprintf("Unable to get %s: %s", gvr.Resource, err.Error()))
+		return false
+	}
+
+	u := item.(*unstructured.Unstructured)
+	ready, err := checkGatewayAPIConditions(u)
+	if err != nil {
+		doneCh <- fmt.Errorf("failed to deploy %s %s: %w", u.GetKind(), u.GetName(), err)
+		return false
+	}
+
+	if ready {
+		doneCh <- nil
+	}
+	return ready
+}
+
+// checkGatewayAPIConditions returns true if the conditions of the given Gateway API resource report it as ready. It
+// returns an error if a condition reports a failure.
+func checkGatewayAPIConditions(item *unstructured.Unstructured) (bool, error) {
+	switch item.GetKind() {
+	case resources_kubernetes.KindGatewayAPIGateway:
+		gateway := gatewayv1beta1.Gateway{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &gateway); err != nil {
+			return false, err
+		}
+
+		return checkConditions(gateway.Generation, gateway.Status.Conditions, string(gatewayv1beta1.GatewayConditionAccepted), string(gatewayv1beta1.GatewayConditionProgrammed))
+	case resources_kubernetes.KindGatewayAPIHTTPRoute, resources_kubernetes.KindGatewayAPITLSRoute:
+		parents, err := getRouteParentStatuses(item)
+		if err != nil {
+			return false, err
+		}
+
+		// The route has not been attached to its gateway yet.
+		if len(parents) == 0 {
+			return false, nil
+		}
+
+		for _, parent := range parents {
+			ready, err := checkConditions(item.GetGeneration(), parent.Conditions, string(gatewayv1beta1.RouteConditionAccepted), string(gatewayv1beta1.RouteConditionResolvedRefs))
+			if err != nil || !ready {
+				return false, err
+			}
+		}
+
+		return true, nil
+	default:
+		return false, fmt.Errorf("unsupported kind %s", item.GetKind())
+	}
+}
+
+// checkConditions returns true if all the given condition types are true for the given generation. It returns an error
+// if one of them is false for a reason other than the resource not being reconciled yet.
+func checkConditions(generation int64, conditions []metav1.Condition, conditionTypes ...string) (bool, error) {
+	for _, conditionType := range conditionTypes {
+		condition := meta.FindStatusCondition(conditions, conditionType)
+		if condition == nil || condition.ObservedGeneration < generation {
+			return false, nil
+		}
+
+		switch condition.Status {
+		case metav1.ConditionTrue:
+			continue
+		case metav1.ConditionFalse:
+			if condition.Reason == GatewayConditionReasonPending {
+				return false, nil
+			}
+			return false, fmt.Errorf("condition %s is false, reason: %s, message: %s", condition.Type, condition.Reason, condition.Message)
+		default:
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func getRouteParentStatuses(item *unstructured.Unstructured) ([]gatewayv1beta1.RouteParentStatus, error) {
+	if item.GetKind() == resources_kubernetes.KindGatewayAPITLSRoute {
+		route := gatewayv1alpha2.TLSRoute{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &route); err != nil {
+			return nil, err
+		}
+		return route.Status.Parents, nil
+	}
+
+	route := gatewayv1beta1.HTTPRoute{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &route); err != nil {
+		return nil, err
+	}
+	return route.Status.Parents, nil
+}
+
+// getGatewayAPIConditions returns the conditions of the given Gateway API resource, or the conditions of its first
+// parent for routes.
+func getGatewayAPIConditions(item *unstructured.Unstructured) ([]metav1.Condition, error) {
+	if item.GetKind() == resources_kubernetes.KindGatewayAPIGateway {
+		gateway := gatewayv1beta1.Gateway{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &gateway); err != nil {
+			return nil, err
+		}
+		return gateway.Status.Conditions, nil
+	}
+
+	parents, err := getRouteParentStatuses(item)
+	if err != nil || len(parents) == 0 {
+		return nil, err
+	}
+	return parents[0].Conditions, nil
+}
+
+// getGatewayAddress returns the first address reported in the status of the given Gateway API Gateway, which is the
+// public endpoint of the Gateway. It returns an empty string if no address has been assigned to the Gateway.
+func getGatewayAddress(item *unstructured.Unstructured) (string, error) {
+	gateway := gatewayv1beta1.Gateway{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &gateway); err != nil {
+		return "", err
+	}
+
+	for _, address := range gateway.Status.Addresses {
+		if address.Value != "" {
+			return address.Value, nil
+		}
+	}
+
+	return "", nil
+}
+
+func getGatewayAPIResource(obj client.Object) (schema.GroupVersionResource, error) {
+	switch obj.GetObjectKind().GroupVersionKind().Kind {
+	case resources_kubernetes.KindGatewayAPIGateway:
+		return GatewayAPIGatewayGVR, nil
+	case resources_kubernetes.KindGatewayAPIHTTPRoute:
+		return GatewayAPIHTTPRouteGVR, nil
+	case resources_kubernetes.KindGatewayAPITLSRoute:
+		return GatewayAPITLSRouteGVR, nil
+	default:
+		return schema.GroupVersionResource{}, fmt.Errorf("unsupported Gateway API kind %s", obj.GetObjectKind().GroupVersionKind().Kind)
+	}
+}
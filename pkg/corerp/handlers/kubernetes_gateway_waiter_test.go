@@ -0,0 +1,253 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/to"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+func TestCheckGatewayStatus_GatewayReady(t *testing.T) {
+	gateway := makeTestGatewayAPIGateway(
+		metav1.Condition{Type: string(gatewayv1beta1.GatewayConditionAccepted), Status: metav1.ConditionTrue, ObservedGeneration: 1},
+		metav1.Condition{Type: string(gatewayv1beta1.GatewayConditionProgrammed), Status: metav1.ConditionTrue, ObservedGeneration: 1},
+	)
+
+	err := runCheckGatewayStatus(t, gateway)
+	require.NoError(t, err)
+}
+
+func TestCheckGatewayStatus_GatewayNotProgrammed(t *testing.T) {
+	gateway := makeTestGatewayAPIGateway(
+		metav1.Condition{Type: string(gatewayv1beta1.GatewayConditionAccepted), Status: metav1.ConditionTrue, ObservedGeneration: 1},
+		metav1.Condition{
+			Type:               string(gatewayv1beta1.GatewayConditionProgrammed),
+			Status:             metav1.ConditionFalse,
+			Reason:             string(gatewayv1beta1.GatewayReasonAddressNotAssigned),
+			Message:            "no address assigned",
+			ObservedGeneration: 1,
+		},
+	)
+
+	err := runCheckGatewayStatus(t, gateway)
+	require.EqualError(t, err, "failed to deploy Gateway example: condition Programmed is false, reason: AddressNotAssigned, message: no address assigned")
+}
+
+func TestCheckGatewayStatus_HTTPRouteNotAccepted(t *testing.T) {
+	route := &gatewayv1beta1.HTTPRoute{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gatewayv1beta1.GroupVersion.String(),
+			Kind:       resources_kubernetes.KindGatewayAPIHTTPRoute,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  "default",
+			Name:       "example-route",
+			Generation: 1,
+		},
+		Status: gatewayv1beta1.HTTPRouteStatus{
+			RouteStatus: gatewayv1beta1.RouteStatus{
+				Parents: []gatewayv1beta1.RouteParentStatus{
+					{
+						ParentRef:      gatewayv1beta1.ParentReference{Name: "example"},
+						ControllerName: "example.com/gateway-controller",
+						Conditions: []metav1.Condition{
+							{
+								Type:               string(gatewayv1beta1.RouteConditionAccepted),
+								Status:             metav1.ConditionFalse,
+								Reason:             string(gatewayv1beta1.RouteReasonNotAllowedByListeners),
+								Message:            "route is not allowed",
+								ObservedGeneration: 1,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	err := runCheckGatewayStatus(t, route)
+	require.EqualError(t, err, "failed to deploy HTTPRoute example-route: condition Accepted is false, reason: NotAllowedByListeners, message: route is not allowed")
+}
+
+func TestCheckGatewayAPIConditions(t *testing.T) {
+	accepted := metav1.Condition{Type: string(gatewayv1beta1.RouteConditionAccepted), Status: metav1.ConditionTrue, ObservedGeneration: 2}
+	resolvedRefs := metav1.Condition{Type: string(gatewayv1beta1.RouteConditionResolvedRefs), Status: metav1.ConditionTrue, ObservedGeneration: 2}
+
+	tests := []struct {
+		name    string
+		parents []gatewayv1beta1.RouteParentStatus
+		ready   bool
+		err     string
+	}{
+		{
+			name:  "no parents",
+			ready: false,
+		},
+		{
+			name: "all parents ready",
+			parents: []gatewayv1beta1.RouteParentStatus{
+				{Conditions: []metav1.Condition{accepted, resolvedRefs}},
+				{Conditions: []metav1.Condition{accepted, resolvedRefs}},
+			},
+			ready: true,
+		},
+		{
+			name: "missing condition",
+			parents: []gatewayv1beta1.RouteParentStatus{
+				{Conditions: []metav1.Condition{accepted}},
+			},
+			ready: false,
+		},
+		{
+			name: "stale generation",
+			parents: []gatewayv1beta1.RouteParentStatus{
+				{Conditions: []metav1.Condition{
+					{Type: string(gatewayv1beta1.RouteConditionAccepted), Status: metav1.ConditionTrue, ObservedGeneration: 1},
+					resolvedRefs,
+				}},
+			},
+			ready: false,
+		},
+		{
+			name: "pending",
+			parents: []gatewayv1beta1.RouteParentStatus{
+				{Conditions: []metav1.Condition{
+					{Type: string(gatewayv1beta1.RouteConditionAccepted), Status: metav1.ConditionFalse, Reason: GatewayConditionReasonPending, ObservedGeneration: 2},
+					resolvedRefs,
+				}},
+			},
+			ready: false,
+		},
+		{
+			name: "unresolved references",
+			parents: []gatewayv1beta1.RouteParentStatus{
+				{Conditions: []metav1.Condition{
+					accepted,
+					{Type: string(gatewayv1beta1.RouteConditionResolvedRefs), Status: metav1.ConditionFalse, Reason: string(gatewayv1beta1.RouteReasonBackendNotFound), Message: "service not found", ObservedGeneration: 2},
+				}},
+			},
+			ready: false,
+			err:   "condition ResolvedRefs is false, reason: BackendNotFound, message: service not found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			route := &gatewayv1beta1.HTTPRoute{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: gatewayv1beta1.GroupVersion.String(),
+					Kind:       resources_kubernetes.KindGatewayAPIHTTPRoute,
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  "default",
+					Name:       "example-route",
+					Generation: 2,
+				},
+				Status: gatewayv1beta1.HTTPRouteStatus{
+					RouteStatus: gatewayv1beta1.RouteStatus{Parents: tc.parents},
+				},
+			}
+
+			ready, err := checkGatewayAPIConditions(toUnstructured(t, route))
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.ready, ready)
+		})
+	}
+}
+
+func makeTestGatewayAPIGateway(conditions ...metav1.Condition) *gatewayv1beta1.Gateway {
+	return &gatewayv1beta1.Gateway{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gatewayv1beta1.GroupVersion.String(),
+			Kind:       resources_kubernetes.KindGatewayAPIGateway,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  "default",
+			Name:       "example",
+			Generation: 1,
+		},
+		Spec: gatewayv1beta1.GatewaySpec{
+			GatewayClassName: "example-class",
+		},
+		Status: gatewayv1beta1.GatewayStatus{
+			Conditions: conditions,
+		},
+	}
+}
+
+func toUnstructured(t *testing.T, obj runtime.Object) *unstructured.Unstructured {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	require.NoError(t, err)
+	return &unstructured.Unstructured{Object: content}
+}
+
+// runCheckGatewayStatus creates the given object with a fake dynamic client and returns the result reported by
+// checkGatewayStatus once the informer cache is synced.
+func runCheckGatewayStatus(t *testing.T, obj runtime.Object) error {
+	fakeClient := fakedynamic.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		GatewayAPIGatewayGVR:   "GatewayList",
+		GatewayAPIHTTPRouteGVR: "HTTPRouteList",
+	})
+
+	ctx := context.Background()
+	u := toUnstructured(t, obj)
+	gvr, err := getGatewayAPIResource(u)
+	require.NoError(t, err)
+	_, err = fakeClient.Resource(gvr).Namespace(u.GetNamespace()).Create(ctx, u, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	dynamicInformerFactory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(fakeClient, 0, u.GetNamespace(), nil)
+	dynamicInformerFactory.ForResource(gvr).Informer()
+	dynamicInformerFactory.Start(ctx.Done())
+	dynamicInformerFactory.WaitForCacheSync(ctx.Done())
+
+	doneCh := make(chan error, 1)
+	waiter := NewGatewayWaiter(fakeClient)
+	go waiter.checkGatewayStatus(ctx, dynamicInformerFactory, u, doneCh)
+	return <-doneCh
+}
+
+func TestGetGatewayAddress(t *testing.T) {
+	gateway := makeTestGatewayAPIGateway()
+
+	address, err := getGatewayAddress(toUnstructured(t, gateway))
+	require.NoError(t, err)
+	require.Empty(t, address)
+
+	gateway.Status.Addresses = []gatewayv1beta1.GatewayAddress{
+		{Type: to.Ptr(gatewayv1beta1.IPAddressType), Value: "10.0.0.1"},
+		{Type: to.Ptr(gatewayv1beta1.HostnameAddressType), Value: "example.com"},
+	}
+	address, err = getGatewayAddress(toUnstructured(t, gateway))
+	require.NoError(t, err)
+	require.Equal(t, "10.0.0.1", address)
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/radius-project/radius/pkg/azure/armauth"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/corerp/handlers"
+	"github.com/radius-project/radius/pkg/corerp/renderers/autoscale"
 	"github.com/radius-project/radius/pkg/corerp/renderers/container"
 	azcontainer "github.com/radius-project/radius/pkg/corerp/renderers/container/azure"
 	"github.com/radius-project/radius/pkg/corerp/renderers/daprextension"
@@ -88,10 +89,12 @@ func NewApplicationModel(arm *armauth.ArmConfig, k8sClient client.Client, k8sCli
 		{
 			ResourceType: container.ResourceType,
 			Renderer: &kubernetesmetadata.Renderer{
-				Inner: &manualscale.Renderer{
-					Inner: &daprextension.Renderer{
-						Inner: &container.Renderer{
-							RoleAssignmentMap: roleAssignmentMap,
+				Inner: &autoscale.Renderer{
+					Inner: &manualscale.Renderer{
+						Inner: &daprextension.Renderer{
+							Inner: &container.Renderer{
+								RoleAssignmentMap: roleAssignmentMap,
+							},
 						},
 					},
 				},
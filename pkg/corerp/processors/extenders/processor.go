@@ -17,7 +17,7 @@ type Processor struct {
 // the recipe output with the existing values in the resource. It returns an error if the secret values are not of type string
 // or if any of the other validations fail.
 func (p *Processor) Process(ctx context.Context, resource *datamodel.Extender, options processors.Options) error {
-	validator := processors.NewValidator(&resource.ComputedValues, &resource.SecretValues, &resource.Properties.Status.OutputResources, resource.Properties.Status.Recipe)
+	validator := processors.NewValidator(resource.ResourceTypeName(), &resource.ComputedValues, &resource.SecretValues, &resource.Properties.Status.OutputResources, resource.Properties.Status.Recipe)
 
 	computedValues := mergeOutputValues(resource.Properties.AdditionalProperties, options.RecipeOutput, false)
 	for k, val := range computedValues {
@@ -0,0 +1,330 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscale
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// ScaledObjectAPIVersion is the API version of the KEDA ScaledObject resource.
+	ScaledObjectAPIVersion = "keda.sh/v1alpha1"
+
+	// ScaledObjectKind is the kind of the KEDA ScaledObject resource.
+	ScaledObjectKind = "ScaledObject"
+
+	// defaultQueueLengthMetadataKey is the metadata key of the target queue length for scalers not listed in queueLengthMetadataKeys.
+	defaultQueueLengthMetadataKey = "queueLength"
+)
+
+// queueLengthMetadataKeys maps the KEDA scalers to the metadata key holding their target queue length.
+var queueLengthMetadataKeys = map[string]string{
+	"rabbitmq":         "value",
+	"azure-servicebus": "messageCount",
+	"azure-queue":      "queueLength",
+	"aws-sqs-queue":    "queueLength",
+	"gcp-pubsub":       "value",
+	"redis":            "listLength",
+	"redis-streams":    "pendingEntriesCount",
+	"kafka":            "lagThreshold",
+	"nats-jetstream":   "lagThreshold",
+}
+
+// Renderer is the renderers.Renderer implementation for the autoscale extension.
+type Renderer struct {
+	Inner renderers.Renderer
+}
+
+// GetDependencyIDs gets the IDs of the dependencies of the given resource.
+func (r *Renderer) GetDependencyIDs(ctx context.Context, resource v1.DataModelInterface) ([]resources.ID, []resources.ID, error) {
+	// Let the inner renderer do its work
+	return r.Inner.GetDependencyIDs(ctx, resource)
+}
+
+// Render checks if the DataModelInterface is a ContainerResource with an AutoScaling extension and if so, adds a
+// HorizontalPodAutoscaler for the deployment, or a KEDA ScaledObject when event-driven triggers are configured.
+func (r *Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options renderers.RenderOptions) (renderers.RendererOutput, error) {
+	// Let the inner renderer do its work
+	output, err := r.Inner.Render(ctx, dm, options)
+	if err != nil {
+		return renderers.RendererOutput{}, err
+	}
+
+	resource, ok := dm.(*datamodel.ContainerResource)
+	if !ok {
+		return renderers.RendererOutput{}, v1.ErrInvalidModelConversion
+	}
+
+	extension := datamodel.FindExtension(resource.Properties.Extensions, datamodel.AutoScaling)
+	if extension == nil || extension.AutoScaling == nil {
+		return output, nil
+	}
+
+	if datamodel.FindExtension(resource.Properties.Extensions, datamodel.ManualScaling) != nil {
+		return renderers.RendererOutput{}, v1.NewClientErrInvalidRequest("the manualScaling and autoScaling extensions cannot be used together")
+	}
+
+	if err := validate(extension.AutoScaling); err != nil {
+		return renderers.RendererOutput{}, err
+	}
+
+	deployment, _ := kubernetes.FindDeployment(output.Resources)
+	if deployment == nil {
+		return output, nil
+	}
+
+	// The replica count is owned by the autoscaler, it must not be reset when the container is redeployed.
+	deployment.Spec.Replicas = nil
+
+	var scaler rpv1.OutputResource
+	if len(extension.AutoScaling.Triggers) > 0 {
+		scaledObject := makeScaledObject(deployment, extension.AutoScaling)
+		scaler = rpv1.NewKubernetesOutputResource(rpv1.LocalIDScaledObject, scaledObject, metav1.ObjectMeta{Name: deployment.Name, Namespace: deployment.Namespace})
+	} else {
+		hpa, err := makeHorizontalPodAutoscaler(deployment, extension.AutoScaling)
+		if err != nil {
+			return renderers.RendererOutput{}, err
+		}
+		scaler = rpv1.NewKubernetesOutputResource(rpv1.LocalIDHorizontalPodAutoscaler, hpa, hpa.ObjectMeta)
+	}
+	scaler.CreateResource.Dependencies = []string{rpv1.LocalIDDeployment}
+
+	output.Resources = append(output.Resources, scaler)
+	return output, nil
+}
+
+// validate validates the replica counts and the targets of the extension.
+func validate(ext *datamodel.AutoScalingExtension) error {
+	if ext.MaxReplicas < 1 {
+		return v1.NewClientErrInvalidRequest("autoScaling maxReplicas must be at least 1")
+	}
+
+	if ext.MinReplicas != nil {
+		if *ext.MinReplicas < 0 {
+			return v1.NewClientErrInvalidRequest("autoScaling minReplicas must not be negative")
+		}
+		if *ext.MinReplicas > ext.MaxReplicas {
+			return v1.NewClientErrInvalidRequest(fmt.Sprintf("autoScaling minReplicas %d must not be greater than maxReplicas %d", *ext.MinReplicas, ext.MaxReplicas))
+		}
+		// Scaling to zero replicas is only possible with event-driven triggers, the HorizontalPodAutoscaler requires at least one replica.
+		if *ext.MinReplicas == 0 && len(ext.Triggers) == 0 {
+			return v1.NewClientErrInvalidRequest("autoScaling minReplicas can only be 0 when triggers are configured")
+		}
+	}
+
+	if ext.TargetCPUUtilizationPercentage != nil && *ext.TargetCPUUtilizationPercentage < 1 {
+		return v1.NewClientErrInvalidRequest("autoScaling targetCpuUtilizationPercentage must be at least 1")
+	}
+
+	if ext.TargetMemoryUtilizationPercentage != nil && *ext.TargetMemoryUtilizationPercentage < 1 {
+		return v1.NewClientErrInvalidRequest("autoScaling targetMemoryUtilizationPercentage must be at least 1")
+	}
+
+	if len(ext.Metrics) > 0 && len(ext.Triggers) > 0 {
+		return v1.NewClientErrInvalidRequest("autoScaling metrics cannot be used together with triggers")
+	}
+
+	for _, metric := range ext.Metrics {
+		if metric.Name == "" {
+			return v1.NewClientErrInvalidRequest("autoScaling metric name must not be empty")
+		}
+		if _, err := resource.ParseQuantity(metric.TargetAverageValue); err != nil {
+			return v1.NewClientErrInvalidRequest(fmt.Sprintf("autoScaling metric %q has an invalid targetAverageValue %q: %s", metric.Name, metric.TargetAverageValue, err.Error()))
+		}
+	}
+
+	for _, trigger := range ext.Triggers {
+		if trigger.Type == "" {
+			return v1.NewClientErrInvalidRequest("autoScaling trigger type must not be empty")
+		}
+		if trigger.QueueLength < 1 {
+			return v1.NewClientErrInvalidRequest(fmt.Sprintf("autoScaling trigger %q queueLength must be at least 1", trigger.Type))
+		}
+	}
+
+	return nil
+}
+
+// makeHorizontalPodAutoscaler creates the HorizontalPodAutoscaler scaling the deployment on its resource utilization
+// and custom pod metrics.
+func makeHorizontalPodAutoscaler(deployment *appsv1.Deployment, ext *datamodel.AutoScalingExtension) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	metrics := []autoscalingv2.MetricSpec{}
+	if ext.TargetCPUUtilizationPercentage != nil {
+		metrics = append(metrics, resourceMetric(corev1.ResourceCPU, *ext.TargetCPUUtilizationPercentage))
+	}
+	if ext.TargetMemoryUtilizationPercentage != nil {
+		metrics = append(metrics, resourceMetric(corev1.ResourceMemory, *ext.TargetMemoryUtilizationPercentage))
+	}
+
+	for _, metric := range ext.Metrics {
+		target, err := resource.ParseQuantity(metric.TargetAverageValue)
+		if err != nil {
+			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("autoScaling metric %q has an invalid targetAverageValue %q: %s", metric.Name, metric.TargetAverageValue, err.Error()))
+		}
+
+		metrics = append(metrics, autoscalingv2.MetricSpec{
+			Type: autoscalingv2.PodsMetricSourceType,
+			Pods: &autoscalingv2.PodsMetricSource{
+				Metric: autoscalingv2.MetricIdentifier{Name: metric.Name},
+				Target: autoscalingv2.MetricTarget{
+					Type:         autoscalingv2.AverageValueMetricType,
+					AverageValue: &target,
+				},
+			},
+		})
+	}
+
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "HorizontalPodAutoscaler",
+			APIVersion: autoscalingv2.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      deployment.Name,
+			Namespace: deployment.Namespace,
+			Labels:    copyLabels(deployment.Labels),
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				Kind:       "Deployment",
+				Name:       deployment.Name,
+				APIVersion: appsv1.SchemeGroupVersion.String(),
+			},
+			MinReplicas: ext.MinReplicas,
+			MaxReplicas: ext.MaxReplicas,
+		},
+	}
+
+	// The HorizontalPodAutoscaler defaults to a CPU utilization target when no metric is set.
+	if len(metrics) > 0 {
+		hpa.Spec.Metrics = metrics
+	}
+
+	return hpa, nil
+}
+
+func resourceMetric(name corev1.ResourceName, utilization int32) autoscalingv2.MetricSpec {
+	return autoscalingv2.MetricSpec{
+		Type: autoscalingv2.ResourceMetricSourceType,
+		Resource: &autoscalingv2.ResourceMetricSource{
+			Name: name,
+			Target: autoscalingv2.MetricTarget{
+				Type:               autoscalingv2.UtilizationMetricType,
+				AverageUtilization: &utilization,
+			},
+		},
+	}
+}
+
+// makeScaledObject creates the KEDA ScaledObject scaling the deployment on the configured triggers and resource
+// utilization. KEDA manages a HorizontalPodAutoscaler for the deployment itself.
+func makeScaledObject(deployment *appsv1.Deployment, ext *datamodel.AutoScalingExtension) *unstructured.Unstructured {
+	triggers := []any{}
+	if ext.TargetCPUUtilizationPercentage != nil {
+		triggers = append(triggers, resourceTrigger("cpu", *ext.TargetCPUUtilizationPercentage))
+	}
+	if ext.TargetMemoryUtilizationPercentage != nil {
+		triggers = append(triggers, resourceTrigger("memory", *ext.TargetMemoryUtilizationPercentage))
+	}
+
+	for _, trigger := range ext.Triggers {
+		metadata := map[string]any{}
+		for k, v := range trigger.Metadata {
+			metadata[k] = v
+		}
+
+		key, ok := queueLengthMetadataKeys[trigger.Type]
+		if !ok {
+			key = defaultQueueLengthMetadataKey
+		}
+		metadata[key] = strconv.Itoa(int(trigger.QueueLength))
+
+		// The RabbitMQ scaler can also scale on the message rate, the mode selects the queue length.
+		if _, ok := metadata["mode"]; !ok && trigger.Type == "rabbitmq" {
+			metadata["mode"] = "QueueLength"
+		}
+
+		triggers = append(triggers, map[string]any{
+			"type":     trigger.Type,
+			"metadata": metadata,
+		})
+	}
+
+	spec := map[string]any{
+		"scaleTargetRef": map[string]any{
+			"apiVersion": appsv1.SchemeGroupVersion.String(),
+			"kind":       "Deployment",
+			"name":       deployment.Name,
+		},
+		"maxReplicaCount": int64(ext.MaxReplicas),
+		"triggers":        triggers,
+	}
+	if ext.MinReplicas != nil {
+		spec["minReplicaCount"] = int64(*ext.MinReplicas)
+	}
+
+	scaledObject := &unstructured.Unstructured{
+		Object: map[string]any{
+			"spec": spec,
+		},
+	}
+	scaledObject.SetAPIVersion(ScaledObjectAPIVersion)
+	scaledObject.SetKind(ScaledObjectKind)
+	scaledObject.SetName(deployment.Name)
+	scaledObject.SetNamespace(deployment.Namespace)
+	scaledObject.SetLabels(copyLabels(deployment.Labels))
+
+	return scaledObject
+}
+
+func resourceTrigger(name string, utilization int32) map[string]any {
+	return map[string]any{
+		"type":       name,
+		"metricType": string(autoscalingv2.UtilizationMetricType),
+		"metadata": map[string]any{
+			"value": strconv.Itoa(int(utilization)),
+		},
+	}
+}
+
+func copyLabels(labels map[string]string) map[string]string {
+	if labels == nil {
+		return nil
+	}
+
+	copied := map[string]string{}
+	for k, v := range labels {
+		copied[k] = v
+	}
+
+	return copied
+}
@@ -0,0 +1,326 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscale
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	k8s_resource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+var _ renderers.Renderer = (*noop)(nil)
+
+type noop struct {
+}
+
+func (r *noop) GetDependencyIDs(ctx context.Context, resource v1.DataModelInterface) ([]resources.ID, []resources.ID, error) {
+	return nil, nil, nil
+}
+
+func (r *noop) Render(ctx context.Context, dm v1.DataModelInterface, options renderers.RenderOptions) (renderers.RendererOutput, error) {
+	// Return a deployment so the autoscale extension can scale it
+	deployment := appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-deployment",
+			Namespace: "test-namespace",
+			Labels:    map[string]string{"app": "test"},
+		},
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: "apps/v1",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: to.Ptr[int32](1),
+		},
+	}
+	resources := []rpv1.OutputResource{rpv1.NewKubernetesOutputResource(rpv1.LocalIDDeployment, &deployment, deployment.ObjectMeta)}
+	return renderers.RendererOutput{Resources: resources}, nil
+}
+
+func Test_Render_HorizontalPodAutoscaler(t *testing.T) {
+	renderer := &Renderer{Inner: &noop{}}
+
+	resource := makeResource(t, datamodel.Extension{
+		Kind: datamodel.AutoScaling,
+		AutoScaling: &datamodel.AutoScalingExtension{
+			MinReplicas:                       to.Ptr[int32](2),
+			MaxReplicas:                       10,
+			TargetCPUUtilizationPercentage:    to.Ptr[int32](70),
+			TargetMemoryUtilizationPercentage: to.Ptr[int32](80),
+			Metrics: []datamodel.AutoScalingMetric{
+				{Name: "http_requests_per_second", TargetAverageValue: "100"},
+			},
+		},
+	})
+
+	output, err := renderer.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 2)
+
+	deployment, _ := kubernetes.FindDeployment(output.Resources)
+	require.NotNil(t, deployment)
+	require.Nil(t, deployment.Spec.Replicas)
+
+	scaler := output.Resources[1]
+	require.Equal(t, rpv1.LocalIDHorizontalPodAutoscaler, scaler.LocalID)
+	require.Equal(t, []string{rpv1.LocalIDDeployment}, scaler.CreateResource.Dependencies)
+	require.Equal(t, "/planes/kubernetes/local/namespaces/test-namespace/providers/autoscaling/HorizontalPodAutoscaler/test-deployment", scaler.ID.String())
+
+	hpa, ok := scaler.CreateResource.Data.(*autoscalingv2.HorizontalPodAutoscaler)
+	require.True(t, ok)
+	require.Equal(t, map[string]string{"app": "test"}, hpa.Labels)
+
+	expectedSpec := autoscalingv2.HorizontalPodAutoscalerSpec{
+		ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+			Kind:       "Deployment",
+			Name:       "test-deployment",
+			APIVersion: "apps/v1",
+		},
+		MinReplicas: to.Ptr[int32](2),
+		MaxReplicas: 10,
+		Metrics: []autoscalingv2.MetricSpec{
+			{
+				Type: autoscalingv2.ResourceMetricSourceType,
+				Resource: &autoscalingv2.ResourceMetricSource{
+					Name:   corev1.ResourceCPU,
+					Target: autoscalingv2.MetricTarget{Type: autoscalingv2.UtilizationMetricType, AverageUtilization: to.Ptr[int32](70)},
+				},
+			},
+			{
+				Type: autoscalingv2.ResourceMetricSourceType,
+				Resource: &autoscalingv2.ResourceMetricSource{
+					Name:   corev1.ResourceMemory,
+					Target: autoscalingv2.MetricTarget{Type: autoscalingv2.UtilizationMetricType, AverageUtilization: to.Ptr[int32](80)},
+				},
+			},
+			{
+				Type: autoscalingv2.PodsMetricSourceType,
+				Pods: &autoscalingv2.PodsMetricSource{
+					Metric: autoscalingv2.MetricIdentifier{Name: "http_requests_per_second"},
+					Target: autoscalingv2.MetricTarget{Type: autoscalingv2.AverageValueMetricType, AverageValue: to.Ptr(k8s_resource.MustParse("100"))},
+				},
+			},
+		},
+	}
+	require.Equal(t, expectedSpec, hpa.Spec)
+}
+
+func Test_Render_ScaledObject(t *testing.T) {
+	renderer := &Renderer{Inner: &noop{}}
+
+	resource := makeResource(t, datamodel.Extension{
+		Kind: datamodel.AutoScaling,
+		AutoScaling: &datamodel.AutoScalingExtension{
+			MinReplicas:                    to.Ptr[int32](0),
+			MaxReplicas:                    5,
+			TargetCPUUtilizationPercentage: to.Ptr[int32](70),
+			Triggers: []datamodel.AutoScalingTrigger{
+				{
+					Type:        "rabbitmq",
+					QueueLength: 20,
+					Metadata:    map[string]string{"queueName": "orders", "hostFromEnv": "RABBITMQ_HOST"},
+				},
+				{
+					Type:        "azure-servicebus",
+					QueueLength: 5,
+					Metadata:    map[string]string{"queueName": "invoices"},
+				},
+			},
+		},
+	})
+
+	output, err := renderer.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 2)
+
+	deployment, _ := kubernetes.FindDeployment(output.Resources)
+	require.NotNil(t, deployment)
+	require.Nil(t, deployment.Spec.Replicas)
+
+	scaler := output.Resources[1]
+	require.Equal(t, rpv1.LocalIDScaledObject, scaler.LocalID)
+	require.Equal(t, []string{rpv1.LocalIDDeployment}, scaler.CreateResource.Dependencies)
+	require.Equal(t, "/planes/kubernetes/local/namespaces/test-namespace/providers/keda.sh/ScaledObject/test-deployment", scaler.ID.String())
+
+	scaledObject, ok := scaler.CreateResource.Data.(*unstructured.Unstructured)
+	require.True(t, ok)
+	require.Equal(t, ScaledObjectAPIVersion, scaledObject.GetAPIVersion())
+	require.Equal(t, ScaledObjectKind, scaledObject.GetKind())
+	require.Equal(t, "test-deployment", scaledObject.GetName())
+	require.Equal(t, "test-namespace", scaledObject.GetNamespace())
+
+	expectedSpec := map[string]any{
+		"scaleTargetRef": map[string]any{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"name":       "test-deployment",
+		},
+		"minReplicaCount": int64(0),
+		"maxReplicaCount": int64(5),
+		"triggers": []any{
+			map[string]any{
+				"type":       "cpu",
+				"metricType": "Utilization",
+				"metadata":   map[string]any{"value": "70"},
+			},
+			map[string]any{
+				"type": "rabbitmq",
+				"metadata": map[string]any{
+					"queueName":   "orders",
+					"hostFromEnv": "RABBITMQ_HOST",
+					"mode":        "QueueLength",
+					"value":       "20",
+				},
+			},
+			map[string]any{
+				"type": "azure-servicebus",
+				"metadata": map[string]any{
+					"queueName":    "invoices",
+					"messageCount": "5",
+				},
+			},
+		},
+	}
+	require.Equal(t, expectedSpec, scaledObject.Object["spec"])
+}
+
+func Test_Render_NoExtension(t *testing.T) {
+	renderer := &Renderer{Inner: &noop{}}
+
+	resource := makeResource(t)
+
+	output, err := renderer.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 1)
+
+	deployment, _ := kubernetes.FindDeployment(output.Resources)
+	require.NotNil(t, deployment)
+	require.Equal(t, to.Ptr[int32](1), deployment.Spec.Replicas)
+}
+
+func Test_Render_InvalidExtension(t *testing.T) {
+	tests := []struct {
+		name       string
+		extensions []datamodel.Extension
+		err        string
+	}{
+		{
+			name: "manual scaling",
+			extensions: []datamodel.Extension{
+				{Kind: datamodel.ManualScaling, ManualScaling: &datamodel.ManualScalingExtension{Replicas: to.Ptr[int32](2)}},
+				{Kind: datamodel.AutoScaling, AutoScaling: &datamodel.AutoScalingExtension{MaxReplicas: 3}},
+			},
+			err: "the manualScaling and autoScaling extensions cannot be used together",
+		},
+		{
+			name:       "missing max replicas",
+			extensions: []datamodel.Extension{{Kind: datamodel.AutoScaling, AutoScaling: &datamodel.AutoScalingExtension{}}},
+			err:        "autoScaling maxReplicas must be at least 1",
+		},
+		{
+			name: "min greater than max",
+			extensions: []datamodel.Extension{
+				{Kind: datamodel.AutoScaling, AutoScaling: &datamodel.AutoScalingExtension{MinReplicas: to.Ptr[int32](4), MaxReplicas: 3}},
+			},
+			err: "autoScaling minReplicas 4 must not be greater than maxReplicas 3",
+		},
+		{
+			name: "scale to zero without triggers",
+			extensions: []datamodel.Extension{
+				{Kind: datamodel.AutoScaling, AutoScaling: &datamodel.AutoScalingExtension{MinReplicas: to.Ptr[int32](0), MaxReplicas: 3}},
+			},
+			err: "autoScaling minReplicas can only be 0 when triggers are configured",
+		},
+		{
+			name: "invalid metric target",
+			extensions: []datamodel.Extension{
+				{Kind: datamodel.AutoScaling, AutoScaling: &datamodel.AutoScalingExtension{
+					MaxReplicas: 3,
+					Metrics:     []datamodel.AutoScalingMetric{{Name: "requests", TargetAverageValue: "lots"}},
+				}},
+			},
+			err: "autoScaling metric \"requests\" has an invalid targetAverageValue \"lots\": quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'",
+		},
+		{
+			name: "metrics and triggers",
+			extensions: []datamodel.Extension{
+				{Kind: datamodel.AutoScaling, AutoScaling: &datamodel.AutoScalingExtension{
+					MaxReplicas: 3,
+					Metrics:     []datamodel.AutoScalingMetric{{Name: "requests", TargetAverageValue: "10"}},
+					Triggers:    []datamodel.AutoScalingTrigger{{Type: "rabbitmq", QueueLength: 10}},
+				}},
+			},
+			err: "autoScaling metrics cannot be used together with triggers",
+		},
+		{
+			name: "missing queue length",
+			extensions: []datamodel.Extension{
+				{Kind: datamodel.AutoScaling, AutoScaling: &datamodel.AutoScalingExtension{
+					MaxReplicas: 3,
+					Triggers:    []datamodel.AutoScalingTrigger{{Type: "rabbitmq"}},
+				}},
+			},
+			err: "autoScaling trigger \"rabbitmq\" queueLength must be at least 1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			renderer := &Renderer{Inner: &noop{}}
+			resource := makeResource(t, tt.extensions...)
+
+			_, err := renderer.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}})
+			require.Equal(t, v1.NewClientErrInvalidRequest(tt.err), err)
+		})
+	}
+}
+
+func makeResource(t *testing.T, extensions ...datamodel.Extension) *datamodel.ContainerResource {
+	resource := datamodel.ContainerResource{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   "/subscriptions/test-sub-id/resourceGroups/test-group/providers/Applications.Core/containers/test-container",
+				Name: "test-container",
+				Type: "Applications.Core/containers",
+			},
+		},
+		Properties: datamodel.ContainerProperties{
+			BasicResourceProperties: rpv1.BasicResourceProperties{
+				Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-app",
+			},
+			Container: datamodel.Container{
+				Image: "someimage:latest",
+			},
+			Extensions: extensions,
+		},
+	}
+	return &resource
+}
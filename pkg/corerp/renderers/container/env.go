@@ -0,0 +1,177 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package container
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+)
+
+// makeEnvVars merges the user-defined environment variables into the given environment variables and returns
+// them in sorted order. Values referenced from connections are added to secretData, so that they are read from the
+// secret of the container rather than stored in plain text in the deployment.
+func makeEnvVars(env map[string]corev1.EnvVar, values map[string]datamodel.EnvironmentVariable, resource *datamodel.ContainerResource, options renderers.RenderOptions, secretData map[string][]byte) ([]corev1.EnvVar, error) {
+	if env == nil {
+		env = map[string]corev1.EnvVar{}
+	}
+
+	for name, value := range values {
+		envVar, err := makeEnvVar(name, value, resource, options, secretData)
+		if err != nil {
+			return nil, err
+		}
+		env[name] = envVar
+	}
+
+	var result []corev1.EnvVar
+	for _, key := range getSortedKeys(env) {
+		result = append(result, env[key])
+	}
+
+	return result, nil
+}
+
+func makeEnvVar(name string, value datamodel.EnvironmentVariable, resource *datamodel.ContainerResource, options renderers.RenderOptions, secretData map[string][]byte) (corev1.EnvVar, error) {
+	if value.ValueFrom == nil {
+		return corev1.EnvVar{Name: name, Value: to.String(value.Value)}, nil
+	}
+
+	var selector *corev1.SecretKeySelector
+	var err error
+	switch {
+	case value.ValueFrom.SecretRef != nil:
+		selector, err = makeSecretStoreKeySelector(name, value.ValueFrom.SecretRef, options)
+	case value.ValueFrom.ConnectionRef != nil:
+		selector, err = makeConnectionKeySelector(name, value.ValueFrom.ConnectionRef, resource, options.Dependencies, secretData)
+	default:
+		err = v1.NewClientErrInvalidRequest(fmt.Sprintf("environment variable %s must specify either valueFrom.secretRef or valueFrom.connectionRef", name))
+	}
+	if err != nil {
+		return corev1.EnvVar{}, err
+	}
+
+	return corev1.EnvVar{Name: name, ValueFrom: &corev1.EnvVarSource{SecretKeyRef: selector}}, nil
+}
+
+// makeSecretStoreKeySelector references the key of the Kubernetes secret of the given secret store. Kubernetes only
+// allows a pod to reference secrets in its own namespace, so the secret store must be in the namespace of the container.
+func makeSecretStoreKeySelector(name string, ref *datamodel.EnvironmentVariableSecretReference, options renderers.RenderOptions) (*corev1.SecretKeySelector, error) {
+	dependency, ok := options.Dependencies[ref.Source]
+	if !ok {
+		return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", ref.Source))
+	}
+
+	secretStore, ok := dependency.Resource.(*datamodel.SecretStore)
+	if !ok {
+		return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("environment variable %s must reference a secretStore resource", name))
+	}
+
+	if _, ok := secretStore.Properties.Data[ref.Key]; !ok {
+		return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s does not contain the key %q referenced by environment variable %s", ref.Source, ref.Key, name))
+	}
+
+	secretResourceID, ok := dependency.OutputResources[rpv1.LocalIDSecret]
+	if !ok {
+		return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", ref.Source))
+	}
+
+	namespace := secretResourceID.FindScope(resources_kubernetes.ScopeNamespaces)
+	if namespace != options.Environment.Namespace {
+		return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s referenced by environment variable %s must be in the namespace %q of the container, but is in %q", ref.Source, name, options.Environment.Namespace, namespace))
+	}
+
+	return &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: secretResourceID.Name()},
+		Key:                  ref.Key,
+	}, nil
+}
+
+// makeConnectionKeySelector stores the referenced value of the connection in the secret of the container and
+// references it. The key is the same as the one used by the default environment variables of the connection.
+func makeConnectionKeySelector(name string, ref *datamodel.EnvironmentVariableConnectionReference, resource *datamodel.ContainerResource, dependencies map[string]renderers.RendererDependency, secretData map[string][]byte) (*corev1.SecretKeySelector, error) {
+	connection, ok := resource.Properties.Connections[ref.Name]
+	if !ok {
+		return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("connection %s referenced by environment variable %s not found", ref.Name, name))
+	}
+
+	dependency, ok := dependencies[connection.Source]
+	if !ok {
+		return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("connection %s referenced by environment variable %s must have a Radius resource as its source", ref.Name, name))
+	}
+
+	value, ok := connectionValueToString(dependency.ComputedValues[ref.Key])
+	if !ok {
+		return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("connection %s does not have the value %q referenced by environment variable %s", ref.Name, ref.Key, name))
+	}
+
+	key := fmt.Sprintf("%s_%s_%s", "CONNECTION", strings.ToUpper(ref.Name), strings.ToUpper(ref.Key))
+	secretData[key] = []byte(value)
+
+	return &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: kubernetes.NormalizeResourceName(resource.Name)},
+		Key:                  key,
+	}, nil
+}
+
+// connectionValueToString converts a computed value of a connected resource to its string representation. Floats
+// are used by the JSON serializer for numbers.
+func connectionValueToString(value any) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case int:
+		return strconv.Itoa(v), true
+	}
+
+	return "", false
+}
+
+// makeSecretStoreHash returns a hash of the secret data of the secret stores referenced by the environment variables
+// of the containers, or an empty string if no secret store is referenced. Environment variables are only read when a
+// container starts, so the hash is added to the pod template to roll out a new revision when the secret data changes.
+func makeSecretStoreHash(properties datamodel.ContainerProperties, dependencies map[string]renderers.RendererDependency) string {
+	hashes := map[string][]byte{}
+	for _, c := range getAllContainers(properties) {
+		for _, value := range c.Env {
+			if value.ValueFrom == nil || value.ValueFrom.SecretRef == nil {
+				continue
+			}
+
+			source := value.ValueFrom.SecretRef.Source
+			hashes[source] = []byte(dependencies[source].SecretDataHash)
+		}
+	}
+
+	if len(hashes) == 0 {
+		return ""
+	}
+
+	return kubernetes.HashSecretData(hashes)
+}
@@ -23,7 +23,6 @@ import (
 	"net"
 	"net/url"
 	"sort"
-	"strconv"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
@@ -112,36 +111,53 @@ func (r Renderer) GetDependencyIDs(ctx context.Context, dm v1.DataModelInterface
 		}
 	}
 
-	for _, port := range properties.Container.Ports {
-		provides := port.Provides
+	for _, c := range getAllContainers(properties) {
+		for _, port := range c.Ports {
+			provides := port.Provides
 
-		// if provides is empty, skip this port. A service for this port will be generated later on.
-		if provides == "" {
-			continue
-		}
+			// if provides is empty, skip this port. A service for this port will be generated later on.
+			if provides == "" {
+				continue
+			}
 
-		resourceID, err := resources.ParseResource(provides)
-		if err != nil {
-			return nil, nil, v1.NewClientErrInvalidRequest(err.Error())
-		}
+			resourceID, err := resources.ParseResource(provides)
+			if err != nil {
+				return nil, nil, v1.NewClientErrInvalidRequest(err.Error())
+			}
 
-		if resources_radius.IsRadiusResource(resourceID) {
-			radiusResourceIDs = append(radiusResourceIDs, resourceID)
-			continue
+			if resources_radius.IsRadiusResource(resourceID) {
+				radiusResourceIDs = append(radiusResourceIDs, resourceID)
+				continue
+			}
 		}
-	}
 
-	for _, volume := range properties.Container.Volumes {
-		switch volume.Kind {
-		case datamodel.Persistent:
-			resourceID, err := resources.ParseResource(volume.Persistent.Source)
+		for _, value := range c.Env {
+			if value.ValueFrom == nil || value.ValueFrom.SecretRef == nil {
+				continue
+			}
+
+			resourceID, err := resources.ParseResource(value.ValueFrom.SecretRef.Source)
 			if err != nil {
 				return nil, nil, v1.NewClientErrInvalidRequest(err.Error())
 			}
 
 			if resources_radius.IsRadiusResource(resourceID) {
 				radiusResourceIDs = append(radiusResourceIDs, resourceID)
-				continue
+			}
+		}
+
+		for _, volume := range c.Volumes {
+			switch volume.Kind {
+			case datamodel.Persistent:
+				resourceID, err := resources.ParseResource(volume.Persistent.Source)
+				if err != nil {
+					return nil, nil, v1.NewClientErrInvalidRequest(err.Error())
+				}
+
+				if resources_radius.IsRadiusResource(resourceID) {
+					radiusResourceIDs = append(radiusResourceIDs, resourceID)
+					continue
+				}
 			}
 		}
 	}
@@ -197,21 +213,23 @@ func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 		}
 	}
 
-	for portName, port := range properties.Container.Ports {
-		// if the container has an exposed port, note that down.
-		// A single service will be generated for a container with one or more exposed ports.
-		if port.ContainerPort == 0 {
-			return renderers.RendererOutput{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid ports definition: must define a ContainerPort, but ContainerPort is: %d.", port.ContainerPort))
-		}
+	for _, c := range getServingContainers(properties) {
+		for portName, port := range c.Ports {
+			// if the container has an exposed port, note that down.
+			// A single service will be generated for a container with one or more exposed ports.
+			if port.ContainerPort == 0 {
+				return renderers.RendererOutput{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid ports definition: must define a ContainerPort, but ContainerPort is: %d.", port.ContainerPort))
+			}
 
-		if port.Port == 0 {
-			port.Port = port.ContainerPort
-			properties.Container.Ports[portName] = port
-		}
+			if port.Port == 0 {
+				port.Port = port.ContainerPort
+				c.Ports[portName] = port
+			}
 
-		// if the container has an exposed port, but no 'provides' field, it requires DNS service generation.
-		if port.Provides == "" {
-			needsServiceGeneration = true
+			// if the container has an exposed port, but no 'provides' field, it requires DNS service generation.
+			if port.Provides == "" {
+				needsServiceGeneration = true
+			}
 		}
 	}
 
@@ -261,15 +279,17 @@ func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 
 	// If the container has an exposed port and uses DNS-SD, generate a service for it.
 	if needsServiceGeneration {
-		for portName, port := range resource.Properties.Container.Ports {
-			// store portNames and portValues for use in service generation.
-			servicePort := corev1.ServicePort{
-				Name:       portName,
-				Port:       port.Port,
-				TargetPort: intstr.FromInt(int(port.ContainerPort)),
-				Protocol:   corev1.ProtocolTCP,
+		for _, c := range getServingContainers(resource.Properties) {
+			for portName, port := range c.Ports {
+				// store portNames and portValues for use in service generation.
+				servicePort := corev1.ServicePort{
+					Name:       portName,
+					Port:       port.Port,
+					TargetPort: intstr.FromInt(int(port.ContainerPort)),
+					Protocol:   corev1.ProtocolTCP,
+				}
+				servicePorts = append(servicePorts, servicePort)
 			}
-			servicePorts = append(servicePorts, servicePort)
 		}
 
 		// if a container has an exposed port, then we need to create a service for it.
@@ -290,6 +310,30 @@ func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 	}, nil
 }
 
+// getServingContainers returns the main container followed by the sidecars of the container resource. Init
+// containers are excluded because they run to completion before the pod starts serving.
+func getServingContainers(properties datamodel.ContainerProperties) []datamodel.Container {
+	containers := []datamodel.Container{properties.Container}
+	for _, c := range properties.Sidecars {
+		containers = append(containers, c.Container)
+	}
+
+	return containers
+}
+
+// getAllContainers returns the main container followed by the init containers and sidecars of the container resource.
+func getAllContainers(properties datamodel.ContainerProperties) []datamodel.Container {
+	containers := []datamodel.Container{properties.Container}
+	for _, c := range properties.InitContainers {
+		containers = append(containers, c.Container)
+	}
+	for _, c := range properties.Sidecars {
+		containers = append(containers, c.Container)
+	}
+
+	return containers
+}
+
 func (r Renderer) makeService(base *corev1.Service, resource *datamodel.ContainerResource, options renderers.RenderOptions, ctx context.Context, servicePorts []corev1.ServicePort) (rpv1.OutputResource, error) {
 	appId, err := resources.ParseResource(resource.Properties.Application)
 	if err != nil {
@@ -326,10 +370,7 @@ func (r Renderer) makeDeployment(
 	resource *datamodel.ContainerResource,
 	roles []rpv1.OutputResource) ([]rpv1.OutputResource, map[string][]byte, error) {
 	// Keep track of the set of routes, we will need these to generate labels later
-	routes := []struct {
-		Name string
-		Type string
-	}{}
+	routes := []route{}
 
 	// If the container requires azure role, it needs to configure workload identity (aka federated identity).
 	identityRequired := len(roles) > 0
@@ -350,63 +391,11 @@ func (r Renderer) makeDeployment(
 		}
 	}
 
-	ports := []corev1.ContainerPort{}
-	for _, port := range properties.Container.Ports {
-		if provides := port.Provides; provides != "" {
-			resourceId, err := resources.ParseResource(provides)
-			if err != nil {
-				return []rpv1.OutputResource{}, nil, v1.NewClientErrInvalidRequest(err.Error())
-			}
-
-			routeName := kubernetes.NormalizeResourceName(resourceId.Name())
-			routeType := resourceId.TypeSegments()[len(resourceId.TypeSegments())-1].Type
-			routeTypeParts := strings.Split(routeType, "/")
-
-			routeTypeSuffix := kubernetes.NormalizeResourceName(routeTypeParts[len(routeTypeParts)-1])
-
-			routes = append(routes, struct {
-				Name string
-				Type string
-			}{Name: routeName, Type: routeTypeSuffix})
-
-			ports = append(ports, corev1.ContainerPort{
-				// Name generation logic has to match the code in HttpRoute
-				Name:          kubernetes.GetShortenedTargetPortName(routeTypeSuffix + routeName),
-				ContainerPort: port.ContainerPort,
-				Protocol:      corev1.ProtocolTCP,
-			})
-		} else {
-			ports = append(ports, corev1.ContainerPort{
-				ContainerPort: port.ContainerPort,
-				Protocol:      corev1.ProtocolTCP,
-			})
-		}
-	}
-
-	container.Image = properties.Container.Image
-	container.Ports = append(container.Ports, ports...)
-	container.Command = properties.Container.Command
-	container.Args = properties.Container.Args
-	container.WorkingDir = properties.Container.WorkingDir
-
-	// If the user has specified an image pull policy, use it. Else, we will use Kubernetes default.
-	if properties.Container.ImagePullPolicy != "" {
-		container.ImagePullPolicy = corev1.PullPolicy(properties.Container.ImagePullPolicy)
-	}
-
-	var err error
-	if !properties.Container.ReadinessProbe.IsEmpty() {
-		container.ReadinessProbe, err = r.makeHealthProbe(properties.Container.ReadinessProbe)
-		if err != nil {
-			return []rpv1.OutputResource{}, nil, fmt.Errorf("readiness probe encountered errors: %w ", err)
-		}
-	}
-	if !properties.Container.LivenessProbe.IsEmpty() {
-		container.LivenessProbe, err = r.makeHealthProbe(properties.Container.LivenessProbe)
-		if err != nil {
-			return []rpv1.OutputResource{}, nil, fmt.Errorf("liveness probe encountered errors: %w ", err)
-		}
+	containerRoutes, err := r.populateContainer(container, properties.Container, options.Environment.DefaultContainerResources)
+	if err != nil {
+		return []rpv1.OutputResource{}, nil, err
 	}
+	routes = append(routes, containerRoutes...)
 
 	// We build the environment variable list in a stable order for testability
 	// For the values that come from connections we back them with secretData. We'll extract the values
@@ -416,13 +405,42 @@ func (r Renderer) makeDeployment(
 		return []rpv1.OutputResource{}, nil, fmt.Errorf("failed to obtain environment variables and secret data: %w", err)
 	}
 
-	for k, v := range properties.Container.Env {
-		env[k] = corev1.EnvVar{Name: k, Value: v}
+	envVars, err := makeEnvVars(env, properties.Container.Env, resource, options, secretData)
+	if err != nil {
+		return []rpv1.OutputResource{}, nil, err
 	}
+	container.Env = append(container.Env, envVars...)
+
+	// Init containers and sidecars are populated on a copy of the matching container from the base manifest, if any,
+	// and are merged into the PodSpec once all of the containers have been populated.
+	initContainers := getNamedContainersBase(podSpec.InitContainers, properties.InitContainers)
+	for i, c := range properties.InitContainers {
+		containerRoutes, err := r.populateContainer(&initContainers[i], c.Container, options.Environment.DefaultContainerResources)
+		if err != nil {
+			return []rpv1.OutputResource{}, nil, err
+		}
+		routes = append(routes, containerRoutes...)
 
-	// Append in sorted order
-	for _, key := range getSortedKeys(env) {
-		container.Env = append(container.Env, env[key])
+		envVars, err := makeEnvVars(nil, c.Env, resource, options, secretData)
+		if err != nil {
+			return []rpv1.OutputResource{}, nil, err
+		}
+		initContainers[i].Env = append(initContainers[i].Env, envVars...)
+	}
+
+	sidecars := getNamedContainersBase(podSpec.Containers, properties.Sidecars)
+	for i, c := range properties.Sidecars {
+		containerRoutes, err := r.populateContainer(&sidecars[i], c.Container, options.Environment.DefaultContainerResources)
+		if err != nil {
+			return []rpv1.OutputResource{}, nil, err
+		}
+		routes = append(routes, containerRoutes...)
+
+		envVars, err := makeEnvVars(nil, c.Env, resource, options, secretData)
+		if err != nil {
+			return []rpv1.OutputResource{}, nil, err
+		}
+		sidecars[i].Env = append(sidecars[i].Env, envVars...)
 	}
 
 	outputResources := []rpv1.OutputResource{}
@@ -441,95 +459,116 @@ func (r Renderer) makeDeployment(
 	// To avoid the naming conflicts, we add the application name prefix to resource name.
 	azIdentityName := azrenderer.MakeResourceName(applicationName, resource.Name, azrenderer.Separator)
 
-	for volumeName, volumeProperties := range properties.Container.Volumes {
-		// Based on the kind, create a persistent/ephemeral volume
-		switch volumeProperties.Kind {
-		case datamodel.Ephemeral:
-			volumeSpec, volumeMountSpec, err := makeEphemeralVolume(volumeName, volumeProperties.Ephemeral)
-			if err != nil {
-				return []rpv1.OutputResource{}, nil, fmt.Errorf("unable to create ephemeral volume spec for volume: %s - %w", volumeName, err)
-			}
-			// Add the volume mount to the Container spec
-			container.VolumeMounts = append(container.VolumeMounts, volumeMountSpec)
-			// Add the volume to the list of volumes to be added to the Volumes spec
-			volumes = append(volumes, volumeSpec)
-		case datamodel.Persistent:
-			var volumeSpec corev1.Volume
-			var volumeMountSpec corev1.VolumeMount
-
-			properties, ok := dependencies[volumeProperties.Persistent.Source]
-			if !ok {
-				return []rpv1.OutputResource{}, nil, errors.New("volume dependency resource not found")
-			}
+	volumeTargets := []volumeTarget{{container: container, volumes: properties.Container.Volumes}}
+	for i, c := range properties.InitContainers {
+		volumeTargets = append(volumeTargets, volumeTarget{container: &initContainers[i], volumes: c.Volumes})
+	}
+	for i, c := range properties.Sidecars {
+		volumeTargets = append(volumeTargets, volumeTarget{container: &sidecars[i], volumes: c.Volumes})
+	}
 
-			vol, ok := properties.Resource.(*datamodel.VolumeResource)
-			if !ok {
-				return []rpv1.OutputResource{}, nil, errors.New("invalid dependency resource")
+	volumeMounts := map[string]corev1.VolumeMount{}
+	for _, target := range volumeTargets {
+		for volumeName, volumeProperties := range target.volumes {
+			// Volumes are shared by name across the containers of the pod. A volume which has already been
+			// created for another container only needs to be mounted.
+			if mount, ok := volumeMounts[volumeName]; ok {
+				mount.MountPath = getVolumeMountPath(volumeProperties)
+				target.container.VolumeMounts = append(target.container.VolumeMounts, mount)
+				continue
 			}
 
-			switch vol.Properties.Kind {
-			case datamodel.AzureKeyVaultVolume:
-				// This will add the required managed identity resources.
-				identityRequired = true
-
-				// Prepare role assignments
-				roleNames := []string{}
-				if len(vol.Properties.AzureKeyVault.Secrets) > 0 {
-					roleNames = append(roleNames, AzureKeyVaultSecretsUserRole)
+			// Based on the kind, create a persistent/ephemeral volume
+			switch volumeProperties.Kind {
+			case datamodel.Ephemeral:
+				volumeSpec, volumeMountSpec, err := makeEphemeralVolume(volumeName, volumeProperties.Ephemeral)
+				if err != nil {
+					return []rpv1.OutputResource{}, nil, fmt.Errorf("unable to create ephemeral volume spec for volume: %s - %w", volumeName, err)
 				}
-				if len(vol.Properties.AzureKeyVault.Certificates) > 0 || len(vol.Properties.AzureKeyVault.Keys) > 0 {
-					roleNames = append(roleNames, AzureKeyVaultCryptoUserRole)
+				// Add the volume mount to the Container spec
+				target.container.VolumeMounts = append(target.container.VolumeMounts, volumeMountSpec)
+				volumeMounts[volumeName] = volumeMountSpec
+				// Add the volume to the list of volumes to be added to the Volumes spec
+				volumes = append(volumes, volumeSpec)
+			case datamodel.Persistent:
+				var volumeSpec corev1.Volume
+				var volumeMountSpec corev1.VolumeMount
+
+				properties, ok := dependencies[volumeProperties.Persistent.Source]
+				if !ok {
+					return []rpv1.OutputResource{}, nil, errors.New("volume dependency resource not found")
 				}
 
-				// Build RoleAssignment output.resource
-				kvID := vol.Properties.AzureKeyVault.Resource
-				roleAssignments, raDeps := azrenderer.MakeRoleAssignments(kvID, roleNames)
-				outputResources = append(outputResources, roleAssignments...)
-				deps = append(deps, raDeps...)
-
-				// Create Per-Pod SecretProviderClass for the selected volume
-				// csiobjectspec must be generated when volume is updated.
-				objectSpec, err := handlers.GetMapValue[string](properties.ComputedValues, azvolrenderer.SPCVolumeObjectSpecKey)
-				if err != nil {
-					return []rpv1.OutputResource{}, nil, err
+				vol, ok := properties.Resource.(*datamodel.VolumeResource)
+				if !ok {
+					return []rpv1.OutputResource{}, nil, errors.New("invalid dependency resource")
 				}
 
-				spcName := kubernetes.NormalizeResourceName(vol.Name)
-				secretProvider, err := azrenderer.MakeKeyVaultSecretProviderClass(applicationName, spcName, vol, objectSpec, &options.Environment)
-				if err != nil {
-					return []rpv1.OutputResource{}, nil, err
+				switch vol.Properties.Kind {
+				case datamodel.AzureKeyVaultVolume:
+					// This will add the required managed identity resources.
+					identityRequired = true
+
+					// Prepare role assignments
+					roleNames := []string{}
+					if len(vol.Properties.AzureKeyVault.Secrets) > 0 {
+						roleNames = append(roleNames, AzureKeyVaultSecretsUserRole)
+					}
+					if len(vol.Properties.AzureKeyVault.Certificates) > 0 || len(vol.Properties.AzureKeyVault.Keys) > 0 {
+						roleNames = append(roleNames, AzureKeyVaultCryptoUserRole)
+					}
+
+					// Build RoleAssignment output.resource
+					kvID := vol.Properties.AzureKeyVault.Resource
+					roleAssignments, raDeps := azrenderer.MakeRoleAssignments(kvID, roleNames)
+					outputResources = append(outputResources, roleAssignments...)
+					deps = append(deps, raDeps...)
+
+					// Create Per-Pod SecretProviderClass for the selected volume
+					// csiobjectspec must be generated when volume is updated.
+					objectSpec, err := handlers.GetMapValue[string](properties.ComputedValues, azvolrenderer.SPCVolumeObjectSpecKey)
+					if err != nil {
+						return []rpv1.OutputResource{}, nil, err
+					}
+
+					spcName := kubernetes.NormalizeResourceName(vol.Name)
+					secretProvider, err := azrenderer.MakeKeyVaultSecretProviderClass(applicationName, spcName, vol, objectSpec, &options.Environment)
+					if err != nil {
+						return []rpv1.OutputResource{}, nil, err
+					}
+					outputResources = append(outputResources, *secretProvider)
+					deps = append(deps, rpv1.LocalIDSecretProviderClass)
+
+					// Create volume spec which associated with secretProviderClass.
+					volumeSpec, volumeMountSpec, err = azrenderer.MakeKeyVaultVolumeSpec(volumeName, volumeProperties.Persistent.MountPath, spcName)
+					if err != nil {
+						return []rpv1.OutputResource{}, nil, fmt.Errorf("unable to create secretstore volume spec for volume: %s - %w", volumeName, err)
+					}
+				default:
+					return []rpv1.OutputResource{}, nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("Unsupported volume kind: %s for volume: %s. Supported kinds are: %v", vol.Properties.Kind, volumeName, GetSupportedKinds()))
 				}
-				outputResources = append(outputResources, *secretProvider)
-				deps = append(deps, rpv1.LocalIDSecretProviderClass)
 
-				// Create volume spec which associated with secretProviderClass.
-				volumeSpec, volumeMountSpec, err = azrenderer.MakeKeyVaultVolumeSpec(volumeName, volumeProperties.Persistent.MountPath, spcName)
-				if err != nil {
-					return []rpv1.OutputResource{}, nil, fmt.Errorf("unable to create secretstore volume spec for volume: %s - %w", volumeName, err)
+				// Add the volume mount to the Container spec
+				target.container.VolumeMounts = append(target.container.VolumeMounts, volumeMountSpec)
+				volumeMounts[volumeName] = volumeMountSpec
+				// Add the volume to the list of volumes to be added to the Volumes spec
+				volumes = append(volumes, volumeSpec)
+
+				// Add azurestorageaccountname and azurestorageaccountkey as secrets
+				// These will be added as key-value pairs to the kubernetes secret created for the container
+				// The key values are as per: https://docs.microsoft.com/en-us/azure/aks/azure-files-volume
+				for key, value := range properties.ComputedValues {
+					if value.(string) == rpv1.LocalIDAzureFileShareStorageAccount {
+						// The storage account was not created when the computed value was rendered
+						// Lookup the actual storage account name from the local id
+						id := properties.OutputResources[value.(string)]
+						value = id.Name()
+					}
+					secretData[key] = []byte(value.(string))
 				}
 			default:
-				return []rpv1.OutputResource{}, nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("Unsupported volume kind: %s for volume: %s. Supported kinds are: %v", vol.Properties.Kind, volumeName, GetSupportedKinds()))
-			}
-
-			// Add the volume mount to the Container spec
-			container.VolumeMounts = append(container.VolumeMounts, volumeMountSpec)
-			// Add the volume to the list of volumes to be added to the Volumes spec
-			volumes = append(volumes, volumeSpec)
-
-			// Add azurestorageaccountname and azurestorageaccountkey as secrets
-			// These will be added as key-value pairs to the kubernetes secret created for the container
-			// The key values are as per: https://docs.microsoft.com/en-us/azure/aks/azure-files-volume
-			for key, value := range properties.ComputedValues {
-				if value.(string) == rpv1.LocalIDAzureFileShareStorageAccount {
-					// The storage account was not created when the computed value was rendered
-					// Lookup the actual storage account name from the local id
-					id := properties.OutputResources[value.(string)]
-					value = id.Name()
-				}
-				secretData[key] = []byte(value.(string))
+				return []rpv1.OutputResource{}, secretData, v1.NewClientErrInvalidRequest(fmt.Sprintf("Only ephemeral or persistent volumes are supported. Got kind: %v", volumeProperties.Kind))
 			}
-		default:
-			return []rpv1.OutputResource{}, secretData, v1.NewClientErrInvalidRequest(fmt.Sprintf("Only ephemeral or persistent volumes are supported. Got kind: %v", volumeProperties.Kind))
 		}
 	}
 
@@ -634,6 +673,8 @@ func (r Renderer) makeDeployment(
 	})
 
 	podSpec.Volumes = append(podSpec.Volumes, volumes...)
+	podSpec.InitContainers = mergeNamedContainers(podSpec.InitContainers, initContainers)
+	podSpec.Containers = mergeNamedContainers(podSpec.Containers, sidecars)
 
 	// See: https://github.com/kubernetes/kubernetes/issues/92226 and
 	// 		https://github.com/radius-project/radius/issues/3002
@@ -662,6 +703,12 @@ func (r Renderer) makeDeployment(
 		deps = append(deps, rpv1.LocalIDSecret)
 	}
 
+	// The same applies to the secrets of the secret stores referenced by the environment variables, which are not
+	// owned by the container, so the hash of their data is provided by the dependencies.
+	if hash := makeSecretStoreHash(properties, dependencies); hash != "" {
+		deployment.Spec.Template.ObjectMeta.Annotations[kubernetes.AnnotationSecretStoreHash] = hash
+	}
+
 	// Patching Runtimes.Kubernetes.Pod to the PodSpec in deployment resource.
 	if properties.Runtimes != nil && properties.Runtimes.Kubernetes != nil && properties.Runtimes.Kubernetes.Pod != "" {
 		patchedPodSpec, err := patchPodSpec(podSpec, []byte(properties.Runtimes.Kubernetes.Pod))
@@ -678,6 +725,143 @@ func (r Renderer) makeDeployment(
 	return outputResources, secretData, nil
 }
 
+// route is a route provided by one of the ports of the container resource.
+type route struct {
+	Name string
+	Type string
+}
+
+// volumeTarget associates the volumes of the container resource with the container they are mounted in.
+type volumeTarget struct {
+	container *corev1.Container
+	volumes   map[string]datamodel.VolumeProperties
+}
+
+// populateContainer sets the image, ports, command, arguments, working directory, pull policy, health probes and
+// resource requirements of the given Kubernetes container from the container definition and returns the routes
+// provided by its ports. The default resource requirements are applied only if neither the container definition
+// nor the base manifest specify any.
+func (r Renderer) populateContainer(container *corev1.Container, spec datamodel.Container, defaultResources *datamodel.ContainerResourceRequirements) ([]route, error) {
+	routes := []route{}
+	ports := []corev1.ContainerPort{}
+	for _, port := range spec.Ports {
+		if provides := port.Provides; provides != "" {
+			resourceId, err := resources.ParseResource(provides)
+			if err != nil {
+				return nil, v1.NewClientErrInvalidRequest(err.Error())
+			}
+
+			routeName := kubernetes.NormalizeResourceName(resourceId.Name())
+			routeType := resourceId.TypeSegments()[len(resourceId.TypeSegments())-1].Type
+			routeTypeParts := strings.Split(routeType, "/")
+
+			routeTypeSuffix := kubernetes.NormalizeResourceName(routeTypeParts[len(routeTypeParts)-1])
+
+			routes = append(routes, route{Name: routeName, Type: routeTypeSuffix})
+
+			ports = append(ports, corev1.ContainerPort{
+				// Name generation logic has to match the code in HttpRoute
+				Name:          kubernetes.GetShortenedTargetPortName(routeTypeSuffix + routeName),
+				ContainerPort: port.ContainerPort,
+				Protocol:      corev1.ProtocolTCP,
+			})
+		} else {
+			ports = append(ports, corev1.ContainerPort{
+				ContainerPort: port.ContainerPort,
+				Protocol:      corev1.ProtocolTCP,
+			})
+		}
+	}
+
+	container.Image = spec.Image
+	container.Ports = append(container.Ports, ports...)
+	container.Command = spec.Command
+	container.Args = spec.Args
+	container.WorkingDir = spec.WorkingDir
+
+	// If the user has specified an image pull policy, use it. Else, we will use Kubernetes default.
+	if spec.ImagePullPolicy != "" {
+		container.ImagePullPolicy = corev1.PullPolicy(spec.ImagePullPolicy)
+	}
+
+	var err error
+	if !spec.ReadinessProbe.IsEmpty() {
+		container.ReadinessProbe, err = r.makeHealthProbe(spec.ReadinessProbe)
+		if err != nil {
+			return nil, fmt.Errorf("readiness probe encountered errors: %w ", err)
+		}
+	}
+	if !spec.LivenessProbe.IsEmpty() {
+		container.LivenessProbe, err = r.makeHealthProbe(spec.LivenessProbe)
+		if err != nil {
+			return nil, fmt.Errorf("liveness probe encountered errors: %w ", err)
+		}
+	}
+
+	if !spec.Resources.IsEmpty() || (len(container.Resources.Requests) == 0 && len(container.Resources.Limits) == 0) {
+		resources, err := makeResourceRequirements(spec.Resources, defaultResources)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(resources.Requests) > 0 || len(resources.Limits) > 0 {
+			container.Resources = resources
+		}
+	}
+
+	return routes, nil
+}
+
+// getNamedContainersBase returns a Kubernetes container for each of the given init or sidecar containers. If the
+// base manifest defines a container with the same name, it is used as the base of the container.
+func getNamedContainersBase(base []corev1.Container, containers []datamodel.NamedContainer) []corev1.Container {
+	result := make([]corev1.Container, len(containers))
+	for i, c := range containers {
+		result[i] = corev1.Container{Name: c.Name}
+		for _, b := range base {
+			if strings.EqualFold(b.Name, c.Name) {
+				result[i] = *b.DeepCopy()
+				break
+			}
+		}
+	}
+
+	return result
+}
+
+// mergeNamedContainers replaces the containers in base with the containers of the same name and appends the rest.
+func mergeNamedContainers(base []corev1.Container, containers []corev1.Container) []corev1.Container {
+NEXT:
+	for _, c := range containers {
+		for i, b := range base {
+			if strings.EqualFold(b.Name, c.Name) {
+				base[i] = c
+				continue NEXT
+			}
+		}
+
+		base = append(base, c)
+	}
+
+	return base
+}
+
+// getVolumeMountPath returns the mount path of the given volume.
+func getVolumeMountPath(volume datamodel.VolumeProperties) string {
+	switch volume.Kind {
+	case datamodel.Ephemeral:
+		if volume.Ephemeral != nil {
+			return volume.Ephemeral.MountPath
+		}
+	case datamodel.Persistent:
+		if volume.Persistent != nil {
+			return volume.Persistent.MountPath
+		}
+	}
+
+	return ""
+}
+
 func getEnvVarsAndSecretData(resource *datamodel.ContainerResource, applicationName string, dependencies map[string]renderers.RendererDependency) (map[string]corev1.EnvVar, map[string][]byte, error) {
 	env := map[string]corev1.EnvVar{}
 	secretData := map[string][]byte{}
@@ -726,16 +910,9 @@ func getEnvVarsAndSecretData(resource *datamodel.ContainerResource, applicationN
 						Key: name,
 					},
 				}
-				switch v := value.(type) {
-				case string:
+				if v, ok := connectionValueToString(value); ok {
 					secretData[name] = []byte(v)
 					env[name] = corev1.EnvVar{Name: name, ValueFrom: &source}
-				case float64:
-					secretData[name] = []byte(strconv.Itoa(int(v)))
-					env[name] = corev1.EnvVar{Name: name, ValueFrom: &source}
-				case int:
-					secretData[name] = []byte(strconv.Itoa(v))
-					env[name] = corev1.EnvVar{Name: name, ValueFrom: &source}
 				}
 			}
 		}
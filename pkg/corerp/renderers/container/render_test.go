@@ -41,6 +41,7 @@ import (
 	"github.com/stretchr/testify/require"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	k8s_resource "k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
@@ -210,6 +211,91 @@ func Test_GetDependencyIDs_Success(t *testing.T) {
 	require.ElementsMatch(t, expectedAzureResourceIDs, azureResourceIDs)
 }
 
+func Test_GetDependencyIDs_InitContainersAndSidecars(t *testing.T) {
+	properties := datamodel.ContainerProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: applicationResourceID,
+		},
+		Container: datamodel.Container{
+			Image: "someimage:latest",
+		},
+		InitContainers: []datamodel.NamedContainer{
+			{
+				Name: "init",
+				Container: datamodel.Container{
+					Image: "initimage:latest",
+					Volumes: map[string]datamodel.VolumeProperties{
+						"vol1": {
+							Kind: datamodel.Persistent,
+							Persistent: &datamodel.PersistentVolume{
+								VolumeBase: datamodel.VolumeBase{
+									MountPath: "/secrets",
+								},
+								Source: makeRadiusResourceID(t, "Applications.Core/volumes", "vol1").String(),
+							},
+						},
+					},
+				},
+			},
+		},
+		Sidecars: []datamodel.NamedContainer{
+			{
+				Name: "proxy",
+				Container: datamodel.Container{
+					Image: "proxyimage:latest",
+					Ports: map[string]datamodel.ContainerPort{
+						"proxy": {
+							ContainerPort: 8080,
+							Provides:      makeRadiusResourceID(t, "Applications.Core/httpRoutes", "A").String(),
+						},
+					},
+				},
+			},
+		},
+	}
+	resource := makeResource(t, properties)
+
+	ctx := testcontext.New(t)
+
+	renderer := Renderer{}
+	radiusResourceIDs, azureResourceIDs, err := renderer.GetDependencyIDs(ctx, resource)
+	require.NoError(t, err)
+	require.Empty(t, azureResourceIDs)
+
+	expectedRadiusResourceIDs := []resources.ID{
+		makeRadiusResourceID(t, "Applications.Core/volumes", "vol1"),
+		makeRadiusResourceID(t, "Applications.Core/httpRoutes", "A"),
+	}
+	require.ElementsMatch(t, expectedRadiusResourceIDs, radiusResourceIDs)
+}
+
+func Test_GetDependencyIDs_EnvironmentVariables(t *testing.T) {
+	secretStoreID := makeRadiusResourceID(t, "Applications.Core/secretStores", "dbsecret")
+	properties := datamodel.ContainerProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: applicationResourceID,
+		},
+		Container: datamodel.Container{
+			Image: "someimage:latest",
+			Env: map[string]datamodel.EnvironmentVariable{
+				envVarName1: {Value: to.Ptr(envVarValue1)},
+				"DB_PASSWORD": {
+					ValueFrom: &datamodel.EnvironmentVariableReference{
+						SecretRef: &datamodel.EnvironmentVariableSecretReference{Source: secretStoreID.String(), Key: "password"},
+					},
+				},
+			},
+		},
+	}
+
+	ctx := testcontext.New(t)
+	renderer := Renderer{}
+	radiusResourceIDs, azureResourceIDs, err := renderer.GetDependencyIDs(ctx, makeResource(t, properties))
+	require.NoError(t, err)
+	require.Equal(t, []resources.ID{secretStoreID}, radiusResourceIDs)
+	require.Empty(t, azureResourceIDs)
+}
+
 func Test_GetDependencyIDs_InvalidId(t *testing.T) {
 	properties := datamodel.ContainerProperties{
 		Connections: map[string]datamodel.ConnectionProperties{
@@ -274,9 +360,9 @@ func Test_Render_Basic(t *testing.T) {
 		},
 		Container: datamodel.Container{
 			Image: "someimage:latest",
-			Env: map[string]string{
-				envVarName1: envVarValue1,
-				envVarName2: envVarValue2,
+			Env: map[string]datamodel.EnvironmentVariable{
+				envVarName1: {Value: to.Ptr(envVarValue1)},
+				envVarName2: {Value: to.Ptr(envVarValue2)},
 			},
 		},
 	}
@@ -342,9 +428,9 @@ func Test_Render_WithCommandArgsWorkingDir(t *testing.T) {
 		},
 		Container: datamodel.Container{
 			Image: "someimage:latest",
-			Env: map[string]string{
-				envVarName1: envVarValue1,
-				envVarName2: envVarValue2,
+			Env: map[string]datamodel.EnvironmentVariable{
+				envVarName1: {Value: to.Ptr(envVarValue1)},
+				envVarName2: {Value: to.Ptr(envVarValue2)},
 			},
 			Command:    []string{"command1", "command2"},
 			Args:       []string{"arg1", "arg2"},
@@ -555,9 +641,9 @@ func Test_Render_Connections(t *testing.T) {
 		},
 		Container: datamodel.Container{
 			Image: "someimage:latest",
-			Env: map[string]string{
-				envVarName1: envVarValue1,
-				envVarName2: envVarValue2,
+			Env: map[string]datamodel.EnvironmentVariable{
+				envVarName1: {Value: to.Ptr(envVarValue1)},
+				envVarName2: {Value: to.Ptr(envVarValue2)},
 			},
 		},
 	}
@@ -717,9 +803,9 @@ func Test_Render_Connections_SecretsGetHashed(t *testing.T) {
 		},
 		Container: datamodel.Container{
 			Image: "someimage:latest",
-			Env: map[string]string{
-				envVarName1: envVarValue1,
-				envVarName2: envVarValue2,
+			Env: map[string]datamodel.EnvironmentVariable{
+				envVarName1: {Value: to.Ptr(envVarValue1)},
+				envVarName2: {Value: to.Ptr(envVarValue2)},
 			},
 		},
 	}
@@ -1012,9 +1098,9 @@ func Test_Render_EphemeralVolumes(t *testing.T) {
 		},
 		Container: datamodel.Container{
 			Image: "someimage:latest",
-			Env: map[string]string{
-				envVarName1: envVarValue1,
-				envVarName2: envVarValue2,
+			Env: map[string]datamodel.EnvironmentVariable{
+				envVarName1: {Value: to.Ptr(envVarValue1)},
+				envVarName2: {Value: to.Ptr(envVarValue2)},
 			},
 			Volumes: map[string]datamodel.VolumeProperties{
 				tempVolName: {
@@ -1263,6 +1349,500 @@ func outputResourcesToResourceTypeMap(resources []rpv1.OutputResource) map[strin
 	return results
 }
 
+func Test_Render_InitContainersAndSidecars(t *testing.T) {
+	const sharedVolName = "shared"
+	properties := datamodel.ContainerProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: applicationResourceID,
+		},
+		Container: datamodel.Container{
+			Image: "someimage:latest",
+			Ports: map[string]datamodel.ContainerPort{
+				"web": {
+					ContainerPort: 3000,
+				},
+			},
+			Volumes: map[string]datamodel.VolumeProperties{
+				sharedVolName: {
+					Kind: datamodel.Ephemeral,
+					Ephemeral: &datamodel.EphemeralVolume{
+						VolumeBase: datamodel.VolumeBase{
+							MountPath: "/app/data",
+						},
+						ManagedStore: datamodel.ManagedStoreDisk,
+					},
+				},
+			},
+		},
+		InitContainers: []datamodel.NamedContainer{
+			{
+				Name: "migrate",
+				Container: datamodel.Container{
+					Image:   "migrate:latest",
+					Command: []string{"/bin/migrate"},
+					Env: map[string]datamodel.EnvironmentVariable{
+						envVarName1: {Value: to.Ptr(envVarValue1)},
+					},
+				},
+			},
+			{
+				Name: "seed",
+				Container: datamodel.Container{
+					Image: "seed:latest",
+					Volumes: map[string]datamodel.VolumeProperties{
+						sharedVolName: {
+							Kind: datamodel.Ephemeral,
+							Ephemeral: &datamodel.EphemeralVolume{
+								VolumeBase: datamodel.VolumeBase{
+									MountPath: "/seed",
+								},
+								ManagedStore: datamodel.ManagedStoreDisk,
+							},
+						},
+					},
+				},
+			},
+		},
+		Sidecars: []datamodel.NamedContainer{
+			{
+				Name: "proxy",
+				Container: datamodel.Container{
+					Image:           "proxy:latest",
+					ImagePullPolicy: "Always",
+					Ports: map[string]datamodel.ContainerPort{
+						"proxy": {
+							ContainerPort: 8080,
+						},
+					},
+					ReadinessProbe: datamodel.HealthProbeProperties{
+						Kind: datamodel.TCPHealthProbe,
+						TCP: &datamodel.TCPHealthProbeProperties{
+							ContainerPort: 8080,
+						},
+					},
+				},
+			},
+		},
+	}
+	resource := makeResource(t, properties)
+	ctx := testcontext.New(t)
+	renderer := Renderer{}
+	output, err := renderer.Render(ctx, resource, renderOptionsEnvAndAppKubeMetadata())
+	require.NoError(t, err)
+
+	t.Run("verify deployment", func(t *testing.T) {
+		deployment, _ := kubernetes.FindDeployment(output.Resources)
+		require.NotNil(t, deployment)
+		podSpec := deployment.Spec.Template.Spec
+
+		require.Len(t, podSpec.Containers, 2)
+		require.Equal(t, resourceName, podSpec.Containers[0].Name)
+		require.Equal(t, []corev1.VolumeMount{{Name: sharedVolName, MountPath: "/app/data"}}, podSpec.Containers[0].VolumeMounts)
+
+		sidecar := podSpec.Containers[1]
+		require.Equal(t, "proxy", sidecar.Name)
+		require.Equal(t, "proxy:latest", sidecar.Image)
+		require.Equal(t, corev1.PullAlways, sidecar.ImagePullPolicy)
+		require.Equal(t, []corev1.ContainerPort{{ContainerPort: 8080, Protocol: corev1.ProtocolTCP}}, sidecar.Ports)
+		require.NotNil(t, sidecar.ReadinessProbe)
+		require.Equal(t, intstr.FromInt(8080), sidecar.ReadinessProbe.TCPSocket.Port)
+
+		require.Len(t, podSpec.InitContainers, 2)
+		require.Equal(t, "migrate", podSpec.InitContainers[0].Name)
+		require.Equal(t, "migrate:latest", podSpec.InitContainers[0].Image)
+		require.Equal(t, []string{"/bin/migrate"}, podSpec.InitContainers[0].Command)
+		require.Equal(t, []corev1.EnvVar{{Name: envVarName1, Value: envVarValue1}}, podSpec.InitContainers[0].Env)
+		require.Equal(t, "seed", podSpec.InitContainers[1].Name)
+		require.Equal(t, []corev1.VolumeMount{{Name: sharedVolName, MountPath: "/seed"}}, podSpec.InitContainers[1].VolumeMounts)
+
+		// The volume is declared by two containers but added to the pod once.
+		require.Len(t, podSpec.Volumes, 1)
+		require.Equal(t, sharedVolName, podSpec.Volumes[0].Name)
+	})
+
+	t.Run("verify service", func(t *testing.T) {
+		service, _ := kubernetes.FindService(output.Resources)
+		require.NotNil(t, service)
+
+		expectedPorts := []corev1.ServicePort{
+			{Name: "proxy", Port: 8080, TargetPort: intstr.FromInt(8080), Protocol: corev1.ProtocolTCP},
+			{Name: "web", Port: 3000, TargetPort: intstr.FromInt(3000), Protocol: corev1.ProtocolTCP},
+		}
+		require.ElementsMatch(t, expectedPorts, service.Spec.Ports)
+	})
+}
+
+func Test_Render_SidecarFromBaseManifest(t *testing.T) {
+	properties := datamodel.ContainerProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: applicationResourceID,
+		},
+		Container: datamodel.Container{
+			Image: "someimage:latest",
+		},
+		Sidecars: []datamodel.NamedContainer{
+			{
+				Name: "proxy",
+				Container: datamodel.Container{
+					Image: "proxy:latest",
+				},
+			},
+		},
+		Runtimes: &datamodel.RuntimeProperties{
+			Kubernetes: &datamodel.KubernetesRuntime{
+				Base: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: test-container
+spec:
+  template:
+    spec:
+      containers:
+      - name: test-container
+      - name: proxy
+        env:
+        - name: FROM_BASE
+          value: "true"
+`,
+			},
+		},
+	}
+	resource := makeResource(t, properties)
+	ctx := testcontext.New(t)
+	renderer := Renderer{}
+	output, err := renderer.Render(ctx, resource, renderOptionsEnvAndAppKubeMetadata())
+	require.NoError(t, err)
+
+	deployment, _ := kubernetes.FindDeployment(output.Resources)
+	require.NotNil(t, deployment)
+
+	containers := deployment.Spec.Template.Spec.Containers
+	require.Len(t, containers, 2)
+	require.Equal(t, "proxy", containers[1].Name)
+	require.Equal(t, "proxy:latest", containers[1].Image)
+	require.Equal(t, []corev1.EnvVar{{Name: "FROM_BASE", Value: "true"}}, containers[1].Env)
+}
+
+func Test_Render_ResourceRequirements(t *testing.T) {
+	properties := datamodel.ContainerProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: applicationResourceID,
+		},
+		Container: datamodel.Container{
+			Image: "someimage:latest",
+			Resources: &datamodel.ContainerResourceRequirements{
+				Requests: &datamodel.ContainerResourceList{
+					CPU:    "500m",
+					Memory: "256Mi",
+				},
+				Limits: &datamodel.ContainerResourceList{
+					Memory:   "512Mi",
+					Extended: map[string]string{"nvidia.com/gpu": "1"},
+				},
+			},
+		},
+		Sidecars: []datamodel.NamedContainer{
+			{
+				Name: "proxy",
+				Container: datamodel.Container{
+					Image: "proxy:latest",
+				},
+			},
+		},
+	}
+	resource := makeResource(t, properties)
+	ctx := testcontext.New(t)
+	renderer := Renderer{}
+
+	t.Run("without environment defaults", func(t *testing.T) {
+		output, err := renderer.Render(ctx, resource, renderOptionsEnvAndAppKubeMetadata())
+		require.NoError(t, err)
+
+		deployment, _ := kubernetes.FindDeployment(output.Resources)
+		require.NotNil(t, deployment)
+
+		containers := deployment.Spec.Template.Spec.Containers
+		require.Len(t, containers, 2)
+
+		expected := corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    k8s_resource.MustParse("500m"),
+				corev1.ResourceMemory: k8s_resource.MustParse("256Mi"),
+			},
+			Limits: corev1.ResourceList{
+				corev1.ResourceMemory: k8s_resource.MustParse("512Mi"),
+				"nvidia.com/gpu":      k8s_resource.MustParse("1"),
+			},
+		}
+		require.Equal(t, expected, containers[0].Resources)
+		require.Equal(t, corev1.ResourceRequirements{}, containers[1].Resources)
+	})
+
+	t.Run("with environment defaults", func(t *testing.T) {
+		options := renderOptionsEnvAndAppKubeMetadata()
+		options.Environment.DefaultContainerResources = &datamodel.ContainerResourceRequirements{
+			Requests: &datamodel.ContainerResourceList{
+				CPU:              "100m",
+				EphemeralStorage: "1Gi",
+			},
+		}
+
+		output, err := renderer.Render(ctx, resource, options)
+		require.NoError(t, err)
+
+		deployment, _ := kubernetes.FindDeployment(output.Resources)
+		require.NotNil(t, deployment)
+
+		containers := deployment.Spec.Template.Spec.Containers
+		require.Len(t, containers, 2)
+
+		// The container specifies its own requirements, so the defaults only apply to the sidecar.
+		require.Equal(t, k8s_resource.MustParse("500m"), containers[0].Resources.Requests[corev1.ResourceCPU])
+		require.NotContains(t, containers[0].Resources.Requests, corev1.ResourceEphemeralStorage)
+
+		expected := corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:              k8s_resource.MustParse("100m"),
+				corev1.ResourceEphemeralStorage: k8s_resource.MustParse("1Gi"),
+			},
+		}
+		require.Equal(t, expected, containers[1].Resources)
+	})
+
+	t.Run("invalid quantity", func(t *testing.T) {
+		properties := datamodel.ContainerProperties{
+			BasicResourceProperties: rpv1.BasicResourceProperties{
+				Application: applicationResourceID,
+			},
+			Container: datamodel.Container{
+				Image: "someimage:latest",
+				Resources: &datamodel.ContainerResourceRequirements{
+					Limits: &datamodel.ContainerResourceList{CPU: "invalid"},
+				},
+			},
+		}
+
+		_, err := renderer.Render(ctx, makeResource(t, properties), renderOptionsEnvAndAppKubeMetadata())
+		require.Error(t, err)
+		require.Equal(t, apiv1.CodeInvalid, err.(*apiv1.ErrClientRP).Code)
+	})
+}
+
+func Test_Render_EnvironmentVariablesFromSecretStoreAndConnection(t *testing.T) {
+	secretStoreID := makeRadiusResourceID(t, "Applications.Core/secretStores", "dbsecret")
+	connectionID := makeRadiusResourceID(t, "Applications.Datastores/sqlDatabases", "db")
+	properties := datamodel.ContainerProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: applicationResourceID,
+		},
+		Connections: map[string]datamodel.ConnectionProperties{
+			"db": {
+				Source:                connectionID.String(),
+				DisableDefaultEnvVars: to.Ptr(true),
+			},
+		},
+		Container: datamodel.Container{
+			Image: "someimage:latest",
+			Env: map[string]datamodel.EnvironmentVariable{
+				envVarName1: {Value: to.Ptr(envVarValue1)},
+				"DB_PASSWORD": {
+					ValueFrom: &datamodel.EnvironmentVariableReference{
+						SecretRef: &datamodel.EnvironmentVariableSecretReference{Source: secretStoreID.String(), Key: "password"},
+					},
+				},
+				"DB_CONNECTION": {
+					ValueFrom: &datamodel.EnvironmentVariableReference{
+						ConnectionRef: &datamodel.EnvironmentVariableConnectionReference{Name: "db", Key: "connectionString"},
+					},
+				},
+			},
+		},
+		Sidecars: []datamodel.NamedContainer{
+			{
+				Name: "proxy",
+				Container: datamodel.Container{
+					Image: "proxy:latest",
+					Env: map[string]datamodel.EnvironmentVariable{
+						"DB_PASSWORD": {
+							ValueFrom: &datamodel.EnvironmentVariableReference{
+								SecretRef: &datamodel.EnvironmentVariableSecretReference{Source: secretStoreID.String(), Key: "password"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	makeDependencies := func(namespace string, secretDataHash string) map[string]renderers.RendererDependency {
+		return map[string]renderers.RendererDependency{
+			secretStoreID.String(): {
+				ResourceID: secretStoreID,
+				Resource: &datamodel.SecretStore{
+					Properties: &datamodel.SecretStoreProperties{
+						Type: datamodel.SecretTypeGeneric,
+						Data: map[string]*datamodel.SecretStoreDataValue{
+							"password": {},
+						},
+					},
+				},
+				OutputResources: map[string]resources.ID{
+					rpv1.LocalIDSecret: resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, "", "Secret", namespace, "dbsecret"),
+				},
+				SecretDataHash: secretDataHash,
+			},
+			connectionID.String(): {
+				ResourceID: connectionID,
+				ComputedValues: map[string]any{
+					"connectionString": "Server=db;Database=test",
+					"port":             float64(1433),
+				},
+			},
+		}
+	}
+
+	ctx := testcontext.New(t)
+	renderer := Renderer{}
+
+	render := func(t *testing.T, properties datamodel.ContainerProperties, dependencies map[string]renderers.RendererDependency) (renderers.RendererOutput, error) {
+		return renderer.Render(ctx, makeResource(t, properties), renderers.RenderOptions{Dependencies: dependencies, Environment: testEnvironmentOptions})
+	}
+
+	t.Run("renders secret key references", func(t *testing.T) {
+		output, err := render(t, properties, makeDependencies("default", "hash1"))
+		require.NoError(t, err)
+
+		deployment, _ := kubernetes.FindDeployment(output.Resources)
+		require.NotNil(t, deployment)
+
+		containers := deployment.Spec.Template.Spec.Containers
+		require.Len(t, containers, 2)
+
+		expected := []corev1.EnvVar{
+			{
+				Name: "DB_CONNECTION",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+						Key:                  "CONNECTION_DB_CONNECTIONSTRING",
+					},
+				},
+			},
+			{
+				Name: "DB_PASSWORD",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "dbsecret"},
+						Key:                  "password",
+					},
+				},
+			},
+			{Name: envVarName1, Value: envVarValue1},
+		}
+		require.Equal(t, expected, containers[0].Env)
+		require.Equal(t, expected[1:2], containers[1].Env)
+
+		// Only the referenced value of the connection is stored in the secret of the container.
+		secret, _ := kubernetes.FindSecret(output.Resources)
+		require.NotNil(t, secret)
+		require.Equal(t, map[string][]byte{"CONNECTION_DB_CONNECTIONSTRING": []byte("Server=db;Database=test")}, secret.Data)
+		require.Contains(t, deployment.Spec.Template.Annotations, kubernetes.AnnotationSecretHash)
+		require.Contains(t, deployment.Spec.Template.Annotations, kubernetes.AnnotationSecretStoreHash)
+	})
+
+	t.Run("secret store change rolls out a new revision", func(t *testing.T) {
+		output1, err := render(t, properties, makeDependencies("default", "hash1"))
+		require.NoError(t, err)
+		output2, err := render(t, properties, makeDependencies("default", "hash2"))
+		require.NoError(t, err)
+
+		deployment1, _ := kubernetes.FindDeployment(output1.Resources)
+		deployment2, _ := kubernetes.FindDeployment(output2.Resources)
+		require.NotEqual(t, deployment1.Spec.Template.Annotations[kubernetes.AnnotationSecretStoreHash], deployment2.Spec.Template.Annotations[kubernetes.AnnotationSecretStoreHash])
+	})
+
+	t.Run("secret store in another namespace", func(t *testing.T) {
+		_, err := render(t, properties, makeDependencies("other", "hash1"))
+		require.Error(t, err)
+		require.Equal(t, apiv1.CodeInvalid, err.(*apiv1.ErrClientRP).Code)
+		require.Contains(t, err.Error(), "must be in the namespace")
+	})
+
+	invalidTests := []struct {
+		name     string
+		valueRef datamodel.EnvironmentVariableReference
+		errMsg   string
+	}{
+		{
+			name: "missing secret store key",
+			valueRef: datamodel.EnvironmentVariableReference{
+				SecretRef: &datamodel.EnvironmentVariableSecretReference{Source: secretStoreID.String(), Key: "username"},
+			},
+			errMsg: "does not contain the key \"username\"",
+		},
+		{
+			name: "missing secret store",
+			valueRef: datamodel.EnvironmentVariableReference{
+				SecretRef: &datamodel.EnvironmentVariableSecretReference{Source: makeRadiusResourceID(t, "Applications.Core/secretStores", "missing").String(), Key: "password"},
+			},
+			errMsg: "not found",
+		},
+		{
+			name: "unknown connection",
+			valueRef: datamodel.EnvironmentVariableReference{
+				ConnectionRef: &datamodel.EnvironmentVariableConnectionReference{Name: "cache", Key: "connectionString"},
+			},
+			errMsg: "connection cache referenced by environment variable TEST_VAR_2 not found",
+		},
+		{
+			name: "missing connection value",
+			valueRef: datamodel.EnvironmentVariableReference{
+				ConnectionRef: &datamodel.EnvironmentVariableConnectionReference{Name: "db", Key: "password"},
+			},
+			errMsg: "connection db does not have the value \"password\"",
+		},
+	}
+
+	for _, tt := range invalidTests {
+		t.Run(tt.name, func(t *testing.T) {
+			properties := properties
+			properties.Container.Env = map[string]datamodel.EnvironmentVariable{
+				envVarName2: {ValueFrom: &tt.valueRef},
+			}
+
+			_, err := render(t, properties, makeDependencies("default", "hash1"))
+			require.Error(t, err)
+			require.Equal(t, apiv1.CodeInvalid, err.(*apiv1.ErrClientRP).Code)
+			require.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func Test_ConnectionValueToString(t *testing.T) {
+	tests := []struct {
+		value    any
+		expected string
+		ok       bool
+	}{
+		{value: "Server=db", expected: "Server=db", ok: true},
+		{value: 6379, expected: "6379", ok: true},
+		{value: float64(6379), expected: "6379", ok: true},
+		{value: 0.25, expected: "0.25", ok: true},
+		{value: float64(12345678901), expected: "12345678901", ok: true},
+		{value: true, ok: false},
+		{value: nil, ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%v", tt.value), func(t *testing.T) {
+			value, ok := connectionValueToString(tt.value)
+			require.Equal(t, tt.ok, ok)
+			require.Equal(t, tt.expected, value)
+		})
+	}
+}
+
 func Test_Render_RestartPolicy(t *testing.T) {
 	properties := datamodel.ContainerProperties{
 		BasicResourceProperties: rpv1.BasicResourceProperties{
@@ -1302,9 +1882,9 @@ func Test_Render_ReadinessProbeHttpGet(t *testing.T) {
 		},
 		Container: datamodel.Container{
 			Image: "someimage:latest",
-			Env: map[string]string{
-				envVarName1: envVarValue1,
-				envVarName2: envVarValue2,
+			Env: map[string]datamodel.EnvironmentVariable{
+				envVarName1: {Value: to.Ptr(envVarValue1)},
+				envVarName2: {Value: to.Ptr(envVarValue2)},
 			},
 			ReadinessProbe: datamodel.HealthProbeProperties{
 				Kind: datamodel.HTTPGetHealthProbe,
@@ -1381,9 +1961,9 @@ func Test_Render_ReadinessProbeTcp(t *testing.T) {
 		},
 		Container: datamodel.Container{
 			Image: "someimage:latest",
-			Env: map[string]string{
-				envVarName1: envVarValue1,
-				envVarName2: envVarValue2,
+			Env: map[string]datamodel.EnvironmentVariable{
+				envVarName1: {Value: to.Ptr(envVarValue1)},
+				envVarName2: {Value: to.Ptr(envVarValue2)},
 			},
 			ReadinessProbe: datamodel.HealthProbeProperties{
 				Kind: datamodel.TCPHealthProbe,
@@ -1451,9 +2031,9 @@ func Test_Render_LivenessProbeExec(t *testing.T) {
 		},
 		Container: datamodel.Container{
 			Image: "someimage:latest",
-			Env: map[string]string{
-				envVarName1: envVarValue1,
-				envVarName2: envVarValue2,
+			Env: map[string]datamodel.EnvironmentVariable{
+				envVarName1: {Value: to.Ptr(envVarValue1)},
+				envVarName2: {Value: to.Ptr(envVarValue2)},
 			},
 			LivenessProbe: datamodel.HealthProbeProperties{
 				Kind: datamodel.ExecHealthProbe,
@@ -1666,9 +2246,9 @@ func Test_Render_ImagePullPolicySpecified(t *testing.T) {
 		Container: datamodel.Container{
 			Image:           "someimage:latest",
 			ImagePullPolicy: "Never",
-			Env: map[string]string{
-				envVarName1: envVarValue1,
-				envVarName2: envVarValue2,
+			Env: map[string]datamodel.EnvironmentVariable{
+				envVarName1: {Value: to.Ptr(envVarValue1)},
+				envVarName2: {Value: to.Ptr(envVarValue2)},
 			},
 		},
 	}
@@ -1723,9 +2303,9 @@ func Test_Render_StrategicPatchMerge(t *testing.T) {
 		},
 		Container: datamodel.Container{
 			Image: "someimage:latest",
-			Env: map[string]string{
-				envVarName1: envVarValue1,
-				envVarName2: envVarValue2,
+			Env: map[string]datamodel.EnvironmentVariable{
+				envVarName1: {Value: to.Ptr(envVarValue1)},
+				envVarName2: {Value: to.Ptr(envVarValue2)},
 			},
 		},
 		Runtimes: &datamodel.RuntimeProperties{
@@ -1785,9 +2365,9 @@ func Test_Render_BaseManifest(t *testing.T) {
 				},
 				Container: datamodel.Container{
 					Image: "someimage:latest",
-					Env: map[string]string{
-						envVarName1: envVarValue1,
-						envVarName2: envVarValue2,
+					Env: map[string]datamodel.EnvironmentVariable{
+						envVarName1: {Value: to.Ptr(envVarValue1)},
+						envVarName2: {Value: to.Ptr(envVarValue2)},
 					},
 					Volumes: map[string]datamodel.VolumeProperties{
 						"ephemeralVolume": {
@@ -1813,9 +2393,9 @@ func Test_Render_BaseManifest(t *testing.T) {
 				},
 				Container: datamodel.Container{
 					Image: "someimage:latest",
-					Env: map[string]string{
-						envVarName1: envVarValue1,
-						envVarName2: envVarValue2,
+					Env: map[string]datamodel.EnvironmentVariable{
+						envVarName1: {Value: to.Ptr(envVarValue1)},
+						envVarName2: {Value: to.Ptr(envVarValue2)},
 					},
 				},
 			},
@@ -0,0 +1,63 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package container
+
+import (
+	"fmt"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// makeResourceRequirements creates the Kubernetes resource requirements of a container. The container's own
+// requirements are used if specified, otherwise the defaults of the environment are used.
+func makeResourceRequirements(requirements *datamodel.ContainerResourceRequirements, defaults *datamodel.ContainerResourceRequirements) (corev1.ResourceRequirements, error) {
+	if requirements.IsEmpty() {
+		requirements = defaults
+	}
+
+	if requirements.IsEmpty() {
+		return corev1.ResourceRequirements{}, nil
+	}
+
+	if err := requirements.Validate(); err != nil {
+		return corev1.ResourceRequirements{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid resources: %s", err.Error()))
+	}
+
+	// Quantities were validated above, so they can be parsed safely.
+	return corev1.ResourceRequirements{
+		Requests: makeResourceList(requirements.Requests),
+		Limits:   makeResourceList(requirements.Limits),
+	}, nil
+}
+
+func makeResourceList(l *datamodel.ContainerResourceList) corev1.ResourceList {
+	quantities := l.Quantities()
+	if len(quantities) == 0 {
+		return nil
+	}
+
+	result := corev1.ResourceList{}
+	for name, value := range quantities {
+		result[corev1.ResourceName(name)] = resource.MustParse(value)
+	}
+
+	return result
+}
@@ -0,0 +1,299 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+)
+
+const (
+	// GatewayAPIHTTPListenerName is the name of the listener of a Gateway serving plain HTTP.
+	GatewayAPIHTTPListenerName = "http"
+	// GatewayAPIHTTPSListenerName is the name of the listener of a Gateway terminating TLS.
+	GatewayAPIHTTPSListenerName = "https"
+	// GatewayAPITLSListenerName is the name of the listener of a Gateway passing TLS through to the route.
+	GatewayAPITLSListenerName = "tls"
+)
+
+// MakeGatewayAPIResources validates the Gateway resource and its dependencies, and creates a Kubernetes Gateway API
+// Gateway resource along with the routes attached to it.
+func MakeGatewayAPIResources(ctx context.Context, options renderers.RenderOptions, gateway *datamodel.Gateway, applicationName string, hostname string) ([]rpv1.OutputResource, error) {
+	gatewayObject, err := MakeGatewayAPIGateway(ctx, options, gateway, applicationName, hostname)
+	if err != nil {
+		return []rpv1.OutputResource{}, err
+	}
+
+	routeObjects, err := MakeGatewayAPIRoutes(ctx, options, gateway, applicationName)
+	if err != nil {
+		return []rpv1.OutputResource{}, err
+	}
+
+	return append([]rpv1.OutputResource{gatewayObject}, routeObjects...), nil
+}
+
+// MakeGatewayAPIGateway creates a Gateway API Gateway resource with a single listener for the hostname of the gateway.
+// The listener serves HTTP, terminates TLS with the certificate of the certificateFrom secret store, or passes TLS
+// through to the route if sslPassthrough is set.
+func MakeGatewayAPIGateway(ctx context.Context, options renderers.RenderOptions, gateway *datamodel.Gateway, applicationName string, hostname string) (rpv1.OutputResource, error) {
+	if options.Environment.Gateway.GatewayClassName == "" {
+		return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("the environment must specify gateway.gatewayClassName when gateway.kind is %q", datamodel.GatewayKindGatewayAPI))
+	}
+
+	if err := validateRoutes(gateway); err != nil {
+		return rpv1.OutputResource{}, err
+	}
+
+	listener := gatewayv1beta1.Listener{
+		Name:     GatewayAPIHTTPListenerName,
+		Port:     gatewayv1beta1.PortNumber(80),
+		Protocol: gatewayv1beta1.HTTPProtocolType,
+	}
+
+	if tls := gateway.Properties.TLS; tls != nil {
+		if tls.SSLPassthrough {
+			listener = gatewayv1beta1.Listener{
+				Name:     GatewayAPITLSListenerName,
+				Port:     gatewayv1beta1.PortNumber(443),
+				Protocol: gatewayv1beta1.TLSProtocolType,
+				TLS: &gatewayv1beta1.GatewayTLSConfig{
+					Mode: to.Ptr(gatewayv1beta1.TLSModePassthrough),
+				},
+			}
+		} else if tls.CertificateFrom != "" {
+			secretResourceID, err := getCertificateSecretID(gateway, options.Dependencies)
+			if err != nil {
+				return rpv1.OutputResource{}, err
+			}
+
+			// The Gateway API has no portable setting for the minimum TLS version, so MinimumProtocolVersion is left
+			// to the defaults of the gateway implementation.
+			listener = gatewayv1beta1.Listener{
+				Name:     GatewayAPIHTTPSListenerName,
+				Port:     gatewayv1beta1.PortNumber(443),
+				Protocol: gatewayv1beta1.HTTPSProtocolType,
+				TLS: &gatewayv1beta1.GatewayTLSConfig{
+					Mode: to.Ptr(gatewayv1beta1.TLSModeTerminate),
+					CertificateRefs: []gatewayv1beta1.SecretObjectReference{
+						{
+							Name:      gatewayv1beta1.ObjectName(secretResourceID.Name()),
+							Namespace: to.Ptr(gatewayv1beta1.Namespace(secretResourceID.FindScope(resources_kubernetes.ScopeNamespaces))),
+						},
+					},
+				},
+			}
+		}
+	}
+
+	// Listener hostnames can't be IP addresses, the listener accepts any hostname in that case.
+	if hostname != "" && net.ParseIP(hostname) == nil {
+		listener.Hostname = to.Ptr(gatewayv1beta1.Hostname(hostname))
+	}
+
+	gatewayObject := &gatewayv1beta1.Gateway{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       resources_kubernetes.KindGatewayAPIGateway,
+			APIVersion: gatewayv1beta1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        kubernetes.NormalizeResourceName(gateway.Name),
+			Namespace:   options.Environment.Namespace,
+			Labels:      renderers.GetLabels(options, applicationName, gateway.Name, gateway.ResourceTypeName()),
+			Annotations: renderers.GetAnnotations(options),
+		},
+		Spec: gatewayv1beta1.GatewaySpec{
+			GatewayClassName: gatewayv1beta1.ObjectName(options.Environment.Gateway.GatewayClassName),
+			Listeners:        []gatewayv1beta1.Listener{listener},
+		},
+	}
+
+	return rpv1.NewKubernetesOutputResource(rpv1.LocalIDGateway, gatewayObject, gatewayObject.ObjectMeta), nil
+}
+
+// MakeGatewayAPIRoutes creates a route attached to the Gateway for each destination of the gateway routes. Routes
+// to the same destination are rules of the same HTTPRoute. If sslPassthrough is set, a TLSRoute forwards the TLS
+// traffic to the single destination instead.
+//
+// Unlike the Contour HTTPProxy objects the routes reference their Gateway, so their names are prefixed with the name
+// of the gateway to allow multiple gateways to route to the same destination.
+func MakeGatewayAPIRoutes(ctx context.Context, options renderers.RenderOptions, gateway *datamodel.Gateway, applicationName string) ([]rpv1.OutputResource, error) {
+	gatewayName := kubernetes.NormalizeResourceName(gateway.Name)
+	parentRefs := []gatewayv1beta1.ParentReference{
+		{
+			Name: gatewayv1beta1.ObjectName(gatewayName),
+		},
+	}
+	sslPassthrough := gateway.Properties.TLS != nil && gateway.Properties.TLS.SSLPassthrough
+
+	localIDs := []string{}
+	httpRoutes := map[string]*gatewayv1beta1.HTTPRoute{}
+	outputResources := []rpv1.OutputResource{}
+	for _, route := range gateway.Properties.Routes {
+		defaultPort := renderers.DefaultPort
+		if sslPassthrough {
+			defaultPort = renderers.DefaultSecurePort
+		}
+
+		port, err := getDestinationPort(route, options.Dependencies, defaultPort)
+		if err != nil {
+			return []rpv1.OutputResource{}, err
+		}
+
+		routeName, err := getRouteName(&route)
+		if err != nil {
+			return []rpv1.OutputResource{}, err
+		}
+
+		// Create unique localID for dependency graph
+		localID := fmt.Sprintf("%s-%s", rpv1.LocalIDHttpRoute, routeName)
+		objectMeta := metav1.ObjectMeta{
+			Name:        kubernetes.NormalizeResourceName(fmt.Sprintf("%s-%s", gatewayName, routeName)),
+			Namespace:   options.Environment.Namespace,
+			Labels:      renderers.GetLabels(options, applicationName, routeName, gateway.ResourceTypeName()),
+			Annotations: renderers.GetAnnotations(options),
+		}
+		backendRef := gatewayv1beta1.BackendRef{
+			BackendObjectReference: gatewayv1beta1.BackendObjectReference{
+				Name: gatewayv1beta1.ObjectName(kubernetes.NormalizeResourceName(routeName)),
+				Port: to.Ptr(gatewayv1beta1.PortNumber(port)),
+			},
+		}
+
+		if sslPassthrough {
+			tlsRoute := &gatewayv1alpha2.TLSRoute{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       resources_kubernetes.KindGatewayAPITLSRoute,
+					APIVersion: gatewayv1alpha2.GroupVersion.String(),
+				},
+				ObjectMeta: objectMeta,
+				Spec: gatewayv1alpha2.TLSRouteSpec{
+					CommonRouteSpec: gatewayv1alpha2.CommonRouteSpec{
+						ParentRefs: parentRefs,
+					},
+					Rules: []gatewayv1alpha2.TLSRouteRule{
+						{
+							BackendRefs: []gatewayv1alpha2.BackendRef{backendRef},
+						},
+					},
+				},
+			}
+
+			outputResource := rpv1.NewKubernetesOutputResource(localID, tlsRoute, tlsRoute.ObjectMeta)
+			outputResource.CreateResource.Dependencies = []string{rpv1.LocalIDGateway}
+			outputResources = append(outputResources, outputResource)
+			continue
+		}
+
+		path := route.Path
+		if path == "" {
+			path = "/"
+		}
+
+		rule := gatewayv1beta1.HTTPRouteRule{
+			Matches: []gatewayv1beta1.HTTPRouteMatch{
+				{
+					Path: &gatewayv1beta1.HTTPPathMatch{
+						Type:  to.Ptr(gatewayv1beta1.PathMatchPathPrefix),
+						Value: to.Ptr(path),
+					},
+				},
+			},
+			BackendRefs: []gatewayv1beta1.HTTPBackendRef{
+				{
+					BackendRef: backendRef,
+				},
+			},
+		}
+
+		if route.ReplacePrefix != "" {
+			rule.Filters = []gatewayv1beta1.HTTPRouteFilter{
+				{
+					Type: gatewayv1beta1.HTTPRouteFilterURLRewrite,
+					URLRewrite: &gatewayv1beta1.HTTPURLRewriteFilter{
+						Path: &gatewayv1beta1.HTTPPathModifier{
+							Type:               gatewayv1beta1.PrefixMatchHTTPPathModifier,
+							ReplacePrefixMatch: to.Ptr(route.ReplacePrefix),
+						},
+					},
+				},
+			}
+		}
+
+		// If this route already exists, add the rule to it
+		if httpRoute, exists := httpRoutes[localID]; exists {
+			httpRoute.Spec.Rules = append(httpRoute.Spec.Rules, rule)
+			continue
+		}
+
+		httpRoutes[localID] = &gatewayv1beta1.HTTPRoute{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       resources_kubernetes.KindGatewayAPIHTTPRoute,
+				APIVersion: gatewayv1beta1.GroupVersion.String(),
+			},
+			ObjectMeta: objectMeta,
+			Spec: gatewayv1beta1.HTTPRouteSpec{
+				CommonRouteSpec: gatewayv1beta1.CommonRouteSpec{
+					ParentRefs: parentRefs,
+				},
+				Rules: []gatewayv1beta1.HTTPRouteRule{rule},
+			},
+		}
+		localIDs = append(localIDs, localID)
+	}
+
+	for _, localID := range localIDs {
+		httpRoute := httpRoutes[localID]
+
+		// The Gateway must exist for the route to be accepted
+		outputResource := rpv1.NewKubernetesOutputResource(localID, httpRoute, httpRoute.ObjectMeta)
+		outputResource.CreateResource.Dependencies = []string{rpv1.LocalIDGateway}
+		outputResources = append(outputResources, outputResource)
+	}
+
+	return outputResources, nil
+}
+
+// getDestinationPort returns the port of the destination of the route. It is the port of the URL for DNS-SD
+// destinations, and the port of the httpRoute resource otherwise.
+func getDestinationPort(route datamodel.GatewayRoute, dependencies map[string]renderers.RendererDependency, defaultPort int32) (int32, error) {
+	if isURL(route.Destination) {
+		_, _, port, err := parseURL(route.Destination)
+		if err != nil {
+			return 0, err
+		}
+		return port, nil
+	}
+
+	if port, ok := dependencies[route.Destination].ComputedValues["port"].(float64); ok {
+		return int32(port), nil
+	}
+
+	return defaultPort, nil
+}
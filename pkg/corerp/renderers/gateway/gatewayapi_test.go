@@ -0,0 +1,511 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/handlers"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	"github.com/stretchr/testify/require"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const (
+	testGatewayClassName = "test-gateway-class"
+)
+
+func Test_Render_GatewayAPI_SingleRoute(t *testing.T) {
+	r := &Renderer{}
+
+	properties, _ := makeTestGateway(datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+	})
+	resource := makeResource(t, properties)
+	environmentOptions := getGatewayAPIEnvironmentOptions("", testExternalIP, "")
+	expectedHostname := fmt.Sprintf("%s.%s.%s.nip.io", resourceName, applicationName, testExternalIP)
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 2)
+	require.Equal(t, "http://"+expectedHostname, output.ComputedValues["url"].Value)
+
+	expectedGatewaySpec := gatewayv1beta1.GatewaySpec{
+		GatewayClassName: testGatewayClassName,
+		Listeners: []gatewayv1beta1.Listener{
+			{
+				Name:     GatewayAPIHTTPListenerName,
+				Hostname: to.Ptr(gatewayv1beta1.Hostname(expectedHostname)),
+				Port:     80,
+				Protocol: gatewayv1beta1.HTTPProtocolType,
+			},
+		},
+	}
+	validateGatewayAPIGateway(t, output.Resources, expectedGatewaySpec)
+
+	expectedRules := []gatewayv1beta1.HTTPRouteRule{
+		makeHTTPRouteRule("/", "routename", 80, ""),
+	}
+	validateGatewayAPIHTTPRoute(t, output.Resources, "routeName", expectedRules)
+}
+
+func Test_Render_GatewayAPI_MultipleRoutes_WithPrefixRewrite(t *testing.T) {
+	r := &Renderer{}
+
+	routeName := "routename"
+	destination := makeRouteResourceID(routeName)
+	properties := datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		Routes: []datamodel.GatewayRoute{
+			{
+				Destination:   destination,
+				Path:          "/backend",
+				ReplacePrefix: "/rewrite",
+			},
+			{
+				Destination: destination,
+				Path:        "/frontend",
+			},
+		},
+	}
+	resource := makeResource(t, properties)
+	dependencies := map[string]renderers.RendererDependency{
+		destination: {
+			ComputedValues: map[string]any{
+				"port": float64(8080),
+			},
+		},
+	}
+	environmentOptions := getGatewayAPIEnvironmentOptions("", testExternalIP, "")
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: dependencies, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 2)
+
+	expectedRules := []gatewayv1beta1.HTTPRouteRule{
+		makeHTTPRouteRule("/backend", routeName, 8080, "/rewrite"),
+		makeHTTPRouteRule("/frontend", routeName, 8080, ""),
+	}
+	validateGatewayAPIHTTPRoute(t, output.Resources, routeName, expectedRules)
+}
+
+func Test_Render_GatewayAPI_WithMissingPublicIP(t *testing.T) {
+	r := &Renderer{}
+
+	properties, _ := makeTestGateway(datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+	})
+	resource := makeResource(t, properties)
+	environmentOptions := getGatewayAPIEnvironmentOptions("", "", "")
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+	require.NoError(t, err)
+
+	// The URL is derived from the address of the Gateway once it is deployed.
+	url := output.ComputedValues["url"]
+	require.Nil(t, url.Value)
+	require.Equal(t, rpv1.LocalIDGateway, url.LocalID)
+	require.Equal(t, handlers.GatewayAddressKey, url.PropertyReference)
+	require.NotNil(t, url.Transformer)
+
+	// The listener accepts any hostname when there is no public endpoint.
+	expectedGatewaySpec := gatewayv1beta1.GatewaySpec{
+		GatewayClassName: testGatewayClassName,
+		Listeners: []gatewayv1beta1.Listener{
+			{
+				Name:     GatewayAPIHTTPListenerName,
+				Port:     80,
+				Protocol: gatewayv1beta1.HTTPProtocolType,
+			},
+		},
+	}
+	validateGatewayAPIGateway(t, output.Resources, expectedGatewaySpec)
+}
+
+func Test_MakeGatewayAPIURLReference(t *testing.T) {
+	const testGatewayAddress = "10.0.0.1"
+
+	tests := []struct {
+		name     string
+		hostname *datamodel.GatewayPropertiesHostname
+		port     string
+		address  any
+		expected string
+	}{
+		{
+			name:     "no address",
+			address:  "",
+			expected: "unknown",
+		},
+		{
+			name:     "IP address",
+			address:  testGatewayAddress,
+			expected: fmt.Sprintf("http://%s.%s.%s.nip.io", resourceName, applicationName, testGatewayAddress),
+		},
+		{
+			name:     "IP address with prefix",
+			hostname: &datamodel.GatewayPropertiesHostname{Prefix: "prefix"},
+			address:  testGatewayAddress,
+			expected: fmt.Sprintf("http://prefix.%s.%s.nip.io", applicationName, testGatewayAddress),
+		},
+		{
+			name:     "hostname with port",
+			port:     testPort,
+			address:  testHostname,
+			expected: fmt.Sprintf("http://%s:%s", testHostname, testPort),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			properties, _ := makeTestGateway(datamodel.GatewayProperties{Hostname: tc.hostname})
+			resource := makeResource(t, properties)
+			options := getGatewayAPIEnvironmentOptions("", "", tc.port).Gateway
+
+			url := makeGatewayAPIURLReference(*resource, applicationName, options)
+			cv := map[string]any{"url": tc.address}
+			require.NoError(t, url.Transformer(resource, cv))
+			require.Equal(t, tc.expected, cv["url"])
+		})
+	}
+
+	t.Run("hostname without prefix", func(t *testing.T) {
+		properties, _ := makeTestGateway(datamodel.GatewayProperties{Hostname: &datamodel.GatewayPropertiesHostname{}})
+		resource := makeResource(t, properties)
+
+		url := makeGatewayAPIURLReference(*resource, applicationName, getGatewayAPIEnvironmentOptions("", "", "").Gateway)
+		err := url.Transformer(resource, map[string]any{"url": testHostname})
+		require.Error(t, err)
+	})
+}
+
+func Test_Render_GatewayAPI_PublicEndpointOverride_WithIP(t *testing.T) {
+	r := &Renderer{}
+
+	properties, _ := makeTestGateway(datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+	})
+	resource := makeResource(t, properties)
+	environmentOptions := getGatewayAPIEnvironmentOptions("127.0.0.1", "", testPort)
+	environmentOptions.Gateway.PublicEndpointOverride = true
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Equal(t, "http://127.0.0.1:8080", output.ComputedValues["url"].Value)
+
+	gateway, _ := getGatewayAPIGateway(t, output.Resources)
+	require.Nil(t, gateway.Spec.Listeners[0].Hostname)
+}
+
+func Test_Render_GatewayAPI_With_TLSTermination(t *testing.T) {
+	r := &Renderer{}
+
+	secretName := "myapp-tls-secret"
+	secretStoreResourceId := makeSecretStoreResourceID(secretName)
+	properties, _ := makeTestGateway(datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		TLS: &datamodel.GatewayPropertiesTLS{
+			MinimumProtocolVersion: "1.2",
+			CertificateFrom:        secretStoreResourceId,
+		},
+	})
+	resource := makeResource(t, properties)
+	environmentOptions := getGatewayAPIEnvironmentOptions("", testExternalIP, "")
+
+	dependencies := map[string]renderers.RendererDependency{
+		(makeResourceID(t, secretStoreResourceId).String()): {
+			ResourceID: makeResourceID(t, secretStoreResourceId),
+			Resource: &datamodel.SecretStore{
+				Properties: &datamodel.SecretStoreProperties{
+					Type: "certificate",
+					Data: map[string]*datamodel.SecretStoreDataValue{
+						"tls.crt": {
+							Value: to.Ptr("test-crt"),
+						},
+						"tls.key": {
+							Value: to.Ptr("test-crt"),
+						},
+					},
+				},
+			},
+			OutputResources: map[string]resources.ID{
+				"Secret": resources_kubernetes.IDFromParts(
+					resources_kubernetes.PlaneNameTODO,
+					"",
+					"Secret",
+					environmentOptions.Namespace,
+					secretName),
+			},
+		},
+	}
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: dependencies, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 2)
+
+	expectedHostname := fmt.Sprintf("%s.%s.%s.nip.io", resourceName, applicationName, testExternalIP)
+	require.Equal(t, "https://"+expectedHostname, output.ComputedValues["url"].Value)
+
+	expectedGatewaySpec := gatewayv1beta1.GatewaySpec{
+		GatewayClassName: testGatewayClassName,
+		Listeners: []gatewayv1beta1.Listener{
+			{
+				Name:     GatewayAPIHTTPSListenerName,
+				Hostname: to.Ptr(gatewayv1beta1.Hostname(expectedHostname)),
+				Port:     443,
+				Protocol: gatewayv1beta1.HTTPSProtocolType,
+				TLS: &gatewayv1beta1.GatewayTLSConfig{
+					Mode: to.Ptr(gatewayv1beta1.TLSModeTerminate),
+					CertificateRefs: []gatewayv1beta1.SecretObjectReference{
+						{
+							Name:      gatewayv1beta1.ObjectName(secretName),
+							Namespace: to.Ptr(gatewayv1beta1.Namespace(environmentOptions.Namespace)),
+						},
+					},
+				},
+			},
+		},
+	}
+	validateGatewayAPIGateway(t, output.Resources, expectedGatewaySpec)
+}
+
+func Test_Render_GatewayAPI_SSLPassthrough(t *testing.T) {
+	r := &Renderer{}
+
+	routeName := "routename"
+	destination := makeRouteResourceID(routeName)
+	properties := datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		TLS: &datamodel.GatewayPropertiesTLS{
+			SSLPassthrough: true,
+		},
+		Routes: []datamodel.GatewayRoute{
+			{
+				Destination: destination,
+			},
+		},
+	}
+	resource := makeResource(t, properties)
+	environmentOptions := getGatewayAPIEnvironmentOptions("", testExternalIP, "")
+	expectedHostname := fmt.Sprintf("%s.%s.%s.nip.io", resourceName, applicationName, testExternalIP)
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 2)
+	require.Equal(t, "https://"+expectedHostname, output.ComputedValues["url"].Value)
+
+	expectedGatewaySpec := gatewayv1beta1.GatewaySpec{
+		GatewayClassName: testGatewayClassName,
+		Listeners: []gatewayv1beta1.Listener{
+			{
+				Name:     GatewayAPITLSListenerName,
+				Hostname: to.Ptr(gatewayv1beta1.Hostname(expectedHostname)),
+				Port:     443,
+				Protocol: gatewayv1beta1.TLSProtocolType,
+				TLS: &gatewayv1beta1.GatewayTLSConfig{
+					Mode: to.Ptr(gatewayv1beta1.TLSModePassthrough),
+				},
+			},
+		},
+	}
+	validateGatewayAPIGateway(t, output.Resources, expectedGatewaySpec)
+
+	var tlsRoute *gatewayv1alpha2.TLSRoute
+	for _, r := range output.Resources {
+		if r.GetResourceType().Type == resources_kubernetes.ResourceTypeGatewayAPITLSRoute {
+			tlsRoute = r.CreateResource.Data.(*gatewayv1alpha2.TLSRoute)
+			require.Equal(t, []string{rpv1.LocalIDGateway}, r.CreateResource.Dependencies)
+		}
+	}
+	require.NotNil(t, tlsRoute)
+	require.Equal(t, "test-gateway-routename", tlsRoute.Name)
+
+	expectedSpec := gatewayv1alpha2.TLSRouteSpec{
+		CommonRouteSpec: gatewayv1alpha2.CommonRouteSpec{
+			ParentRefs: []gatewayv1beta1.ParentReference{
+				{
+					Name: gatewayv1beta1.ObjectName(resourceName),
+				},
+			},
+		},
+		Rules: []gatewayv1alpha2.TLSRouteRule{
+			{
+				BackendRefs: []gatewayv1beta1.BackendRef{
+					{
+						BackendObjectReference: gatewayv1beta1.BackendObjectReference{
+							Name: gatewayv1beta1.ObjectName(routeName),
+							Port: to.Ptr(gatewayv1beta1.PortNumber(renderers.DefaultSecurePort)),
+						},
+					},
+				},
+			},
+		},
+	}
+	require.Equal(t, expectedSpec, tlsRoute.Spec)
+}
+
+func Test_Render_GatewayAPI_Fails_WithoutGatewayClassName(t *testing.T) {
+	r := &Renderer{}
+
+	properties, _ := makeTestGateway(datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+	})
+	resource := makeResource(t, properties)
+	environmentOptions := getGatewayAPIEnvironmentOptions("", testExternalIP, "")
+	environmentOptions.Gateway.GatewayClassName = ""
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+	require.Error(t, err)
+	require.Equal(t, v1.CodeInvalid, err.(*v1.ErrClientRP).Code)
+	require.Equal(t, "the environment must specify gateway.gatewayClassName when gateway.kind is \"gatewayAPI\"", err.(*v1.ErrClientRP).Message)
+	require.Len(t, output.Resources, 0)
+}
+
+func Test_Render_GatewayAPI_Fails_WithNoRoute(t *testing.T) {
+	r := &Renderer{}
+
+	properties := datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+	}
+	resource := makeResource(t, properties)
+	environmentOptions := getGatewayAPIEnvironmentOptions("", testExternalIP, "")
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+	require.Error(t, err)
+	require.Equal(t, v1.CodeInvalid, err.(*v1.ErrClientRP).Code)
+	require.Equal(t, "must have at least one route when declaring a Gateway resource", err.(*v1.ErrClientRP).Message)
+	require.Len(t, output.Resources, 0)
+}
+
+func validateGatewayAPIGateway(t *testing.T, outputResources []rpv1.OutputResource, expectedSpec gatewayv1beta1.GatewaySpec) {
+	gateway, outputResource := getGatewayAPIGateway(t, outputResources)
+
+	require.Equal(t, rpv1.LocalIDGateway, outputResource.LocalID)
+	require.Equal(t, resourceName, gateway.Name)
+	require.Equal(t, applicationName, gateway.Namespace)
+	require.Equal(t, expectedSpec, gateway.Spec)
+}
+
+func getGatewayAPIGateway(t *testing.T, outputResources []rpv1.OutputResource) (*gatewayv1beta1.Gateway, rpv1.OutputResource) {
+	for _, r := range outputResources {
+		if r.GetResourceType().Type != resources_kubernetes.ResourceTypeGatewayAPIGateway {
+			continue
+		}
+
+		gateway, ok := r.CreateResource.Data.(*gatewayv1beta1.Gateway)
+		require.True(t, ok)
+		return gateway, r
+	}
+
+	require.Fail(t, "Gateway output resource not found")
+	return nil, rpv1.OutputResource{}
+}
+
+func validateGatewayAPIHTTPRoute(t *testing.T, outputResources []rpv1.OutputResource, routeName string, expectedRules []gatewayv1beta1.HTTPRouteRule) {
+	expectedLocalID := fmt.Sprintf("%s-%s", rpv1.LocalIDHttpRoute, routeName)
+	for _, r := range outputResources {
+		if r.LocalID != expectedLocalID {
+			continue
+		}
+
+		require.Equal(t, resources_kubernetes.ResourceTypeGatewayAPIHTTPRoute, r.GetResourceType().Type)
+		require.Equal(t, []string{rpv1.LocalIDGateway}, r.CreateResource.Dependencies)
+
+		httpRoute, ok := r.CreateResource.Data.(*gatewayv1beta1.HTTPRoute)
+		require.True(t, ok)
+		require.Equal(t, kubernetes.NormalizeResourceName(fmt.Sprintf("%s-%s", resourceName, routeName)), httpRoute.Name)
+		require.Equal(t, applicationName, httpRoute.Namespace)
+		require.Equal(t, []gatewayv1beta1.ParentReference{{Name: gatewayv1beta1.ObjectName(resourceName)}}, httpRoute.Spec.ParentRefs)
+		require.Equal(t, expectedRules, httpRoute.Spec.Rules)
+		return
+	}
+
+	require.Fail(t, fmt.Sprintf("HTTPRoute output resource %s not found", expectedLocalID))
+}
+
+func makeHTTPRouteRule(path string, serviceName string, port int32, replacePrefix string) gatewayv1beta1.HTTPRouteRule {
+	rule := gatewayv1beta1.HTTPRouteRule{
+		Matches: []gatewayv1beta1.HTTPRouteMatch{
+			{
+				Path: &gatewayv1beta1.HTTPPathMatch{
+					Type:  to.Ptr(gatewayv1beta1.PathMatchPathPrefix),
+					Value: to.Ptr(path),
+				},
+			},
+		},
+		BackendRefs: []gatewayv1beta1.HTTPBackendRef{
+			{
+				BackendRef: gatewayv1beta1.BackendRef{
+					BackendObjectReference: gatewayv1beta1.BackendObjectReference{
+						Name: gatewayv1beta1.ObjectName(serviceName),
+						Port: to.Ptr(gatewayv1beta1.PortNumber(port)),
+					},
+				},
+			},
+		},
+	}
+
+	if replacePrefix != "" {
+		rule.Filters = []gatewayv1beta1.HTTPRouteFilter{
+			{
+				Type: gatewayv1beta1.HTTPRouteFilterURLRewrite,
+				URLRewrite: &gatewayv1beta1.HTTPURLRewriteFilter{
+					Path: &gatewayv1beta1.HTTPPathModifier{
+						Type:               gatewayv1beta1.PrefixMatchHTTPPathModifier,
+						ReplacePrefixMatch: to.Ptr(replacePrefix),
+					},
+				},
+			},
+		}
+	}
+
+	return rule
+}
+
+func getGatewayAPIEnvironmentOptions(hostname, externalIP, port string) renderers.EnvironmentOptions {
+	environmentOptions := getEnvironmentOptions(hostname, externalIP, port, false, false)
+	environmentOptions.Gateway.Kind = datamodel.GatewayKindGatewayAPI
+	environmentOptions.Gateway.GatewayClassName = testGatewayClassName
+
+	return environmentOptions
+}
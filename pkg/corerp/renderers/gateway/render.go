@@ -30,6 +30,7 @@ import (
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/handlers"
 	"github.com/radius-project/radius/pkg/corerp/renderers"
 	"github.com/radius-project/radius/pkg/kubernetes"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
@@ -103,6 +104,31 @@ func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 		publicEndpoint = getPublicEndpoint(hostname, options.Environment.Gateway.Port, isHttps)
 	}
 
+	computedValues := map[string]rpv1.ComputedValueReference{
+		"url": {
+			Value: publicEndpoint,
+		},
+	}
+
+	if options.Environment.Gateway.Kind == datamodel.GatewayKindGatewayAPI {
+		// The public endpoint of a Gateway API Gateway is the address assigned to the Gateway, which is only known
+		// once the Gateway is deployed. The listener of the Gateway accepts any hostname in that case.
+		if publicEndpoint == "unknown" {
+			hostname = ""
+			computedValues["url"] = makeGatewayAPIURLReference(*gateway, applicationName, options.Environment.Gateway)
+		}
+
+		gatewayAPIObjects, err := MakeGatewayAPIResources(ctx, options, gateway, applicationName, hostname)
+		if err != nil {
+			return renderers.RendererOutput{}, err
+		}
+
+		return renderers.RendererOutput{
+			Resources:      gatewayAPIObjects,
+			ComputedValues: computedValues,
+		}, nil
+	}
+
 	gatewayObject, err := MakeRootHTTPProxy(ctx, options, gateway, gateway.Name, applicationName, hostname)
 	if err != nil {
 		return renderers.RendererOutput{}, err
@@ -110,12 +136,6 @@ func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 
 	outputResources = append(outputResources, gatewayObject)
 
-	computedValues := map[string]rpv1.ComputedValueReference{
-		"url": {
-			Value: publicEndpoint,
-		},
-	}
-
 	httpRouteObjects, err := MakeRoutesHTTPProxies(ctx, options, *gateway, &gateway.Properties, gatewayName, gatewayObject, applicationName)
 	if err != nil {
 		return renderers.RendererOutput{}, err
@@ -132,10 +152,9 @@ func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 // to act as the Gateway.
 func MakeRootHTTPProxy(ctx context.Context, options renderers.RenderOptions, gateway *datamodel.Gateway, resourceName string, applicationName string, hostname string) (rpv1.OutputResource, error) {
 	includes := []contourv1.Include{}
-	dependencies := options.Dependencies
 
-	if len(gateway.Properties.Routes) < 1 {
-		return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("must have at least one route when declaring a Gateway resource")
+	if err := validateRoutes(gateway); err != nil {
+		return rpv1.OutputResource{}, err
 	}
 
 	sslPassthrough := false
@@ -146,68 +165,20 @@ func MakeRootHTTPProxy(ctx context.Context, options renderers.RenderOptions, gat
 		sslPassthrough = gateway.Properties.TLS.SSLPassthrough
 
 		if gateway.Properties.TLS.CertificateFrom != "" {
-			secretStoreResourceId := gateway.Properties.TLS.CertificateFrom
-			secretStoreResource, ok := dependencies[secretStoreResourceId]
-			if !ok {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", secretStoreResourceId))
-			}
-
-			referencedResource := dependencies[secretStoreResourceId].Resource
-			if !strings.EqualFold(referencedResource.ResourceTypeName(), datamodel.SecretStoreResourceType) {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource")
-			}
-
-			// Validate the secretStore resource: it must be of type certificate and have tls.crt and tls.key
-			secretStore, ok := referencedResource.(*datamodel.SecretStore)
-			if !ok {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource")
-			}
-
-			if secretStore.Properties.Type != datamodel.SecretTypeCert {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource with type certificate")
-			}
-
-			if secretStore.Properties.Data["tls.crt"] == nil {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource with tls.crt")
-			}
-
-			if secretStore.Properties.Data["tls.key"] == nil {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource with tls.key")
-			}
-
-			// Get the name and namespace of the Kubernetes secret resource from the secretStore OutputResources
-			if secretStoreResource.OutputResources == nil {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", secretStoreResourceId))
-			}
-
-			secretResourceID, ok := secretStoreResource.OutputResources[rpv1.LocalIDSecret]
-			if !ok {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", secretStoreResourceId))
-			}
-
-			secretName := secretResourceID.Name()
-			secretNamespace := secretResourceID.FindScope(resources_kubernetes.ScopeNamespaces)
-			if secretNamespace == "" {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", secretStoreResourceId))
+			secretResourceID, err := getCertificateSecretID(gateway, options.Dependencies)
+			if err != nil {
+				return rpv1.OutputResource{}, err
 			}
 
 			contourTLSConfig = &contourv1.TLS{
-				SecretName:             fmt.Sprintf("%s/%s", secretNamespace, secretName),
+				SecretName:             fmt.Sprintf("%s/%s", secretResourceID.FindScope(resources_kubernetes.ScopeNamespaces), secretResourceID.Name()),
 				MinimumProtocolVersion: string(gateway.Properties.TLS.MinimumProtocolVersion),
 			}
 		}
 	}
 
-	// If SSL Passthrough is enabled, then we can only have one route
-	if sslPassthrough && len(gateway.Properties.Routes) > 1 {
-		return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("cannot support multiple routes with sslPassthrough set to true")
-	}
-
 	var route datamodel.GatewayRoute //route will hold the one sslPassthrough route, if sslPassthrough is true
 	for _, route = range gateway.Properties.Routes {
-		if sslPassthrough && (route.Path != "" || route.ReplacePrefix != "") {
-			return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("cannot support `path` or `replacePrefix` in routes with sslPassthrough set to true")
-		}
 		routeName, err := getRouteName(&route)
 		if err != nil {
 			return rpv1.OutputResource{}, err
@@ -400,6 +371,80 @@ func MakeRoutesHTTPProxies(ctx context.Context, options renderers.RenderOptions,
 	return outputResources, nil
 }
 
+// validateRoutes validates the routes of the Gateway resource. A gateway must have at least one route, and a gateway
+// with sslPassthrough set to true must have a single route without `path` or `replacePrefix`.
+func validateRoutes(gateway *datamodel.Gateway) error {
+	if len(gateway.Properties.Routes) < 1 {
+		return v1.NewClientErrInvalidRequest("must have at least one route when declaring a Gateway resource")
+	}
+
+	if gateway.Properties.TLS == nil || !gateway.Properties.TLS.SSLPassthrough {
+		return nil
+	}
+
+	// If SSL Passthrough is enabled, then we can only have one route
+	if len(gateway.Properties.Routes) > 1 {
+		return v1.NewClientErrInvalidRequest("cannot support multiple routes with sslPassthrough set to true")
+	}
+
+	for _, route := range gateway.Properties.Routes {
+		if route.Path != "" || route.ReplacePrefix != "" {
+			return v1.NewClientErrInvalidRequest("cannot support `path` or `replacePrefix` in routes with sslPassthrough set to true")
+		}
+	}
+
+	return nil
+}
+
+// getCertificateSecretID validates the secretStore resource referenced by the certificateFrom property of the Gateway
+// resource, and returns the resource ID of the Kubernetes secret holding the certificate.
+func getCertificateSecretID(gateway *datamodel.Gateway, dependencies map[string]renderers.RendererDependency) (resources.ID, error) {
+	secretStoreResourceId := gateway.Properties.TLS.CertificateFrom
+	secretStoreResource, ok := dependencies[secretStoreResourceId]
+	if !ok {
+		return resources.ID{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", secretStoreResourceId))
+	}
+
+	referencedResource := secretStoreResource.Resource
+	if !strings.EqualFold(referencedResource.ResourceTypeName(), datamodel.SecretStoreResourceType) {
+		return resources.ID{}, v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource")
+	}
+
+	// Validate the secretStore resource: it must be of type certificate and have tls.crt and tls.key
+	secretStore, ok := referencedResource.(*datamodel.SecretStore)
+	if !ok {
+		return resources.ID{}, v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource")
+	}
+
+	if secretStore.Properties.Type != datamodel.SecretTypeCert {
+		return resources.ID{}, v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource with type certificate")
+	}
+
+	if secretStore.Properties.Data["tls.crt"] == nil {
+		return resources.ID{}, v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource with tls.crt")
+	}
+
+	if secretStore.Properties.Data["tls.key"] == nil {
+		return resources.ID{}, v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource with tls.key")
+	}
+
+	// Get the name and namespace of the Kubernetes secret resource from the secretStore OutputResources
+	if secretStoreResource.OutputResources == nil {
+		return resources.ID{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", secretStoreResourceId))
+	}
+
+	secretResourceID, ok := secretStoreResource.OutputResources[rpv1.LocalIDSecret]
+	if !ok {
+		return resources.ID{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", secretStoreResourceId))
+	}
+
+	if secretResourceID.FindScope(resources_kubernetes.ScopeNamespaces) == "" {
+		return resources.ID{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", secretStoreResourceId))
+	}
+
+	return secretResourceID, nil
+}
+
 func getRouteName(route *datamodel.GatewayRoute) (string, error) {
 	// if isURL, then name is hostname (DNS-SD case)
 	if isURL(route.Destination) {
@@ -474,6 +519,37 @@ func getHostname(resource datamodel.Gateway, gateway *datamodel.GatewayPropertie
 	return baseHostname, nil
 }
 
+// makeGatewayAPIURLReference returns the computed value of the URL of a gateway which is derived from the address of
+// its Gateway API Gateway, as returned by the Kubernetes handler once the Gateway is ready.
+func makeGatewayAPIURLReference(resource datamodel.Gateway, applicationName string, options renderers.GatewayOptions) rpv1.ComputedValueReference {
+	return rpv1.ComputedValueReference{
+		LocalID:           rpv1.LocalIDGateway,
+		PropertyReference: handlers.GatewayAddressKey,
+		Transformer: func(_ v1.DataModelInterface, cv map[string]any) error {
+			address, _ := cv["url"].(string)
+			if address == "" {
+				cv["url"] = "unknown"
+				return nil
+			}
+
+			if net.ParseIP(address) != nil {
+				options.ExternalIP = address
+			} else {
+				options.Hostname = address
+			}
+
+			hostname, err := getHostname(resource, &resource.Properties, applicationName, options)
+			if err != nil {
+				return fmt.Errorf("getting hostname failed with error: %s", err)
+			}
+
+			isHttps := resource.Properties.TLS != nil && (resource.Properties.TLS.SSLPassthrough || resource.Properties.TLS.CertificateFrom != "")
+			cv["url"] = getPublicEndpoint(hostname, options.Port, isHttps)
+			return nil
+		},
+	}
+}
+
 // getPublicEndpoint adds http:// or https:// and the port (if it exists) to the given hostname
 func getPublicEndpoint(hostname string, port string, isHttps bool) string {
 	authority := hostname
@@ -58,6 +58,10 @@ type RendererDependency struct {
 
 	// OutputResources is a map of the output resource IDs of the dependency. The map is keyed on the LocalID of the output resource.
 	OutputResources map[string]resources.ID
+
+	// SecretDataHash is the hash of the secret data which the dependency stores outside of Radius, such as the Kubernetes
+	// secret of an Applications.Core/secretStores resource. It is empty if the dependency does not store secret data.
+	SecretDataHash string
 }
 
 // EnvironmentOptions represents the options for the linked environment resource.
@@ -74,6 +78,8 @@ type EnvironmentOptions struct {
 	KubernetesMetadata *datamodel.KubeMetadataExtension
 	// Simulated represents whether the environment is a simulated environment.
 	Simulated bool
+	// DefaultContainerResources represents the resource requirements applied to containers which don't specify any.
+	DefaultContainerResources *datamodel.ContainerResourceRequirements
 }
 
 // ApplicationOptions represents the options for the linked application resource.
@@ -87,6 +93,11 @@ type GatewayOptions struct {
 	Hostname               string
 	Port                   string
 	ExternalIP             string
+
+	// Kind is the gateway implementation used to render gateways. Contour is used if it is empty.
+	Kind datamodel.GatewayKind
+	// GatewayClassName is the name of the GatewayClass of the Gateway API Gateway resources.
+	GatewayClassName string
 }
 
 type RendererOutput struct {
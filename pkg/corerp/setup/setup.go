@@ -19,7 +19,9 @@ package setup
 import (
 	"time"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	asyncctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/worker"
 	"github.com/radius-project/radius/pkg/armrpc/builder"
 	apictrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
@@ -45,6 +47,17 @@ const (
 	AsyncOperationRetryAfter = time.Duration(5) * time.Second
 )
 
+// ContainerRetryPolicy is the retry policy of the async operations which deploy containers. The deployment fails with an
+// internal error when the Kubernetes API server can't be reached or the workload does not become ready in time, which
+// is often transient.
+var ContainerRetryPolicy = worker.RetryPolicy{
+	MaxAttempts:         3,
+	InitialBackoff:      time.Duration(10) * time.Second,
+	MaxBackoff:          time.Duration(2) * time.Minute,
+	Jitter:              0.2,
+	RetryableErrorCodes: []string{v1.CodeInternal},
+}
+
 // SetupNamespace builds the namespace for core resource provider.
 func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerConfig) *builder.Namespace {
 	ns := builder.NewNamespace("Applications.Core")
@@ -54,10 +67,14 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		ResponseConverter: converter.EnvironmentDataModelToVersioned,
 
 		Put: builder.Operation[datamodel.Environment]{
-			APIController: env_ctrl.NewCreateOrUpdateEnvironment,
+			APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+				return env_ctrl.NewCreateOrUpdateEnvironment(opt, recipeControllerConfig.RecipeMetadataCache)
+			},
 		},
 		Patch: builder.Operation[datamodel.Environment]{
-			APIController: env_ctrl.NewCreateOrUpdateEnvironment,
+			APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+				return env_ctrl.NewCreateOrUpdateEnvironment(opt, recipeControllerConfig.RecipeMetadataCache)
+			},
 		},
 		Custom: map[string]builder.Operation[datamodel.Environment]{
 			"getmetadata": {
@@ -65,6 +82,11 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 					return env_ctrl.NewGetRecipeMetadata(opt, recipeControllerConfig.Engine)
 				},
 			},
+			"plan": {
+				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+					return env_ctrl.NewPlanRecipe(opt, recipeControllerConfig.Engine)
+				},
+			},
 		},
 	})
 
@@ -122,6 +144,7 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			},
 			AsyncJobController:       backend_ctrl.NewCreateOrUpdateResource,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncRetryPolicy:         ContainerRetryPolicy,
 		},
 		Patch: builder.Operation[datamodel.ContainerResource]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.ContainerResource]{
@@ -130,6 +153,7 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			},
 			AsyncJobController:       backend_ctrl.NewCreateOrUpdateResource,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncRetryPolicy:         ContainerRetryPolicy,
 		},
 		Delete: builder.Operation[datamodel.ContainerResource]{
 			AsyncJobController:       backend_ctrl.NewDeleteResource,
@@ -226,22 +250,26 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		Put: builder.Operation[datamodel.Extender]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.Extender]{
 				rp_frontend.PrepareRadiusResource[*datamodel.Extender],
+				rp_frontend.ValidateRecipeParameters[*datamodel.Extender](recipeControllerConfig.RecipeMetadataCache, recipeControllerConfig.ConfigLoader),
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
 				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.Extender, datamodel.Extender](options, &ext_processor.Processor{}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
 			},
 			AsyncOperationTimeout:    ext_ctrl.AsyncCreateOrUpdateExtenderTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncRetryPolicy:         pr_ctrl.RecipeRetryPolicy,
 		},
 		Patch: builder.Operation[datamodel.Extender]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.Extender]{
 				rp_frontend.PrepareRadiusResource[*datamodel.Extender],
+				rp_frontend.ValidateRecipeParameters[*datamodel.Extender](recipeControllerConfig.RecipeMetadataCache, recipeControllerConfig.ConfigLoader),
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
 				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.Extender, datamodel.Extender](options, &ext_processor.Processor{}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
 			},
 			AsyncOperationTimeout:    ext_ctrl.AsyncCreateOrUpdateExtenderTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncRetryPolicy:         pr_ctrl.RecipeRetryPolicy,
 		},
 		Delete: builder.Operation[datamodel.Extender]{
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
@@ -249,6 +277,7 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			},
 			AsyncOperationTimeout:    ext_ctrl.AsyncDeleteExtenderTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncRetryPolicy:         pr_ctrl.RecipeRetryPolicy,
 		},
 		Custom: map[string]builder.Operation[datamodel.Extender]{
 			"listsecrets": {
@@ -92,6 +92,14 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: ctr_ctrl.ResourceTypeName, Method: v1.OperationDelete},
 		Path:          "/resourcegroups/testrg/providers/applications.core/containers/ctr0",
 		Method:        http.MethodDelete,
+	}, {
+		OperationType: v1.OperationType{Type: ctr_ctrl.ResourceTypeName + "/operations", Method: v1.OperationList},
+		Path:          "/resourcegroups/testrg/providers/applications.core/containers/ctr0/operations",
+		Method:        http.MethodGet,
+	}, {
+		OperationType: v1.OperationType{Type: ctr_ctrl.ResourceTypeName + "/operations", Method: v1.OperationGet},
+		Path:          "/resourcegroups/testrg/providers/applications.core/containers/ctr0/operations/00000000-0000-0000-0000-000000000001",
+		Method:        http.MethodGet,
 	}, {
 		OperationType: v1.OperationType{Type: env_ctrl.ResourceTypeName, Method: v1.OperationPlaneScopeList},
 		Path:          "/providers/applications.core/environments",
@@ -120,6 +128,10 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: env_ctrl.ResourceTypeName, Method: "ACTIONGETMETADATA"},
 		Path:          "/resourcegroups/testrg/providers/applications.core/environments/env0/getmetadata",
 		Method:        http.MethodPost,
+	}, {
+		OperationType: v1.OperationType{Type: env_ctrl.ResourceTypeName, Method: "ACTIONPLAN"},
+		Path:          "/resourcegroups/testrg/providers/applications.core/environments/env0/plan",
+		Method:        http.MethodPost,
 	}, {
 		OperationType: v1.OperationType{Type: gtwy_ctrl.ResourceTypeName, Method: v1.OperationPlaneScopeList},
 		Path:          "/providers/applications.core/gateways",
@@ -224,6 +236,10 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: "Applications.Core/operationStatuses", Method: v1.OperationGet},
 		Path:          "/providers/applications.core/locations/global/operationstatuses/00000000-0000-0000-0000-000000000000",
 		Method:        http.MethodGet,
+	}, {
+		OperationType: v1.OperationType{Type: "Applications.Core/operationStatuses", Method: v1.OperationCancel},
+		Path:          "/providers/applications.core/locations/global/operationstatuses/00000000-0000-0000-0000-000000000000/cancel",
+		Method:        http.MethodPost,
 	}, {
 		OperationType: v1.OperationType{Type: "Applications.Core/operationStatuses", Method: v1.OperationGet},
 		Path:          "/providers/applications.core/locations/global/operationresults/00000000-0000-0000-0000-000000000000",
@@ -112,6 +112,42 @@ func fromRecipeStatus(recipeStatus *rpv1.RecipeStatus) *RecipeStatus {
 		status.TemplateVersion = to.Ptr(recipeStatus.TemplateVersion)
 	}
 
+	status.Drift = fromRecipeDriftStatus(recipeStatus.Drift)
+
+	return status
+}
+
+func fromRecipeDriftStatus(drift *rpv1.RecipeDriftStatus) *RecipeDriftStatus {
+	if drift == nil {
+		return nil
+	}
+
+	status := &RecipeDriftStatus{
+		Detected:  to.Ptr(drift.Detected),
+		Resources: []*DriftedResource{},
+	}
+
+	if !drift.LastCheckedTime.IsZero() {
+		status.LastCheckedTime = to.Ptr(drift.LastCheckedTime)
+	}
+
+	if drift.LastRemediatedTime != nil {
+		status.LastRemediatedTime = to.Ptr(*drift.LastRemediatedTime)
+	}
+
+	for _, resource := range drift.Resources {
+		r := &DriftedResource{
+			Reason: to.Ptr(DriftReason(resource.Reason)),
+		}
+		if resource.ResourceID != "" {
+			r.ResourceID = to.Ptr(resource.ResourceID)
+		}
+		if resource.Address != "" {
+			r.Address = to.Ptr(resource.Address)
+		}
+		status.Resources = append(status.Resources, r)
+	}
+
 	return status
 }
 
@@ -46,6 +46,24 @@ func PossibleCreatedByTypeValues() []CreatedByType {
 	}
 }
 
+// DriftReason - The reason a resource deployed by a recipe is reported as drifted.
+type DriftReason string
+
+const (
+	// DriftReasonDeleted - The resource was deleted outside of the recipe.
+	DriftReasonDeleted DriftReason = "Deleted"
+	// DriftReasonModified - The resource was modified outside of the recipe.
+	DriftReasonModified DriftReason = "Modified"
+)
+
+// PossibleDriftReasonValues returns the possible values for the DriftReason const type.
+func PossibleDriftReasonValues() []DriftReason {
+	return []DriftReason{	
+		DriftReasonDeleted,
+		DriftReasonModified,
+	}
+}
+
 // IdentitySettingKind - IdentitySettingKind is the kind of supported external identity setting
 type IdentitySettingKind string
 
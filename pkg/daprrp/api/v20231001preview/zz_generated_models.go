@@ -321,6 +321,18 @@ type DaprStateStoreResourceUpdateProperties struct {
 	Version *string
 }
 
+// DriftedResource - A resource deployed by a recipe which no longer matches what was deployed.
+type DriftedResource struct {
+	// REQUIRED; The reason the resource is reported as drifted.
+	Reason *DriftReason
+
+	// The address of the resource in the recipe template, such as the address of a Terraform resource.
+	Address *string
+
+	// Fully qualified resource ID of the resource. Omitted if the ID of the resource is not known.
+	ResourceID *string
+}
+
 // EnvironmentCompute - Represents backing compute resource
 type EnvironmentCompute struct {
 	// REQUIRED; Discriminator property for EnvironmentCompute.
@@ -478,6 +490,21 @@ type Recipe struct {
 	Parameters map[string]any
 }
 
+// RecipeDriftStatus - The result of the drift check of the resources deployed by a recipe.
+type RecipeDriftStatus struct {
+	// REQUIRED; Indicates whether any of the resources deployed by the recipe have drifted.
+	Detected *bool
+
+	// The time of the last drift check.
+	LastCheckedTime *time.Time
+
+	// The time the recipe was last redeployed to remediate drift.
+	LastRemediatedTime *time.Time
+
+	// The resources deployed by the recipe which have drifted.
+	Resources []*DriftedResource
+}
+
 // RecipeStatus - Recipe status at deployment time for a resource.
 type RecipeStatus struct {
 	// REQUIRED; TemplateKind is the kind of the recipe template used by the portable resource upon deployment.
@@ -486,6 +513,9 @@ type RecipeStatus struct {
 	// REQUIRED; TemplatePath is the path of the recipe consumed by the portable resource upon deployment.
 	TemplatePath *string
 
+	// The result of the last drift check of the resources deployed by the recipe.
+	Drift *RecipeDriftStatus
+
 	// TemplateVersion is the version number of the template.
 	TemplateVersion *string
 }
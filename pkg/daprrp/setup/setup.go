@@ -20,6 +20,7 @@ import (
 	"time"
 
 	asyncctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
 	"github.com/radius-project/radius/pkg/armrpc/builder"
 	apictrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/daprrp/datamodel"
@@ -50,22 +51,26 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		Put: builder.Operation[datamodel.DaprPubSubBroker]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.DaprPubSubBroker]{
 				rp_frontend.PrepareRadiusResource[*datamodel.DaprPubSubBroker],
+				rp_frontend.ValidateRecipeParameters[*datamodel.DaprPubSubBroker](recipeControllerConfig.RecipeMetadataCache, recipeControllerConfig.ConfigLoader),
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
 				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.DaprPubSubBroker, datamodel.DaprPubSubBroker](options, &pubsub_proc.Processor{Client: options.KubeClient}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
 			},
 			AsyncOperationTimeout:    dapr_ctrl.AsyncCreateOrUpdateDaprPubSubBrokerTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncRetryPolicy:         pr_ctrl.RecipeRetryPolicy,
 		},
 		Patch: builder.Operation[datamodel.DaprPubSubBroker]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.DaprPubSubBroker]{
 				rp_frontend.PrepareRadiusResource[*datamodel.DaprPubSubBroker],
+				rp_frontend.ValidateRecipeParameters[*datamodel.DaprPubSubBroker](recipeControllerConfig.RecipeMetadataCache, recipeControllerConfig.ConfigLoader),
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
 				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.DaprPubSubBroker, datamodel.DaprPubSubBroker](options, &pubsub_proc.Processor{Client: options.KubeClient}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
 			},
 			AsyncOperationTimeout:    dapr_ctrl.AsyncCreateOrUpdateDaprPubSubBrokerTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncRetryPolicy:         pr_ctrl.RecipeRetryPolicy,
 		},
 		Delete: builder.Operation[datamodel.DaprPubSubBroker]{
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
@@ -73,6 +78,7 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			},
 			AsyncOperationTimeout:    dapr_ctrl.AsyncDeleteDaprPubSubBrokerTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncRetryPolicy:         pr_ctrl.RecipeRetryPolicy,
 		},
 	})
 
@@ -83,22 +89,26 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		Put: builder.Operation[datamodel.DaprStateStore]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.DaprStateStore]{
 				rp_frontend.PrepareRadiusResource[*datamodel.DaprStateStore],
+				rp_frontend.ValidateRecipeParameters[*datamodel.DaprStateStore](recipeControllerConfig.RecipeMetadataCache, recipeControllerConfig.ConfigLoader),
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
 				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.DaprStateStore, datamodel.DaprStateStore](options, &statestore_proc.Processor{Client: options.KubeClient}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
 			},
 			AsyncOperationTimeout:    dapr_ctrl.AsyncCreateOrUpdateDaprStateStoreTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncRetryPolicy:         pr_ctrl.RecipeRetryPolicy,
 		},
 		Patch: builder.Operation[datamodel.DaprStateStore]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.DaprStateStore]{
 				rp_frontend.PrepareRadiusResource[*datamodel.DaprStateStore],
+				rp_frontend.ValidateRecipeParameters[*datamodel.DaprStateStore](recipeControllerConfig.RecipeMetadataCache, recipeControllerConfig.ConfigLoader),
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
 				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.DaprStateStore, datamodel.DaprStateStore](options, &statestore_proc.Processor{Client: options.KubeClient}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
 			},
 			AsyncOperationTimeout:    dapr_ctrl.AsyncCreateOrUpdateDaprStateStoreTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncRetryPolicy:         pr_ctrl.RecipeRetryPolicy,
 		},
 		Delete: builder.Operation[datamodel.DaprStateStore]{
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
@@ -106,6 +116,7 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			},
 			AsyncOperationTimeout:    dapr_ctrl.AsyncDeleteDaprStateStoreTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncRetryPolicy:         pr_ctrl.RecipeRetryPolicy,
 		},
 	})
 
@@ -116,22 +127,26 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		Put: builder.Operation[datamodel.DaprSecretStore]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.DaprSecretStore]{
 				rp_frontend.PrepareRadiusResource[*datamodel.DaprSecretStore],
+				rp_frontend.ValidateRecipeParameters[*datamodel.DaprSecretStore](recipeControllerConfig.RecipeMetadataCache, recipeControllerConfig.ConfigLoader),
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
 				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.DaprSecretStore, datamodel.DaprSecretStore](options, &secretstore_proc.Processor{Client: options.KubeClient}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
 			},
 			AsyncOperationTimeout:    dapr_ctrl.AsyncCreateOrUpdateDaprSecretStoreTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncRetryPolicy:         pr_ctrl.RecipeRetryPolicy,
 		},
 		Patch: builder.Operation[datamodel.DaprSecretStore]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.DaprSecretStore]{
 				rp_frontend.PrepareRadiusResource[*datamodel.DaprSecretStore],
+				rp_frontend.ValidateRecipeParameters[*datamodel.DaprSecretStore](recipeControllerConfig.RecipeMetadataCache, recipeControllerConfig.ConfigLoader),
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
 				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.DaprSecretStore, datamodel.DaprSecretStore](options, &secretstore_proc.Processor{Client: options.KubeClient}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
 			},
 			AsyncOperationTimeout:    dapr_ctrl.AsyncCreateOrUpdateDaprSecretStoreTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncRetryPolicy:         pr_ctrl.RecipeRetryPolicy,
 		},
 		Delete: builder.Operation[datamodel.DaprSecretStore]{
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
@@ -139,6 +154,7 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			},
 			AsyncOperationTimeout:    dapr_ctrl.AsyncDeleteDaprSecretStoreTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncRetryPolicy:         pr_ctrl.RecipeRetryPolicy,
 		},
 	})
 
@@ -147,3 +163,19 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 
 	return ns
 }
+
+// SetupDriftDetection returns the controllers used to periodically check the resources deployed by the recipes of
+// the Dapr resources for drift, keyed by resource type.
+func SetupDriftDetection(recipeControllerConfig *controllerconfig.RecipeControllerConfig) map[string]pr_ctrl.DriftControllerFactoryFunc {
+	return map[string]pr_ctrl.DriftControllerFactoryFunc{
+		"Applications.Dapr/pubSubBrokers": func(options asyncctrl.Options, statusManager statusmanager.StatusManager) (asyncctrl.Controller, error) {
+			return pr_ctrl.NewDetectDriftResource[*datamodel.DaprPubSubBroker, datamodel.DaprPubSubBroker](options, statusManager, recipeControllerConfig.Engine, recipeControllerConfig.ConfigLoader, dapr_ctrl.AsyncCreateOrUpdateDaprPubSubBrokerTimeout)
+		},
+		"Applications.Dapr/stateStores": func(options asyncctrl.Options, statusManager statusmanager.StatusManager) (asyncctrl.Controller, error) {
+			return pr_ctrl.NewDetectDriftResource[*datamodel.DaprStateStore, datamodel.DaprStateStore](options, statusManager, recipeControllerConfig.Engine, recipeControllerConfig.ConfigLoader, dapr_ctrl.AsyncCreateOrUpdateDaprStateStoreTimeout)
+		},
+		"Applications.Dapr/secretStores": func(options asyncctrl.Options, statusManager statusmanager.StatusManager) (asyncctrl.Controller, error) {
+			return pr_ctrl.NewDetectDriftResource[*datamodel.DaprSecretStore, datamodel.DaprSecretStore](options, statusManager, recipeControllerConfig.Engine, recipeControllerConfig.ConfigLoader, dapr_ctrl.AsyncCreateOrUpdateDaprSecretStoreTimeout)
+		},
+	}
+}
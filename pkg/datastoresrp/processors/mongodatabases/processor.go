@@ -32,7 +32,7 @@ type Processor struct {
 // Process implements the processors.Processor interface for Mongo database resources. It  validates Mongo database properties
 // and applies the values from the RecipeOutput.
 func (p *Processor) Process(ctx context.Context, resource *datamodel.MongoDatabase, options processors.Options) error {
-	validator := processors.NewValidator(&resource.ComputedValues, &resource.SecretValues, &resource.Properties.Status.OutputResources, resource.Properties.Status.Recipe)
+	validator := processors.NewValidator(resource.ResourceTypeName(), &resource.ComputedValues, &resource.SecretValues, &resource.Properties.Status.OutputResources, resource.Properties.Status.Recipe)
 
 	validator.AddResourcesField(&resource.Properties.Resources)
 	validator.AddRequiredStringField(renderers.Host, &resource.Properties.Host)
@@ -221,17 +221,43 @@ func Test_Process(t *testing.T) {
 		require.Equal(t, expectedOutputResources, resource.Properties.Status.OutputResources)
 	})
 
+	t.Run("success - recipe with required values set by resource", func(t *testing.T) {
+		resource := &datamodel.MongoDatabase{
+			Properties: datamodel.MongoDatabaseProperties{
+				Host:     host,
+				Port:     port,
+				Database: database,
+			},
+		}
+		options := processors.Options{
+			RecipeOutput: &recipes.RecipeOutput{
+				Resources: []string{
+					azureMongoResourceID1,
+				},
+				Secrets: map[string]any{
+					"password": password,
+				},
+			},
+		}
+
+		err := processor.Process(context.Background(), resource, options)
+		require.NoError(t, err)
+
+		require.Equal(t, host, resource.Properties.Host)
+		require.Equal(t, int32(port), resource.Properties.Port)
+		require.Equal(t, database, resource.Properties.Database)
+		require.Equal(t, password, resource.Properties.Secrets.Password)
+	})
+
 	t.Run("failure - missing required values", func(t *testing.T) {
 		resource := &datamodel.MongoDatabase{}
 		options := processors.Options{RecipeOutput: &recipes.RecipeOutput{}}
 
 		err := processor.Process(context.Background(), resource, options)
 		require.Error(t, err)
-		require.IsType(t, &processors.ValidationError{}, err)
-		require.Equal(t, `validation returned multiple errors:
-
-the connection value "host" should be provided by the recipe, set '.properties.host' to provide a value manually
-the connection value "port" should be provided by the recipe, set '.properties.port' to provide a value manually
-the connection value "database" should be provided by the recipe, set '.properties.database' to provide a value manually`, err.Error())
+		recipeError, ok := err.(*recipes.RecipeError)
+		require.True(t, ok)
+		require.Equal(t, recipes.InvalidRecipeOutputs, recipeError.ErrorDetails.Code)
+		require.Equal(t, `recipe output does not match the expected contract: missing required value "host"; missing required value "port"; missing required value "database"`, recipeError.ErrorDetails.Message)
 	})
 }
@@ -213,12 +213,10 @@ func Test_Process(t *testing.T) {
 
 		err := processor.Process(context.Background(), resource, options)
 		require.Error(t, err)
-		require.IsType(t, &processors.ValidationError{}, err)
-		require.Equal(t, `validation returned multiple errors:
-
-the connection value "database" should be provided by the recipe, set '.properties.database' to provide a value manually
-the connection value "server" should be provided by the recipe, set '.properties.server' to provide a value manually
-the connection value "port" should be provided by the recipe, set '.properties.port' to provide a value manually`, err.Error())
+		recipeError, ok := err.(*recipes.RecipeError)
+		require.True(t, ok)
+		require.Equal(t, recipes.InvalidRecipeOutputs, recipeError.ErrorDetails.Code)
+		require.Equal(t, `recipe output does not match the expected contract: missing required value "database"; missing required value "server"; missing required value "port"`, recipeError.ErrorDetails.Message)
 
 	})
 }
@@ -20,6 +20,7 @@ import (
 	"time"
 
 	asyncctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
 	"github.com/radius-project/radius/pkg/armrpc/builder"
 	apictrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/datastoresrp/datamodel"
@@ -53,22 +54,26 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		Put: builder.Operation[datamodel.RedisCache]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.RedisCache]{
 				rp_frontend.PrepareRadiusResource[*datamodel.RedisCache],
+				rp_frontend.ValidateRecipeParameters[*datamodel.RedisCache](recipeControllerConfig.RecipeMetadataCache, recipeControllerConfig.ConfigLoader),
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
 				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.RedisCache, datamodel.RedisCache](options, &rds_proc.Processor{}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
 			},
 			AsyncOperationTimeout:    ds_ctrl.AsyncCreateOrUpdateRedisCacheTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncRetryPolicy:         pr_ctrl.RecipeRetryPolicy,
 		},
 		Patch: builder.Operation[datamodel.RedisCache]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.RedisCache]{
 				rp_frontend.PrepareRadiusResource[*datamodel.RedisCache],
+				rp_frontend.ValidateRecipeParameters[*datamodel.RedisCache](recipeControllerConfig.RecipeMetadataCache, recipeControllerConfig.ConfigLoader),
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
 				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.RedisCache, datamodel.RedisCache](options, &rds_proc.Processor{}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
 			},
 			AsyncOperationTimeout:    ds_ctrl.AsyncCreateOrUpdateRedisCacheTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncRetryPolicy:         pr_ctrl.RecipeRetryPolicy,
 		},
 		Delete: builder.Operation[datamodel.RedisCache]{
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
@@ -76,6 +81,7 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			},
 			AsyncOperationTimeout:    ds_ctrl.AsyncDeleteRedisCacheTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncRetryPolicy:         pr_ctrl.RecipeRetryPolicy,
 		},
 		Custom: map[string]builder.Operation[datamodel.RedisCache]{
 			"listsecrets": {
@@ -91,22 +97,26 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		Put: builder.Operation[datamodel.MongoDatabase]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.MongoDatabase]{
 				rp_frontend.PrepareRadiusResource[*datamodel.MongoDatabase],
+				rp_frontend.ValidateRecipeParameters[*datamodel.MongoDatabase](recipeControllerConfig.RecipeMetadataCache, recipeControllerConfig.ConfigLoader),
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
 				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.MongoDatabase, datamodel.MongoDatabase](options, &mongo_proc.Processor{}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
 			},
 			AsyncOperationTimeout:    ds_ctrl.AsyncCreateOrUpdateMongoDatabaseTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncRetryPolicy:         pr_ctrl.RecipeRetryPolicy,
 		},
 		Patch: builder.Operation[datamodel.MongoDatabase]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.MongoDatabase]{
 				rp_frontend.PrepareRadiusResource[*datamodel.MongoDatabase],
+				rp_frontend.ValidateRecipeParameters[*datamodel.MongoDatabase](recipeControllerConfig.RecipeMetadataCache, recipeControllerConfig.ConfigLoader),
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
 				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.MongoDatabase, datamodel.MongoDatabase](options, &mongo_proc.Processor{}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
 			},
 			AsyncOperationTimeout:    ds_ctrl.AsyncCreateOrUpdateMongoDatabaseTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncRetryPolicy:         pr_ctrl.RecipeRetryPolicy,
 		},
 		Delete: builder.Operation[datamodel.MongoDatabase]{
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
@@ -114,6 +124,7 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			},
 			AsyncOperationTimeout:    ds_ctrl.AsyncDeleteMongoDatabaseTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncRetryPolicy:         pr_ctrl.RecipeRetryPolicy,
 		},
 		Custom: map[string]builder.Operation[datamodel.MongoDatabase]{
 			"listsecrets": {
@@ -129,22 +140,26 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		Put: builder.Operation[datamodel.SqlDatabase]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.SqlDatabase]{
 				rp_frontend.PrepareRadiusResource[*datamodel.SqlDatabase],
+				rp_frontend.ValidateRecipeParameters[*datamodel.SqlDatabase](recipeControllerConfig.RecipeMetadataCache, recipeControllerConfig.ConfigLoader),
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
 				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.SqlDatabase, datamodel.SqlDatabase](options, &sql_proc.Processor{}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
 			},
 			AsyncOperationTimeout:    ds_ctrl.AsyncCreateOrUpdateSqlDatabaseTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncRetryPolicy:         pr_ctrl.RecipeRetryPolicy,
 		},
 		Patch: builder.Operation[datamodel.SqlDatabase]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.SqlDatabase]{
 				rp_frontend.PrepareRadiusResource[*datamodel.SqlDatabase],
+				rp_frontend.ValidateRecipeParameters[*datamodel.SqlDatabase](recipeControllerConfig.RecipeMetadataCache, recipeControllerConfig.ConfigLoader),
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
 				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.SqlDatabase, datamodel.SqlDatabase](options, &sql_proc.Processor{}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
 			},
 			AsyncOperationTimeout:    ds_ctrl.AsyncCreateOrUpdateSqlDatabaseTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncRetryPolicy:         pr_ctrl.RecipeRetryPolicy,
 		},
 		Delete: builder.Operation[datamodel.SqlDatabase]{
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
@@ -152,6 +167,7 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			},
 			AsyncOperationTimeout:    ds_ctrl.AsyncDeleteSqlDatabaseTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncRetryPolicy:         pr_ctrl.RecipeRetryPolicy,
 		},
 		Custom: map[string]builder.Operation[datamodel.SqlDatabase]{
 			"listsecrets": {
@@ -165,3 +181,19 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 
 	return ns
 }
+
+// SetupDriftDetection returns the controllers used to periodically check the resources deployed by the recipes of
+// the datastores resources for drift, keyed by resource type.
+func SetupDriftDetection(recipeControllerConfig *controllerconfig.RecipeControllerConfig) map[string]pr_ctrl.DriftControllerFactoryFunc {
+	return map[string]pr_ctrl.DriftControllerFactoryFunc{
+		"Applications.Datastores/redisCaches": func(options asyncctrl.Options, statusManager statusmanager.StatusManager) (asyncctrl.Controller, error) {
+			return pr_ctrl.NewDetectDriftResource[*datamodel.RedisCache, datamodel.RedisCache](options, statusManager, recipeControllerConfig.Engine, recipeControllerConfig.ConfigLoader, ds_ctrl.AsyncCreateOrUpdateRedisCacheTimeout)
+		},
+		"Applications.Datastores/mongoDatabases": func(options asyncctrl.Options, statusManager statusmanager.StatusManager) (asyncctrl.Controller, error) {
+			return pr_ctrl.NewDetectDriftResource[*datamodel.MongoDatabase, datamodel.MongoDatabase](options, statusManager, recipeControllerConfig.Engine, recipeControllerConfig.ConfigLoader, ds_ctrl.AsyncCreateOrUpdateMongoDatabaseTimeout)
+		},
+		"Applications.Datastores/sqlDatabases": func(options asyncctrl.Options, statusManager statusmanager.StatusManager) (asyncctrl.Controller, error) {
+			return pr_ctrl.NewDetectDriftResource[*datamodel.SqlDatabase, datamodel.SqlDatabase](options, statusManager, recipeControllerConfig.Engine, recipeControllerConfig.ConfigLoader, ds_ctrl.AsyncCreateOrUpdateSqlDatabaseTimeout)
+		},
+	}
+}
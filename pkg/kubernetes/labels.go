@@ -43,6 +43,9 @@ const (
 	AnnotationSecretHash = "radapp.io/secret-hash"
 	RadiusDevPrefix      = "radapp.io/"
 
+	// AnnotationSecretStoreHash is the annotation for the hash of the secret stores referenced by a workload.
+	AnnotationSecretStoreHash = "radapp.io/secret-store-hash"
+
 	// AnnotationIdentityType is the annotation for supported identity.
 	AnnotationIdentityType = "radapp.io/identity-type"
 )
@@ -18,6 +18,7 @@ package v20231001preview
 
 import (
 	"testing"
+	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/portableresources"
@@ -90,6 +91,38 @@ func Test_fromRecipeStatus(t *testing.T) {
 			TemplatePath:    to.Ptr("/path/to/template.bicep"),
 			TemplateVersion: nil,
 		}},
+		{&rpv1.RecipeStatus{
+			TemplateKind: recipes.TemplateKindTerraform,
+			TemplatePath: "/path/to/template.tf",
+			Drift: &rpv1.RecipeDriftStatus{
+				Detected:        true,
+				LastCheckedTime: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+				Resources: []rpv1.DriftedResource{
+					{
+						ResourceID: "/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Cache/redis/redis-test",
+						Address:    "module.redis.azurerm_redis_cache.redis",
+						Reason:     rpv1.DriftReasonModified,
+					},
+				},
+				Fingerprints: map[string]string{
+					"/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Cache/redis/redis-test": "abc",
+				},
+			},
+		}, &RecipeStatus{
+			TemplateKind: to.Ptr(recipes.TemplateKindTerraform),
+			TemplatePath: to.Ptr("/path/to/template.tf"),
+			Drift: &RecipeDriftStatus{
+				Detected:        to.Ptr(true),
+				LastCheckedTime: to.Ptr(time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)),
+				Resources: []*DriftedResource{
+					{
+						ResourceID: to.Ptr("/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Cache/redis/redis-test"),
+						Address:    to.Ptr("module.redis.azurerm_redis_cache.redis"),
+						Reason:     to.Ptr(DriftReasonModified),
+					},
+				},
+			},
+		}},
 	}
 
 	for _, tt := range testCases {
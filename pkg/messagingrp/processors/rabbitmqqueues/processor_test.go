@@ -178,11 +178,9 @@ func Test_Process(t *testing.T) {
 
 		err := processor.Process(context.Background(), resource, options)
 		require.Error(t, err)
-		require.IsType(t, &processors.ValidationError{}, err)
-		require.Equal(t, `validation returned multiple errors:
-
-the connection value "queue" should be provided by the recipe, set '.properties.queue' to provide a value manually
-the connection value "host" should be provided by the recipe, set '.properties.host' to provide a value manually
-the connection value "port" should be provided by the recipe, set '.properties.port' to provide a value manually`, err.Error())
+		recipeError, ok := err.(*recipes.RecipeError)
+		require.True(t, ok)
+		require.Equal(t, recipes.InvalidRecipeOutputs, recipeError.ErrorDetails.Code)
+		require.Equal(t, `recipe output does not match the expected contract: missing required value "queue"; missing required value "host"; missing required value "port"`, recipeError.ErrorDetails.Message)
 	})
 }
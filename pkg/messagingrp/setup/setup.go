@@ -17,6 +17,7 @@ import (
 	"time"
 
 	asyncctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
 	"github.com/radius-project/radius/pkg/armrpc/builder"
 	apictrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/messagingrp/datamodel"
@@ -46,22 +47,26 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		Put: builder.Operation[datamodel.RabbitMQQueue]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.RabbitMQQueue]{
 				rp_frontend.PrepareRadiusResource[*datamodel.RabbitMQQueue],
+				rp_frontend.ValidateRecipeParameters[*datamodel.RabbitMQQueue](recipeControllerConfig.RecipeMetadataCache, recipeControllerConfig.ConfigLoader),
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
 				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.RabbitMQQueue, datamodel.RabbitMQQueue](options, &rmq_proc.Processor{}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
 			},
 			AsyncOperationTimeout:    msrp_ctrl.AsyncCreateOrUpdateRabbitMQTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncRetryPolicy:         pr_ctrl.RecipeRetryPolicy,
 		},
 		Patch: builder.Operation[datamodel.RabbitMQQueue]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.RabbitMQQueue]{
 				rp_frontend.PrepareRadiusResource[*datamodel.RabbitMQQueue],
+				rp_frontend.ValidateRecipeParameters[*datamodel.RabbitMQQueue](recipeControllerConfig.RecipeMetadataCache, recipeControllerConfig.ConfigLoader),
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
 				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.RabbitMQQueue, datamodel.RabbitMQQueue](options, &rmq_proc.Processor{}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
 			},
 			AsyncOperationTimeout:    msrp_ctrl.AsyncCreateOrUpdateRabbitMQTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncRetryPolicy:         pr_ctrl.RecipeRetryPolicy,
 		},
 		Delete: builder.Operation[datamodel.RabbitMQQueue]{
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
@@ -69,6 +74,7 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			},
 			AsyncOperationTimeout:    msrp_ctrl.AsyncDeleteRabbitMQTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			AsyncRetryPolicy:         pr_ctrl.RecipeRetryPolicy,
 		},
 		Custom: map[string]builder.Operation[datamodel.RabbitMQQueue]{
 			"listsecrets": {
@@ -82,3 +88,13 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 
 	return ns
 }
+
+// SetupDriftDetection returns the controllers used to periodically check the resources deployed by the recipes of
+// the messaging resources for drift, keyed by resource type.
+func SetupDriftDetection(recipeControllerConfig *controllerconfig.RecipeControllerConfig) map[string]pr_ctrl.DriftControllerFactoryFunc {
+	return map[string]pr_ctrl.DriftControllerFactoryFunc{
+		"Applications.Messaging/rabbitMQQueues": func(options asyncctrl.Options, statusManager statusmanager.StatusManager) (asyncctrl.Controller, error) {
+			return pr_ctrl.NewDetectDriftResource[*datamodel.RabbitMQQueue, datamodel.RabbitMQQueue](options, statusManager, recipeControllerConfig.Engine, recipeControllerConfig.ConfigLoader, msrp_ctrl.AsyncCreateOrUpdateRabbitMQTimeout)
+		},
+	}
+}
@@ -42,6 +42,9 @@ const (
 
 	// AsyncOperationDuration is the metric name for async operation duration.
 	AsnycOperationDuration = "asyncoperation.duration"
+
+	// FailedOperationHistoryWriteCount is the metric name for the number of failed writes to the operation history.
+	FailedOperationHistoryWriteCount = "asyncoperation.history.failed.write"
 )
 
 type asyncOperationMetrics struct {
@@ -77,6 +80,11 @@ func (a *asyncOperationMetrics) Init() error {
 		return err
 	}
 
+	a.counters[FailedOperationHistoryWriteCount], err = meter.Int64Counter(FailedOperationHistoryWriteCount)
+	if err != nil {
+		return err
+	}
+
 	a.valueRecorders[AsnycOperationDuration], err = meter.Float64Histogram(AsnycOperationDuration)
 	if err != nil {
 		return err
@@ -123,6 +131,16 @@ func (a *asyncOperationMetrics) RecordAsyncOperationDuration(ctx context.Context
 	}
 }
 
+// RecordFailedOperationHistoryWrite increments the FailedOperationHistoryWriteCount metric for the resource type of the
+// given resource. It should be called when the operation history of a resource can't be written.
+func (a *asyncOperationMetrics) RecordFailedOperationHistoryWrite(ctx context.Context, id resources.ID) {
+	if a.counters[FailedOperationHistoryWriteCount] != nil {
+		a.counters[FailedOperationHistoryWriteCount].Add(ctx, 1,
+			metric.WithAttributes(resourceTypeAttrKey.String(normalizeAttrValue(id.Type()))),
+		)
+	}
+}
+
 func newAsyncOperationCommonAttributes(req *ctrl.Request, res *ctrl.Result) []attribute.KeyValue {
 	attrs := make([]attribute.KeyValue, 0)
 
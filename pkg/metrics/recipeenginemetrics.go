@@ -57,6 +57,12 @@ const (
 
 	// RecipeEngineOperationGC represents the Garbage Collection operation of the Recipe Engine.
 	RecipeEngineOperationGC = "garbage.collection.recipe"
+
+	// RecipeEngineOperationPlan represents the Plan operation of the Recipe Engine.
+	RecipeEngineOperationPlan = "plan"
+
+	// RecipeEngineOperationDetectDrift represents the DetectDrift operation of the Recipe Engine.
+	RecipeEngineOperationDetectDrift = "detectdrift"
 )
 
 type recipeEngineMetrics struct {
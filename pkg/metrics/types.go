@@ -45,9 +45,18 @@ const (
 	// TerraformVersionAttrKey is the attribute key for the Terraform version.
 	TerraformVersionAttrKey = attribute.Key("terraform_version")
 
+	// RecipeCacheAttrKey is the attribute key for whether the recipe template was served from the local cache.
+	RecipeCacheAttrKey = attribute.Key("recipe_cache")
+
 	// SuccessfulOperationState is the value for a successful operation state.
 	SuccessfulOperationState = "success"
 
 	// FailedOperationState is the value for a failed operation state.
 	FailedOperationState = "failed"
+
+	// CacheHit is the value for a recipe template served from the local cache.
+	CacheHit = "hit"
+
+	// CacheMiss is the value for a recipe template downloaded from its source.
+	CacheMiss = "miss"
 )
@@ -26,6 +26,7 @@ import (
 	"github.com/radius-project/radius/pkg/portableresources/processors"
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/configloader"
+	"github.com/radius-project/radius/pkg/recipes/driver"
 	"github.com/radius-project/radius/pkg/recipes/engine"
 	"github.com/radius-project/radius/pkg/recipes/util"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
@@ -98,20 +99,7 @@ func (c *CreateOrUpdateResource[P, T]) Run(ctx context.Context, req *ctrl.Reques
 	if err != nil {
 		if recipeError, ok := err.(*recipes.RecipeError); ok {
 			logger.Error(err, fmt.Sprintf("failed to execute recipe. Encountered error while processing %s ", recipeError.ErrorDetails.Target))
-			// Set the deployment status to the recipe error code.
-			recipeDataModel.Recipe().DeploymentStatus = util.RecipeDeploymentStatus(recipeError.DeploymentStatus)
-			update := &store.Object{
-				Metadata: store.Metadata{
-					ID: req.ResourceID,
-				},
-				Data: recipeDataModel.(rpv1.RadiusResourceModel),
-			}
-			// Save portable resource with updated deployment status to track errors during deletion.
-			err = c.StorageClient().Save(ctx, update, store.WithETag(obj.ETag))
-			if err != nil {
-				return ctrl.Result{}, err
-			}
-			return ctrl.NewFailedResult(recipeError.ErrorDetails), nil
+			return c.saveRecipeError(ctx, req, obj, recipeDataModel, recipeError)
 		}
 		return ctrl.Result{}, err
 	}
@@ -122,8 +110,15 @@ func (c *CreateOrUpdateResource[P, T]) Run(ctx context.Context, req *ctrl.Reques
 		// Now we're ready to process the resource. This will handle the updates to any user-visible state.
 		err = c.processor.Process(ctx, data, processors.Options{RecipeOutput: recipeOutput, RuntimeConfiguration: config.Runtime})
 		if err != nil {
+			// The processor returns a recipe error if the recipe output doesn't match the contract of the resource type.
+			if recipeError, ok := err.(*recipes.RecipeError); ok {
+				logger.Error(err, "failed to process recipe output")
+				return c.saveRecipeError(ctx, req, obj, recipeDataModel, recipeError)
+			}
 			return ctrl.Result{}, err
 		}
+
+		c.recordDriftBaseline(ctx, data, recipeOutput)
 	}
 	if recipeDataModel.Recipe() != nil {
 		recipeDataModel.Recipe().DeploymentStatus = util.Success
@@ -143,6 +138,61 @@ func (c *CreateOrUpdateResource[P, T]) Run(ctx context.Context, req *ctrl.Reques
 	return ctrl.Result{}, err
 }
 
+// saveRecipeError sets the deployment status of the recipe to the status of the recipe error, and saves the resource
+// so that errors are tracked during deletion. It returns a failed result with the details of the recipe error.
+func (c *CreateOrUpdateResource[P, T]) saveRecipeError(ctx context.Context, req *ctrl.Request, obj *store.Object, recipeDataModel datamodel.RecipeDataModel, recipeError *recipes.RecipeError) (ctrl.Result, error) {
+	// Set the deployment status to the recipe error code.
+	recipeDataModel.Recipe().DeploymentStatus = util.RecipeDeploymentStatus(recipeError.DeploymentStatus)
+	update := &store.Object{
+		Metadata: store.Metadata{
+			ID: req.ResourceID,
+		},
+		Data: recipeDataModel.(rpv1.RadiusResourceModel),
+	}
+	// Save portable resource with updated deployment status to track errors during deletion.
+	err := c.StorageClient().Save(ctx, update, store.WithETag(obj.ETag))
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.NewFailedResult(recipeError.ErrorDetails), nil
+}
+
+// recordDriftBaseline resets the drift status of the resource after the recipe was deployed, the deployed resources
+// match the recipe again. The fingerprints of the deployed resources are recorded as the baseline of the following
+// drift checks. Terraform recipes are checked against the plan of the recipe module and don't need the fingerprints.
+func (c *CreateOrUpdateResource[P, T]) recordDriftBaseline(ctx context.Context, data P, recipeOutput *recipes.RecipeOutput) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	status := data.ResourceMetadata().Status.Recipe
+	if status == nil {
+		return
+	}
+
+	var fingerprints map[string]string
+	if recipeOutput != nil && recipeOutput.Status != nil && recipeOutput.Status.TemplateKind != recipes.TemplateKindTerraform {
+		var err error
+		fingerprints, err = driver.OutputResourceFingerprints(ctx, c.client, data.OutputResources())
+		if err != nil {
+			// The first drift check records the fingerprints if they can't be recorded now.
+			logger.Error(err, "Failed to record the fingerprints of the deployed resources")
+			fingerprints = nil
+		}
+	}
+
+	if status.Drift == nil && len(fingerprints) == 0 {
+		return
+	}
+
+	drift := &rpv1.RecipeDriftStatus{
+		Resources:    []rpv1.DriftedResource{},
+		Fingerprints: fingerprints,
+	}
+	if status.Drift != nil {
+		drift.LastCheckedTime = status.Drift.LastCheckedTime
+		drift.LastRemediatedTime = status.Drift.LastRemediatedTime
+	}
+	status.Drift = drift
+}
+
 func (c *CreateOrUpdateResource[P, T]) copyOutputResources(data P) []string {
 	previousOutputResources := []string{}
 	for _, outputResource := range data.OutputResources() {
@@ -36,7 +36,9 @@ import (
 	"github.com/radius-project/radius/pkg/recipes/configloader"
 	"github.com/radius-project/radius/pkg/recipes/controllerconfig"
 	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/recipes/util"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/store"
 )
@@ -437,3 +439,146 @@ func TestCreateOrUpdateResource_Run(t *testing.T) {
 		})
 	}
 }
+
+type DriftBaselineProcessor struct {
+}
+
+// Process applies the recipe status and adds a Radius-managed output resource to the TestResource object.
+func (p *DriftBaselineProcessor) Process(ctx context.Context, data *TestResource, options processors.Options) error {
+	data.Properties.Status.Recipe = options.RecipeOutput.Status
+	data.Properties.Status.OutputResources = []rpv1.OutputResource{
+		{ID: resources.MustParse(newOutputResourceResourceID), RadiusManaged: to.Ptr(true)},
+	}
+	return nil
+}
+
+// Delete returns no error.
+func (p *DriftBaselineProcessor) Delete(ctx context.Context, data *TestResource, options processors.Options) error {
+	return nil
+}
+
+func TestCreateOrUpdateResource_RecordDriftBaseline(t *testing.T) {
+	cases := []struct {
+		description          string
+		templateKind         string
+		getErr               error
+		expectedFingerprints bool
+	}{
+		{"bicep", recipes.TemplateKindBicep, nil, true},
+		{"terraform", recipes.TemplateKindTerraform, nil, false},
+		{"fingerprint-error", recipes.TemplateKindBicep, errors.New("get error"), false},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.description, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			msc := store.NewMockStorageClient(mctrl)
+			eng := engine.NewMockEngine(mctrl)
+			cfg := configloader.NewMockConfigurationLoader(mctrl)
+			client := processors.NewMockResourceClient(mctrl)
+
+			data := map[string]any{
+				"name":     "tr",
+				"type":     "Applications.Test/testResources",
+				"id":       TestResourceID,
+				"location": v1.LocationGlobal,
+				"properties": map[string]any{
+					"application":       TestApplicationID,
+					"environment":       TestEnvironmentID,
+					"provisioningState": "Accepted",
+					"recipe": map[string]any{
+						"name": "test-recipe",
+					},
+				},
+			}
+			msc.EXPECT().Get(gomock.Any(), TestResourceID).Return(&store.Object{Data: data}, nil)
+			cfg.EXPECT().LoadConfiguration(gomock.Any(), gomock.Any()).Return(&recipes.Configuration{}, nil)
+			eng.EXPECT().Execute(gomock.Any(), gomock.Any()).
+				Return(&recipes.RecipeOutput{Status: &rpv1.RecipeStatus{TemplateKind: tt.templateKind}}, nil)
+			if tt.templateKind != recipes.TemplateKindTerraform {
+				client.EXPECT().Get(gomock.Any(), newOutputResourceResourceID).
+					Return(map[string]any{"properties": map[string]any{"sku": "Basic"}}, tt.getErr)
+			}
+
+			var saved *TestResource
+			msc.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+					saved = obj.Data.(*TestResource)
+					return nil
+				})
+
+			processor := processors.ResourceProcessor[*TestResource, TestResource](&DriftBaselineProcessor{})
+			genCtrl, err := NewCreateOrUpdateResource(ctrl.Options{StorageClient: msc}, processor, eng, client, cfg)
+			require.NoError(t, err)
+
+			_, err = genCtrl.Run(context.Background(), &ctrl.Request{ResourceID: TestResourceID})
+			require.NoError(t, err)
+			require.NotNil(t, saved)
+
+			drift := saved.Properties.Status.Recipe.Drift
+			if tt.expectedFingerprints {
+				require.NotNil(t, drift)
+				require.Empty(t, drift.Resources)
+				require.Contains(t, drift.Fingerprints, newOutputResourceResourceID)
+			} else {
+				require.Nil(t, drift)
+			}
+		})
+	}
+}
+
+type InvalidRecipeOutputsProcessor struct {
+}
+
+// Process returns a recipe error for invalid recipe outputs.
+func (p *InvalidRecipeOutputsProcessor) Process(ctx context.Context, data *TestResource, options processors.Options) error {
+	return recipes.NewRecipeError(recipes.InvalidRecipeOutputs, "recipe output does not match the expected contract", util.ExecutionError, nil)
+}
+
+// Delete returns no error.
+func (p *InvalidRecipeOutputsProcessor) Delete(ctx context.Context, data *TestResource, options processors.Options) error {
+	return nil
+}
+
+func TestCreateOrUpdateResource_InvalidRecipeOutputs(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	msc := store.NewMockStorageClient(mctrl)
+	eng := engine.NewMockEngine(mctrl)
+	cfg := configloader.NewMockConfigurationLoader(mctrl)
+	client := processors.NewMockResourceClient(mctrl)
+
+	data := map[string]any{
+		"name":     "tr",
+		"type":     "Applications.Test/testResources",
+		"id":       TestResourceID,
+		"location": v1.LocationGlobal,
+		"properties": map[string]any{
+			"application":       TestApplicationID,
+			"environment":       TestEnvironmentID,
+			"provisioningState": "Accepted",
+			"recipe": map[string]any{
+				"name": "test-recipe",
+			},
+		},
+	}
+	msc.EXPECT().Get(gomock.Any(), TestResourceID).Return(&store.Object{Data: data}, nil)
+	cfg.EXPECT().LoadConfiguration(gomock.Any(), gomock.Any()).Return(&recipes.Configuration{}, nil)
+	eng.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(&recipes.RecipeOutput{}, nil)
+
+	var saved *TestResource
+	msc.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+			saved = obj.Data.(*TestResource)
+			return nil
+		})
+
+	processor := processors.ResourceProcessor[*TestResource, TestResource](&InvalidRecipeOutputsProcessor{})
+	genCtrl, err := NewCreateOrUpdateResource(ctrl.Options{StorageClient: msc}, processor, eng, client, cfg)
+	require.NoError(t, err)
+
+	res, err := genCtrl.Run(context.Background(), &ctrl.Request{ResourceID: TestResourceID})
+	require.NoError(t, err)
+	require.Equal(t, recipes.InvalidRecipeOutputs, res.Error.Code)
+	require.NotNil(t, saved)
+	require.Equal(t, util.ExecutionError, saved.Recipe().DeploymentStatus)
+}
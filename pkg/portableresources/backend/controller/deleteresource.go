@@ -92,6 +92,7 @@ func (c *DeleteResource[P, T]) Run(ctx context.Context, request *ctrl.Request) (
 				Recipe: recipeData,
 			},
 			OutputResources: data.OutputResources(),
+			RecipeStatus:    data.ResourceMetadata().Status.Recipe,
 		})
 		if err != nil {
 			if recipeError, ok := err.(*recipes.RecipeError); ok {
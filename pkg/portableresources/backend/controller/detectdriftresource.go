@@ -0,0 +1,226 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	sm "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/portableresources/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/configloader"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/recipes/util"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// DriftControllerFactoryFunc creates a drift detection controller. The status manager is used to queue the operations
+// which redeploy recipes to remediate drift.
+type DriftControllerFactoryFunc func(opts ctrl.Options, statusManager sm.StatusManager) (ctrl.Controller, error)
+
+// DetectDriftResource is the controller to check the resources deployed by the recipe of a portable resource for drift.
+// It is run periodically by the drift detector rather than through the async operation queue, so it doesn't change the
+// provisioning state of the resource unless the drift is remediated.
+type DetectDriftResource[P interface {
+	*T
+	rpv1.RadiusResourceModel
+}, T any] struct {
+	ctrl.BaseController
+	statusManager       sm.StatusManager
+	engine              engine.Engine
+	configurationLoader configloader.ConfigurationLoader
+	remediationTimeout  time.Duration
+}
+
+// NewDetectDriftResource creates a new controller for detecting the drift of the resources deployed by the recipe of a
+// resource. Drift is remediated by queueing a PUT operation of the resource through the given status manager, which
+// times out after the given remediation timeout.
+func NewDetectDriftResource[P interface {
+	*T
+	rpv1.RadiusResourceModel
+}, T any](opts ctrl.Options, statusManager sm.StatusManager, eng engine.Engine, configurationLoader configloader.ConfigurationLoader, remediationTimeout time.Duration) (ctrl.Controller, error) {
+	return &DetectDriftResource[P, T]{
+		ctrl.NewBaseAsyncController(opts),
+		statusManager,
+		eng,
+		configurationLoader,
+		remediationTimeout,
+	}, nil
+}
+
+// Run retrieves the resource, compares the resources deployed by its recipe against their current state and records
+// the result on the recipe status of the resource. If drift is detected and auto-remediation is enabled for the
+// environment, an operation redeploying the recipe is queued.
+func (c *DetectDriftResource[P, T]) Run(ctx context.Context, req *ctrl.Request) (ctrl.Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	obj, err := c.StorageClient().Get(ctx, req.ResourceID)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	data := P(new(T))
+	if err = obj.As(data); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Only resources whose recipe was deployed successfully are checked. Resources which are being deployed or
+	// whose deployment failed will be brought up to date by the next deployment.
+	recipeDataModel, supportsRecipes := any(data).(datamodel.RecipeDataModel)
+	if !supportsRecipes || recipeDataModel.Recipe() == nil || recipeDataModel.Recipe().DeploymentStatus != util.Success || !data.ProvisioningState().IsTerminal() {
+		return ctrl.Result{}, nil
+	}
+
+	recipeStatus := data.ResourceMetadata().Status.Recipe
+	outputResources := data.OutputResources()
+	if recipeStatus == nil || len(outputResources) == 0 {
+		return ctrl.Result{}, nil
+	}
+
+	input := recipeDataModel.Recipe()
+	metadata := recipes.ResourceMetadata{
+		Name:          input.Name,
+		Parameters:    input.Parameters,
+		EnvironmentID: data.ResourceMetadata().Environment,
+		ApplicationID: data.ResourceMetadata().Application,
+		ResourceID:    data.GetBaseResource().ID,
+	}
+
+	var fingerprints map[string]string
+	if recipeStatus.Drift != nil {
+		fingerprints = recipeStatus.Drift.Fingerprints
+	}
+
+	drift, err := c.engine.DetectDrift(ctx, engine.DriftOptions{
+		BaseOptions: engine.BaseOptions{
+			Recipe: metadata,
+		},
+		OutputResources: outputResources,
+		Fingerprints:    fingerprints,
+	})
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	driftStatus := &rpv1.RecipeDriftStatus{
+		Detected:        len(drift.Resources) > 0,
+		LastCheckedTime: time.Now().UTC(),
+		Resources:       drift.Resources,
+		Fingerprints:    mergeFingerprints(fingerprints, drift.Fingerprints),
+	}
+	if recipeStatus.Drift != nil {
+		driftStatus.LastRemediatedTime = recipeStatus.Drift.LastRemediatedTime
+	}
+
+	if driftStatus.Detected {
+		logger.Info(fmt.Sprintf("Detected drift of %d resource(s) deployed by recipe %q", len(drift.Resources), input.Name))
+
+		config, err := c.configurationLoader.LoadConfiguration(ctx, recipes.ResourceMetadata{EnvironmentID: metadata.EnvironmentID, ApplicationID: metadata.ApplicationID, ResourceID: metadata.ResourceID})
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		if config.RecipeConfig.Drift.AutoRemediate && !config.Simulated {
+			return ctrl.Result{}, c.queueRemediation(ctx, req, obj.ETag, data, driftStatus)
+		}
+	}
+
+	setDriftStatus(data, driftStatus)
+	update := &store.Object{
+		Metadata: store.Metadata{
+			ID: req.ResourceID,
+		},
+		Data: data,
+	}
+	err = c.StorageClient().Save(ctx, update, store.WithETag(obj.ETag))
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// queueRemediation queues a PUT operation of the resource, which redeploys its recipe the same way as a deployment of
+// the resource. The resource is saved in the Accepted state together with the operation, so that other operations on
+// the resource are rejected until the recipe is redeployed.
+func (c *DetectDriftResource[P, T]) queueRemediation(ctx context.Context, req *ctrl.Request, etag string, data P, driftStatus *rpv1.RecipeDriftStatus) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	id, err := resources.ParseResource(req.ResourceID)
+	if err != nil {
+		return err
+	}
+
+	remediatedTime := time.Now().UTC()
+	driftStatus.LastRemediatedTime = &remediatedTime
+	setDriftStatus(data, driftStatus)
+	data.SetProvisioningState(v1.ProvisioningStateAccepted)
+
+	sCtx := &v1.ARMRequestContext{
+		ResourceID:    id,
+		OperationID:   uuid.New(),
+		OperationType: v1.OperationType{Type: strings.ToUpper(id.Type()), Method: v1.OperationPut},
+		APIVersion:    data.GetBaseResource().UpdatedAPIVersion,
+		CorrelationID: req.CorrelationID,
+	}
+
+	logger.Info(fmt.Sprintf("Queueing operation %s to redeploy the recipe and remediate drift", sCtx.OperationID))
+	return c.statusManager.QueueAsyncOperation(ctx, sCtx, sm.QueueOperationOptions{
+		OperationTimeout: c.remediationTimeout,
+		RetryAfter:       v1.DefaultRetryAfterDuration,
+		Resource: &store.Object{
+			Metadata: store.Metadata{ID: req.ResourceID},
+			Data:     data,
+		},
+		ResourceETag: etag,
+	})
+}
+
+// mergeFingerprints returns the baseline fingerprints recorded at deployment time with the fingerprints observed by
+// the drift check added for the resources which don't have a baseline. The baseline is never overwritten by a drift
+// check, so that drift keeps being reported until the recipe is deployed again.
+func mergeFingerprints(baseline map[string]string, observed map[string]string) map[string]string {
+	if len(baseline) == 0 && len(observed) == 0 {
+		return nil
+	}
+
+	merged := map[string]string{}
+	for id, fingerprint := range observed {
+		merged[id] = fingerprint
+	}
+	for id, fingerprint := range baseline {
+		merged[id] = fingerprint
+	}
+	return merged
+}
+
+// setDriftStatus sets the drift status on the recipe status of the resource.
+func setDriftStatus[P rpv1.RadiusResourceModel](data P, driftStatus *rpv1.RecipeDriftStatus) {
+	if data.ResourceMetadata().Status.Recipe == nil {
+		data.ResourceMetadata().Status.Recipe = &rpv1.RecipeStatus{}
+	}
+	data.ResourceMetadata().Status.Recipe.Drift = driftStatus
+}
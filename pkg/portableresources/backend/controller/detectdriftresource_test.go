@@ -0,0 +1,277 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/configloader"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+func TestDetectDriftResource_Run(t *testing.T) {
+	driftedResources := []rpv1.DriftedResource{
+		{
+			ResourceID: oldOutputResourceResourceID,
+			Reason:     rpv1.DriftReasonModified,
+		},
+	}
+
+	cases := []struct {
+		description       string
+		recipeStatus      string
+		provisioningState string
+		baseline          map[string]string
+		drift             *recipes.RecipeDrift
+		driftErr          error
+		autoRemediate     bool
+		queueErr          error
+		expectedDetected  bool
+		expectQueued      bool
+		expectSave        bool
+		expectedErr       error
+		expectedBaseline  map[string]string
+	}{
+		{
+			description:  "skipped-recipe-not-deployed",
+			recipeStatus: "failed",
+		},
+		{
+			description:       "skipped-operation-in-progress",
+			recipeStatus:      "success",
+			provisioningState: "Updating",
+		},
+		{
+			description:  "detect-drift-error",
+			recipeStatus: "success",
+			driftErr:     errors.New("failed to detect drift"),
+			expectedErr:  errors.New("failed to detect drift"),
+		},
+		{
+			description:  "no-drift",
+			recipeStatus: "success",
+			drift: &recipes.RecipeDrift{
+				Resources:    []rpv1.DriftedResource{},
+				Fingerprints: map[string]string{oldOutputResourceResourceID: "abc"},
+			},
+			expectSave: true,
+		},
+		{
+			description:  "drift-detected",
+			recipeStatus: "success",
+			drift: &recipes.RecipeDrift{
+				Resources: driftedResources,
+			},
+			expectedDetected: true,
+			expectSave:       true,
+		},
+		{
+			description:  "drift-detected-baseline-kept",
+			recipeStatus: "success",
+			baseline:     map[string]string{oldOutputResourceResourceID: "abc"},
+			drift: &recipes.RecipeDrift{
+				Resources:    driftedResources,
+				Fingerprints: map[string]string{oldOutputResourceResourceID: "def"},
+			},
+			expectedDetected: true,
+			expectSave:       true,
+			expectedBaseline: map[string]string{oldOutputResourceResourceID: "abc"},
+		},
+		{
+			description:  "drift-remediation-queued",
+			recipeStatus: "success",
+			drift: &recipes.RecipeDrift{
+				Resources: driftedResources,
+			},
+			autoRemediate:    true,
+			expectedDetected: true,
+			expectQueued:     true,
+		},
+		{
+			description:  "drift-remediation-queue-failed",
+			recipeStatus: "success",
+			drift: &recipes.RecipeDrift{
+				Resources: driftedResources,
+			},
+			autoRemediate: true,
+			queueErr:      errors.New("failed to queue operation"),
+			expectQueued:  true,
+			expectedErr:   errors.New("failed to queue operation"),
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.description, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			msc := store.NewMockStorageClient(mctrl)
+			eng := engine.NewMockEngine(mctrl)
+			cfg := configloader.NewMockConfigurationLoader(mctrl)
+			msm := statusmanager.NewMockStatusManager(mctrl)
+
+			provisioningState := tt.provisioningState
+			if provisioningState == "" {
+				provisioningState = "Succeeded"
+			}
+
+			req := &ctrl.Request{
+				OperationID:      uuid.New(),
+				OperationType:    "APPLICATIONS.TEST/TESTRESOURCES|DETECTDRIFT",
+				ResourceID:       TestResourceID,
+				CorrelationID:    uuid.NewString(),
+				OperationTimeout: &ctrl.DefaultAsyncOperationTimeout,
+			}
+
+			recipeStatus := map[string]any{
+				"templateKind": recipes.TemplateKindBicep,
+				"templatePath": "test/recipe:latest",
+			}
+			if tt.baseline != nil {
+				recipeStatus["drift"] = map[string]any{
+					"fingerprints": tt.baseline,
+				}
+			}
+
+			data := map[string]any{
+				"name":              "tr",
+				"type":              "Applications.Test/testResources",
+				"id":                TestResourceID,
+				"location":          v1.LocationGlobal,
+				"provisioningState": provisioningState,
+				"properties": map[string]any{
+					"application":       TestApplicationID,
+					"environment":       TestEnvironmentID,
+					"provisioningState": "Succeeded",
+					"status": map[string]any{
+						"outputResources": []map[string]any{
+							{
+								"id":            oldOutputResourceResourceID,
+								"radiusManaged": true,
+							},
+						},
+						"recipe": recipeStatus,
+					},
+					"recipe": map[string]any{
+						"name":         "test-recipe",
+						"recipeStatus": tt.recipeStatus,
+					},
+				},
+			}
+
+			msc.EXPECT().
+				Get(gomock.Any(), TestResourceID).
+				Return(&store.Object{Data: data}, nil).
+				Times(1)
+
+			if tt.drift != nil || tt.driftErr != nil {
+				eng.EXPECT().
+					DetectDrift(gomock.Any(), gomock.Any()).
+					Return(tt.drift, tt.driftErr).
+					Times(1)
+			}
+
+			if tt.drift != nil && len(tt.drift.Resources) > 0 {
+				cfg.EXPECT().
+					LoadConfiguration(gomock.Any(), gomock.Any()).
+					Return(&recipes.Configuration{
+						RecipeConfig: datamodel.RecipeConfigProperties{
+							Drift: datamodel.RecipeDriftConfig{
+								AutoRemediate: tt.autoRemediate,
+							},
+						},
+					}, nil).
+					Times(1)
+			}
+
+			var queued *TestResource
+			if tt.expectQueued {
+				msm.EXPECT().
+					QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, sCtx *v1.ARMRequestContext, options statusmanager.QueueOperationOptions) error {
+						require.Equal(t, TestResourceID, sCtx.ResourceID.String())
+						require.Equal(t, v1.OperationType{Type: "APPLICATIONS.TEST/TESTRESOURCES", Method: v1.OperationPut}, sCtx.OperationType)
+						require.Equal(t, req.CorrelationID, sCtx.CorrelationID)
+						require.Equal(t, ctrl.DefaultAsyncOperationTimeout, options.OperationTimeout)
+						require.Equal(t, TestResourceID, options.Resource.ID)
+						queued = options.Resource.Data.(*TestResource)
+						return tt.queueErr
+					}).
+					Times(1)
+			}
+
+			var saved *TestResource
+			if tt.expectSave {
+				msc.EXPECT().
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, obj *store.Object, opts ...store.SaveOptions) error {
+						saved = obj.Data.(*TestResource)
+						return nil
+					}).
+					Times(1)
+			}
+
+			genCtrl, err := NewDetectDriftResource[*TestResource, TestResource](ctrl.Options{StorageClient: msc}, msm, eng, cfg, ctrl.DefaultAsyncOperationTimeout)
+			require.NoError(t, err)
+
+			res, err := genCtrl.Run(context.Background(), req)
+			if tt.expectedErr != nil {
+				require.Equal(t, tt.expectedErr, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, ctrl.Result{}, res)
+
+			if tt.expectQueued {
+				// The resource is saved together with the queued operation.
+				require.Equal(t, v1.ProvisioningStateAccepted, queued.ProvisioningState())
+				drift := queued.Properties.Status.Recipe.Drift
+				require.True(t, drift.Detected)
+				require.NotNil(t, drift.LastRemediatedTime)
+				require.Equal(t, tt.drift.Resources, drift.Resources)
+				return
+			}
+
+			if !tt.expectSave {
+				return
+			}
+
+			drift := saved.Properties.Status.Recipe.Drift
+			require.NotNil(t, drift)
+			require.Equal(t, tt.expectedDetected, drift.Detected)
+			require.False(t, drift.LastCheckedTime.IsZero())
+			require.Nil(t, drift.LastRemediatedTime)
+			require.Equal(t, tt.drift.Resources, drift.Resources)
+			if tt.expectedBaseline != nil {
+				require.Equal(t, tt.expectedBaseline, drift.Fingerprints)
+			} else {
+				require.Equal(t, tt.drift.Fingerprints, drift.Fingerprints)
+			}
+		})
+	}
+}
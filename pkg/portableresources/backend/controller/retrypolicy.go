@@ -0,0 +1,39 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/worker"
+	"github.com/radius-project/radius/pkg/recipes"
+)
+
+// RecipeRetryPolicy is the retry policy of the async operations which deploy or delete resources using recipes. The
+// failures to download the recipe and to delete its resources are often transient, so they are retried. The failures
+// to deploy the recipe are mostly caused by the template or its parameters, and the other recipe errors, such as
+// invalid recipe outputs or a missing recipe, fail the same way on every attempt, so none of them are retried.
+var RecipeRetryPolicy = worker.RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: time.Duration(10) * time.Second,
+	MaxBackoff:     time.Duration(2) * time.Minute,
+	Jitter:         0.2,
+	RetryableErrorCodes: []string{
+		recipes.RecipeDownloadFailed,
+		recipes.RecipeDeletionFailed,
+	},
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecipeRetryPolicy(t *testing.T) {
+	require.NoError(t, RecipeRetryPolicy.Validate())
+
+	retryable := []string{recipes.RecipeDownloadFailed, recipes.RecipeDeletionFailed}
+	for _, code := range retryable {
+		require.True(t, RecipeRetryPolicy.IsRetryable(&v1.ErrorDetails{Code: code}), code)
+	}
+
+	nonTransient := []string{
+		recipes.RecipeDeploymentFailed,
+		recipes.RecipeValidationFailed,
+		recipes.InvalidRecipeOutputs,
+		recipes.RecipeLanguageFailure,
+		recipes.RecipeNotFoundFailure,
+		recipes.RecipeDriverNotFoundFailure,
+		recipes.RecipeConfigurationFailure,
+		recipes.RecipeDeploymentCanceled,
+	}
+	for _, code := range nonTransient {
+		require.False(t, RecipeRetryPolicy.IsRetryable(&v1.ErrorDetails{Code: code}), code)
+	}
+}
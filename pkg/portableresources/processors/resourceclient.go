@@ -18,6 +18,7 @@ package processors
 
 import (
 	context "context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -35,6 +36,7 @@ import (
 	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	"go.opentelemetry.io/otel/attribute"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -89,6 +91,33 @@ func (c *resourceClient) Delete(ctx context.Context, id string) error {
 	}
 }
 
+// Get retrieves a resource, either through UCP, Azure, or Kubernetes, depending on the resource type.
+func (c *resourceClient) Get(ctx context.Context, id string) (map[string]any, error) {
+	parsed, err := resources.ParseResource(id)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := []attribute.KeyValue{{Key: attribute.Key(ucplog.LogFieldTargetResourceID), Value: attribute.StringValue(id)}}
+	ctx, span := trace.StartCustomSpan(ctx, "resourceclient.Get", trace.BackendTracerName, attributes)
+	defer span.End()
+
+	var obj map[string]any
+	ns := strings.ToLower(parsed.PlaneNamespace())
+	if !parsed.IsUCPQualfied() || strings.HasPrefix(ns, "azure/") {
+		obj, err = c.getAzureResource(ctx, parsed)
+	} else if strings.HasPrefix(ns, "kubernetes/") {
+		obj, err = c.getKubernetesResource(ctx, parsed)
+	} else {
+		obj, err = c.getUCPResource(ctx, parsed)
+	}
+	if err != nil {
+		return nil, c.wrapError(parsed, err)
+	}
+
+	return obj, nil
+}
+
 func (c *resourceClient) wrapError(id resources.ID, err error) error {
 	if err != nil {
 		return &ResourceError{Inner: err, ID: id.String()}
@@ -139,6 +168,37 @@ func (c *resourceClient) deleteAzureResource(ctx context.Context, id resources.I
 	return nil
 }
 
+func (c *resourceClient) getAzureResource(ctx context.Context, id resources.ID) (map[string]any, error) {
+	var err error
+	if id.IsUCPQualfied() {
+		id, err = resources.ParseResource(resources.MakeRelativeID(id.ScopeSegments()[1:], id.TypeSegments(), id.ExtensionSegments()))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	apiVersion, err := c.lookupARMAPIVersion(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := clientv2.NewGenericResourceClient(id.FindScope(resources_azure.ScopeSubscriptions), &c.arm.ClientOptions, c.armClientOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetByID(ctx, id.String(), apiVersion, &armresources.ClientGetByIDOptions{})
+	if err != nil {
+		if clients.Is404Error(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return toMap(resp.GenericResource)
+}
+
 func (c *resourceClient) lookupARMAPIVersion(ctx context.Context, id resources.ID) (string, error) {
 	client, err := clientv2.NewProvidersClient(id.FindScope(resources_azure.ScopeSubscriptions), &c.arm.ClientOptions, c.armClientOptions)
 	if err != nil {
@@ -207,6 +267,25 @@ func (c *resourceClient) deleteUCPResource(ctx context.Context, id resources.ID)
 	return nil
 }
 
+func (c *resourceClient) getUCPResource(ctx context.Context, id resources.ID) (map[string]any, error) {
+	// NOTE: see deleteUCPResource for why the API version is not looked up here.
+	client, err := generated.NewGenericResourcesClient(id.RootScope(), id.Type(), &aztoken.AnonymousCredential{}, sdk.NewClientOptions(c.connection))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Get(ctx, id.Name(), nil)
+	if err != nil {
+		if clients.Is404Error(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return toMap(resp.GenericResource)
+}
+
 func (c *resourceClient) deleteKubernetesResource(ctx context.Context, id resources.ID) error {
 	apiVersion, err := c.lookupKubernetesAPIVersion(ctx, id)
 	if err != nil {
@@ -242,6 +321,31 @@ func (c *resourceClient) deleteKubernetesResource(ctx context.Context, id resour
 	return nil
 }
 
+func (c *resourceClient) getKubernetesResource(ctx context.Context, id resources.ID) (map[string]any, error) {
+	apiVersion, err := c.lookupKubernetesAPIVersion(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	group, kind, namespace, name := resources_kubernetes.ToParts(id)
+	if group != "" {
+		apiVersion = fmt.Sprintf("%s/%s", group, apiVersion)
+	}
+
+	obj := unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+
+	err = c.k8sClient.Get(ctx, runtime_client.ObjectKey{Namespace: namespace, Name: name}, &obj)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return obj.Object, nil
+}
+
 func (c *resourceClient) lookupKubernetesAPIVersion(ctx context.Context, id resources.ID) (string, error) {
 	group, kind, namespace, _ := resources_kubernetes.ToParts(id)
 	var resourceLists []*v1.APIResourceList
@@ -278,3 +382,19 @@ func (c *resourceClient) lookupKubernetesAPIVersion(ctx context.Context, id reso
 
 	return "", fmt.Errorf("could not find API version for type %q, type was not found", id.Type())
 }
+
+// toMap converts a resource returned by a generated client to its generic JSON representation.
+func toMap(resource any) (map[string]any, error) {
+	b, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	obj := map[string]any{}
+	err = json.Unmarshal(b, &obj)
+	if err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
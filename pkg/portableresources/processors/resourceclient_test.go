@@ -347,6 +347,168 @@ func Test_Delete_UCP(t *testing.T) {
 	})
 }
 
+func Test_Get_InvalidResourceID(t *testing.T) {
+	c := NewResourceClient(nil, nil, nil, nil)
+	_, err := c.Get(context.Background(), "invalid")
+	require.Error(t, err)
+}
+
+func Test_Get_ARM(t *testing.T) {
+	provider := armresources.Provider{
+		Namespace: to.Ptr("Microsoft.Compute"),
+		ResourceTypes: []*armresources.ProviderResourceType{
+			{
+				ResourceType:      to.Ptr("virtualMachines"),
+				DefaultAPIVersion: to.Ptr(ARMAPIVersion),
+			},
+		},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc(ARMResourceID, handleJSONResponse(t, armresources.GenericResource{
+			ID: to.Ptr(ARMResourceID),
+			Properties: map[string]any{
+				"size": "large",
+			},
+		}, 200))
+		mux.HandleFunc(ARMProviderPath, handleJSONResponse(t, provider, 200))
+
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		c := NewResourceClient(newArmOptions(server.URL), nil, nil, nil)
+		c.armClientOptions = newClientOptions(server.Client(), server.URL)
+
+		obj, err := c.Get(context.Background(), ARMResourceID)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{"size": "large"}, obj["properties"])
+	})
+
+	t.Run("success - get returns 404", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc(ARMResourceID, handleNotFound(t))
+		mux.HandleFunc(ARMProviderPath, handleJSONResponse(t, provider, 200))
+
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		c := NewResourceClient(newArmOptions(server.URL), nil, nil, nil)
+		c.armClientOptions = newClientOptions(server.Client(), server.URL)
+
+		obj, err := c.Get(context.Background(), AzureUCPResourceID)
+		require.NoError(t, err)
+		require.Nil(t, obj)
+	})
+
+	t.Run("failure - get fails", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc(ARMResourceID, handleJSONResponse(t, v1.ErrorResponse{
+			Error: v1.ErrorDetails{
+				Code: v1.CodeConflict,
+			},
+		}, 409))
+		mux.HandleFunc(ARMProviderPath, handleJSONResponse(t, provider, 200))
+
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		c := NewResourceClient(newArmOptions(server.URL), nil, nil, nil)
+		c.armClientOptions = newClientOptions(server.Client(), server.URL)
+
+		_, err := c.Get(context.Background(), ARMResourceID)
+		require.Error(t, err)
+		require.IsType(t, &ResourceError{}, err)
+	})
+}
+
+func Test_Get_Kubernetes(t *testing.T) {
+	dc := &k8sutil.DiscoveryClient{
+		Resources: []*metav1.APIResourceList{
+			{
+				GroupVersion: "v1",
+				APIResources: []metav1.APIResource{
+					{
+						Name:    "api1",
+						Version: "v1",
+						Kind:    "Secret",
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		client := fake.NewClientBuilder().WithObjects(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-name",
+				Namespace: "test-namespace",
+			},
+			StringData: map[string]string{
+				"key": "value",
+			},
+		}).Build()
+
+		c := NewResourceClient(nil, nil, client, dc)
+
+		obj, err := c.Get(context.Background(), KubernetesCoreGroupResourceID)
+		require.NoError(t, err)
+		require.Equal(t, "Secret", obj["kind"])
+		require.Equal(t, map[string]any{"key": "value"}, obj["stringData"])
+	})
+
+	t.Run("success - resource not found", func(t *testing.T) {
+		client := fake.NewClientBuilder().Build()
+
+		c := NewResourceClient(nil, nil, client, dc)
+
+		obj, err := c.Get(context.Background(), KubernetesCoreGroupResourceID)
+		require.NoError(t, err)
+		require.Nil(t, obj)
+	})
+}
+
+func Test_Get_UCP(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc(AWSResourceID, handleJSONResponse(t, map[string]any{
+			"id": AWSResourceID,
+			"properties": map[string]any{
+				"ShardCount": 1,
+			},
+		}, 200))
+
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		connection, err := sdk.NewDirectConnection(server.URL)
+		require.NoError(t, err)
+
+		c := NewResourceClient(nil, connection, nil, nil)
+
+		obj, err := c.Get(context.Background(), AWSResourceID)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{"ShardCount": float64(1)}, obj["properties"])
+	})
+
+	t.Run("success - get returns 404", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc(AWSResourceID, handleNotFound(t))
+
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		connection, err := sdk.NewDirectConnection(server.URL)
+		require.NoError(t, err)
+
+		c := NewResourceClient(nil, connection, nil, nil)
+
+		obj, err := c.Get(context.Background(), AWSResourceID)
+		require.NoError(t, err)
+		require.Nil(t, obj)
+	})
+}
+
 func newArmOptions(url string) *armauth.ArmConfig {
 	return &armauth.ArmConfig{
 		ClientOptions: clientv2.Options{
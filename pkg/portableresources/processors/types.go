@@ -66,6 +66,11 @@ type ResourceClient interface {
 	//
 	// If the API version is omitted, then an attempt will be made to look up the API version.
 	Delete(ctx context.Context, id string) error
+
+	// Get retrieves the current state of a resource by id. A nil object is returned if the resource does not exist.
+	//
+	// If the API version is omitted, then an attempt will be made to look up the API version.
+	Get(ctx context.Context, id string) (map[string]any, error)
 }
 
 // ResourceError represents an error that occurred while processing a resource.
@@ -23,6 +23,7 @@ import (
 
 	"github.com/radius-project/radius/pkg/portableresources"
 	"github.com/radius-project/radius/pkg/recipes"
+	rp_pr "github.com/radius-project/radius/pkg/rp/portableresources"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 )
 
@@ -43,6 +44,8 @@ type Validator struct {
 	resourcesField *[]*portableresources.ResourceReference
 	fields         []func(output *recipes.RecipeOutput) string
 	computedFields []func(output *recipes.RecipeOutput) string
+	setFields      []func() string
+	outputSchema   *recipes.OutputSchema
 
 	// ConnectionValues stores the connection values extracted from the data model and recipe output.
 	ConnectionValues map[string]any
@@ -59,19 +62,25 @@ type Validator struct {
 
 // NewValidator initializes and returns a new Validator instance with empty data structures for connection values,
 // connection secrets and output resources. Use the parameters to pass in pointers to the corresponding fields on
-// the resource data model.
-func NewValidator(connectionValues *map[string]any, connectionSecrets *map[string]rpv1.SecretValueReference, outputResources *[]rpv1.OutputResource, status *rpv1.RecipeStatus) *Validator {
+// the resource data model. The contract of the recipe outputs of the given resource type is registered with the
+// validator if the resource type declares one.
+func NewValidator(resourceType string, connectionValues *map[string]any, connectionSecrets *map[string]rpv1.SecretValueReference, outputResources *[]rpv1.OutputResource, status *rpv1.RecipeStatus) *Validator {
 	// Empty the computed data structures. This ensures that we don't accumulate data from previous validations.
 	*connectionValues = map[string]any{}
 	*connectionSecrets = map[string]rpv1.SecretValueReference{}
 	*outputResources = []rpv1.OutputResource{}
 
-	return &Validator{
+	v := &Validator{
 		ConnectionValues:  *connectionValues,
 		ConnectionSecrets: *connectionSecrets,
 		OutputResources:   outputResources,
 		Status:            status,
 	}
+	if schema, ok := rp_pr.GetRecipeOutputSchema(resourceType); ok {
+		v.setOutputSchema(schema)
+	}
+
+	return v
 }
 
 // AddResourceField registers a field containing a resource ID with the validator.
@@ -79,6 +88,12 @@ func (v *Validator) AddResourcesField(ref *[]*portableresources.ResourceReferenc
 	v.resourcesField = ref
 }
 
+// setOutputSchema registers the contract of the outputs returned by recipes for the resource type. Values and secrets
+// which are set on the resource don't need to be returned by the recipe, even if the contract requires them.
+func (v *Validator) setOutputSchema(schema recipes.OutputSchema) {
+	v.outputSchema = &schema
+}
+
 // AddRequiredInt32Field registers a field containing a required int32 connection value. The zero value will be treated as an "unset" value.
 func (v *Validator) AddRequiredInt32Field(name string, ref *int32) {
 	v.fields = append(v.fields, bind(v, name, ref, true, false, "int32", convertToInt32, nil))
@@ -154,6 +169,9 @@ func (v *Validator) AddComputedSecretField(name string, ref *string, compute fun
 // This function returns *ValidationError for validation failures.
 //
 // After calling SetAndValidate, the connection values/secrets and output resources will be populated.
+//
+// If an output schema is registered, the recipe output is validated against it before the fields are bound, and a
+// *recipes.RecipeError is returned if the recipe output doesn't match. The output resources are populated in that case.
 func (v *Validator) SetAndValidate(output *recipes.RecipeOutput) error {
 	msgs := []string{}
 
@@ -168,6 +186,20 @@ func (v *Validator) SetAndValidate(output *recipes.RecipeOutput) error {
 		if output.Status != nil {
 			*v.Status = *output.Status
 		}
+
+		if v.outputSchema != nil {
+			// Check the fields set on the resource before binding, binding sets the fields from the recipe output.
+			setByResource := []string{}
+			for _, field := range v.setFields {
+				if name := field(); name != "" {
+					setByResource = append(setByResource, name)
+				}
+			}
+
+			if err := v.outputSchema.Validate(output, setByResource...); err != nil {
+				return err
+			}
+		}
 	}
 
 	if v.resourcesField != nil {
@@ -210,6 +242,14 @@ func (v *Validator) SetAndValidate(output *recipes.RecipeOutput) error {
 }
 
 func bind[T any](v *Validator, name string, ref *T, required bool, secret bool, typeName string, convert func(value any) (T, bool), compute func() (T, *ValidationError)) func(output *recipes.RecipeOutput) string {
+	v.setFields = append(v.setFields, func() string {
+		if reflect.ValueOf(*ref).IsZero() {
+			return ""
+		}
+
+		return name
+	})
+
 	return func(output *recipes.RecipeOutput) string {
 		valueKind := kindConnectionValue
 		propertyPath := fmt.Sprintf(".properties.%v", name)
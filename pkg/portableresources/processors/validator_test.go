@@ -27,6 +27,9 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+// testResourceType is a resource type which doesn't declare a contract of the recipe outputs.
+const testResourceType = "Applications.Test/testResources"
+
 type testDatamodel struct {
 	StringField            string
 	AnotherCoolStringField string
@@ -41,7 +44,7 @@ func Test_NewValidator(t *testing.T) {
 		var secrets map[string]rpv1.SecretValueReference
 		var status rpv1.RecipeStatus = rpv1.RecipeStatus{}
 
-		v := NewValidator(&values, &secrets, &outputResources, &status)
+		v := NewValidator(testResourceType, &values, &secrets, &outputResources, &status)
 		require.NotNil(t, v)
 		require.NotNil(t, outputResources)
 		require.NotNil(t, values)
@@ -61,7 +64,7 @@ func Test_NewValidator(t *testing.T) {
 		secrets := map[string]rpv1.SecretValueReference{"test": {}}
 		status := rpv1.RecipeStatus{}
 
-		v := NewValidator(&values, &secrets, &outputResources, &status)
+		v := NewValidator(testResourceType, &values, &secrets, &outputResources, &status)
 		require.NotNil(t, v)
 		require.NotNil(t, outputResources)
 		require.NotNil(t, values)
@@ -84,7 +87,7 @@ func Test_Validator_SetAndValidate_OutputResources(t *testing.T) {
 		secrets := map[string]rpv1.SecretValueReference{}
 		status := rpv1.RecipeStatus{}
 
-		v := NewValidator(&values, &secrets, &outputResources, &status)
+		v := NewValidator(testResourceType, &values, &secrets, &outputResources, &status)
 		err := v.SetAndValidate(nil)
 		require.NoError(t, err)
 
@@ -98,7 +101,7 @@ func Test_Validator_SetAndValidate_OutputResources(t *testing.T) {
 
 		var resources *[]*portableresources.ResourceReference
 
-		v := NewValidator(&values, &secrets, &outputResources, &status)
+		v := NewValidator(testResourceType, &values, &secrets, &outputResources, &status)
 		v.AddResourcesField(resources)
 
 		err := v.SetAndValidate(nil)
@@ -114,7 +117,7 @@ func Test_Validator_SetAndValidate_OutputResources(t *testing.T) {
 
 		resources := []*portableresources.ResourceReference{}
 
-		v := NewValidator(&values, &secrets, &outputResources, &status)
+		v := NewValidator(testResourceType, &values, &secrets, &outputResources, &status)
 		v.AddResourcesField(&resources)
 
 		err := v.SetAndValidate(nil)
@@ -128,7 +131,7 @@ func Test_Validator_SetAndValidate_OutputResources(t *testing.T) {
 		secrets := map[string]rpv1.SecretValueReference{}
 		status := rpv1.RecipeStatus{}
 
-		v := NewValidator(&values, &secrets, &outputResources, &status)
+		v := NewValidator(testResourceType, &values, &secrets, &outputResources, &status)
 
 		err := v.SetAndValidate(&recipes.RecipeOutput{Status: &rpv1.RecipeStatus{}})
 		require.NoError(t, err)
@@ -143,7 +146,7 @@ func Test_Validator_SetAndValidate_OutputResources(t *testing.T) {
 
 		resources := []*portableresources.ResourceReference{{ID: "////invalid//////"}}
 
-		v := NewValidator(&values, &secrets, &outputResources, &status)
+		v := NewValidator(testResourceType, &values, &secrets, &outputResources, &status)
 		v.AddResourcesField(&resources)
 
 		err := v.SetAndValidate(nil)
@@ -163,7 +166,7 @@ func Test_Validator_SetAndValidate_OutputResources(t *testing.T) {
 			Resources: []string{"////invalid//////"},
 		}
 
-		v := NewValidator(&values, &secrets, &outputResources, &status)
+		v := NewValidator(testResourceType, &values, &secrets, &outputResources, &status)
 
 		err := v.SetAndValidate(&output)
 		require.Error(t, err)
@@ -188,7 +191,7 @@ func Test_Validator_SetAndValidate_OutputResources(t *testing.T) {
 			Status:    &rpv1.RecipeStatus{},
 		}
 
-		v := NewValidator(&values, &secrets, &outputResources, &status)
+		v := NewValidator(testResourceType, &values, &secrets, &outputResources, &status)
 		v.AddResourcesField(&resourcesField)
 
 		err := v.SetAndValidate(&output)
@@ -211,7 +214,7 @@ func Test_Validator_SetAndValidate_OutputResources(t *testing.T) {
 
 func Test_Validator_SetAndValidate_Required_Strings(t *testing.T) {
 	t.Run("existing required value preserved", func(t *testing.T) {
-		v := NewValidator(&map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
+		v := NewValidator(testResourceType, &map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
 
 		model := testDatamodel{
 			StringField: "existing",
@@ -227,7 +230,7 @@ func Test_Validator_SetAndValidate_Required_Strings(t *testing.T) {
 	})
 
 	t.Run("existing required secret preserved", func(t *testing.T) {
-		v := NewValidator(&map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
+		v := NewValidator(testResourceType, &map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
 
 		model := testDatamodel{
 			StringField: "existing",
@@ -243,7 +246,7 @@ func Test_Validator_SetAndValidate_Required_Strings(t *testing.T) {
 	})
 
 	t.Run("required recipe value set", func(t *testing.T) {
-		v := NewValidator(&map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
+		v := NewValidator(testResourceType, &map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
 
 		model := testDatamodel{
 			StringField: "",
@@ -258,7 +261,7 @@ func Test_Validator_SetAndValidate_Required_Strings(t *testing.T) {
 	})
 
 	t.Run("required recipe secret set", func(t *testing.T) {
-		v := NewValidator(&map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
+		v := NewValidator(testResourceType, &map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
 
 		model := testDatamodel{
 			StringField: "",
@@ -273,7 +276,7 @@ func Test_Validator_SetAndValidate_Required_Strings(t *testing.T) {
 	})
 
 	t.Run("required value missing with recipe", func(t *testing.T) {
-		v := NewValidator(&map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
+		v := NewValidator(testResourceType, &map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
 
 		model := testDatamodel{
 			StringField: "",
@@ -287,7 +290,7 @@ func Test_Validator_SetAndValidate_Required_Strings(t *testing.T) {
 	})
 
 	t.Run("required value missing without recipe", func(t *testing.T) {
-		v := NewValidator(&map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
+		v := NewValidator(testResourceType, &map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
 
 		model := testDatamodel{
 			StringField: "",
@@ -301,7 +304,7 @@ func Test_Validator_SetAndValidate_Required_Strings(t *testing.T) {
 	})
 
 	t.Run("required secret missing with recipe", func(t *testing.T) {
-		v := NewValidator(&map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
+		v := NewValidator(testResourceType, &map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
 
 		model := testDatamodel{
 			StringField: "",
@@ -315,7 +318,7 @@ func Test_Validator_SetAndValidate_Required_Strings(t *testing.T) {
 	})
 
 	t.Run("required secret missing without recipe", func(t *testing.T) {
-		v := NewValidator(&map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
+		v := NewValidator(testResourceType, &map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
 
 		model := testDatamodel{
 			StringField: "",
@@ -331,7 +334,7 @@ func Test_Validator_SetAndValidate_Required_Strings(t *testing.T) {
 
 func Test_Validator_SetAndValidate_Optional_Strings(t *testing.T) {
 	t.Run("existing optional value preserved", func(t *testing.T) {
-		v := NewValidator(&map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
+		v := NewValidator(testResourceType, &map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
 
 		model := testDatamodel{
 			StringField: "existing",
@@ -347,7 +350,7 @@ func Test_Validator_SetAndValidate_Optional_Strings(t *testing.T) {
 	})
 
 	t.Run("existing optional secret preserved", func(t *testing.T) {
-		v := NewValidator(&map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
+		v := NewValidator(testResourceType, &map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
 
 		model := testDatamodel{
 			StringField: "existing",
@@ -363,7 +366,7 @@ func Test_Validator_SetAndValidate_Optional_Strings(t *testing.T) {
 	})
 
 	t.Run("optional recipe value set", func(t *testing.T) {
-		v := NewValidator(&map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
+		v := NewValidator(testResourceType, &map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
 
 		model := testDatamodel{
 			StringField: "",
@@ -378,7 +381,7 @@ func Test_Validator_SetAndValidate_Optional_Strings(t *testing.T) {
 	})
 
 	t.Run("Optional recipe secret set", func(t *testing.T) {
-		v := NewValidator(&map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
+		v := NewValidator(testResourceType, &map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
 
 		model := testDatamodel{
 			StringField: "",
@@ -393,7 +396,7 @@ func Test_Validator_SetAndValidate_Optional_Strings(t *testing.T) {
 	})
 
 	t.Run("optional value missing with recipe", func(t *testing.T) {
-		v := NewValidator(&map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
+		v := NewValidator(testResourceType, &map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
 
 		model := testDatamodel{
 			StringField: "",
@@ -408,7 +411,7 @@ func Test_Validator_SetAndValidate_Optional_Strings(t *testing.T) {
 	})
 
 	t.Run("optional value missing without recipe", func(t *testing.T) {
-		v := NewValidator(&map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
+		v := NewValidator(testResourceType, &map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
 
 		model := testDatamodel{
 			StringField: "",
@@ -423,7 +426,7 @@ func Test_Validator_SetAndValidate_Optional_Strings(t *testing.T) {
 	})
 
 	t.Run("optional secret missing with recipe", func(t *testing.T) {
-		v := NewValidator(&map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
+		v := NewValidator(testResourceType, &map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
 
 		model := testDatamodel{
 			StringField: "",
@@ -438,7 +441,7 @@ func Test_Validator_SetAndValidate_Optional_Strings(t *testing.T) {
 	})
 
 	t.Run("optional secret missing without recipe", func(t *testing.T) {
-		v := NewValidator(&map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
+		v := NewValidator(testResourceType, &map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
 
 		model := testDatamodel{
 			StringField: "",
@@ -456,7 +459,7 @@ func Test_Validator_SetAndValidate_Optional_Strings(t *testing.T) {
 func Test_Validator_SetAndValidate_Computed_Strings(t *testing.T) {
 	// Code path for computed strings is the same as optional, except when the value is missing.\
 	t.Run("computed secret is computed with recipe", func(t *testing.T) {
-		v := NewValidator(&map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
+		v := NewValidator(testResourceType, &map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
 
 		model := testDatamodel{
 			StringField:            "",
@@ -477,7 +480,7 @@ func Test_Validator_SetAndValidate_Computed_Strings(t *testing.T) {
 	})
 
 	t.Run("computed secret is computed without recipe", func(t *testing.T) {
-		v := NewValidator(&map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
+		v := NewValidator(testResourceType, &map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
 
 		model := testDatamodel{
 			StringField:            "YO",
@@ -498,7 +501,7 @@ func Test_Validator_SetAndValidate_Computed_Strings(t *testing.T) {
 	})
 
 	t.Run("computed secret error", func(t *testing.T) {
-		v := NewValidator(&map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
+		v := NewValidator(testResourceType, &map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
 
 		model := testDatamodel{
 			StringField:            "",
@@ -516,7 +519,7 @@ func Test_Validator_SetAndValidate_Computed_Strings(t *testing.T) {
 	})
 
 	t.Run("computed secret not run if regular fields error", func(t *testing.T) {
-		v := NewValidator(&map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
+		v := NewValidator(testResourceType, &map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
 
 		model := testDatamodel{
 			StringField:            "",
@@ -538,7 +541,7 @@ func Test_Validator_SetAndValidate_Computed_Strings(t *testing.T) {
 func Test_Validator_SetAndValidate_Computed_Boolean(t *testing.T) {
 	// Code path for computed booleans is the same as optional, except when the value is missing.\
 	t.Run("computed value is computed with recipe", func(t *testing.T) {
-		v := NewValidator(&map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
+		v := NewValidator(testResourceType, &map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
 
 		model := testDatamodel{
 			BooleanField: false,
@@ -557,7 +560,7 @@ func Test_Validator_SetAndValidate_Computed_Boolean(t *testing.T) {
 	})
 
 	t.Run("computed value is computed without recipe", func(t *testing.T) {
-		v := NewValidator(&map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
+		v := NewValidator(testResourceType, &map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
 
 		model := testDatamodel{
 			BooleanField: false,
@@ -576,7 +579,7 @@ func Test_Validator_SetAndValidate_Computed_Boolean(t *testing.T) {
 	})
 
 	t.Run("computed value with recipe override", func(t *testing.T) {
-		v := NewValidator(&map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
+		v := NewValidator(testResourceType, &map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
 
 		model := testDatamodel{
 			BooleanField: false,
@@ -598,7 +601,7 @@ func Test_Validator_SetAndValidate_Computed_Boolean(t *testing.T) {
 func Test_Validator_SetAndValidate_TypeMismatch_Strings(t *testing.T) {
 	// Type mismatches are only possible with recipes
 	t.Run("type mismatch with recipe", func(t *testing.T) {
-		v := NewValidator(&map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
+		v := NewValidator(testResourceType, &map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
 
 		model := testDatamodel{
 			StringField: "",
@@ -615,7 +618,7 @@ func Test_Validator_SetAndValidate_TypeMismatch_Strings(t *testing.T) {
 
 func Test_Validator_SetAndValidate_Required_Int32(t *testing.T) {
 	t.Run("existing required value preserved", func(t *testing.T) {
-		v := NewValidator(&map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
+		v := NewValidator(testResourceType, &map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
 
 		model := testDatamodel{
 			Int32Field: 47,
@@ -631,7 +634,7 @@ func Test_Validator_SetAndValidate_Required_Int32(t *testing.T) {
 	})
 
 	t.Run("required recipe value set", func(t *testing.T) {
-		v := NewValidator(&map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
+		v := NewValidator(testResourceType, &map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
 
 		model := testDatamodel{
 			Int32Field: 0,
@@ -646,7 +649,7 @@ func Test_Validator_SetAndValidate_Required_Int32(t *testing.T) {
 	})
 
 	t.Run("required value missing with recipe", func(t *testing.T) {
-		v := NewValidator(&map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
+		v := NewValidator(testResourceType, &map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
 
 		model := testDatamodel{
 			Int32Field: 0,
@@ -660,7 +663,7 @@ func Test_Validator_SetAndValidate_Required_Int32(t *testing.T) {
 	})
 
 	t.Run("required value missing without recipe", func(t *testing.T) {
-		v := NewValidator(&map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
+		v := NewValidator(testResourceType, &map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
 
 		model := testDatamodel{
 			Int32Field: 0,
@@ -676,7 +679,7 @@ func Test_Validator_SetAndValidate_Required_Int32(t *testing.T) {
 
 func Test_Validator_SetAndValidate_Optional_Int32(t *testing.T) {
 	t.Run("existing optional value preserved", func(t *testing.T) {
-		v := NewValidator(&map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
+		v := NewValidator(testResourceType, &map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
 
 		model := testDatamodel{
 			Int32Field: 47,
@@ -692,7 +695,7 @@ func Test_Validator_SetAndValidate_Optional_Int32(t *testing.T) {
 	})
 
 	t.Run("optional recipe value set", func(t *testing.T) {
-		v := NewValidator(&map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
+		v := NewValidator(testResourceType, &map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
 
 		model := testDatamodel{
 			Int32Field: 0,
@@ -707,7 +710,7 @@ func Test_Validator_SetAndValidate_Optional_Int32(t *testing.T) {
 	})
 
 	t.Run("optional value missing with recipe", func(t *testing.T) {
-		v := NewValidator(&map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
+		v := NewValidator(testResourceType, &map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
 
 		model := testDatamodel{
 			Int32Field: 0,
@@ -722,7 +725,7 @@ func Test_Validator_SetAndValidate_Optional_Int32(t *testing.T) {
 	})
 
 	t.Run("optional value missing without recipe", func(t *testing.T) {
-		v := NewValidator(&map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
+		v := NewValidator(testResourceType, &map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
 
 		model := testDatamodel{
 			Int32Field: 0,
@@ -739,7 +742,7 @@ func Test_Validator_SetAndValidate_Optional_Int32(t *testing.T) {
 
 func Test_Validator_TypeConversions_Int32(t *testing.T) {
 	t.Run("conversion from int", func(t *testing.T) {
-		v := NewValidator(&map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
+		v := NewValidator(testResourceType, &map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
 
 		model := testDatamodel{
 			Int32Field: 0,
@@ -754,7 +757,7 @@ func Test_Validator_TypeConversions_Int32(t *testing.T) {
 	})
 
 	t.Run("conversion from float64", func(t *testing.T) {
-		v := NewValidator(&map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
+		v := NewValidator(testResourceType, &map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
 
 		model := testDatamodel{
 			Int32Field: 0,
@@ -769,7 +772,7 @@ func Test_Validator_TypeConversions_Int32(t *testing.T) {
 	})
 
 	t.Run("failed conversion", func(t *testing.T) {
-		v := NewValidator(&map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
+		v := NewValidator(testResourceType, &map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
 
 		model := testDatamodel{
 			Int32Field: 0,
@@ -784,7 +787,7 @@ func Test_Validator_TypeConversions_Int32(t *testing.T) {
 }
 
 func Test_Validator_SetAndValidate_MultipleErrors(t *testing.T) {
-	v := NewValidator(&map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
+	v := NewValidator(testResourceType, &map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
 
 	model := testDatamodel{
 		StringField:            "",
@@ -806,7 +809,7 @@ func Test_Validator_SetAndValidate_Status(t *testing.T) {
 		secrets := map[string]rpv1.SecretValueReference{}
 		status := rpv1.RecipeStatus{}
 
-		v := NewValidator(&values, &secrets, &outputResources, &status)
+		v := NewValidator(testResourceType, &values, &secrets, &outputResources, &status)
 		recipeOutput := recipes.RecipeOutput{
 			Status: &rpv1.RecipeStatus{
 				TemplateKind:    "terraform",
@@ -820,3 +823,80 @@ func Test_Validator_SetAndValidate_Status(t *testing.T) {
 		require.Equal(t, v.Status, recipeOutput.Status)
 	})
 }
+
+func Test_Validator_SetAndValidate_OutputSchema(t *testing.T) {
+	schema := recipes.OutputSchema{
+		Values: []recipes.OutputField{
+			{Name: "host", Type: recipes.OutputTypeString, Required: true},
+			{Name: "port", Type: recipes.OutputTypeInt, Required: true},
+		},
+		Secrets: []recipes.OutputField{
+			{Name: "password", Type: recipes.OutputTypeString},
+		},
+	}
+
+	t.Run("recipe output matches schema", func(t *testing.T) {
+		v := NewValidator(testResourceType, &map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
+
+		model := testDatamodel{}
+		v.setOutputSchema(schema)
+		v.AddRequiredStringField("host", &model.StringField)
+		v.AddRequiredInt32Field("port", &model.Int32Field)
+		v.AddOptionalSecretField("password", &model.AnotherCoolStringField)
+
+		err := v.SetAndValidate(&recipes.RecipeOutput{Values: map[string]any{"host": "myhost", "port": 6379}, Secrets: map[string]any{"password": "secret"}})
+		require.NoError(t, err)
+		require.Equal(t, "myhost", model.StringField)
+		require.Equal(t, int32(6379), model.Int32Field)
+	})
+
+	t.Run("required values set by resource", func(t *testing.T) {
+		v := NewValidator(testResourceType, &map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
+
+		model := testDatamodel{
+			StringField: "existing",
+			Int32Field:  6380,
+		}
+		v.setOutputSchema(schema)
+		v.AddRequiredStringField("host", &model.StringField)
+		v.AddRequiredInt32Field("port", &model.Int32Field)
+
+		err := v.SetAndValidate(&recipes.RecipeOutput{Secrets: map[string]any{"password": "secret"}})
+		require.NoError(t, err)
+		require.Equal(t, "existing", model.StringField)
+		require.Equal(t, int32(6380), model.Int32Field)
+	})
+
+	t.Run("schema registered for resource type", func(t *testing.T) {
+		v := NewValidator("Applications.Datastores/redisCaches", &map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
+		require.NotNil(t, v.outputSchema)
+
+		v = NewValidator(testResourceType, &map[string]any{}, &map[string]rpv1.SecretValueReference{}, &[]rpv1.OutputResource{}, &rpv1.RecipeStatus{})
+		require.Nil(t, v.outputSchema)
+	})
+
+	t.Run("recipe output doesn't match schema", func(t *testing.T) {
+		outputResources := []rpv1.OutputResource{}
+		v := NewValidator(testResourceType, &map[string]any{}, &map[string]rpv1.SecretValueReference{}, &outputResources, &rpv1.RecipeStatus{})
+
+		model := testDatamodel{}
+		v.setOutputSchema(schema)
+		v.AddRequiredStringField("host", &model.StringField)
+		v.AddRequiredInt32Field("port", &model.Int32Field)
+
+		err := v.SetAndValidate(&recipes.RecipeOutput{
+			Values:    map[string]any{"hostname": "myhost", "port": "6379"},
+			Resources: []string{"/planes/aws/aws/accounts/123341234/regions/us-west-2/providers/AWS.Kinesis/Stream/mystream"},
+		})
+		require.Error(t, err)
+		recipeError, ok := err.(*recipes.RecipeError)
+		require.True(t, ok)
+		require.Equal(t, recipes.InvalidRecipeOutputs, recipeError.ErrorDetails.Code)
+		require.Contains(t, recipeError.ErrorDetails.Message, `missing required value "host"`)
+		require.Contains(t, recipeError.ErrorDetails.Message, `value "port" is expected to be of type int, got string`)
+
+		// Output resources are still populated so that they are tracked for deletion.
+		require.Len(t, outputResources, 1)
+		require.Empty(t, model.StringField)
+	})
+}
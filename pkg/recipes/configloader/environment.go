@@ -111,6 +111,23 @@ func getConfiguration(environment *v20231001preview.EnvironmentResource, applica
 		}
 	}
 
+	recipeConfig := environment.Properties.RecipeConfig
+	if recipeConfig != nil && recipeConfig.Terraform != nil && recipeConfig.Terraform.Backend != nil {
+		config.RecipeConfig.Terraform.Backend = &datamodel.TerraformBackend{
+			Kind:   to.String(recipeConfig.Terraform.Backend.Kind),
+			Config: to.StringMap(recipeConfig.Terraform.Backend.Config),
+			Secret: to.String(recipeConfig.Terraform.Backend.Secret),
+		}
+	}
+	if recipeConfig != nil && recipeConfig.Terraform != nil {
+		config.RecipeConfig.Terraform.ProviderMirrorPath = to.String(recipeConfig.Terraform.ProviderMirrorPath)
+		config.RecipeConfig.Terraform.Authentication = getTerraformAuthentication(recipeConfig.Terraform.Authentication)
+		config.RecipeConfig.Terraform.Providers = getTerraformProviders(recipeConfig.Terraform.Providers)
+	}
+	if recipeConfig != nil && recipeConfig.Drift != nil && recipeConfig.Drift.AutoRemediate != nil {
+		config.RecipeConfig.Drift.AutoRemediate = *recipeConfig.Drift.AutoRemediate
+	}
+
 	if environment.Properties.Simulated != nil && *environment.Properties.Simulated {
 		config.Simulated = true
 	}
@@ -118,6 +135,70 @@ func getConfiguration(environment *v20231001preview.EnvironmentResource, applica
 	return &config, nil
 }
 
+// getTerraformAuthentication returns the references to the secret stores holding the credentials used to download
+// Terraform modules from private sources.
+func getTerraformAuthentication(auth *v20231001preview.TerraformAuthenticationProperties) datamodel.TerraformAuthentication {
+	authentication := datamodel.TerraformAuthentication{}
+	if auth == nil {
+		return authentication
+	}
+
+	if auth.Git != nil {
+		authentication.Git.PAT = getSecretReferences(auth.Git.Pat)
+		authentication.Git.SSH = getSecretReferences(auth.Git.SSH)
+	}
+	authentication.Registry = getSecretReferences(auth.Registry)
+
+	return authentication
+}
+
+func getSecretReferences(refs map[string]*v20231001preview.TerraformSecretReference) map[string]datamodel.SecretReference {
+	if len(refs) == 0 {
+		return nil
+	}
+
+	secretReferences := map[string]datamodel.SecretReference{}
+	for host, ref := range refs {
+		if ref != nil {
+			secretReferences[host] = datamodel.SecretReference{Secret: to.String(ref.Secret)}
+		}
+	}
+
+	return secretReferences
+}
+
+// getTerraformProviders returns the configurations of the additional Terraform providers of the environment.
+func getTerraformProviders(providers map[string][]*v20231001preview.TerraformProviderConfigProperties) map[string][]datamodel.TerraformProviderConfig {
+	if len(providers) == 0 {
+		return nil
+	}
+
+	providerConfigs := map[string][]datamodel.TerraformProviderConfig{}
+	for name, configs := range providers {
+		for _, config := range configs {
+			if config == nil {
+				continue
+			}
+
+			providerConfig := datamodel.TerraformProviderConfig{Values: config.Values}
+			for key, ref := range config.Secrets {
+				if ref == nil {
+					continue
+				}
+
+				if providerConfig.Secrets == nil {
+					providerConfig.Secrets = map[string]datamodel.ProviderSecretReference{}
+				}
+				providerConfig.Secrets[key] = datamodel.ProviderSecretReference{Source: to.String(ref.Source), Key: to.String(ref.Key)}
+			}
+
+			providerConfigs[name] = append(providerConfigs[name], providerConfig)
+		}
+	}
+
+	return providerConfigs
+}
+
 // LoadRecipe fetches the recipe information from the environment. It returns an error if the environment cannot be fetched.
 func (e *environmentLoader) LoadRecipe(ctx context.Context, recipe *recipes.ResourceMetadata) (*recipes.EnvironmentDefinition, error) {
 	environment, err := util.FetchEnvironment(ctx, recipe.EnvironmentID, e.ArmClientOptions)
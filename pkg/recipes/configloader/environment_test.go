@@ -140,6 +140,106 @@ func TestGetConfiguration(t *testing.T) {
 				Providers: createAWSProvider(),
 			},
 		},
+		{
+			name: "terraform backend with env resource",
+			envResource: &model.EnvironmentResource{
+				Properties: &model.EnvironmentProperties{
+					Compute: &model.KubernetesCompute{
+						Kind:       to.Ptr(kind),
+						Namespace:  to.Ptr(envNamespace),
+						ResourceID: to.Ptr(envResourceId),
+					},
+					RecipeConfig: &model.RecipeConfigProperties{
+						Terraform: &model.TerraformConfigProperties{
+							Backend: &model.TerraformBackendProperties{
+								Kind: to.Ptr("s3"),
+								Config: map[string]*string{
+									"bucket": to.Ptr("tfstate"),
+									"region": to.Ptr("us-west-2"),
+								},
+							},
+						},
+					},
+				},
+			},
+			appResource: nil,
+			expectedConfig: &recipes.Configuration{
+				Runtime: recipes.RuntimeConfiguration{
+					Kubernetes: &recipes.KubernetesRuntime{
+						Namespace:            envNamespace,
+						EnvironmentNamespace: envNamespace,
+					},
+				},
+				Providers: datamodel.Providers{},
+				RecipeConfig: datamodel.RecipeConfigProperties{
+					Terraform: datamodel.TerraformConfigProperties{
+						Backend: &datamodel.TerraformBackend{
+							Kind: "s3",
+							Config: map[string]string{
+								"bucket": "tfstate",
+								"region": "us-west-2",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "terraform providers with env resource",
+			envResource: &model.EnvironmentResource{
+				Properties: &model.EnvironmentProperties{
+					Compute: &model.KubernetesCompute{
+						Kind:       to.Ptr(kind),
+						Namespace:  to.Ptr(envNamespace),
+						ResourceID: to.Ptr(envResourceId),
+					},
+					RecipeConfig: &model.RecipeConfigProperties{
+						Terraform: &model.TerraformConfigProperties{
+							Providers: map[string][]*model.TerraformProviderConfigProperties{
+								"postgresql": {
+									{
+										Values: map[string]any{"host": "postgres"},
+										Secrets: map[string]*model.TerraformProviderSecretReference{
+											"password": {
+												Source: to.Ptr("/planes/radius/local/resourcegroups/test-rg/providers/Applications.Core/secretStores/postgres"),
+												Key:    to.Ptr("password"),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			appResource: nil,
+			expectedConfig: &recipes.Configuration{
+				Runtime: recipes.RuntimeConfiguration{
+					Kubernetes: &recipes.KubernetesRuntime{
+						Namespace:            envNamespace,
+						EnvironmentNamespace: envNamespace,
+					},
+				},
+				Providers: datamodel.Providers{},
+				RecipeConfig: datamodel.RecipeConfigProperties{
+					Terraform: datamodel.TerraformConfigProperties{
+						Providers: map[string][]datamodel.TerraformProviderConfig{
+							"postgresql": {
+								{
+									Values: map[string]any{"host": "postgres"},
+									Secrets: map[string]datamodel.ProviderSecretReference{
+										"password": {
+											Source: "/planes/radius/local/resourcegroups/test-rg/providers/Applications.Core/secretStores/postgres",
+											Key:    "password",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "invalid app resource",
 			envResource: &model.EnvironmentResource{
@@ -0,0 +1,88 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configloader
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+//go:generate mockgen -destination=./mock_secrets_loader.go -package=configloader -self_package github.com/radius-project/radius/pkg/recipes/configloader github.com/radius-project/radius/pkg/recipes/configloader SecretsLoader
+
+var _ SecretsLoader = (*secretsLoader)(nil)
+
+// NewSecretStoreLoader creates a new SecretsLoader instance with the given ARM Client Options.
+func NewSecretStoreLoader(armOptions *arm.ClientOptions) SecretsLoader {
+	return &secretsLoader{ArmClientOptions: armOptions}
+}
+
+// secretsLoader struct is initialized with arm clients and provides functionality to get the secrets of a secret store.
+type secretsLoader struct {
+	// ArmClientOptions represents the client options for ARM clients.
+	ArmClientOptions *arm.ClientOptions
+}
+
+// LoadSecrets lists the secrets of the Applications.Core/secretStores resource with the given ID and returns their
+// decoded values keyed by the secret name.
+func (e *secretsLoader) LoadSecrets(ctx context.Context, secretStoreID string) (map[string]string, error) {
+	id, err := resources.ParseResource(secretStoreID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := v20231001preview.NewSecretStoresClient(id.RootScope(), &aztoken.AnonymousCredential{}, e.ArmClientOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := client.ListSecrets(ctx, id.Name(), map[string]any{}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the secrets of secret store %q: %w", secretStoreID, err)
+	}
+
+	return decodeSecrets(response.SecretStoreListSecretsResult)
+}
+
+// decodeSecrets returns the values of the secrets of a secret store keyed by the secret name. Base64 encoded values
+// are decoded.
+func decodeSecrets(result v20231001preview.SecretStoreListSecretsResult) (map[string]string, error) {
+	secrets := map[string]string{}
+	for name, secret := range result.Data {
+		if secret == nil || secret.Value == nil {
+			continue
+		}
+
+		value := *secret.Value
+		if secret.Encoding != nil && *secret.Encoding == v20231001preview.SecretValueEncodingBase64 {
+			decoded, err := base64.StdEncoding.DecodeString(value)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode the value of secret %q: %w", name, err)
+			}
+			value = string(decoded)
+		}
+
+		secrets[name] = value
+	}
+
+	return secrets, nil
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configloader
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeSecrets(t *testing.T) {
+	t.Run("raw and base64 encoded values", func(t *testing.T) {
+		secrets, err := decodeSecrets(v20231001preview.SecretStoreListSecretsResult{
+			Data: map[string]*v20231001preview.SecretValueProperties{
+				"pat": {
+					Value: to.Ptr("ghp_token"),
+				},
+				"privateKey": {
+					Encoding: to.Ptr(v20231001preview.SecretValueEncodingBase64),
+					Value:    to.Ptr("cHJpdmF0ZS1rZXk="),
+				},
+				"empty": nil,
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"pat": "ghp_token", "privateKey": "private-key"}, secrets)
+	})
+
+	t.Run("invalid base64 value", func(t *testing.T) {
+		_, err := decodeSecrets(v20231001preview.SecretStoreListSecretsResult{
+			Data: map[string]*v20231001preview.SecretValueProperties{
+				"privateKey": {
+					Encoding: to.Ptr(v20231001preview.SecretValueEncodingBase64),
+					Value:    to.Ptr("not base64"),
+				},
+			},
+		})
+		require.ErrorContains(t, err, "failed to decode the value of secret \"privateKey\"")
+	})
+}
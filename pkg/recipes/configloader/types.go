@@ -28,3 +28,9 @@ type ConfigurationLoader interface {
 	// LoadRecipe fetches the recipe information from the environment.
 	LoadRecipe(ctx context.Context, recipe *recipes.ResourceMetadata) (*recipes.EnvironmentDefinition, error)
 }
+
+// SecretsLoader loads the secrets of secret stores.
+type SecretsLoader interface {
+	// LoadSecrets fetches the secrets of the Applications.Core/secretStores resource with the given ID and returns their values keyed by the secret name.
+	LoadSecrets(ctx context.Context, secretStoreID string) (map[string]string, error)
+}
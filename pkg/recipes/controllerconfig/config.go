@@ -17,6 +17,7 @@ limitations under the License.
 package controllerconfig
 
 import (
+	"path/filepath"
 	"strconv"
 
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
@@ -32,6 +33,11 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/secret/provider"
 )
 
+const (
+	// terraformCacheSubDir is the default subdirectory of the Terraform directory used to cache Terraform modules and providers.
+	terraformCacheSubDir = "cache"
+)
+
 // RecipeControllerConfig is the configuration for the controllers which uses recipe.
 type RecipeControllerConfig struct {
 	// K8sClients is the collections of Kubernetes clients.
@@ -49,6 +55,9 @@ type RecipeControllerConfig struct {
 	// Engine is the engine for executing recipes.
 	Engine engine.Engine
 
+	// RecipeMetadataCache is the cache of the metadata of recipe templates used to validate recipe parameters.
+	RecipeMetadataCache *engine.MetadataCache
+
 	// UCPConnection is the connection to UCP
 	UCPConnection *sdk.Connection
 }
@@ -86,6 +95,18 @@ func New(options hostoptions.HostOptions) (*RecipeControllerConfig, error) {
 		return nil, err
 	}
 
+	terraformOptions := driver.TerraformOptions{
+		Path:    options.Config.Terraform.Path,
+		Version: options.Config.Terraform.Version,
+	}
+	if cache := options.Config.Terraform.Cache; cache != nil && cache.Enabled {
+		terraformOptions.CacheDir = cache.Path
+		if terraformOptions.CacheDir == "" {
+			terraformOptions.CacheDir = filepath.Join(options.Config.Terraform.Path, terraformCacheSubDir)
+		}
+		terraformOptions.CacheMaxSizeBytes = cache.MaxSizeMB * 1024 * 1024
+	}
+
 	cfg.ConfigLoader = configloader.NewEnvironmentLoader(clientOptions)
 	cfg.Engine = engine.NewEngine(engine.Options{
 		ConfigurationLoader: cfg.ConfigLoader,
@@ -100,11 +121,11 @@ func New(options hostoptions.HostOptions) (*RecipeControllerConfig, error) {
 				},
 			),
 			recipes.TemplateKindTerraform: driver.NewTerraformDriver(options.UCPConnection, provider.NewSecretProvider(options.Config.SecretProvider),
-				driver.TerraformOptions{
-					Path: options.Config.Terraform.Path,
-				}, cfg.K8sClients.ClientSet),
+				configloader.NewSecretStoreLoader(clientOptions), terraformOptions, cfg.K8sClients.ClientSet),
+			recipes.TemplateKindHelm: driver.NewHelmDriver(options.K8sConfig, cfg.K8sClients.ClientSet, cfg.ResourceClient, driver.HelmOptions{}),
 		},
 	})
+	cfg.RecipeMetadataCache = engine.NewMetadataCache(cfg.Engine, engine.DefaultMetadataTimeout, engine.DefaultMetadataTTL)
 
 	return cfg, nil
 }
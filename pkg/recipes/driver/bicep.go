@@ -20,12 +20,14 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
 	"github.com/radius-project/radius/pkg/to"
+	"golang.org/x/exp/slices"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/go-logr/logr"
@@ -83,43 +85,9 @@ func (d *bicepDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipe
 	logger := logr.FromContextOrDiscard(ctx)
 	logger.Info(fmt.Sprintf("Deploying recipe: %q, template: %q", opts.Definition.Name, opts.Definition.TemplatePath))
 
-	recipeData := make(map[string]any)
-	downloadStartTime := time.Now()
-	err := util.ReadFromRegistry(ctx, opts.Definition.TemplatePath, &recipeData)
-	if err != nil {
-		metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
-			metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, opts.Recipe.Name, &opts.Definition, recipes.RecipeDownloadFailed))
-		return nil, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
-	}
-	metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
-		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, opts.Recipe.Name, &opts.Definition, metrics.SuccessfulOperationState))
-
-	// create the context object to be passed to the recipe deployment
-	recipeContext, err := recipecontext.New(&opts.Recipe, &opts.Configuration)
-	if err != nil {
-		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
-	}
-
-	// get the parameters after resolving the conflict between developer and operator parameters
-	// if the recipe template also has the context parameter defined then add it to the parameter for deployment
-	isContextParameterDefined := hasContextParameter(recipeData)
-	parameters := createRecipeParameters(opts.Recipe.Parameters, opts.Definition.Parameters, isContextParameterDefined, recipeContext)
-
-	deploymentName := deploymentPrefix + strconv.FormatInt(time.Now().UnixNano(), 10)
-	deploymentID, err := createDeploymentID(recipeContext.Resource.ID, deploymentName)
+	deployment, deploymentID, err := d.prepareDeployment(ctx, opts, recipes.RecipeDeploymentFailed)
 	if err != nil {
-		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
-	}
-
-	// Provider config will specify the Azure and AWS scopes (if provided).
-	providerConfig := newProviderConfig(deploymentID.FindScope(resources_radius.ScopeResourceGroups), opts.Configuration.Providers)
-
-	logger.Info("deploying bicep template for recipe", "deploymentID", deploymentID)
-	if providerConfig.AWS != nil {
-		logger.Info("using AWS provider", "deploymentID", deploymentID, "scope", providerConfig.AWS.Value.Scope)
-	}
-	if providerConfig.Az != nil {
-		logger.Info("using Azure provider", "deploymentID", deploymentID, "scope", providerConfig.Az.Value.Scope)
+		return nil, err
 	}
 
 	if opts.Configuration.Simulated {
@@ -129,14 +97,7 @@ func (d *bicepDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipe
 
 	poller, err := d.DeploymentClient.CreateOrUpdate(
 		ctx,
-		clients.Deployment{
-			Properties: &clients.DeploymentProperties{
-				Mode:           armresources.DeploymentModeIncremental,
-				ProviderConfig: &providerConfig,
-				Parameters:     parameters,
-				Template:       recipeData,
-			},
-		},
+		deployment,
 		deploymentID.String(),
 		clients.DeploymentsClientAPIVersion,
 	)
@@ -146,7 +107,16 @@ func (d *bicepDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipe
 	}
 
 	resp, err := poller.PollUntilDone(ctx, &runtime.PollUntilDoneOptions{Frequency: pollFrequency})
-	if err != nil {
+	if err != nil && ctx.Err() != nil {
+		// The operation is canceled while the deployment is running. Cancel the deployment so that the deployment engine
+		// stops deploying the remaining resources. The context is already done, so the request is sent with a context
+		// which is not canceled.
+		logger.Info("canceling the deployment of the canceled operation", "deploymentID", deploymentID)
+		if cancelErr := d.DeploymentClient.Cancel(context.WithoutCancel(ctx), deploymentID.String(), clients.DeploymentsClientAPIVersion); cancelErr != nil {
+			logger.Info(fmt.Sprintf("failed to cancel the deployment: %s", cancelErr.Error()), "deploymentID", deploymentID)
+		}
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentCanceled, fmt.Sprintf("deployment of recipe %s of type %s was canceled", opts.BaseOptions.Recipe.Name, opts.BaseOptions.Definition.ResourceType), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	} else if err != nil {
 		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, fmt.Sprintf("failed to deploy recipe %s of type %s", opts.BaseOptions.Recipe.Name, opts.BaseOptions.Definition.ResourceType), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
 	}
 
@@ -179,6 +149,31 @@ func (d *bicepDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipe
 	return recipeResponse, nil
 }
 
+// Plan fetches recipe contents from container registry and runs the what-if operation of the deployment of the recipe
+// using UCP deployment client. It returns the resources which would be created, updated or deleted by the deployment,
+// including the previously deployed resources which are no longer part of the recipe and would be garbage collected.
+func (d *bicepDriver) Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error) {
+	logger := logr.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("Planning recipe: %q, template: %q", opts.Definition.Name, opts.Definition.TemplatePath))
+
+	deployment, deploymentID, err := d.prepareDeployment(ctx, opts, recipes.RecipePlanFailed)
+	if err != nil {
+		return nil, err
+	}
+
+	poller, err := d.DeploymentClient.WhatIf(ctx, deployment, deploymentID.String(), clients.DeploymentsClientAPIVersion)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, fmt.Sprintf("failed to plan recipe %s of type %s", opts.BaseOptions.Recipe.Name, opts.BaseOptions.Definition.ResourceType), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	resp, err := poller.PollUntilDone(ctx, &runtime.PollUntilDoneOptions{Frequency: pollFrequency})
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, fmt.Sprintf("failed to plan recipe %s of type %s", opts.BaseOptions.Recipe.Name, opts.BaseOptions.Definition.ResourceType), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	return d.preparePlanResponse(resp.WhatIfOperationResult, opts.PrevState), nil
+}
+
 // Delete deletes all of the output resources that are marked as managed by Radius.
 // It will create a goroutine for each resource to be deleted and wait for them to finish,
 // retrying if necessary.
@@ -238,6 +233,12 @@ func (d *bicepDriver) Delete(ctx context.Context, opts DeleteOptions) error {
 	return nil
 }
 
+// DetectDrift compares the current state of the output resources of the recipe against the state recorded at
+// deployment time.
+func (d *bicepDriver) DetectDrift(ctx context.Context, opts DriftOptions) (*recipes.RecipeDrift, error) {
+	return detectOutputResourceDrift(ctx, d.ResourceClient, opts)
+}
+
 // GetRecipeMetadata gets the Bicep recipe parameters information from the container registry
 func (d *bicepDriver) GetRecipeMetadata(ctx context.Context, opts BaseOptions) (map[string]any, error) {
 	// Recipe parameters can be found in the recipe data pulled from the registry in the following format:
@@ -375,6 +376,105 @@ func (d *bicepDriver) prepareRecipeResponse(templatePath string, outputs any, re
 	return recipeResponse, nil
 }
 
+// prepareDeployment fetches recipe contents from container registry and creates the deployment ID, the recipe context
+// parameter, the recipe parameters and the provider config of the deployment of the recipe. errorCode is the code of the
+// recipe error returned if the deployment can't be prepared.
+func (d *bicepDriver) prepareDeployment(ctx context.Context, opts ExecuteOptions, errorCode string) (clients.Deployment, resources.ID, error) {
+	logger := logr.FromContextOrDiscard(ctx)
+
+	recipeData := make(map[string]any)
+	downloadStartTime := time.Now()
+	err := util.ReadFromRegistry(ctx, opts.Definition.TemplatePath, &recipeData)
+	if err != nil {
+		metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
+			metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, opts.Recipe.Name, &opts.Definition, recipes.RecipeDownloadFailed))
+		return clients.Deployment{}, resources.ID{}, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+	metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
+		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, opts.Recipe.Name, &opts.Definition, metrics.SuccessfulOperationState))
+
+	// create the context object to be passed to the recipe deployment
+	recipeContext, err := recipecontext.New(&opts.Recipe, &opts.Configuration)
+	if err != nil {
+		return clients.Deployment{}, resources.ID{}, recipes.NewRecipeError(errorCode, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	// get the parameters after resolving the conflict between developer and operator parameters
+	// if the recipe template also has the context parameter defined then add it to the parameter for deployment
+	isContextParameterDefined := hasContextParameter(recipeData)
+	parameters := createRecipeParameters(opts.Recipe.Parameters, opts.Definition.Parameters, isContextParameterDefined, recipeContext)
+
+	deploymentName := deploymentPrefix + strconv.FormatInt(time.Now().UnixNano(), 10)
+	deploymentID, err := createDeploymentID(recipeContext.Resource.ID, deploymentName)
+	if err != nil {
+		return clients.Deployment{}, resources.ID{}, recipes.NewRecipeError(errorCode, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	// Provider config will specify the Azure and AWS scopes (if provided).
+	providerConfig := newProviderConfig(deploymentID.FindScope(resources_radius.ScopeResourceGroups), opts.Configuration.Providers)
+
+	logger.Info("deploying bicep template for recipe", "deploymentID", deploymentID)
+	if providerConfig.AWS != nil {
+		logger.Info("using AWS provider", "deploymentID", deploymentID, "scope", providerConfig.AWS.Value.Scope)
+	}
+	if providerConfig.Az != nil {
+		logger.Info("using Azure provider", "deploymentID", deploymentID, "scope", providerConfig.Az.Value.Scope)
+	}
+
+	return clients.Deployment{
+		Properties: &clients.DeploymentProperties{
+			Mode:           armresources.DeploymentModeIncremental,
+			ProviderConfig: &providerConfig,
+			Parameters:     parameters,
+			Template:       recipeData,
+		},
+	}, deploymentID, nil
+}
+
+// preparePlanResponse converts the changes of the what-if result to the changes of the recipe. Resources which are not
+// changed are not included. The previously deployed resources which are not part of the what-if result are deleted by the
+// garbage collection after the deployment.
+func (d *bicepDriver) preparePlanResponse(result armresources.WhatIfOperationResult, prevState []string) *recipes.RecipePlan {
+	plan := &recipes.RecipePlan{Changes: []recipes.ResourceChange{}}
+	planned := []string{}
+	if result.Properties != nil {
+		for _, change := range result.Properties.Changes {
+			if change == nil || change.ResourceID == nil || change.ChangeType == nil {
+				continue
+			}
+			planned = append(planned, strings.ToLower(*change.ResourceID))
+
+			var changeType recipes.ResourceChangeType
+			switch *change.ChangeType {
+			case armresources.ChangeTypeCreate:
+				changeType = recipes.ResourceChangeTypeCreate
+			case armresources.ChangeTypeModify, armresources.ChangeTypeDeploy:
+				changeType = recipes.ResourceChangeTypeUpdate
+			case armresources.ChangeTypeDelete:
+				changeType = recipes.ResourceChangeTypeDelete
+			default:
+				continue
+			}
+
+			plan.Changes = append(plan.Changes, recipes.ResourceChange{
+				ChangeType: changeType,
+				ResourceID: *change.ResourceID,
+			})
+		}
+	}
+
+	for _, id := range prevState {
+		if !slices.Contains(planned, strings.ToLower(id)) {
+			plan.Changes = append(plan.Changes, recipes.ResourceChange{
+				ChangeType: recipes.ResourceChangeTypeDelete,
+				ResourceID: id,
+			})
+		}
+	}
+
+	return plan
+}
+
 // getGCOutputResources [GC stands for Garbage Collection] compares two slices of resource ids and
 // returns a slice of OutputResources that contains the elements that are in the "previous" slice but not in the "current".
 func (d *bicepDriver) getGCOutputResources(current []string, previous []string) ([]rpv1.OutputResource, error) {
@@ -298,6 +298,7 @@ func Test_Bicep_PrepareRecipeResponse_Success(t *testing.T) {
 			TemplateKind: recipes.TemplateKindBicep,
 			TemplatePath: "radiusdev.azurecr.io/recipes/functionaltest/parameters/mongodatabases/azure:1.0",
 		},
+		RawOutput: value,
 	}
 
 	opts := ExecuteOptions{
@@ -346,6 +347,7 @@ func Test_Bicep_PrepareRecipeResponse_EmptySecret(t *testing.T) {
 			TemplateKind: recipes.TemplateKindBicep,
 			TemplatePath: "radiusdev.azurecr.io/recipes/functionaltest/parameters/mongodatabases/azure:1.0",
 		},
+		RawOutput: value,
 	}
 
 	actualResponse, err := d.prepareRecipeResponse("radiusdev.azurecr.io/recipes/functionaltest/parameters/mongodatabases/azure:1.0", response, resources)
@@ -375,6 +377,67 @@ func Test_Bicep_PrepareRecipeResponse_EmptyResult(t *testing.T) {
 	require.Equal(t, expectedResponse, actualResponse)
 }
 
+func Test_Bicep_PreparePlanResponse(t *testing.T) {
+	d := &bicepDriver{}
+
+	result := armresources.WhatIfOperationResult{
+		Properties: &armresources.WhatIfOperationProperties{
+			Changes: []*armresources.WhatIfChange{
+				{
+					ResourceID: to.Ptr("/planes/azure/azure/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Cache/redis/redis"),
+					ChangeType: to.Ptr(armresources.ChangeTypeCreate),
+				},
+				{
+					ResourceID: to.Ptr("/planes/azure/azure/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Storage/storageAccounts/sa"),
+					ChangeType: to.Ptr(armresources.ChangeTypeModify),
+				},
+				{
+					ResourceID: to.Ptr("/planes/kubernetes/local/namespaces/default/providers/core/Service/redis"),
+					ChangeType: to.Ptr(armresources.ChangeTypeNoChange),
+				},
+				{
+					ResourceID: to.Ptr("/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/redis"),
+					ChangeType: to.Ptr(armresources.ChangeTypeDelete),
+				},
+			},
+		},
+	}
+	prevState := []string{
+		"/planes/kubernetes/local/namespaces/default/providers/core/Service/redis",
+		"/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/redis",
+		"/planes/kubernetes/local/namespaces/default/providers/core/Secret/redis",
+	}
+
+	expected := &recipes.RecipePlan{
+		Changes: []recipes.ResourceChange{
+			{
+				ChangeType: recipes.ResourceChangeTypeCreate,
+				ResourceID: "/planes/azure/azure/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Cache/redis/redis",
+			},
+			{
+				ChangeType: recipes.ResourceChangeTypeUpdate,
+				ResourceID: "/planes/azure/azure/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Storage/storageAccounts/sa",
+			},
+			{
+				ChangeType: recipes.ResourceChangeTypeDelete,
+				ResourceID: "/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/redis",
+			},
+			{
+				ChangeType: recipes.ResourceChangeTypeDelete,
+				ResourceID: "/planes/kubernetes/local/namespaces/default/providers/core/Secret/redis",
+			},
+		},
+	}
+
+	require.Equal(t, expected, d.preparePlanResponse(result, prevState))
+}
+
+func Test_Bicep_PreparePlanResponse_Empty(t *testing.T) {
+	d := &bicepDriver{}
+
+	require.Equal(t, &recipes.RecipePlan{Changes: []recipes.ResourceChange{}}, d.preparePlanResponse(armresources.WhatIfOperationResult{}, nil))
+}
+
 func Test_Bicep_Execute_SimulatedEnvironment(t *testing.T) {
 	t.Skip("This test makes outbound calls. #6490")
 	opts := ExecuteOptions{
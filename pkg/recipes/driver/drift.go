@@ -0,0 +1,161 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/portableresources/processors"
+	"github.com/radius-project/radius/pkg/recipes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// OutputResourceFingerprints computes the fingerprints of the output resources of a recipe, keyed by resource ID. The
+// fingerprints are recorded when the recipe is deployed and used as the baseline of the following drift checks.
+// Resources which are not managed by Radius or which no longer exist are skipped.
+func OutputResourceFingerprints(ctx context.Context, client processors.ResourceClient, outputResources []rpv1.OutputResource) (map[string]string, error) {
+	fingerprints := map[string]string{}
+	for _, outputResource := range outputResources {
+		if outputResource.RadiusManaged == nil || !*outputResource.RadiusManaged {
+			continue
+		}
+
+		id := outputResource.ID.String()
+		obj, err := client.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if obj == nil {
+			continue
+		}
+
+		fingerprint, err := resourceFingerprint(outputResource, obj)
+		if err != nil {
+			return nil, err
+		}
+		fingerprints[id] = fingerprint
+	}
+
+	return fingerprints, nil
+}
+
+// detectOutputResourceDrift compares the current state of the output resources of a recipe against the fingerprints
+// recorded at deployment time. It is used by the drivers which can't ask the recipe template for the desired state of
+// the resources.
+//
+// A resource which no longer exists is reported as deleted. A resource whose fingerprint differs from the recorded
+// fingerprint is reported as modified, and keeps being reported until the recipe is deployed again. A resource without
+// a recorded fingerprint, e.g. one deployed before the fingerprints were recorded, is not reported, its current
+// fingerprint is added to the baseline for the next drift checks.
+func detectOutputResourceDrift(ctx context.Context, client processors.ResourceClient, opts DriftOptions) (*recipes.RecipeDrift, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	drift := &recipes.RecipeDrift{
+		Resources:    []rpv1.DriftedResource{},
+		Fingerprints: map[string]string{},
+	}
+
+	for _, outputResource := range opts.OutputResources {
+		id := outputResource.ID.String()
+
+		// If the resource is not managed by Radius, changes to it are expected.
+		if outputResource.RadiusManaged == nil || !*outputResource.RadiusManaged {
+			logger.V(ucplog.LevelDebug).Info(fmt.Sprintf("Skipping drift check of output resource: %q, not managed by Radius", id))
+			continue
+		}
+
+		obj, err := client.Get(ctx, id)
+		if err != nil {
+			return nil, recipes.NewRecipeError(recipes.RecipeDriftDetectionFailed, err.Error(), "", recipes.GetErrorDetails(err))
+		}
+
+		previous, recorded := opts.Fingerprints[id]
+		if recorded {
+			// The baseline recorded at deployment time is kept, so that drift is reported until the recipe is deployed again.
+			drift.Fingerprints[id] = previous
+		}
+
+		if obj == nil {
+			drift.Resources = append(drift.Resources, rpv1.DriftedResource{
+				ResourceID: id,
+				Reason:     rpv1.DriftReasonDeleted,
+			})
+			continue
+		}
+
+		fingerprint, err := resourceFingerprint(outputResource, obj)
+		if err != nil {
+			return nil, recipes.NewRecipeError(recipes.RecipeDriftDetectionFailed, err.Error(), "", recipes.GetErrorDetails(err))
+		}
+		if !recorded {
+			drift.Fingerprints[id] = fingerprint
+		} else if previous != fingerprint {
+			drift.Resources = append(drift.Resources, rpv1.DriftedResource{
+				ResourceID: id,
+				Reason:     rpv1.DriftReasonModified,
+			})
+		}
+	}
+
+	return drift, nil
+}
+
+// resourceFingerprint computes a hash of the user-controlled state of a resource. Server-populated fields which change
+// without user intervention, such as the Kubernetes object metadata and status or the provisioning state of Azure and
+// AWS resources, are excluded.
+func resourceFingerprint(outputResource rpv1.OutputResource, obj map[string]any) (string, error) {
+	var state any
+	if strings.HasPrefix(strings.ToLower(outputResource.ID.PlaneNamespace()), "kubernetes/") {
+		filtered := map[string]any{}
+		for k, v := range obj {
+			if k == "metadata" || k == "status" {
+				continue
+			}
+			filtered[k] = v
+		}
+		state = filtered
+	} else {
+		properties := map[string]any{}
+		if p, ok := obj["properties"].(map[string]any); ok {
+			for k, v := range p {
+				if strings.EqualFold(k, "provisioningState") {
+					continue
+				}
+				properties[k] = v
+			}
+		}
+		state = map[string]any{
+			"properties": properties,
+			"tags":       obj["tags"],
+		}
+	}
+
+	// encoding/json sorts map keys, so the output is stable for the same state.
+	b, err := json.Marshal(state)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
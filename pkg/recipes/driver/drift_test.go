@@ -0,0 +1,259 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"errors"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/portableresources/processors"
+	"github.com/radius-project/radius/pkg/recipes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	driftAzureResourceID      = "/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Cache/redis/redis-test"
+	driftKubernetesResourceID = "/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/redis"
+)
+
+func Test_DetectOutputResourceDrift(t *testing.T) {
+	azureResource := map[string]any{
+		"id": driftAzureResourceID,
+		"properties": map[string]any{
+			"sku":               "Basic",
+			"provisioningState": "Succeeded",
+		},
+	}
+	kubernetesResource := map[string]any{
+		"kind": "Deployment",
+		"metadata": map[string]any{
+			"name":            "redis",
+			"resourceVersion": "1",
+		},
+		"spec": map[string]any{
+			"replicas": float64(1),
+		},
+	}
+	outputResources := []rpv1.OutputResource{
+		{
+			ID:            resources.MustParse(driftAzureResourceID),
+			RadiusManaged: to.Ptr(true),
+		},
+		{
+			ID:            resources.MustParse(driftKubernetesResourceID),
+			RadiusManaged: to.Ptr(true),
+		},
+	}
+
+	azureFingerprint, err := resourceFingerprint(outputResources[0], azureResource)
+	require.NoError(t, err)
+	kubernetesFingerprint, err := resourceFingerprint(outputResources[1], kubernetesResource)
+	require.NoError(t, err)
+
+	t.Run("no previous fingerprints", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := processors.NewMockResourceClient(ctrl)
+		client.EXPECT().Get(gomock.Any(), driftAzureResourceID).Return(azureResource, nil)
+		client.EXPECT().Get(gomock.Any(), driftKubernetesResourceID).Return(kubernetesResource, nil)
+
+		drift, err := detectOutputResourceDrift(testcontext.New(t), client, DriftOptions{OutputResources: outputResources})
+		require.NoError(t, err)
+		require.Empty(t, drift.Resources)
+		require.Equal(t, map[string]string{
+			driftAzureResourceID:      azureFingerprint,
+			driftKubernetesResourceID: kubernetesFingerprint,
+		}, drift.Fingerprints)
+	})
+
+	t.Run("server populated fields changed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := processors.NewMockResourceClient(ctrl)
+		client.EXPECT().Get(gomock.Any(), driftAzureResourceID).Return(map[string]any{
+			"id": driftAzureResourceID,
+			"properties": map[string]any{
+				"sku":               "Basic",
+				"provisioningState": "Updating",
+			},
+		}, nil)
+		client.EXPECT().Get(gomock.Any(), driftKubernetesResourceID).Return(map[string]any{
+			"kind": "Deployment",
+			"metadata": map[string]any{
+				"name":            "redis",
+				"resourceVersion": "2",
+			},
+			"spec": map[string]any{
+				"replicas": float64(1),
+			},
+			"status": map[string]any{
+				"readyReplicas": float64(1),
+			},
+		}, nil)
+
+		drift, err := detectOutputResourceDrift(testcontext.New(t), client, DriftOptions{
+			OutputResources: outputResources,
+			Fingerprints: map[string]string{
+				driftAzureResourceID:      azureFingerprint,
+				driftKubernetesResourceID: kubernetesFingerprint,
+			},
+		})
+		require.NoError(t, err)
+		require.Empty(t, drift.Resources)
+	})
+
+	t.Run("resources modified and deleted", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := processors.NewMockResourceClient(ctrl)
+		client.EXPECT().Get(gomock.Any(), driftAzureResourceID).Return(map[string]any{
+			"id": driftAzureResourceID,
+			"properties": map[string]any{
+				"sku": "Premium",
+			},
+		}, nil)
+		client.EXPECT().Get(gomock.Any(), driftKubernetesResourceID).Return(nil, nil)
+
+		drift, err := detectOutputResourceDrift(testcontext.New(t), client, DriftOptions{
+			OutputResources: outputResources,
+			Fingerprints: map[string]string{
+				driftAzureResourceID:      azureFingerprint,
+				driftKubernetesResourceID: kubernetesFingerprint,
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []rpv1.DriftedResource{
+			{ResourceID: driftAzureResourceID, Reason: rpv1.DriftReasonModified},
+			{ResourceID: driftKubernetesResourceID, Reason: rpv1.DriftReasonDeleted},
+		}, drift.Resources)
+		require.Equal(t, map[string]string{
+			driftAzureResourceID:      azureFingerprint,
+			driftKubernetesResourceID: kubernetesFingerprint,
+		}, drift.Fingerprints)
+	})
+
+	t.Run("drift reported on every check", func(t *testing.T) {
+		modified := map[string]any{
+			"id": driftAzureResourceID,
+			"properties": map[string]any{
+				"sku": "Premium",
+			},
+		}
+
+		ctrl := gomock.NewController(t)
+		client := processors.NewMockResourceClient(ctrl)
+		client.EXPECT().Get(gomock.Any(), driftAzureResourceID).Return(modified, nil).Times(2)
+		client.EXPECT().Get(gomock.Any(), driftKubernetesResourceID).Return(kubernetesResource, nil).Times(2)
+
+		expected := []rpv1.DriftedResource{
+			{ResourceID: driftAzureResourceID, Reason: rpv1.DriftReasonModified},
+		}
+
+		first, err := detectOutputResourceDrift(testcontext.New(t), client, DriftOptions{
+			OutputResources: outputResources,
+			Fingerprints: map[string]string{
+				driftAzureResourceID:      azureFingerprint,
+				driftKubernetesResourceID: kubernetesFingerprint,
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, expected, first.Resources)
+
+		// The second check uses the fingerprints saved by the first check as its baseline.
+		second, err := detectOutputResourceDrift(testcontext.New(t), client, DriftOptions{
+			OutputResources: outputResources,
+			Fingerprints:    first.Fingerprints,
+		})
+		require.NoError(t, err)
+		require.Equal(t, expected, second.Resources)
+		require.Equal(t, azureFingerprint, second.Fingerprints[driftAzureResourceID])
+	})
+
+	t.Run("resource not managed by Radius", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := processors.NewMockResourceClient(ctrl)
+
+		drift, err := detectOutputResourceDrift(testcontext.New(t), client, DriftOptions{
+			OutputResources: []rpv1.OutputResource{
+				{
+					ID:            resources.MustParse(driftAzureResourceID),
+					RadiusManaged: to.Ptr(false),
+				},
+			},
+		})
+		require.NoError(t, err)
+		require.Empty(t, drift.Resources)
+		require.Empty(t, drift.Fingerprints)
+	})
+
+	t.Run("get fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := processors.NewMockResourceClient(ctrl)
+		client.EXPECT().Get(gomock.Any(), driftAzureResourceID).Return(nil, errors.New("failed to get resource"))
+
+		_, err := detectOutputResourceDrift(testcontext.New(t), client, DriftOptions{OutputResources: outputResources})
+		require.Error(t, err)
+		require.Equal(t, recipes.RecipeDriftDetectionFailed, recipes.GetErrorDetails(err).Code)
+	})
+}
+
+func Test_OutputResourceFingerprints(t *testing.T) {
+	azureResource := map[string]any{
+		"properties": map[string]any{
+			"sku": "Basic",
+		},
+	}
+	outputResources := []rpv1.OutputResource{
+		{
+			ID:            resources.MustParse(driftAzureResourceID),
+			RadiusManaged: to.Ptr(true),
+		},
+		{
+			ID:            resources.MustParse(driftKubernetesResourceID),
+			RadiusManaged: to.Ptr(true),
+		},
+		{
+			ID:            resources.MustParse("/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Cache/redis/unmanaged"),
+			RadiusManaged: to.Ptr(false),
+		},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := processors.NewMockResourceClient(ctrl)
+		client.EXPECT().Get(gomock.Any(), driftAzureResourceID).Return(azureResource, nil)
+		client.EXPECT().Get(gomock.Any(), driftKubernetesResourceID).Return(nil, nil)
+
+		fingerprints, err := OutputResourceFingerprints(testcontext.New(t), client, outputResources)
+		require.NoError(t, err)
+
+		expected, err := resourceFingerprint(outputResources[0], azureResource)
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{driftAzureResourceID: expected}, fingerprints)
+	})
+
+	t.Run("get error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := processors.NewMockResourceClient(ctrl)
+		client.EXPECT().Get(gomock.Any(), driftAzureResourceID).Return(nil, errors.New("get error"))
+
+		_, err := OutputResourceFingerprints(testcontext.New(t), client, outputResources)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,763 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/exp/slices"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/cli"
+	"helm.sh/helm/v3/pkg/getter"
+	"helm.sh/helm/v3/pkg/registry"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/releaseutil"
+	"helm.sh/helm/v3/pkg/repo"
+	helm_driver "helm.sh/helm/v3/pkg/storage/driver"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"sigs.k8s.io/yaml"
+
+	"github.com/radius-project/radius/pkg/metrics"
+	"github.com/radius-project/radius/pkg/portableresources/processors"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/recipecontext"
+	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// helmStorageDriver is the Helm storage driver used to store the releases of the recipes as Kubernetes secrets.
+	helmStorageDriver = "secret"
+
+	// helmDefaultTimeout is the default time to wait for the resources of a chart to be ready or deleted.
+	helmDefaultTimeout = 10 * time.Minute
+
+	// helmReleaseNameMaxLength is the maximum length of a Helm release name.
+	helmReleaseNameMaxLength = 53
+
+	// HelmResultSuffix is the suffix of the name of the ConfigMap and the Secret that a recipe chart creates to return
+	// its outputs. For example, a chart creates the ConfigMap "{{ .Release.Name }}-result" whose data become the values
+	// of the recipe output and the Secret "{{ .Release.Name }}-result" whose data become the secrets.
+	HelmResultSuffix = "-result"
+)
+
+var (
+	_ Driver = (*helmDriver)(nil)
+
+	invalidReleaseNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+)
+
+// NewHelmDriver creates a new instance of driver to install a Helm chart recipe.
+func NewHelmDriver(restConfig *rest.Config, k8sClientSet kubernetes.Interface, client processors.ResourceClient, options HelmOptions) Driver {
+	return &helmDriver{
+		actionConfig:   newHelmActionConfiguration(restConfig),
+		restMapper:     (&restClientGetter{restConfig: restConfig}).ToRESTMapper,
+		loadChart:      loadHelmChart,
+		k8sClientSet:   k8sClientSet,
+		ResourceClient: client,
+		options:        options,
+	}
+}
+
+// HelmOptions represents the options required for execution of Helm driver.
+type HelmOptions struct {
+	// Timeout is the time to wait for the resources of a chart to be ready or deleted. Defaults to 10 minutes.
+	Timeout time.Duration
+}
+
+// helmDriver represents a driver to interact with Helm chart recipes - install chart, uninstall release, etc.
+type helmDriver struct {
+	// actionConfig returns the Helm action configuration for the given namespace.
+	actionConfig func(ctx context.Context, namespace string) (*action.Configuration, error)
+
+	// restMapper returns the REST mapper used to find the scope of the Kubernetes objects of a release.
+	restMapper func() (meta.RESTMapper, error)
+
+	// loadChart downloads and loads the chart at the given path with the given version.
+	loadChart func(templatePath string, templateVersion string) (*chart.Chart, error)
+
+	// k8sClientSet is the Kubernetes client used to read the outputs of the recipe.
+	k8sClientSet kubernetes.Interface
+
+	// ResourceClient is used to delete the output resources which are no longer part of the recipe.
+	ResourceClient processors.ResourceClient
+
+	options HelmOptions
+}
+
+// Execute downloads the chart and installs it, or upgrades the release if it already exists, with the recipe parameters
+// and the recipe context as values. It returns a RecipeOutput with the Kubernetes resources of the release and the outputs
+// read from the result ConfigMap and Secret of the release, and deletes the previously deployed resources which are no
+// longer part of the recipe.
+func (d *helmDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipes.RecipeOutput, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("Deploying recipe: %q, chart: %q, version: %q", opts.Definition.Name, opts.Definition.TemplatePath, opts.Definition.TemplateVersion))
+
+	input, err := d.prepareRelease(ctx, opts, recipes.RecipeDeploymentFailed)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Configuration.Simulated {
+		logger.Info("simulated environment is set to true, skipping deployment")
+		return nil, nil
+	}
+
+	rel, err := d.installOrUpgrade(ctx, input, false)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	recipeResponse, err := d.prepareRecipeResponse(ctx, opts.Definition, rel)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.InvalidRecipeOutputs, fmt.Sprintf("failed to read the recipe output %q: %s", recipes.ResultPropertyName, err.Error()), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	// Helm deletes the objects which are removed from the chart when the release is upgraded, but the resources deployed
+	// before the recipe was changed to this chart must be deleted here. Deleting the objects already removed by Helm is a no-op.
+	garbageCollectionStartTime := time.Now()
+	diff, err := d.getGCOutputResources(recipeResponse.Resources, opts.PrevState)
+	if err != nil {
+		return nil, err
+	}
+
+	err = d.deleteOutputResources(ctx, diff)
+	if err != nil {
+		metrics.DefaultRecipeEngineMetrics.RecordRecipeGarbageCollectionDuration(ctx, garbageCollectionStartTime,
+			metrics.NewRecipeAttributes(metrics.RecipeEngineOperationGC, opts.Recipe.Name, &opts.Definition, metrics.FailedOperationState))
+		return nil, recipes.NewRecipeError(recipes.RecipeGarbageCollectionFailed, err.Error(), recipes_util.ExecutionError, nil)
+	}
+	metrics.DefaultRecipeEngineMetrics.RecordRecipeGarbageCollectionDuration(ctx, garbageCollectionStartTime,
+		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationGC, opts.Recipe.Name, &opts.Definition, metrics.SuccessfulOperationState))
+
+	return recipeResponse, nil
+}
+
+// Delete uninstalls the Helm release of the recipe and waits for its resources to be deleted.
+// Returns nil if the release doesn't exist.
+func (d *helmDriver) Delete(ctx context.Context, opts DeleteOptions) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	namespace, err := helmNamespace(opts.Configuration)
+	if err != nil {
+		return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
+	releaseName, err := helmReleaseName(opts.Recipe.ResourceID)
+	if err != nil {
+		return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
+	cfg, err := d.actionConfig(ctx, namespace)
+	if err != nil {
+		return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
+	logger.Info(fmt.Sprintf("Uninstalling Helm release %q in namespace %q", releaseName, namespace))
+	uninstall := action.NewUninstall(cfg)
+	uninstall.Wait = true
+	uninstall.Timeout = d.timeout()
+	_, err = uninstall.Run(releaseName)
+	if err != nil {
+		if errors.Is(err, helm_driver.ErrReleaseNotFound) {
+			logger.Info(fmt.Sprintf("Helm release %q does not exist, skipping uninstall", releaseName))
+			return nil
+		}
+
+		return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
+	return nil
+}
+
+// DetectDrift compares the current state of the Kubernetes objects of the Helm release against the state recorded at
+// deployment time.
+func (d *helmDriver) DetectDrift(ctx context.Context, opts DriftOptions) (*recipes.RecipeDrift, error) {
+	return detectOutputResourceDrift(ctx, d.ResourceClient, opts)
+}
+
+// GetRecipeMetadata downloads the chart and returns the properties of its values schema (values.schema.json) as the recipe parameters.
+func (d *helmDriver) GetRecipeMetadata(ctx context.Context, opts BaseOptions) (map[string]any, error) {
+	ch, err := d.loadChart(opts.Definition.TemplatePath, opts.Definition.TemplateVersion)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeGetMetadataFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
+	parameters := map[string]any{}
+	if len(ch.Schema) > 0 {
+		schema := map[string]any{}
+		if err := json.Unmarshal(ch.Schema, &schema); err != nil {
+			err = fmt.Errorf("failed to parse values.schema.json of chart %q: %w", ch.Name(), err)
+			return nil, recipes.NewRecipeError(recipes.RecipeGetMetadataFailed, err.Error(), "", recipes.GetErrorDetails(err))
+		}
+
+		if properties, ok := schema["properties"].(map[string]any); ok {
+			parameters = properties
+		}
+	}
+
+	return map[string]any{
+		recipeParameters: parameters,
+	}, nil
+}
+
+// Plan downloads the chart and renders the release of the recipe with a dry run of the install or upgrade. It returns the
+// Kubernetes objects which would be created, updated or deleted compared to the current release, including the previously
+// deployed resources which are no longer part of the recipe and would be garbage collected.
+func (d *helmDriver) Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("Planning recipe: %q, chart: %q, version: %q", opts.Definition.Name, opts.Definition.TemplatePath, opts.Definition.TemplateVersion))
+
+	input, err := d.prepareRelease(ctx, opts, recipes.RecipePlanFailed)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := d.getRelease(ctx, input.namespace, input.name)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	planned, err := d.installOrUpgrade(ctx, input, true)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	plan, err := d.preparePlanResponse(current, planned, opts.PrevState)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	return plan, nil
+}
+
+// helmReleaseInput represents the inputs to install or upgrade the Helm release of a recipe.
+type helmReleaseInput struct {
+	chart     *chart.Chart
+	values    map[string]any
+	namespace string
+	name      string
+}
+
+// prepareRelease downloads the chart and creates the values, the namespace and the name of the Helm release of the recipe.
+// errorCode is the code of the recipe error returned if the release can't be prepared.
+func (d *helmDriver) prepareRelease(ctx context.Context, opts ExecuteOptions, errorCode string) (*helmReleaseInput, error) {
+	downloadStartTime := time.Now()
+	ch, err := d.loadChart(opts.Definition.TemplatePath, opts.Definition.TemplateVersion)
+	if err != nil {
+		metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
+			metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, opts.Recipe.Name, &opts.Definition, recipes.RecipeDownloadFailed))
+		return nil, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+	metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
+		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, opts.Recipe.Name, &opts.Definition, metrics.SuccessfulOperationState))
+
+	namespace, err := helmNamespace(opts.Configuration)
+	if err != nil {
+		return nil, recipes.NewRecipeError(errorCode, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	recipeContext, err := recipecontext.New(&opts.Recipe, &opts.Configuration)
+	if err != nil {
+		return nil, recipes.NewRecipeError(errorCode, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	values, err := createHelmValues(opts.Recipe.Parameters, opts.Definition.Parameters, recipeContext)
+	if err != nil {
+		return nil, recipes.NewRecipeError(errorCode, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	releaseName, err := helmReleaseName(opts.Recipe.ResourceID)
+	if err != nil {
+		return nil, recipes.NewRecipeError(errorCode, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	return &helmReleaseInput{
+		chart:     ch,
+		values:    values,
+		namespace: namespace,
+		name:      releaseName,
+	}, nil
+}
+
+// getRelease returns the current release with the given name, or nil if the release doesn't exist.
+func (d *helmDriver) getRelease(ctx context.Context, namespace string, releaseName string) (*release.Release, error) {
+	cfg, err := d.actionConfig(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	rel, err := action.NewGet(cfg).Run(releaseName)
+	if errors.Is(err, helm_driver.ErrReleaseNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return rel, nil
+}
+
+// installOrUpgrade installs the chart as a new release, or upgrades the release if it already exists. When dryRun is set
+// the release is rendered and validated but the objects are not applied to the cluster and the release is not stored.
+func (d *helmDriver) installOrUpgrade(ctx context.Context, input *helmReleaseInput, dryRun bool) (*release.Release, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	cfg, err := d.actionConfig(ctx, input.namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	history := action.NewHistory(cfg)
+	history.Max = 1
+	_, err = history.Run(input.name)
+	if errors.Is(err, helm_driver.ErrReleaseNotFound) {
+		logger.Info(fmt.Sprintf("Installing Helm release %q in namespace %q", input.name, input.namespace), "dryRun", dryRun)
+		install := action.NewInstall(cfg)
+		install.ReleaseName = input.name
+		install.Namespace = input.namespace
+		install.DryRun = dryRun
+		install.Wait = !dryRun
+		install.Timeout = d.timeout()
+		return install.RunWithContext(ctx, input.chart, input.values)
+	} else if err != nil {
+		return nil, err
+	}
+
+	logger.Info(fmt.Sprintf("Upgrading Helm release %q in namespace %q", input.name, input.namespace), "dryRun", dryRun)
+	upgrade := action.NewUpgrade(cfg)
+	upgrade.Namespace = input.namespace
+	upgrade.DryRun = dryRun
+	upgrade.Wait = !dryRun
+	upgrade.Timeout = d.timeout()
+	return upgrade.RunWithContext(ctx, input.name, input.chart, input.values)
+}
+
+// prepareRecipeResponse populates the recipe response from the result ConfigMap and Secret of the release and the
+// Kubernetes resources in the release manifest.
+func (d *helmDriver) prepareRecipeResponse(ctx context.Context, definition recipes.EnvironmentDefinition, rel *release.Release) (*recipes.RecipeOutput, error) {
+	recipeResponse := &recipes.RecipeOutput{
+		Values:    map[string]any{},
+		Secrets:   map[string]any{},
+		Resources: []string{},
+	}
+
+	resultName := rel.Name + HelmResultSuffix
+	configMap, err := d.k8sClientSet.CoreV1().ConfigMaps(rel.Namespace).Get(ctx, resultName, metav1.GetOptions{})
+	if err != nil && !k8s_errors.IsNotFound(err) {
+		return nil, err
+	} else if err == nil {
+		for k, v := range configMap.Data {
+			// Values which are valid JSON, such as numbers and booleans, are decoded to keep their type.
+			var value any
+			if json.Unmarshal([]byte(v), &value) != nil {
+				value = v
+			}
+			recipeResponse.Values[k] = value
+		}
+	}
+
+	secret, err := d.k8sClientSet.CoreV1().Secrets(rel.Namespace).Get(ctx, resultName, metav1.GetOptions{})
+	if err != nil && !k8s_errors.IsNotFound(err) {
+		return nil, err
+	} else if err == nil {
+		for k, v := range secret.Data {
+			recipeResponse.Secrets[k] = string(v)
+		}
+	}
+
+	mapper, err := d.restMapper()
+	if err != nil {
+		return nil, err
+	}
+
+	objects, err := getHelmReleaseObjects(rel, mapper)
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range objects {
+		recipeResponse.Resources = append(recipeResponse.Resources, obj.id)
+	}
+
+	recipeResponse.Status = &rpv1.RecipeStatus{
+		TemplateKind:    recipes.TemplateKindHelm,
+		TemplatePath:    definition.TemplatePath,
+		TemplateVersion: definition.TemplateVersion,
+	}
+
+	return recipeResponse, nil
+}
+
+// deleteOutputResources deletes the given output resources.
+func (d *helmDriver) deleteOutputResources(ctx context.Context, outputResources []rpv1.OutputResource) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	for _, outputResource := range outputResources {
+		id := outputResource.ID.String()
+		logger.Info(fmt.Sprintf("Deleting output resource: %q", id))
+		if err := d.ResourceClient.Delete(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *helmDriver) timeout() time.Duration {
+	if d.options.Timeout == 0 {
+		return helmDefaultTimeout
+	}
+
+	return d.options.Timeout
+}
+
+// helmObject represents a Kubernetes object in the manifest of a Helm release.
+type helmObject struct {
+	// id is the UCP resource ID of the object.
+	id string
+
+	// manifest is the rendered manifest of the object.
+	manifest string
+}
+
+// getHelmReleaseObjects returns the Kubernetes objects in the manifest of the given release, in the order of the manifest.
+// The mapper is used to find the cluster-scoped objects, whose IDs don't have a namespace.
+func getHelmReleaseObjects(rel *release.Release, mapper meta.RESTMapper) ([]helmObject, error) {
+	manifests := releaseutil.SplitManifests(rel.Manifest)
+	keys := make([]string, 0, len(manifests))
+	for k := range manifests {
+		keys = append(keys, k)
+	}
+	sort.Sort(releaseutil.BySplitManifestsOrder(keys))
+
+	objects := []helmObject{}
+	for _, key := range keys {
+		manifest := manifests[key]
+		obj := struct {
+			APIVersion string            `json:"apiVersion"`
+			Kind       string            `json:"kind"`
+			Metadata   metav1.ObjectMeta `json:"metadata"`
+		}{}
+		if err := yaml.Unmarshal([]byte(manifest), &obj); err != nil {
+			return nil, fmt.Errorf("failed to parse the manifest of release %q: %w", rel.Name, err)
+		}
+
+		if obj.Kind == "" || obj.Metadata.Name == "" {
+			continue
+		}
+
+		gv, err := schema.ParseGroupVersion(obj.APIVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		// Objects without a namespace are created in the namespace of the release, except for cluster-scoped objects.
+		namespaced, err := isNamespacedKind(mapper, gv.WithKind(obj.Kind))
+		if err != nil {
+			return nil, err
+		}
+
+		namespace := obj.Metadata.Namespace
+		if !namespaced {
+			namespace = ""
+		} else if namespace == "" {
+			namespace = rel.Namespace
+		}
+
+		objects = append(objects, helmObject{
+			id:       resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, gv.Group, obj.Kind, namespace, obj.Metadata.Name).String(),
+			manifest: manifest,
+		})
+	}
+
+	return objects, nil
+}
+
+// isNamespacedKind returns true if the objects of the given kind are namespaced. Kinds unknown to the cluster, such as the
+// custom resources of a CRD installed by the same chart, are assumed to be namespaced.
+func isNamespacedKind(mapper meta.RESTMapper, gvk schema.GroupVersionKind) (bool, error) {
+	mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if meta.IsNoMatchError(err) {
+		return true, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return mapping.Scope.Name() == meta.RESTScopeNameNamespace, nil
+}
+
+// preparePlanResponse compares the Kubernetes objects of the current release with the objects of the planned release.
+// current is nil if the release isn't installed yet. The previously deployed resources which are not part of the planned
+// release are deleted by the garbage collection after the deployment.
+func (d *helmDriver) preparePlanResponse(current *release.Release, planned *release.Release, prevState []string) (*recipes.RecipePlan, error) {
+	mapper, err := d.restMapper()
+	if err != nil {
+		return nil, err
+	}
+
+	currentObjects := []helmObject{}
+	if current != nil {
+		currentObjects, err = getHelmReleaseObjects(current, mapper)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	plannedObjects, err := getHelmReleaseObjects(planned, mapper)
+	if err != nil {
+		return nil, err
+	}
+
+	currentManifests := map[string]string{}
+	for _, obj := range currentObjects {
+		currentManifests[obj.id] = obj.manifest
+	}
+
+	plan := &recipes.RecipePlan{Changes: []recipes.ResourceChange{}}
+	seen := []string{}
+	for _, obj := range plannedObjects {
+		seen = append(seen, strings.ToLower(obj.id))
+
+		manifest, ok := currentManifests[obj.id]
+		if !ok {
+			plan.Changes = append(plan.Changes, recipes.ResourceChange{ChangeType: recipes.ResourceChangeTypeCreate, ResourceID: obj.id})
+		} else if manifest != obj.manifest {
+			plan.Changes = append(plan.Changes, recipes.ResourceChange{ChangeType: recipes.ResourceChangeTypeUpdate, ResourceID: obj.id})
+		}
+	}
+
+	deleted := []string{}
+	for _, obj := range currentObjects {
+		deleted = append(deleted, obj.id)
+	}
+	deleted = append(deleted, prevState...)
+	for _, id := range deleted {
+		if slices.Contains(seen, strings.ToLower(id)) {
+			continue
+		}
+
+		seen = append(seen, strings.ToLower(id))
+		plan.Changes = append(plan.Changes, recipes.ResourceChange{ChangeType: recipes.ResourceChangeTypeDelete, ResourceID: id})
+	}
+
+	return plan, nil
+}
+
+// getGCOutputResources [GC stands for Garbage Collection] compares two slices of resource ids and
+// returns a slice of OutputResources that contains the elements that are in the "previous" slice but not in the "current".
+func (d *helmDriver) getGCOutputResources(current []string, previous []string) ([]rpv1.OutputResource, error) {
+	diff := []rpv1.OutputResource{}
+	for _, prevResourceId := range previous {
+		if slices.Contains(current, prevResourceId) {
+			continue
+		}
+
+		id, err := resources.Parse(prevResourceId)
+		if err != nil {
+			return nil, recipes.NewRecipeError(recipes.RecipeGarbageCollectionFailed, err.Error(), recipes_util.ExecutionError, nil)
+		}
+
+		diff = append(diff, rpv1.OutputResource{
+			ID:            id,
+			RadiusManaged: to.Ptr(true),
+		})
+	}
+
+	return diff, nil
+}
+
+// createHelmValues creates the values of the chart from the parameters set by the operator and the developer. In case of
+// conflict the developer parameter takes precedence. The recipe context is added as the "context" value.
+func createHelmValues(devParams, operatorParams map[string]any, recipeContext *recipecontext.Context) (map[string]any, error) {
+	values := map[string]any{}
+	for k, v := range operatorParams {
+		values[k] = v
+	}
+	for k, v := range devParams {
+		values[k] = v
+	}
+
+	// Helm values must be plain maps, so the recipe context is converted through JSON.
+	b, err := json.Marshal(recipeContext)
+	if err != nil {
+		return nil, err
+	}
+	contextValue := map[string]any{}
+	if err := json.Unmarshal(b, &contextValue); err != nil {
+		return nil, err
+	}
+	values[recipecontext.RecipeContextParamKey] = contextValue
+
+	return values, nil
+}
+
+// helmNamespace returns the Kubernetes namespace where the chart is installed.
+func helmNamespace(configuration recipes.Configuration) (string, error) {
+	if configuration.Runtime.Kubernetes == nil || configuration.Runtime.Kubernetes.Namespace == "" {
+		return "", errors.New("helm recipes require a Kubernetes environment")
+	}
+
+	return configuration.Runtime.Kubernetes.Namespace, nil
+}
+
+// helmReleaseName returns the name of the Helm release of the recipe for the given resource. The name is the resource name
+// followed by a hash of the resource ID, so that it's unique and stable across deployments of the resource.
+func helmReleaseName(resourceID string) (string, error) {
+	parsed, err := resources.ParseResource(resourceID)
+	if err != nil {
+		return "", err
+	}
+
+	hash := fmt.Sprintf("%x", sha1.Sum([]byte(strings.ToLower(parsed.String()))))[:8]
+	name := strings.Trim(invalidReleaseNameChars.ReplaceAllString(strings.ToLower(parsed.Name()), "-"), "-")
+	if maxLength := helmReleaseNameMaxLength - len(hash) - 1; len(name) > maxLength {
+		name = strings.TrimRight(name[:maxLength], "-")
+	}
+	if name == "" {
+		return hash, nil
+	}
+
+	return name + "-" + hash, nil
+}
+
+// loadHelmChart downloads and loads the chart with the given version. The template path is either a reference to a chart
+// in an OCI registry, for example "oci://myregistry.azurecr.io/charts/redis", or the URL of a Helm repository followed by
+// the name of the chart, for example "https://charts.example.com/stable/redis".
+func loadHelmChart(templatePath string, templateVersion string) (*chart.Chart, error) {
+	if registry.IsOCI(templatePath) {
+		client, err := registry.NewClient()
+		if err != nil {
+			return nil, err
+		}
+
+		ref := strings.TrimPrefix(templatePath, fmt.Sprintf("%s://", registry.OCIScheme))
+		if templateVersion != "" {
+			ref = ref + ":" + templateVersion
+		}
+
+		result, err := client.Pull(ref, registry.PullOptWithChart(true))
+		if err != nil {
+			return nil, fmt.Errorf("failed to pull chart %q: %w", templatePath, err)
+		}
+
+		return loader.LoadArchive(bytes.NewReader(result.Chart.Data))
+	}
+
+	u, err := url.Parse(templatePath)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return nil, fmt.Errorf("invalid chart path %q: must be an OCI reference or the URL of a chart in a Helm repository", templatePath)
+	}
+
+	chartName := path.Base(u.Path)
+	u.Path = path.Dir(u.Path)
+	getters := getter.All(cli.New())
+	chartURL, err := repo.FindChartInRepoURL(u.String(), chartName, templateVersion, "", "", "", getters)
+	if err != nil {
+		return nil, err
+	}
+
+	httpGetter, err := getter.NewHTTPGetter()
+	if err != nil {
+		return nil, err
+	}
+
+	archive, err := httpGetter.Get(chartURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download chart %q: %w", chartURL, err)
+	}
+
+	return loader.LoadArchive(archive)
+}
+
+// newHelmActionConfiguration returns a function which creates the Helm action configuration for a namespace. The releases
+// are stored as Kubernetes secrets in the namespace.
+func newHelmActionConfiguration(restConfig *rest.Config) func(ctx context.Context, namespace string) (*action.Configuration, error) {
+	return func(ctx context.Context, namespace string) (*action.Configuration, error) {
+		logger := ucplog.FromContextOrDiscard(ctx)
+
+		cfg := &action.Configuration{}
+		err := cfg.Init(&restClientGetter{restConfig: restConfig, namespace: namespace}, namespace, helmStorageDriver, func(format string, v ...any) {
+			logger.V(ucplog.LevelDebug).Info(fmt.Sprintf(format, v...))
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return cfg, nil
+	}
+}
+
+// restClientGetter implements the Helm RESTClientGetter interface from a rest.Config.
+type restClientGetter struct {
+	restConfig *rest.Config
+	namespace  string
+}
+
+func (g *restClientGetter) ToRESTConfig() (*rest.Config, error) {
+	return rest.CopyConfig(g.restConfig), nil
+}
+
+func (g *restClientGetter) ToDiscoveryClient() (discovery.CachedDiscoveryInterface, error) {
+	client, err := discovery.NewDiscoveryClientForConfig(g.restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return memory.NewMemCacheClient(client), nil
+}
+
+func (g *restClientGetter) ToRESTMapper() (meta.RESTMapper, error) {
+	client, err := g.ToDiscoveryClient()
+	if err != nil {
+		return nil, err
+	}
+
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(client)
+	return restmapper.NewShortcutExpander(mapper, client), nil
+}
+
+func (g *restClientGetter) ToRawKubeConfigLoader() clientcmd.ClientConfig {
+	return clientcmd.NewDefaultClientConfig(clientcmdapi.Config{}, &clientcmd.ConfigOverrides{
+		Context: clientcmdapi.Context{Namespace: g.namespace},
+	})
+}
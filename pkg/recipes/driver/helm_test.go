@@ -0,0 +1,462 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/portableresources/processors"
+	"github.com/radius-project/radius/pkg/recipes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chartutil"
+	kubefake "helm.sh/helm/v3/pkg/kube/fake"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/repo"
+	"helm.sh/helm/v3/pkg/storage"
+	helm_driver "helm.sh/helm/v3/pkg/storage/driver"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const (
+	helmTestNamespace  = "test-namespace"
+	helmTestResourceID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/redis"
+)
+
+var helmTestTemplates = map[string]string{
+	"templates/deployment.yaml": `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: {{ .Release.Name }}
+spec:
+  replicas: {{ .Values.replicas }}
+`,
+	"templates/service.yaml": `apiVersion: v1
+kind: Service
+metadata:
+  name: {{ .Release.Name }}
+  labels:
+    radapp.io/resource: {{ .Values.context.resource.name }}
+`,
+}
+
+func newTestChart(version string, templates map[string]string) *chart.Chart {
+	ch := &chart.Chart{
+		Metadata: &chart.Metadata{
+			APIVersion: chart.APIVersionV2,
+			Name:       "redis",
+			Version:    version,
+		},
+		Values: map[string]any{
+			"replicas": 1,
+		},
+		Schema: []byte(`{"properties":{"replicas":{"type":"integer","description":"The number of replicas."}}}`),
+	}
+
+	for name, data := range templates {
+		ch.Templates = append(ch.Templates, &chart.File{Name: name, Data: []byte(data)})
+	}
+
+	return ch
+}
+
+// newTestRESTMapper returns a REST mapper which knows the kinds used by the test charts.
+func newTestRESTMapper() meta.RESTMapper {
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "Service"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRole"}, meta.RESTScopeRoot)
+	return mapper
+}
+
+func setupHelmDriver(t *testing.T, ch *chart.Chart, objects ...*corev1.ConfigMap) (*helmDriver, *storage.Storage, *processors.MockResourceClient) {
+	ctrl := gomock.NewController(t)
+	client := processors.NewMockResourceClient(ctrl)
+
+	clientSet := fake.NewSimpleClientset()
+	for _, obj := range objects {
+		_, err := clientSet.CoreV1().ConfigMaps(obj.Namespace).Create(context.Background(), obj, metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+
+	store := storage.Init(helm_driver.NewMemory())
+	d := &helmDriver{
+		actionConfig: func(ctx context.Context, namespace string) (*action.Configuration, error) {
+			return &action.Configuration{
+				Releases:     store,
+				KubeClient:   &kubefake.PrintingKubeClient{Out: io.Discard},
+				Capabilities: chartutil.DefaultCapabilities,
+				Log:          t.Logf,
+			}, nil
+		},
+		restMapper: func() (meta.RESTMapper, error) {
+			return newTestRESTMapper(), nil
+		},
+		loadChart: func(templatePath string, templateVersion string) (*chart.Chart, error) {
+			return ch, nil
+		},
+		k8sClientSet:   clientSet,
+		ResourceClient: client,
+	}
+
+	return d, store, client
+}
+
+func helmExecuteOptions(prevState []string) ExecuteOptions {
+	return ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: recipes.Configuration{
+				Runtime: recipes.RuntimeConfiguration{
+					Kubernetes: &recipes.KubernetesRuntime{
+						Namespace: helmTestNamespace,
+					},
+				},
+			},
+			Recipe: recipes.ResourceMetadata{
+				Name:          "redis",
+				EnvironmentID: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/env",
+				ApplicationID: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/app",
+				ResourceID:    helmTestResourceID,
+				Parameters: map[string]any{
+					"replicas": 3,
+				},
+			},
+			Definition: recipes.EnvironmentDefinition{
+				Name:            "redis",
+				Driver:          recipes.TemplateKindHelm,
+				TemplatePath:    "oci://myregistry.azurecr.io/charts/redis",
+				TemplateVersion: "1.0.0",
+				ResourceType:    "Applications.Datastores/redisCaches",
+				Parameters: map[string]any{
+					"replicas": 2,
+					"tier":     "basic",
+				},
+			},
+		},
+		PrevState: prevState,
+	}
+}
+
+func Test_Helm_Execute_Install(t *testing.T) {
+	releaseName, err := helmReleaseName(helmTestResourceID)
+	require.NoError(t, err)
+
+	result := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: releaseName + HelmResultSuffix, Namespace: helmTestNamespace},
+		Data: map[string]string{
+			"host": "redis.test-namespace.svc.cluster.local",
+			"port": "6379",
+		},
+	}
+	d, store, _ := setupHelmDriver(t, newTestChart("1.0.0", helmTestTemplates), result)
+
+	output, err := d.Execute(testcontext.New(t), helmExecuteOptions(nil))
+	require.NoError(t, err)
+
+	expected := &recipes.RecipeOutput{
+		Values: map[string]any{
+			"host": "redis.test-namespace.svc.cluster.local",
+			"port": float64(6379),
+		},
+		Secrets: map[string]any{},
+		Resources: []string{
+			"/planes/kubernetes/local/namespaces/test-namespace/providers/core/Service/" + releaseName,
+			"/planes/kubernetes/local/namespaces/test-namespace/providers/apps/Deployment/" + releaseName,
+		},
+		Status: &rpv1.RecipeStatus{
+			TemplateKind:    recipes.TemplateKindHelm,
+			TemplatePath:    "oci://myregistry.azurecr.io/charts/redis",
+			TemplateVersion: "1.0.0",
+		},
+	}
+	require.Equal(t, expected, output)
+
+	rel, err := store.Last(releaseName)
+	require.NoError(t, err)
+	require.Equal(t, release.StatusDeployed, rel.Info.Status)
+	require.Equal(t, helmTestNamespace, rel.Namespace)
+
+	// Developer parameters take precedence over operator parameters.
+	require.Equal(t, 3, rel.Config["replicas"])
+	require.Equal(t, "basic", rel.Config["tier"])
+	require.Contains(t, rel.Manifest, "replicas: 3")
+	require.Contains(t, rel.Manifest, "radapp.io/resource: redis")
+}
+
+func Test_Helm_Execute_Upgrade(t *testing.T) {
+	d, store, client := setupHelmDriver(t, newTestChart("1.0.0", helmTestTemplates))
+	ctx := testcontext.New(t)
+
+	output, err := d.Execute(ctx, helmExecuteOptions(nil))
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 2)
+
+	// The new version of the chart no longer contains the deployment, and the resource was previously deployed by a
+	// recipe which also created a resource outside the chart.
+	external := "/planes/kubernetes/local/namespaces/test-namespace/providers/core/Secret/external"
+	d.loadChart = func(templatePath string, templateVersion string) (*chart.Chart, error) {
+		return newTestChart("2.0.0", map[string]string{"templates/service.yaml": helmTestTemplates["templates/service.yaml"]}), nil
+	}
+	client.EXPECT().Delete(gomock.Any(), output.Resources[1]).Times(1).Return(nil)
+	client.EXPECT().Delete(gomock.Any(), external).Times(1).Return(nil)
+
+	output, err = d.Execute(ctx, helmExecuteOptions(append(output.Resources, external)))
+	require.NoError(t, err)
+	releaseName, err := helmReleaseName(helmTestResourceID)
+	require.NoError(t, err)
+	require.Equal(t, []string{"/planes/kubernetes/local/namespaces/test-namespace/providers/core/Service/" + releaseName}, output.Resources)
+
+	rel, err := store.Last(releaseName)
+	require.NoError(t, err)
+	require.Equal(t, 2, rel.Version)
+	require.Equal(t, "2.0.0", rel.Chart.Metadata.Version)
+}
+
+func Test_Helm_Execute_SimulatedEnvironment(t *testing.T) {
+	d, store, _ := setupHelmDriver(t, newTestChart("1.0.0", helmTestTemplates))
+	opts := helmExecuteOptions(nil)
+	opts.Configuration.Simulated = true
+
+	output, err := d.Execute(testcontext.New(t), opts)
+	require.NoError(t, err)
+	require.Nil(t, output)
+
+	releases, err := store.ListReleases()
+	require.NoError(t, err)
+	require.Empty(t, releases)
+}
+
+func Test_Helm_Execute_NoKubernetesRuntime(t *testing.T) {
+	d, _, _ := setupHelmDriver(t, newTestChart("1.0.0", helmTestTemplates))
+	opts := helmExecuteOptions(nil)
+	opts.Configuration.Runtime.Kubernetes = nil
+
+	_, err := d.Execute(testcontext.New(t), opts)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "helm recipes require a Kubernetes environment")
+}
+
+func Test_Helm_Delete(t *testing.T) {
+	d, store, _ := setupHelmDriver(t, newTestChart("1.0.0", helmTestTemplates))
+	ctx := testcontext.New(t)
+	opts := helmExecuteOptions(nil)
+
+	_, err := d.Execute(ctx, opts)
+	require.NoError(t, err)
+
+	err = d.Delete(ctx, DeleteOptions{BaseOptions: opts.BaseOptions})
+	require.NoError(t, err)
+
+	releases, err := store.ListReleases()
+	require.NoError(t, err)
+	require.Empty(t, releases)
+
+	// Deleting a release which doesn't exist is a no-op.
+	err = d.Delete(ctx, DeleteOptions{BaseOptions: opts.BaseOptions})
+	require.NoError(t, err)
+}
+
+func Test_Helm_Plan_Install(t *testing.T) {
+	d, store, _ := setupHelmDriver(t, newTestChart("1.0.0", helmTestTemplates))
+
+	plan, err := d.Plan(testcontext.New(t), helmExecuteOptions(nil))
+	require.NoError(t, err)
+
+	releaseName, err := helmReleaseName(helmTestResourceID)
+	require.NoError(t, err)
+	require.Equal(t, &recipes.RecipePlan{
+		Changes: []recipes.ResourceChange{
+			{ChangeType: recipes.ResourceChangeTypeCreate, ResourceID: "/planes/kubernetes/local/namespaces/test-namespace/providers/core/Service/" + releaseName},
+			{ChangeType: recipes.ResourceChangeTypeCreate, ResourceID: "/planes/kubernetes/local/namespaces/test-namespace/providers/apps/Deployment/" + releaseName},
+		},
+	}, plan)
+
+	// The dry run doesn't install the release.
+	releases, err := store.ListReleases()
+	require.NoError(t, err)
+	require.Empty(t, releases)
+}
+
+func Test_Helm_Plan_Upgrade(t *testing.T) {
+	d, store, _ := setupHelmDriver(t, newTestChart("1.0.0", helmTestTemplates))
+	ctx := testcontext.New(t)
+
+	output, err := d.Execute(ctx, helmExecuteOptions(nil))
+	require.NoError(t, err)
+
+	// The new version of the chart no longer contains the deployment, adds a config map and changes the service.
+	d.loadChart = func(templatePath string, templateVersion string) (*chart.Chart, error) {
+		return newTestChart("2.0.0", map[string]string{
+			"templates/service.yaml": helmTestTemplates["templates/service.yaml"] + "    tier: {{ .Values.tier }}\n",
+			"templates/configmap.yaml": `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .Release.Name }}
+`,
+		}), nil
+	}
+	external := "/planes/kubernetes/local/namespaces/test-namespace/providers/core/Secret/external"
+	opts := helmExecuteOptions(append(output.Resources, external))
+
+	plan, err := d.Plan(ctx, opts)
+	require.NoError(t, err)
+
+	releaseName, err := helmReleaseName(helmTestResourceID)
+	require.NoError(t, err)
+	require.Equal(t, &recipes.RecipePlan{
+		Changes: []recipes.ResourceChange{
+			{ChangeType: recipes.ResourceChangeTypeCreate, ResourceID: "/planes/kubernetes/local/namespaces/test-namespace/providers/core/ConfigMap/" + releaseName},
+			{ChangeType: recipes.ResourceChangeTypeUpdate, ResourceID: "/planes/kubernetes/local/namespaces/test-namespace/providers/core/Service/" + releaseName},
+			{ChangeType: recipes.ResourceChangeTypeDelete, ResourceID: "/planes/kubernetes/local/namespaces/test-namespace/providers/apps/Deployment/" + releaseName},
+			{ChangeType: recipes.ResourceChangeTypeDelete, ResourceID: external},
+		},
+	}, plan)
+
+	// The dry run doesn't upgrade the release.
+	rel, err := store.Last(releaseName)
+	require.NoError(t, err)
+	require.Equal(t, 1, rel.Version)
+}
+
+func Test_Helm_ReleaseObjects_Scope(t *testing.T) {
+	rel := &release.Release{
+		Name:      "redis",
+		Namespace: helmTestNamespace,
+		Manifest: `---
+# Source: redis/templates/clusterrole.yaml
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: redis-reader
+---
+# Source: redis/templates/configmap.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: redis
+  namespace: other-namespace
+---
+# Source: redis/templates/service.yaml
+apiVersion: v1
+kind: Service
+metadata:
+  name: redis
+---
+# Source: redis/templates/widget.yaml
+apiVersion: example.com/v1
+kind: Widget
+metadata:
+  name: redis
+`,
+	}
+
+	objects, err := getHelmReleaseObjects(rel, newTestRESTMapper())
+	require.NoError(t, err)
+
+	ids := []string{}
+	for _, obj := range objects {
+		ids = append(ids, obj.id)
+	}
+	require.ElementsMatch(t, []string{
+		"/planes/kubernetes/local/providers/rbac.authorization.k8s.io/ClusterRole/redis-reader",
+		"/planes/kubernetes/local/namespaces/other-namespace/providers/core/ConfigMap/redis",
+		"/planes/kubernetes/local/namespaces/test-namespace/providers/core/Service/redis",
+		// Kinds unknown to the cluster are assumed to be namespaced.
+		"/planes/kubernetes/local/namespaces/test-namespace/providers/example.com/Widget/redis",
+	}, ids)
+}
+
+func Test_Helm_GetRecipeMetadata(t *testing.T) {
+	d, _, _ := setupHelmDriver(t, newTestChart("1.0.0", helmTestTemplates))
+
+	metadata, err := d.GetRecipeMetadata(testcontext.New(t), helmExecuteOptions(nil).BaseOptions)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"parameters": map[string]any{
+			"replicas": map[string]any{
+				"type":        "integer",
+				"description": "The number of replicas.",
+			},
+		},
+	}, metadata)
+}
+
+func Test_Helm_GetRecipeMetadata_NoSchema(t *testing.T) {
+	ch := newTestChart("1.0.0", helmTestTemplates)
+	ch.Schema = nil
+	d, _, _ := setupHelmDriver(t, ch)
+
+	metadata, err := d.GetRecipeMetadata(testcontext.New(t), helmExecuteOptions(nil).BaseOptions)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"parameters": map[string]any{}}, metadata)
+}
+
+func Test_Helm_ReleaseName(t *testing.T) {
+	name, err := helmReleaseName(helmTestResourceID)
+	require.NoError(t, err)
+	require.Regexp(t, "^redis-[0-9a-f]{8}$", name)
+
+	other, err := helmReleaseName(strings.Replace(helmTestResourceID, "test-group", "other-group", 1))
+	require.NoError(t, err)
+	require.NotEqual(t, name, other)
+
+	long, err := helmReleaseName(helmTestResourceID + "_With_A_Very_Long_Name_Which_Does_Not_Fit_In_A_Release_Name")
+	require.NoError(t, err)
+	require.LessOrEqual(t, len(long), helmReleaseNameMaxLength)
+	require.Regexp(t, "^redis-with-a-very-long-name[a-z0-9-]*-[0-9a-f]{8}$", long)
+
+	_, err = helmReleaseName("invalid")
+	require.Error(t, err)
+}
+
+func Test_Helm_LoadChart_HTTPRepository(t *testing.T) {
+	dir := t.TempDir()
+	_, err := chartutil.Save(newTestChart("1.0.0", helmTestTemplates), dir)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.FileServer(http.Dir(dir)))
+	defer server.Close()
+
+	index, err := repo.IndexDirectory(dir, server.URL)
+	require.NoError(t, err)
+	require.NoError(t, index.WriteFile(dir+"/index.yaml", 0644))
+
+	ch, err := loadHelmChart(server.URL+"/redis", "1.0.0")
+	require.NoError(t, err)
+	require.Equal(t, "redis", ch.Name())
+	require.Equal(t, "1.0.0", ch.Metadata.Version)
+
+	_, err = loadHelmChart(server.URL+"/redis", "2.0.0")
+	require.Error(t, err)
+
+	_, err = loadHelmChart("myregistry.azurecr.io/charts/redis", "1.0.0")
+	require.Error(t, err)
+}
@@ -30,7 +30,9 @@ import (
 	"golang.org/x/exp/slices"
 	"k8s.io/client-go/kubernetes"
 
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/configloader"
 
 	"github.com/radius-project/radius/pkg/recipes/terraform"
 	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
@@ -48,10 +50,16 @@ import (
 var _ Driver = (*terraformDriver)(nil)
 
 // NewTerraformDriver creates a new instance of driver to execute a Terraform recipe.
-func NewTerraformDriver(ucpConn sdk.Connection, secretProvider *ucp_provider.SecretProvider, options TerraformOptions, k8sClientSet kubernetes.Interface) Driver {
+func NewTerraformDriver(ucpConn sdk.Connection, secretProvider *ucp_provider.SecretProvider, secretsLoader configloader.SecretsLoader, options TerraformOptions, k8sClientSet kubernetes.Interface) Driver {
+	var cache *terraform.Cache
+	if options.CacheDir != "" {
+		cache = terraform.NewCache(options.CacheDir, options.CacheMaxSizeBytes)
+	}
+
 	return &terraformDriver{
-		terraformExecutor: terraform.NewExecutor(ucpConn, secretProvider, k8sClientSet),
+		terraformExecutor: terraform.NewExecutor(ucpConn, secretProvider, k8sClientSet, cache, options.Version),
 		options:           options,
+		secretsLoader:     secretsLoader,
 	}
 }
 
@@ -59,6 +67,17 @@ func NewTerraformDriver(ucpConn sdk.Connection, secretProvider *ucp_provider.Sec
 type TerraformOptions struct {
 	// Path is the path to the directory mounted to the container where terraform can be installed and executed.
 	Path string
+
+	// CacheDir is the path to the directory where Terraform, modules and providers are cached across executions.
+	// Nothing is cached if it is empty.
+	CacheDir string
+
+	// CacheMaxSizeBytes is the maximum size of the cached Terraform modules, and separately of the cached providers.
+	// The size is not limited if it is zero.
+	CacheMaxSizeBytes int64
+
+	// Version is the version of Terraform to install. The latest version is installed if it is empty.
+	Version string
 }
 
 // terraformDriver represents a driver to interact with Terraform Recipe - deploy recipe, delete resources, etc.
@@ -68,6 +87,9 @@ type terraformDriver struct {
 
 	// options contains options required to execute a Terraform recipe, such as the path to the directory mounted to the container where Terraform can be executed in sub directories.
 	options TerraformOptions
+
+	// secretsLoader is used to load the credentials used to download Terraform modules from private sources.
+	secretsLoader configloader.SecretsLoader
 }
 
 // Execute creates a unique directory for each execution of terraform and deploys the recipe using the
@@ -90,11 +112,17 @@ func (d *terraformDriver) Execute(ctx context.Context, opts ExecuteOptions) (*re
 		return nil, nil
 	}
 
+	secrets, err := d.loadSecrets(ctx, opts.Configuration.RecipeConfig.Terraform)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
 	tfState, err := d.terraformExecutor.Deploy(ctx, terraform.Options{
 		RootDir:        requestDirPath,
 		EnvConfig:      &opts.Configuration,
 		ResourceRecipe: &opts.Recipe,
 		EnvRecipe:      &opts.Definition,
+		Secrets:        secrets,
 	})
 	if err != nil {
 		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
@@ -104,14 +132,27 @@ func (d *terraformDriver) Execute(ctx context.Context, opts ExecuteOptions) (*re
 	if err != nil {
 		return nil, recipes.NewRecipeError(recipes.InvalidRecipeOutputs, fmt.Sprintf("failed to read the recipe output %q: %s", recipes.ResultPropertyName, err.Error()), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
 	}
+	recipeOutputs.Status.TerraformBackend = recordedBackend(opts.Configuration.RecipeConfig.Terraform.Backend)
 
 	return recipeOutputs, nil
 }
 
-// Delete returns an error if called as it is not yet implemented.
+// Delete creates a unique directory for each execution of terraform and runs terraform destroy for the recipe using
+// the Terraform CLI through terraform-exec. The Terraform state is read from the backend recorded when the recipe was
+// deployed, the backend of the environment is only used for recipes deployed before the backend was recorded.
+// It returns an error if the Terraform state can't be found while the recipe has deployed resources.
 func (d *terraformDriver) Delete(ctx context.Context, opts DeleteOptions) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
+	if opts.RecipeStatus != nil && opts.RecipeStatus.TerraformBackend != nil {
+		backend := opts.RecipeStatus.TerraformBackend
+		opts.Configuration.RecipeConfig.Terraform.Backend = &datamodel.TerraformBackend{
+			Kind:   backend.Kind,
+			Config: backend.Config,
+			Secret: backend.Secret,
+		}
+	}
+
 	requestDirPath, err := d.createExecutionDirectory(ctx, opts.Recipe, opts.Definition)
 	if err != nil {
 		return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetErrorDetails(err))
@@ -122,12 +163,23 @@ func (d *terraformDriver) Delete(ctx context.Context, opts DeleteOptions) error
 		}
 	}()
 
+	secrets, err := d.loadSecrets(ctx, opts.Configuration.RecipeConfig.Terraform)
+	if err != nil {
+		return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
 	err = d.terraformExecutor.Delete(ctx, terraform.Options{
 		RootDir:        requestDirPath,
 		EnvConfig:      &opts.Configuration,
 		ResourceRecipe: &opts.Recipe,
 		EnvRecipe:      &opts.Definition,
+		Secrets:        secrets,
 	})
+	if errors.Is(err, terraform.ErrStateNotFound) && len(opts.OutputResources) == 0 {
+		// The state is not created if the deployment of the recipe failed before Terraform apply, there is nothing to destroy.
+		logger.Info("Skipping deletion of recipe resources: Terraform state of the recipe does not exist.")
+		return nil
+	}
 	if err != nil {
 		return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetErrorDetails(err))
 	}
@@ -135,6 +187,238 @@ func (d *terraformDriver) Delete(ctx context.Context, opts DeleteOptions) error
 	return nil
 }
 
+// recordedBackend returns the Terraform backend to record in the recipe status from the backend configured on the
+// environment. The Kubernetes backend is recorded if no backend is configured.
+func recordedBackend(backend *datamodel.TerraformBackend) *rpv1.RecipeTerraformBackend {
+	if backend == nil || backend.Kind == "" {
+		return &rpv1.RecipeTerraformBackend{Kind: recipes.TerraformBackendKubernetes}
+	}
+
+	return &rpv1.RecipeTerraformBackend{
+		Kind:   backend.Kind,
+		Config: backend.Config,
+		Secret: backend.Secret,
+	}
+}
+
+// Plan creates a unique directory for each execution of terraform and runs terraform plan for the recipe using the
+// Terraform CLI through terraform-exec. It returns the changes terraform apply would make to the resources of the recipe.
+func (d *terraformDriver) Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	requestDirPath, err := d.createExecutionDirectory(ctx, opts.Recipe, opts.Definition)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+	defer func() {
+		if err := os.RemoveAll(requestDirPath); err != nil {
+			logger.Info(fmt.Sprintf("Failed to cleanup Terraform execution directory %q. Err: %s", requestDirPath, err.Error()))
+		}
+	}()
+
+	secrets, err := d.loadSecrets(ctx, opts.Configuration.RecipeConfig.Terraform)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	tfPlan, err := d.terraformExecutor.Plan(ctx, terraform.Options{
+		RootDir:        requestDirPath,
+		EnvConfig:      &opts.Configuration,
+		ResourceRecipe: &opts.Recipe,
+		EnvRecipe:      &opts.Definition,
+		Secrets:        secrets,
+	})
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	return d.preparePlanResponse(ctx, tfPlan), nil
+}
+
+// DetectDrift creates a unique directory for each execution of terraform and runs a refresh-only terraform plan for the
+// recipe. It returns the resources which were changed or deleted outside of Terraform since the recipe was deployed.
+func (d *terraformDriver) DetectDrift(ctx context.Context, opts DriftOptions) (*recipes.RecipeDrift, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	requestDirPath, err := d.createExecutionDirectory(ctx, opts.Recipe, opts.Definition)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDriftDetectionFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+	defer func() {
+		if err := os.RemoveAll(requestDirPath); err != nil {
+			logger.Info(fmt.Sprintf("Failed to cleanup Terraform execution directory %q. Err: %s", requestDirPath, err.Error()))
+		}
+	}()
+
+	secrets, err := d.loadSecrets(ctx, opts.Configuration.RecipeConfig.Terraform)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDriftDetectionFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	tfState, tfDrift, err := d.terraformExecutor.DetectDrift(ctx, terraform.Options{
+		RootDir:        requestDirPath,
+		EnvConfig:      &opts.Configuration,
+		ResourceRecipe: &opts.Recipe,
+		EnvRecipe:      &opts.Definition,
+		Secrets:        secrets,
+	})
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDriftDetectionFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	return d.prepareDriftResponse(ctx, tfState, tfDrift), nil
+}
+
+// loadSecrets loads the secrets of the secret stores referenced by the Terraform authentication, provider and backend
+// configurations, keyed by the secret store ID. Each secret store is loaded once.
+func (d *terraformDriver) loadSecrets(ctx context.Context, tfConfig datamodel.TerraformConfigProperties) (map[string]map[string]string, error) {
+	// Map the secret store IDs to the name of the host or provider using them, which is used in error messages.
+	sources := map[string]string{}
+	for _, refs := range []map[string]datamodel.SecretReference{tfConfig.Authentication.Git.PAT, tfConfig.Authentication.Git.SSH, tfConfig.Authentication.Registry} {
+		for host, ref := range refs {
+			sources[ref.Secret] = fmt.Sprintf("the credentials for %q", host)
+		}
+	}
+	for provider, configs := range tfConfig.Providers {
+		for _, config := range configs {
+			for _, ref := range config.Secrets {
+				sources[ref.Source] = fmt.Sprintf("the secrets of provider %q", provider)
+			}
+		}
+	}
+	if tfConfig.Backend != nil && tfConfig.Backend.Secret != "" {
+		sources[tfConfig.Backend.Secret] = fmt.Sprintf("the credentials of the %s Terraform backend", tfConfig.Backend.Kind)
+	}
+
+	secrets := map[string]map[string]string{}
+	for source, usage := range sources {
+		if d.secretsLoader == nil {
+			return nil, fmt.Errorf("failed to load %s: secrets loader is not configured", usage)
+		}
+
+		values, err := d.secretsLoader.LoadSecrets(ctx, source)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load %s: %w", usage, err)
+		}
+		secrets[source] = values
+	}
+
+	return secrets, nil
+}
+
+// prepareDriftResponse converts the drifted resources reported by Terraform to the drifted resources of the recipe.
+// The resource IDs are read from the Terraform state, the ID is empty if the resource provider is not supported by
+// output resources.
+func (d *terraformDriver) prepareDriftResponse(ctx context.Context, tfState *tfjson.State, tfDrift []terraform.ResourceDrift) *recipes.RecipeDrift {
+	drift := &recipes.RecipeDrift{Resources: []rpv1.DriftedResource{}}
+
+	stateResources := map[string]*tfjson.StateResource{}
+	if tfState != nil && tfState.Values != nil {
+		collectStateResources(tfState.Values.RootModule, stateResources)
+	}
+
+	for _, rd := range tfDrift {
+		reason := rpv1.DriftReasonModified
+		if rd.Action == "delete" {
+			reason = rpv1.DriftReasonDeleted
+		}
+
+		resourceID := ""
+		if resource, ok := stateResources[rd.Address]; ok {
+			ids, err := d.getDeployedOutputResources(ctx, &tfjson.StateModule{Resources: []*tfjson.StateResource{resource}})
+			if err == nil && len(ids) > 0 {
+				resourceID = ids[0]
+			}
+		}
+
+		drift.Resources = append(drift.Resources, rpv1.DriftedResource{
+			ResourceID: resourceID,
+			Address:    rd.Address,
+			Reason:     reason,
+		})
+	}
+
+	return drift
+}
+
+// collectStateResources indexes the resources of the module and its child modules by address.
+func collectStateResources(module *tfjson.StateModule, resources map[string]*tfjson.StateResource) {
+	if module == nil {
+		return
+	}
+
+	for _, resource := range module.Resources {
+		resources[resource.Address] = resource
+	}
+
+	for _, childModule := range module.ChildModules {
+		collectStateResources(childModule, resources)
+	}
+}
+
+// preparePlanResponse converts the resource changes of the Terraform plan to the changes of the recipe. Reads of data
+// sources and resources which are not changed are not included.
+func (d *terraformDriver) preparePlanResponse(ctx context.Context, tfPlan *tfjson.Plan) *recipes.RecipePlan {
+	plan := &recipes.RecipePlan{Changes: []recipes.ResourceChange{}}
+	if tfPlan == nil {
+		return plan
+	}
+
+	for _, rc := range tfPlan.ResourceChanges {
+		if rc.Change == nil || rc.Mode == tfjson.DataResourceMode {
+			continue
+		}
+
+		var changeType recipes.ResourceChangeType
+		values := rc.Change.After
+		switch {
+		case rc.Change.Actions.Create():
+			changeType = recipes.ResourceChangeTypeCreate
+		case rc.Change.Actions.Delete():
+			changeType = recipes.ResourceChangeTypeDelete
+			values = rc.Change.Before
+		case rc.Change.Actions.Update(), rc.Change.Actions.Replace():
+			changeType = recipes.ResourceChangeTypeUpdate
+		default:
+			continue
+		}
+
+		plan.Changes = append(plan.Changes, recipes.ResourceChange{
+			ChangeType: changeType,
+			ResourceID: d.getPlannedResourceID(ctx, rc, values),
+			Address:    rc.Address,
+		})
+	}
+
+	return plan
+}
+
+// getPlannedResourceID returns the UCP qualified ID of the resource from its planned attribute values. The ID is empty
+// if it's not known before the resource is created, or if the resource provider is not supported by output resources.
+func (d *terraformDriver) getPlannedResourceID(ctx context.Context, rc *tfjson.ResourceChange, values any) string {
+	attributes, ok := values.(map[string]any)
+	if !ok {
+		return ""
+	}
+
+	ids, err := d.getDeployedOutputResources(ctx, &tfjson.StateModule{
+		Resources: []*tfjson.StateResource{
+			{
+				Address:         rc.Address,
+				Type:            rc.Type,
+				Name:            rc.Name,
+				ProviderName:    rc.ProviderName,
+				AttributeValues: attributes,
+			},
+		},
+	})
+	if err != nil || len(ids) == 0 {
+		return ""
+	}
+
+	return ids[0]
+}
+
 // prepareRecipeResponse populates the recipe response from the module output named "result" and the
 // resources deployed by the Terraform module. The outputs and resources are retrieved from the input Terraform JSON state.
 func (d *terraformDriver) prepareRecipeResponse(ctx context.Context, definition recipes.EnvironmentDefinition, tfState *tfjson.State) (*recipes.RecipeOutput, error) {
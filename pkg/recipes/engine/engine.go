@@ -101,7 +101,7 @@ func (e *engine) Delete(ctx context.Context, opts DeleteOptions) error {
 	deletionStart := time.Now()
 	result := metrics.SuccessfulOperationState
 
-	definition, err := e.deleteCore(ctx, opts.Recipe, opts.OutputResources)
+	definition, err := e.deleteCore(ctx, opts.Recipe, opts.OutputResources, opts.RecipeStatus)
 	if err != nil {
 		result = metrics.FailedOperationState
 		if recipes.GetErrorDetails(err) != nil {
@@ -118,7 +118,7 @@ func (e *engine) Delete(ctx context.Context, opts DeleteOptions) error {
 
 // deleteCore function is the core logic of the Delete function.
 // Any changes to the core logic of the Delete function should be made here.
-func (e *engine) deleteCore(ctx context.Context, recipe recipes.ResourceMetadata, outputResources []rpv1.OutputResource) (*recipes.EnvironmentDefinition, error) {
+func (e *engine) deleteCore(ctx context.Context, recipe recipes.ResourceMetadata, outputResources []rpv1.OutputResource, recipeStatus *rpv1.RecipeStatus) (*recipes.EnvironmentDefinition, error) {
 	definition, driver, err := e.getDriver(ctx, recipe)
 	if err != nil {
 		return nil, err
@@ -136,6 +136,7 @@ func (e *engine) deleteCore(ctx context.Context, recipe recipes.ResourceMetadata
 			Definition:    *definition,
 		},
 		OutputResources: outputResources,
+		RecipeStatus:    recipeStatus,
 	})
 	if err != nil {
 		return definition, err
@@ -144,6 +145,105 @@ func (e *engine) deleteCore(ctx context.Context, recipe recipes.ResourceMetadata
 	return definition, nil
 }
 
+// Plan loads the recipe definition from the environment, finds the driver associated with the recipe, loads the
+// configuration associated with the recipe, and then computes the changes the recipe would make using the driver.
+func (e *engine) Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error) {
+	planStart := time.Now()
+	result := metrics.SuccessfulOperationState
+
+	plan, definition, err := e.planCore(ctx, opts.Recipe, opts.PreviousState)
+	if err != nil {
+		result = metrics.FailedOperationState
+		if recipes.GetErrorDetails(err) != nil {
+			result = recipes.GetErrorDetails(err).Code
+		}
+	}
+
+	metrics.DefaultRecipeEngineMetrics.RecordRecipeOperationDuration(ctx, planStart,
+		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationPlan, opts.Recipe.Name,
+			definition, result))
+
+	return plan, err
+}
+
+// planCore function is the core logic of the Plan function.
+// Any changes to the core logic of the Plan function should be made here.
+func (e *engine) planCore(ctx context.Context, recipe recipes.ResourceMetadata, prevState []string) (*recipes.RecipePlan, *recipes.EnvironmentDefinition, error) {
+	definition, driver, err := e.getDriver(ctx, recipe)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	configuration, err := e.options.ConfigurationLoader.LoadConfiguration(ctx, recipe)
+	if err != nil {
+		return nil, definition, recipes.NewRecipeError(recipes.RecipeConfigurationFailure, err.Error(), util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	plan, err := driver.Plan(ctx, recipedriver.ExecuteOptions{
+		BaseOptions: recipedriver.BaseOptions{
+			Configuration: *configuration,
+			Recipe:        recipe,
+			Definition:    *definition,
+		},
+		PrevState: prevState,
+	})
+	if err != nil {
+		return nil, definition, err
+	}
+
+	return plan, definition, nil
+}
+
+// DetectDrift loads the recipe definition from the environment, finds the driver associated with the recipe, loads the
+// configuration associated with the recipe, and then compares the output resources against their current state using the driver.
+func (e *engine) DetectDrift(ctx context.Context, opts DriftOptions) (*recipes.RecipeDrift, error) {
+	driftStart := time.Now()
+	result := metrics.SuccessfulOperationState
+
+	drift, definition, err := e.detectDriftCore(ctx, opts)
+	if err != nil {
+		result = metrics.FailedOperationState
+		if recipes.GetErrorDetails(err) != nil {
+			result = recipes.GetErrorDetails(err).Code
+		}
+	}
+
+	metrics.DefaultRecipeEngineMetrics.RecordRecipeOperationDuration(ctx, driftStart,
+		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDetectDrift, opts.Recipe.Name,
+			definition, result))
+
+	return drift, err
+}
+
+// detectDriftCore function is the core logic of the DetectDrift function.
+// Any changes to the core logic of the DetectDrift function should be made here.
+func (e *engine) detectDriftCore(ctx context.Context, opts DriftOptions) (*recipes.RecipeDrift, *recipes.EnvironmentDefinition, error) {
+	definition, driver, err := e.getDriver(ctx, opts.Recipe)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	configuration, err := e.options.ConfigurationLoader.LoadConfiguration(ctx, opts.Recipe)
+	if err != nil {
+		return nil, definition, recipes.NewRecipeError(recipes.RecipeConfigurationFailure, err.Error(), util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	drift, err := driver.DetectDrift(ctx, recipedriver.DriftOptions{
+		BaseOptions: recipedriver.BaseOptions{
+			Configuration: *configuration,
+			Recipe:        opts.Recipe,
+			Definition:    *definition,
+		},
+		OutputResources: opts.OutputResources,
+		Fingerprints:    opts.Fingerprints,
+	})
+	if err != nil {
+		return nil, definition, err
+	}
+
+	return drift, definition, nil
+}
+
 // Gets the Recipe metadata and parameters from Recipe's template path.
 func (e *engine) GetRecipeMetadata(ctx context.Context, recipeDefinition recipes.EnvironmentDefinition) (map[string]any, error) {
 	recipeData, err := e.getRecipeMetadataCore(ctx, recipeDefinition)
@@ -548,6 +548,203 @@ func Test_GetRecipeMetadata_Driver_InvalidDriver(t *testing.T) {
 	require.Contains(t, err.Error(), "could not find driver invalid")
 }
 
+func Test_Engine_Plan_Success(t *testing.T) {
+	recipeMetadata, recipeDefinition, _ := getRecipeInputs()
+	prevState := []string{
+		"/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/test1",
+	}
+	envConfig := &recipes.Configuration{
+		Runtime: recipes.RuntimeConfiguration{
+			Kubernetes: &recipes.KubernetesRuntime{
+				Namespace: "default",
+			},
+		},
+	}
+	recipePlan := &recipes.RecipePlan{
+		Changes: []recipes.ResourceChange{
+			{
+				ChangeType: recipes.ResourceChangeTypeCreate,
+				ResourceID: "/subscriptions/test-sub/resourcegroups/test-rg/providers/Microsoft.DocumentDB/accounts/test-account",
+			},
+			{
+				ChangeType: recipes.ResourceChangeTypeDelete,
+				ResourceID: "/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/test1",
+			},
+		},
+	}
+
+	ctx := testcontext.New(t)
+	engine, configLoader, driver := setup(t)
+
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(&recipeDefinition, nil)
+	driver.EXPECT().
+		Plan(ctx, recipedriver.ExecuteOptions{
+			BaseOptions: recipedriver.BaseOptions{
+				Configuration: *envConfig,
+				Recipe:        recipeMetadata,
+				Definition:    recipeDefinition,
+			},
+			PrevState: prevState,
+		}).
+		Times(1).
+		Return(recipePlan, nil)
+
+	result, err := engine.Plan(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+		PreviousState: prevState,
+	})
+	require.NoError(t, err)
+	require.Equal(t, recipePlan, result)
+}
+
+func Test_Engine_Plan_Error(t *testing.T) {
+	recipeMetadata, recipeDefinition, _ := getRecipeInputs()
+	envConfig := &recipes.Configuration{}
+
+	ctx := testcontext.New(t)
+	engine, configLoader, driver := setup(t)
+
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(&recipeDefinition, nil)
+	driver.EXPECT().
+		Plan(ctx, gomock.Any()).
+		Times(1).
+		Return(nil, recipes.NewRecipeError(recipes.RecipePlanFailed, "failed to plan recipe", "", nil))
+
+	_, err := engine.Plan(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+	})
+	require.Error(t, err)
+	require.Equal(t, "code RecipePlanFailed: err failed to plan recipe", err.Error())
+}
+
+func Test_Engine_Plan_InvalidDriver(t *testing.T) {
+	recipeMetadata, recipeDefinition, _ := getRecipeInputs()
+	recipeDefinition.Driver = "invalid"
+
+	ctx := testcontext.New(t)
+	engine, configLoader, _ := setup(t)
+
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(&recipeDefinition, nil)
+
+	_, err := engine.Plan(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+	})
+	require.Error(t, err)
+	require.Equal(t, "code DriverNotFoundFailure: err could not find driver `invalid`", err.Error())
+}
+
+func Test_Engine_DetectDrift_Success(t *testing.T) {
+	recipeMetadata, recipeDefinition, outputResources := getRecipeInputs()
+	envConfig := &recipes.Configuration{
+		Runtime: recipes.RuntimeConfiguration{
+			Kubernetes: &recipes.KubernetesRuntime{
+				Namespace: "default",
+			},
+		},
+	}
+	fingerprints := map[string]string{
+		"/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.DocumentDB/accounts/test-account": "abc",
+	}
+	recipeDrift := &recipes.RecipeDrift{
+		Resources: []rpv1.DriftedResource{
+			{
+				ResourceID: "/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.DocumentDB/accounts/test-account",
+				Reason:     rpv1.DriftReasonModified,
+			},
+		},
+		Fingerprints: map[string]string{
+			"/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.DocumentDB/accounts/test-account": "def",
+		},
+	}
+
+	ctx := testcontext.New(t)
+	engine, configLoader, driver := setup(t)
+
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(&recipeDefinition, nil)
+	driver.EXPECT().
+		DetectDrift(ctx, recipedriver.DriftOptions{
+			BaseOptions: recipedriver.BaseOptions{
+				Configuration: *envConfig,
+				Recipe:        recipeMetadata,
+				Definition:    recipeDefinition,
+			},
+			OutputResources: outputResources,
+			Fingerprints:    fingerprints,
+		}).
+		Times(1).
+		Return(recipeDrift, nil)
+
+	result, err := engine.DetectDrift(ctx, DriftOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+		OutputResources: outputResources,
+		Fingerprints:    fingerprints,
+	})
+	require.NoError(t, err)
+	require.Equal(t, recipeDrift, result)
+}
+
+func Test_Engine_DetectDrift_Error(t *testing.T) {
+	recipeMetadata, recipeDefinition, outputResources := getRecipeInputs()
+	envConfig := &recipes.Configuration{}
+
+	ctx := testcontext.New(t)
+	engine, configLoader, driver := setup(t)
+
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(&recipeDefinition, nil)
+	driver.EXPECT().
+		DetectDrift(ctx, gomock.Any()).
+		Times(1).
+		Return(nil, recipes.NewRecipeError(recipes.RecipeDriftDetectionFailed, "failed to detect drift", "", nil))
+
+	_, err := engine.DetectDrift(ctx, DriftOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+		OutputResources: outputResources,
+	})
+	require.Error(t, err)
+	require.Equal(t, "code RecipeDriftDetectionFailed: err failed to detect drift", err.Error())
+}
+
 func getRecipeInputs() (recipes.ResourceMetadata, recipes.EnvironmentDefinition, []rpv1.OutputResource) {
 	recipeMetadata := recipes.ResourceMetadata{
 		Name:          "mongo-azure",
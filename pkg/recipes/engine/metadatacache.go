@@ -0,0 +1,126 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/terraform"
+)
+
+const (
+	// DefaultMetadataTimeout is the default timeout to retrieve the metadata of a recipe template.
+	DefaultMetadataTimeout = 10 * time.Second
+
+	// DefaultMetadataTTL is the default duration the metadata of a recipe template is cached.
+	DefaultMetadataTTL = 10 * time.Minute
+
+	// maxMetadataCacheEntries is the maximum number of recipe templates whose metadata is cached.
+	maxMetadataCacheEntries = 1000
+)
+
+// MetadataCache retrieves the metadata of recipe templates through the engine with a timeout, and caches the metadata
+// keyed by the template kind, path and version and by the identity of the credentials configured to download the
+// template. It is used to validate recipe parameters in the request path, where
+// the recipe template must not be downloaded on every request. Failures are not cached.
+type MetadataCache struct {
+	engine  Engine
+	timeout time.Duration
+	ttl     time.Duration
+
+	mu      sync.Mutex
+	entries map[metadataCacheKey]metadataCacheEntry
+}
+
+type metadataCacheKey struct {
+	driver          string
+	templatePath    string
+	templateVersion string
+	credentialID    string
+}
+
+type metadataCacheEntry struct {
+	metadata map[string]any
+	expires  time.Time
+}
+
+// NewMetadataCache creates a new cache of the metadata of recipe templates retrieved through the given engine, with
+// the given timeout to retrieve the metadata of a template and the given duration the metadata is cached.
+func NewMetadataCache(engine Engine, timeout, ttl time.Duration) *MetadataCache {
+	return &MetadataCache{
+		engine:  engine,
+		timeout: timeout,
+		ttl:     ttl,
+		entries: map[metadataCacheKey]metadataCacheEntry{},
+	}
+}
+
+// Timeout returns the timeout to retrieve the metadata of a template.
+func (c *MetadataCache) Timeout() time.Duration {
+	return c.timeout
+}
+
+// GetRecipeMetadata returns the cached metadata of the template of the given recipe, or retrieves it through the
+// engine if it is not cached. The metadata is cached separately for each identity of the credentials configured by the
+// given recipe configuration, so that the metadata of a private template is never returned for an environment which
+// isn't allowed to download it. It returns an error if the metadata can't be retrieved within the timeout.
+func (c *MetadataCache) GetRecipeMetadata(ctx context.Context, recipeDefinition recipes.EnvironmentDefinition, recipeConfig datamodel.RecipeConfigProperties) (map[string]any, error) {
+	key := metadataCacheKey{
+		driver:          recipeDefinition.Driver,
+		templatePath:    recipeDefinition.TemplatePath,
+		templateVersion: recipeDefinition.TemplateVersion,
+	}
+	if recipeDefinition.Driver == recipes.TemplateKindTerraform {
+		key.credentialID = terraform.ModuleCredentialID(recipeConfig.Terraform.Authentication)
+	}
+
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+	if ok && time.Now().Before(entry.expires) {
+		return entry.metadata, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	metadata, err := c.engine.GetRecipeMetadata(ctx, recipeDefinition)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if len(c.entries) >= maxMetadataCacheEntries {
+		for k, e := range c.entries {
+			if !now.Before(e.expires) {
+				delete(c.entries, k)
+			}
+		}
+	}
+	if len(c.entries) < maxMetadataCacheEntries {
+		c.entries[key] = metadataCacheEntry{metadata: metadata, expires: now.Add(c.ttl)}
+	}
+
+	return metadata, nil
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_MetadataCache(t *testing.T) {
+	definition := recipes.EnvironmentDefinition{
+		Name:         "mongo-azure",
+		Driver:       recipes.TemplateKindBicep,
+		ResourceType: "Applications.Datastores/mongoDatabases",
+		TemplatePath: "ghcr.io/radius-project/dev/recipes/mongodatabases/azure:1.0",
+	}
+	metadata := map[string]any{"parameters": map[string]any{}}
+
+	t.Run("cached by template", func(t *testing.T) {
+		mEngine := NewMockEngine(gomock.NewController(t))
+		mEngine.EXPECT().GetRecipeMetadata(gomock.Any(), definition).Return(metadata, nil).Times(1)
+
+		otherVersion := definition
+		otherVersion.TemplateVersion = "2.0.0"
+		mEngine.EXPECT().GetRecipeMetadata(gomock.Any(), otherVersion).Return(metadata, nil).Times(1)
+
+		cache := NewMetadataCache(mEngine, time.Second, time.Minute)
+		for i := 0; i < 2; i++ {
+			result, err := cache.GetRecipeMetadata(context.Background(), definition, datamodel.RecipeConfigProperties{})
+			require.NoError(t, err)
+			require.Equal(t, metadata, result)
+		}
+
+		// The parameters of the environment don't change the metadata of the template.
+		withParameters := definition
+		withParameters.Parameters = map[string]any{"location": "westus"}
+		_, err := cache.GetRecipeMetadata(context.Background(), withParameters, datamodel.RecipeConfigProperties{})
+		require.NoError(t, err)
+
+		_, err = cache.GetRecipeMetadata(context.Background(), otherVersion, datamodel.RecipeConfigProperties{})
+		require.NoError(t, err)
+	})
+
+	t.Run("cached by module credentials", func(t *testing.T) {
+		module := recipes.EnvironmentDefinition{
+			Name:         "redis-azure",
+			Driver:       recipes.TemplateKindTerraform,
+			ResourceType: "Applications.Datastores/redisCaches",
+			TemplatePath: "git::https://dev.azure.com/project/module",
+		}
+		withCredentials := func(secret string) datamodel.RecipeConfigProperties {
+			return datamodel.RecipeConfigProperties{
+				Terraform: datamodel.TerraformConfigProperties{
+					Authentication: datamodel.TerraformAuthentication{
+						Git: datamodel.GitAuthentication{
+							PAT: map[string]datamodel.SecretReference{"dev.azure.com": {Secret: secret}},
+						},
+					},
+				},
+			}
+		}
+
+		mEngine := NewMockEngine(gomock.NewController(t))
+		mEngine.EXPECT().GetRecipeMetadata(gomock.Any(), module).Return(metadata, nil).Times(3)
+
+		cache := NewMetadataCache(mEngine, time.Second, time.Minute)
+		for _, config := range []datamodel.RecipeConfigProperties{
+			withCredentials("/planes/radius/local/resourcegroups/rg/providers/Applications.Core/secretStores/git"),
+			withCredentials("/planes/radius/local/resourcegroups/rg/providers/Applications.Core/secretStores/git"),
+			withCredentials("/planes/radius/local/resourcegroups/other/providers/Applications.Core/secretStores/git"),
+			{},
+		} {
+			_, err := cache.GetRecipeMetadata(context.Background(), module, config)
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		mEngine := NewMockEngine(gomock.NewController(t))
+		mEngine.EXPECT().GetRecipeMetadata(gomock.Any(), definition).Return(metadata, nil).Times(2)
+
+		cache := NewMetadataCache(mEngine, time.Second, 0)
+		for i := 0; i < 2; i++ {
+			_, err := cache.GetRecipeMetadata(context.Background(), definition, datamodel.RecipeConfigProperties{})
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("failures are not cached", func(t *testing.T) {
+		mEngine := NewMockEngine(gomock.NewController(t))
+		gomock.InOrder(
+			mEngine.EXPECT().GetRecipeMetadata(gomock.Any(), definition).Return(nil, errors.New("failed to pull the recipe")),
+			mEngine.EXPECT().GetRecipeMetadata(gomock.Any(), definition).Return(metadata, nil),
+		)
+
+		cache := NewMetadataCache(mEngine, time.Second, time.Minute)
+		_, err := cache.GetRecipeMetadata(context.Background(), definition, datamodel.RecipeConfigProperties{})
+		require.Error(t, err)
+
+		result, err := cache.GetRecipeMetadata(context.Background(), definition, datamodel.RecipeConfigProperties{})
+		require.NoError(t, err)
+		require.Equal(t, metadata, result)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		mEngine := NewMockEngine(gomock.NewController(t))
+		mEngine.EXPECT().GetRecipeMetadata(gomock.Any(), definition).
+			DoAndReturn(func(ctx context.Context, _ recipes.EnvironmentDefinition) (map[string]any, error) {
+				<-ctx.Done()
+				return nil, ctx.Err()
+			})
+
+		cache := NewMetadataCache(mEngine, 10*time.Millisecond, time.Minute)
+		_, err := cache.GetRecipeMetadata(context.Background(), definition, datamodel.RecipeConfigProperties{})
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
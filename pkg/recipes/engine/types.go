@@ -35,6 +35,14 @@ type Engine interface {
 
 	// Gets the Recipe metadata and parameters from Recipe's template path
 	GetRecipeMetadata(ctx context.Context, recipeDefinition recipes.EnvironmentDefinition) (map[string]any, error)
+
+	// Plan gathers environment configuration, recipe definition and calls the driver to compute the changes the
+	// deployment of the recipe would make, without deploying it.
+	Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error)
+
+	// DetectDrift gathers environment configuration, recipe definition and calls the driver to compare the output
+	// resources deployed by the recipe against their current state.
+	DetectDrift(ctx context.Context, opts DriftOptions) (*recipes.RecipeDrift, error)
 }
 
 // BaseOptions is the base options for the engine operations.
@@ -58,4 +66,18 @@ type DeleteOptions struct {
 
 	// OutputResources is the list of output resources for the recipe.
 	OutputResources []rpv1.OutputResource
+
+	// RecipeStatus is the status of the recipe recorded when it was deployed.
+	RecipeStatus *rpv1.RecipeStatus
+}
+
+// DriftOptions is the options for the DetectDrift method.
+type DriftOptions struct {
+	BaseOptions
+
+	// OutputResources is the list of output resources for the recipe.
+	OutputResources []rpv1.OutputResource
+
+	// Fingerprints is the list of fingerprints recorded at deployment time, keyed by resource ID.
+	Fingerprints map[string]string
 }
@@ -20,6 +20,9 @@ const (
 	// Used for recipe deployment failures.
 	RecipeDeploymentFailed = "RecipeDeploymentFailed"
 
+	// Used for recipe deployments canceled before they are completed.
+	RecipeDeploymentCanceled = "RecipeDeploymentCanceled"
+
 	// Used for recipe validation failures.
 	RecipeValidationFailed = "RecipeValidationFailed"
 
@@ -38,6 +41,12 @@ const (
 	// Used for errors encountered when getting recipe parameters.
 	RecipeGetMetadataFailed = "RecipeGetMetadataFailed"
 
+	// Used for errors encountered when computing the changes a recipe deployment would make.
+	RecipePlanFailed = "RecipePlanFailed"
+
+	// Used for errors encountered when checking the resources deployed by a recipe for drift.
+	RecipeDriftDetectionFailed = "RecipeDriftDetectionFailed"
+
 	// Used for errors when checking the existence of a recipe.
 	RecipeNotFoundFailure = "RecipeNotFoundFailure"
 
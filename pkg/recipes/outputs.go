@@ -0,0 +1,115 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipes
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/recipes/util"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// OutputTypeString is the type of string recipe outputs.
+	OutputTypeString = "string"
+	// OutputTypeInt is the type of integer recipe outputs.
+	OutputTypeInt = "int"
+	// OutputTypeBool is the type of boolean recipe outputs.
+	OutputTypeBool = "bool"
+)
+
+// OutputSchema represents the contract of the outputs a recipe must return for a portable resource type.
+type OutputSchema struct {
+	// Values are the connection values the recipe can return.
+	Values []OutputField `json:"values,omitempty"`
+
+	// Secrets are the connection secrets the recipe can return.
+	Secrets []OutputField `json:"secrets,omitempty"`
+}
+
+// OutputField represents a single value or secret of the recipe output contract.
+type OutputField struct {
+	// Name is the name of the field in the recipe output.
+	Name string `json:"name"`
+
+	// Type is the expected type of the field value, one of "string", "int" or "bool".
+	Type string `json:"type"`
+
+	// Required determines whether the recipe must return the field.
+	Required bool `json:"required"`
+
+	// Description describes the field.
+	Description string `json:"description,omitempty"`
+}
+
+// Validate checks the values and secrets returned by a recipe against the output schema. It returns a RecipeError
+// with the InvalidRecipeOutputs code naming every missing required field and every field with an unexpected type.
+// Fields which are not declared by the schema are ignored. The given fields are set by the resource itself, so the
+// recipe doesn't need to return them even if they are required.
+func (s *OutputSchema) Validate(output *RecipeOutput, setByResource ...string) error {
+	var values, secrets map[string]any
+	if output != nil {
+		values = output.Values
+		secrets = output.Secrets
+	}
+
+	violations := validateOutputFields("value", s.Values, values, setByResource)
+	violations = append(violations, validateOutputFields("secret", s.Secrets, secrets, setByResource)...)
+	if len(violations) == 0 {
+		return nil
+	}
+
+	msg := fmt.Sprintf("recipe output does not match the expected contract: %s", strings.Join(violations, "; "))
+	return NewRecipeError(InvalidRecipeOutputs, msg, util.ExecutionError, nil)
+}
+
+func validateOutputFields(kind string, fields []OutputField, outputs map[string]any, setByResource []string) []string {
+	violations := []string{}
+	for _, field := range fields {
+		value, ok := outputs[field.Name]
+		if !ok || value == nil {
+			if field.Required && !slices.Contains(setByResource, field.Name) {
+				violations = append(violations, fmt.Sprintf("missing required %s %q", kind, field.Name))
+			}
+			continue
+		}
+
+		if !isOutputType(field.Type, value) {
+			violations = append(violations, fmt.Sprintf("%s %q is expected to be of type %s, got %T", kind, field.Name, field.Type, value))
+		}
+	}
+
+	return violations
+}
+
+func isOutputType(outputType string, value any) bool {
+	switch outputType {
+	case OutputTypeString:
+		_, ok := value.(string)
+		return ok
+	case OutputTypeBool:
+		_, ok := value.(bool)
+		return ok
+	case OutputTypeInt:
+		n := toNumber(value)
+		return n != nil && *n == math.Trunc(*n)
+	default:
+		return true
+	}
+}
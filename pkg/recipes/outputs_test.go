@@ -0,0 +1,129 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipes
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutputSchema_Validate(t *testing.T) {
+	schema := OutputSchema{
+		Values: []OutputField{
+			{Name: "host", Type: OutputTypeString, Required: true},
+			{Name: "port", Type: OutputTypeInt, Required: true},
+			{Name: "tls", Type: OutputTypeBool},
+		},
+		Secrets: []OutputField{
+			{Name: "password", Type: OutputTypeString},
+		},
+	}
+
+	tests := []struct {
+		desc          string
+		output        *RecipeOutput
+		setByResource []string
+		violations    []string
+	}{
+		{
+			desc: "valid outputs",
+			output: &RecipeOutput{
+				Values:  map[string]any{"host": "localhost", "port": float64(6379), "tls": true},
+				Secrets: map[string]any{"password": "secret"},
+			},
+		},
+		{
+			desc: "optional outputs omitted",
+			output: &RecipeOutput{
+				Values: map[string]any{"host": "localhost", "port": json.Number("6379")},
+			},
+		},
+		{
+			desc: "undeclared outputs ignored",
+			output: &RecipeOutput{
+				Values:  map[string]any{"host": "localhost", "port": 6379, "extra": []any{"a"}},
+				Secrets: map[string]any{"extra": 1},
+			},
+		},
+		{
+			desc: "missing required outputs",
+			output: &RecipeOutput{
+				Values: map[string]any{"hostname": "localhost"},
+			},
+			violations: []string{`missing required value "host"`, `missing required value "port"`},
+		},
+		{
+			desc: "required outputs set by resource",
+			output: &RecipeOutput{
+				Values: map[string]any{"port": 6379},
+			},
+			setByResource: []string{"host", "port"},
+		},
+		{
+			desc: "mistyped outputs set by resource",
+			output: &RecipeOutput{
+				Values: map[string]any{"port": "6379"},
+			},
+			setByResource: []string{"host", "port"},
+			violations:    []string{`value "port" is expected to be of type int, got string`},
+		},
+		{
+			desc:       "no outputs",
+			output:     nil,
+			violations: []string{`missing required value "host"`, `missing required value "port"`},
+		},
+		{
+			desc: "mistyped outputs",
+			output: &RecipeOutput{
+				Values:  map[string]any{"host": "localhost", "port": "6379", "tls": "true"},
+				Secrets: map[string]any{"password": float64(1234)},
+			},
+			violations: []string{
+				`value "port" is expected to be of type int, got string`,
+				`value "tls" is expected to be of type bool, got string`,
+				`secret "password" is expected to be of type string, got float64`,
+			},
+		},
+		{
+			desc: "fractional int output",
+			output: &RecipeOutput{
+				Values: map[string]any{"host": "localhost", "port": 63.79},
+			},
+			violations: []string{`value "port" is expected to be of type int, got float64`},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := schema.Validate(tc.output, tc.setByResource...)
+			if len(tc.violations) == 0 {
+				require.NoError(t, err)
+				return
+			}
+
+			var recipeError *RecipeError
+			require.True(t, errors.As(err, &recipeError))
+			require.Equal(t, InvalidRecipeOutputs, recipeError.ErrorDetails.Code)
+			for _, violation := range tc.violations {
+				require.Contains(t, recipeError.ErrorDetails.Message, violation)
+			}
+		})
+	}
+}
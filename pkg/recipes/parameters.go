@@ -0,0 +1,449 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipes
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	pr_dm "github.com/radius-project/radius/pkg/portableresources/datamodel"
+)
+
+const (
+	// parameterTypeString is the type of string parameters.
+	parameterTypeString = "string"
+	// parameterTypeInt is the type of integer parameters.
+	parameterTypeInt = "int"
+	// parameterTypeNumber is the type of number parameters.
+	parameterTypeNumber = "number"
+	// parameterTypeBool is the type of boolean parameters.
+	parameterTypeBool = "bool"
+	// parameterTypeObject is the type of object and map parameters.
+	parameterTypeObject = "object"
+	// parameterTypeArray is the type of array, list, set and tuple parameters.
+	parameterTypeArray = "array"
+)
+
+// ParameterSchema represents the parameters declared by a recipe template, which are used to validate the parameters
+// set by the environment and by the resources using the recipe.
+type ParameterSchema struct {
+	// parameters are the definitions of the parameters declared by the template, keyed by the parameter name.
+	parameters map[string]parameterDefinition
+
+	// allowUndeclared determines whether parameters which are not declared by the template can be set. This is the
+	// case for Helm charts, whose values schema doesn't have to describe every value.
+	allowUndeclared bool
+
+	// convertPrimitives determines whether primitive values are converted to the declared type when the recipe is
+	// deployed, as Terraform does for strings, numbers and booleans.
+	convertPrimitives bool
+}
+
+// parameterDefinition represents the constraints of a parameter declared by a recipe template.
+type parameterDefinition struct {
+	// types are the allowed types of the parameter value. Any type is allowed if it is empty.
+	types []string
+
+	// allowedValues are the allowed values of the parameter. Any value is allowed if it is empty.
+	allowedValues []any
+
+	// required determines whether the parameter must be set since the template doesn't define a default value.
+	required bool
+
+	// minValue and maxValue are the bounds of number parameters.
+	minValue *float64
+	maxValue *float64
+
+	// minLength and maxLength are the bounds of the length of string and array parameters.
+	minLength *float64
+	maxLength *float64
+}
+
+// ParameterValidationError represents the violations of the parameter schema of a recipe.
+type ParameterValidationError struct {
+	// Violations are the descriptions of the invalid parameters.
+	Violations []string
+}
+
+// Error returns the violations of the parameter schema.
+func (e *ParameterValidationError) Error() string {
+	return strings.Join(e.Violations, "; ")
+}
+
+// NewParameterSchema creates the parameter schema of a recipe from the metadata returned by the driver of the given
+// template kind. The metadata contains the parameters in the format of the template:
+//   - Bicep recipes return the parameters of the ARM template, with the "type", "allowedValues", "defaultValue",
+//     "minValue", "maxValue", "minLength" and "maxLength" constraints.
+//   - Terraform recipes return the variables of the module, with the "type" and "required" constraints.
+//   - Helm recipes return the properties of the values schema of the chart, with the "type", "enum", "minimum",
+//     "maximum", "minLength" and "maxLength" JSON schema constraints.
+func NewParameterSchema(templateKind string, metadata map[string]any) (*ParameterSchema, error) {
+	schema := &ParameterSchema{
+		parameters:        map[string]parameterDefinition{},
+		allowUndeclared:   templateKind == TemplateKindHelm,
+		convertPrimitives: templateKind == TemplateKindTerraform,
+	}
+
+	if metadata["parameters"] == nil {
+		return schema, nil
+	}
+
+	parameters, ok := metadata["parameters"].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("parameters are not in expected format")
+	}
+
+	for name, value := range parameters {
+		if name == pr_dm.RecipeContextParameter {
+			// The context parameter is set by the resource provider.
+			continue
+		}
+
+		details, ok := value.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("parameter details are not in expected format")
+		}
+
+		switch templateKind {
+		case TemplateKindBicep:
+			schema.parameters[name] = newBicepParameterDefinition(details)
+		case TemplateKindTerraform:
+			schema.parameters[name] = newTerraformParameterDefinition(details)
+		case TemplateKindHelm:
+			schema.parameters[name] = newHelmParameterDefinition(details)
+		default:
+			return nil, fmt.Errorf("unsupported template kind %q", templateKind)
+		}
+	}
+
+	return schema, nil
+}
+
+func newBicepParameterDefinition(details map[string]any) parameterDefinition {
+	definition := parameterDefinition{
+		allowedValues: toSlice(details["allowedValues"]),
+		minValue:      toNumber(details["minValue"]),
+		maxValue:      toNumber(details["maxValue"]),
+		minLength:     toNumber(details["minLength"]),
+		maxLength:     toNumber(details["maxLength"]),
+	}
+
+	_, hasDefault := details["defaultValue"]
+	nullable, _ := details["nullable"].(bool)
+	definition.required = !hasDefault && !nullable
+
+	switch t, _ := details["type"].(string); strings.ToLower(t) {
+	case "string", "securestring":
+		definition.types = []string{parameterTypeString}
+	case "int":
+		definition.types = []string{parameterTypeInt}
+	case "bool":
+		definition.types = []string{parameterTypeBool}
+	case "object", "secureobject":
+		definition.types = []string{parameterTypeObject}
+	case "array":
+		definition.types = []string{parameterTypeArray}
+	}
+
+	return definition
+}
+
+func newTerraformParameterDefinition(details map[string]any) parameterDefinition {
+	definition := parameterDefinition{}
+	definition.required, _ = details["required"].(bool)
+
+	// The type is a Terraform type constraint, such as "string", "list(string)" or "object({ name = string })".
+	t, _ := details["type"].(string)
+	t = strings.TrimSpace(t)
+	switch {
+	case t == "string":
+		definition.types = []string{parameterTypeString}
+	case t == "number":
+		definition.types = []string{parameterTypeNumber}
+	case t == "bool":
+		definition.types = []string{parameterTypeBool}
+	case strings.HasPrefix(t, "list(") || strings.HasPrefix(t, "set(") || strings.HasPrefix(t, "tuple("):
+		definition.types = []string{parameterTypeArray}
+	case strings.HasPrefix(t, "map(") || strings.HasPrefix(t, "object("):
+		definition.types = []string{parameterTypeObject}
+	}
+
+	return definition
+}
+
+func newHelmParameterDefinition(details map[string]any) parameterDefinition {
+	definition := parameterDefinition{
+		allowedValues: toSlice(details["enum"]),
+		minValue:      toNumber(details["minimum"]),
+		maxValue:      toNumber(details["maximum"]),
+		minLength:     toNumber(details["minLength"]),
+		maxLength:     toNumber(details["maxLength"]),
+	}
+
+	types := []string{}
+	switch t := details["type"].(type) {
+	case string:
+		types = append(types, t)
+	case []any:
+		for _, v := range t {
+			if s, ok := v.(string); ok {
+				types = append(types, s)
+			}
+		}
+	}
+
+	for _, t := range types {
+		switch t {
+		case "string":
+			definition.types = append(definition.types, parameterTypeString)
+		case "integer":
+			definition.types = append(definition.types, parameterTypeInt)
+		case "number":
+			definition.types = append(definition.types, parameterTypeNumber)
+		case "boolean":
+			definition.types = append(definition.types, parameterTypeBool)
+		case "object":
+			definition.types = append(definition.types, parameterTypeObject)
+		case "array":
+			definition.types = append(definition.types, parameterTypeArray)
+		case "null":
+			// Null values are not validated.
+			definition.types = nil
+			return definition
+		}
+	}
+
+	return definition
+}
+
+// Validate validates the names, types and values of the given parameters against the schema. It returns a
+// ParameterValidationError describing every invalid parameter.
+func (s *ParameterSchema) Validate(parameters map[string]any) error {
+	violations := []string{}
+	for _, name := range SortedKeys(parameters) {
+		definition, ok := s.parameters[name]
+		if !ok {
+			if !s.allowUndeclared {
+				violations = append(violations, fmt.Sprintf("parameter %q is not declared by the recipe", name))
+			}
+			continue
+		}
+
+		violations = append(violations, s.validateValue(name, definition, parameters[name])...)
+	}
+
+	if len(violations) > 0 {
+		return &ParameterValidationError{Violations: violations}
+	}
+
+	return nil
+}
+
+// ValidateRequired validates that every parameter required by the schema is set by one of the given sets of
+// parameters, such as the parameters of the environment and the parameters of the resource. It returns a
+// ParameterValidationError listing the missing parameters.
+func (s *ParameterSchema) ValidateRequired(parameterSets ...map[string]any) error {
+	violations := []string{}
+	for _, name := range SortedKeys(s.parameters) {
+		if !s.parameters[name].required {
+			continue
+		}
+
+		set := false
+		for _, parameters := range parameterSets {
+			if _, ok := parameters[name]; ok {
+				set = true
+				break
+			}
+		}
+
+		if !set {
+			violations = append(violations, fmt.Sprintf("required parameter %q is not set", name))
+		}
+	}
+
+	if len(violations) > 0 {
+		return &ParameterValidationError{Violations: violations}
+	}
+
+	return nil
+}
+
+func (s *ParameterSchema) validateValue(name string, definition parameterDefinition, value any) []string {
+	if value == nil {
+		return nil
+	}
+
+	if len(definition.types) > 0 && !s.hasType(definition.types, value) {
+		return []string{fmt.Sprintf("parameter %q must be of type %s", name, strings.Join(definition.types, " or "))}
+	}
+
+	violations := []string{}
+	if len(definition.allowedValues) > 0 && !containsValue(definition.allowedValues, value) {
+		allowed := []string{}
+		for _, v := range definition.allowedValues {
+			b, _ := json.Marshal(v)
+			allowed = append(allowed, string(b))
+		}
+		violations = append(violations, fmt.Sprintf("parameter %q must be one of [%s]", name, strings.Join(allowed, ", ")))
+	}
+
+	if n := toNumber(value); n != nil {
+		if definition.minValue != nil && *n < *definition.minValue {
+			violations = append(violations, fmt.Sprintf("parameter %q must be at least %v", name, *definition.minValue))
+		}
+		if definition.maxValue != nil && *n > *definition.maxValue {
+			violations = append(violations, fmt.Sprintf("parameter %q must be at most %v", name, *definition.maxValue))
+		}
+	}
+
+	length := -1
+	switch v := value.(type) {
+	case string:
+		length = len([]rune(v))
+	case []any:
+		length = len(v)
+	}
+	if length >= 0 {
+		if definition.minLength != nil && float64(length) < *definition.minLength {
+			violations = append(violations, fmt.Sprintf("length of parameter %q must be at least %v", name, *definition.minLength))
+		}
+		if definition.maxLength != nil && float64(length) > *definition.maxLength {
+			violations = append(violations, fmt.Sprintf("length of parameter %q must be at most %v", name, *definition.maxLength))
+		}
+	}
+
+	return violations
+}
+
+// hasType returns true if the value is of one of the given types, or can be converted to it when primitive values are
+// converted.
+func (s *ParameterSchema) hasType(types []string, value any) bool {
+	for _, t := range types {
+		switch t {
+		case parameterTypeString:
+			if _, ok := value.(string); ok {
+				return true
+			}
+			if s.convertPrimitives && (toNumber(value) != nil || isBool(value)) {
+				return true
+			}
+		case parameterTypeInt:
+			if n := toNumber(value); n != nil && *n == math.Trunc(*n) {
+				return true
+			}
+		case parameterTypeNumber:
+			if toNumber(value) != nil {
+				return true
+			}
+			if str, ok := value.(string); ok && s.convertPrimitives {
+				if _, err := strconv.ParseFloat(str, 64); err == nil {
+					return true
+				}
+			}
+		case parameterTypeBool:
+			if isBool(value) {
+				return true
+			}
+			if str, ok := value.(string); ok && s.convertPrimitives && (str == "true" || str == "false") {
+				return true
+			}
+		case parameterTypeObject:
+			if _, ok := value.(map[string]any); ok {
+				return true
+			}
+		case parameterTypeArray:
+			if _, ok := value.([]any); ok {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// containsValue returns true if the value is one of the allowed values. Numbers are compared by value regardless of
+// their Go type.
+func containsValue(allowedValues []any, value any) bool {
+	for _, allowed := range allowedValues {
+		if a, v := toNumber(allowed), toNumber(value); a != nil && v != nil {
+			if *a == *v {
+				return true
+			}
+			continue
+		}
+
+		if reflect.DeepEqual(allowed, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// toNumber returns the value as a float64 if it is a number.
+func toNumber(value any) *float64 {
+	var n float64
+	switch v := value.(type) {
+	case float64:
+		n = v
+	case float32:
+		n = float64(v)
+	case int:
+		n = float64(v)
+	case int32:
+		n = float64(v)
+	case int64:
+		n = float64(v)
+	case json.Number:
+		f, err := v.Float64()
+		if err != nil {
+			return nil
+		}
+		n = f
+	default:
+		return nil
+	}
+
+	return &n
+}
+
+func toSlice(value any) []any {
+	s, _ := value.([]any)
+	return s
+}
+
+func isBool(value any) bool {
+	_, ok := value.(bool)
+	return ok
+}
+
+// SortedKeys returns the keys of the given map in sorted order.
+func SortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
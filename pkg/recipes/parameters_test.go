@@ -0,0 +1,207 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParameterSchema_Validate(t *testing.T) {
+	bicepMetadata := map[string]any{
+		"parameters": map[string]any{
+			"context": map[string]any{
+				"type": "object",
+			},
+			"location": map[string]any{
+				"type":         "string",
+				"defaultValue": "[resourceGroup().location]",
+			},
+			"sku": map[string]any{
+				"type":          "string",
+				"allowedValues": []any{"Basic", "Standard"},
+			},
+			"capacity": map[string]any{
+				"type":         "int",
+				"minValue":     float64(1),
+				"maxValue":     float64(6),
+				"defaultValue": float64(1),
+			},
+			"name": map[string]any{
+				"type":         "string",
+				"minLength":    float64(3),
+				"defaultValue": "cache",
+			},
+			"tags": map[string]any{
+				"type":         "object",
+				"defaultValue": map[string]any{},
+			},
+		},
+	}
+
+	terraformMetadata := map[string]any{
+		"parameters": map[string]any{
+			"port": map[string]any{
+				"type":     "number",
+				"required": false,
+			},
+			"enabled": map[string]any{
+				"type":     "bool",
+				"required": false,
+			},
+			"zones": map[string]any{
+				"type":     "list(string)",
+				"required": false,
+			},
+			"name": map[string]any{
+				"type":     "string",
+				"required": true,
+			},
+		},
+	}
+
+	helmMetadata := map[string]any{
+		"parameters": map[string]any{
+			"replicaCount": map[string]any{
+				"type":    "integer",
+				"minimum": float64(1),
+			},
+			"mode": map[string]any{
+				"type": "string",
+				"enum": []any{"standalone", "cluster"},
+			},
+		},
+	}
+
+	tests := []struct {
+		desc         string
+		templateKind string
+		metadata     map[string]any
+		parameters   map[string]any
+		violations   []string
+	}{
+		{
+			desc:         "bicep valid parameters",
+			templateKind: TemplateKindBicep,
+			metadata:     bicepMetadata,
+			parameters:   map[string]any{"sku": "Basic", "capacity": float64(2), "name": "redis", "tags": map[string]any{"env": "dev"}},
+		},
+		{
+			desc:         "bicep invalid parameters",
+			templateKind: TemplateKindBicep,
+			metadata:     bicepMetadata,
+			parameters:   map[string]any{"skus": "Basic", "sku": "Premium", "capacity": float64(8), "name": "ab", "tags": "env=dev"},
+			violations: []string{
+				"parameter \"capacity\" must be at most 6",
+				"length of parameter \"name\" must be at least 3",
+				"parameter \"sku\" must be one of [\"Basic\", \"Standard\"]",
+				"parameter \"skus\" is not declared by the recipe",
+				"parameter \"tags\" must be of type object",
+			},
+		},
+		{
+			desc:         "bicep non integer",
+			templateKind: TemplateKindBicep,
+			metadata:     bicepMetadata,
+			parameters:   map[string]any{"capacity": float64(1.5)},
+			violations:   []string{"parameter \"capacity\" must be of type int"},
+		},
+		{
+			desc:         "terraform converts primitive values",
+			templateKind: TemplateKindTerraform,
+			metadata:     terraformMetadata,
+			parameters:   map[string]any{"port": "6379", "enabled": "true", "name": float64(1), "zones": []any{"1"}},
+		},
+		{
+			desc:         "terraform invalid parameters",
+			templateKind: TemplateKindTerraform,
+			metadata:     terraformMetadata,
+			parameters:   map[string]any{"port": "http", "enabled": "yes", "zones": "1", "size": "small"},
+			violations: []string{
+				"parameter \"enabled\" must be of type bool",
+				"parameter \"port\" must be of type number",
+				"parameter \"size\" is not declared by the recipe",
+				"parameter \"zones\" must be of type array",
+			},
+		},
+		{
+			desc:         "helm allows undeclared values",
+			templateKind: TemplateKindHelm,
+			metadata:     helmMetadata,
+			parameters:   map[string]any{"replicaCount": float64(3), "mode": "cluster", "image": map[string]any{"tag": "7"}},
+		},
+		{
+			desc:         "helm invalid values",
+			templateKind: TemplateKindHelm,
+			metadata:     helmMetadata,
+			parameters:   map[string]any{"replicaCount": float64(0), "mode": "sentinel"},
+			violations: []string{
+				"parameter \"mode\" must be one of [\"standalone\", \"cluster\"]",
+				"parameter \"replicaCount\" must be at least 1",
+			},
+		},
+		{
+			desc:         "no parameters in metadata",
+			templateKind: TemplateKindBicep,
+			metadata:     map[string]any{},
+			parameters:   map[string]any{"sku": "Basic"},
+			violations:   []string{"parameter \"sku\" is not declared by the recipe"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			schema, err := NewParameterSchema(tt.templateKind, tt.metadata)
+			require.NoError(t, err)
+
+			err = schema.Validate(tt.parameters)
+			if tt.violations == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Equal(t, &ParameterValidationError{Violations: tt.violations}, err)
+		})
+	}
+}
+
+func TestParameterSchema_ValidateRequired(t *testing.T) {
+	schema, err := NewParameterSchema(TemplateKindBicep, map[string]any{
+		"parameters": map[string]any{
+			"context": map[string]any{"type": "object"},
+			"sku":     map[string]any{"type": "string"},
+			"name":    map[string]any{"type": "string"},
+			"tags":    map[string]any{"type": "object", "defaultValue": map[string]any{}},
+		},
+	})
+	require.NoError(t, err)
+
+	err = schema.ValidateRequired(map[string]any{"sku": "Basic"}, map[string]any{"name": "redis"})
+	require.NoError(t, err)
+
+	err = schema.ValidateRequired(map[string]any{"sku": "Basic"}, nil)
+	require.EqualError(t, err, "required parameter \"name\" is not set")
+}
+
+func TestNewParameterSchema_InvalidMetadata(t *testing.T) {
+	_, err := NewParameterSchema(TemplateKindBicep, map[string]any{"parameters": "invalid"})
+	require.EqualError(t, err, "parameters are not in expected format")
+
+	_, err = NewParameterSchema(TemplateKindBicep, map[string]any{"parameters": map[string]any{"sku": "invalid"}})
+	require.EqualError(t, err, "parameter details are not in expected format")
+}
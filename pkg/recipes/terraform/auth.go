@@ -0,0 +1,261 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// gitPATSecretKey is the key of the secret store secret holding the Git personal access token.
+	gitPATSecretKey = "pat"
+
+	// gitUsernameSecretKey is the key of the optional secret store secret holding the user name of the Git personal access token.
+	gitUsernameSecretKey = "username"
+
+	// sshPrivateKeySecretKey is the key of the secret store secret holding the SSH private key.
+	sshPrivateKeySecretKey = "privateKey"
+
+	// sshKnownHostsSecretKey is the key of the optional secret store secret holding the SSH known hosts.
+	sshKnownHostsSecretKey = "knownHosts"
+
+	// registryTokenSecretKey is the key of the secret store secret holding the module registry token.
+	registryTokenSecretKey = "token"
+
+	// defaultGitUsername is the user name used with a Git personal access token when the user name is not set.
+	// Git hosting services ignore the user name when authenticating with a token, but it must not be empty.
+	defaultGitUsername = "radius"
+
+	// gitConfigCountEnvVar is the environment variable holding the number of Git configuration entries passed with the
+	// GIT_CONFIG_KEY_<n> and GIT_CONFIG_VALUE_<n> environment variables.
+	// https://git-scm.com/docs/git-config#Documentation/git-config.txt-GITCONFIGCOUNT
+	gitConfigCountEnvVar = "GIT_CONFIG_COUNT"
+)
+
+// configureModuleAuthentication writes the credentials used to download Terraform modules from private Git
+// repositories and module registries, and adds the environment variables pointing Git and Terraform to them to the
+// given environment:
+//   - Git personal access tokens are written to a Git credential store file, which Git uses through a credential
+//     helper configured with the GIT_CONFIG_* environment variables, and to a .netrc file passed with NETRC.
+//   - SSH private keys are written along with an SSH configuration file which is passed to Git with GIT_SSH_COMMAND.
+//   - Module registry tokens are passed with the TF_TOKEN_<host> environment variables.
+//
+// The files are written to a temporary directory outside of the Terraform working directory, which is removed by the
+// returned cleanup function. The credentials are never logged.
+func configureModuleAuthentication(ctx context.Context, auth datamodel.TerraformAuthentication, secrets map[string]map[string]string, env map[string]string) (func(), error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	cleanup := func() {}
+
+	for _, host := range sortedHosts(auth.Registry) {
+		token, err := getSecretValue(secrets, auth.Registry[host], registryTokenSecretKey, host)
+		if err != nil {
+			return cleanup, err
+		}
+
+		env[registryTokenEnvVar(host)] = token
+	}
+
+	if len(auth.Git.PAT) == 0 && len(auth.Git.SSH) == 0 {
+		return cleanup, nil
+	}
+
+	authDir, err := os.MkdirTemp("", "radius-terraform-auth-")
+	if err != nil {
+		return cleanup, fmt.Errorf("failed to create directory for Terraform module credentials: %w", err)
+	}
+	cleanup = func() {
+		if err := os.RemoveAll(authDir); err != nil {
+			logger.Info(fmt.Sprintf("Failed to cleanup Terraform module credentials directory %q. Err: %s", authDir, err.Error()))
+		}
+	}
+
+	if err := configureGitPAT(auth.Git.PAT, secrets, authDir, env); err != nil {
+		cleanup()
+		return func() {}, err
+	}
+
+	if err := configureGitSSH(auth.Git.SSH, secrets, authDir, env); err != nil {
+		cleanup()
+		return func() {}, err
+	}
+
+	return cleanup, nil
+}
+
+// configureGitPAT writes the Git personal access tokens to a Git credential store file in the given directory and
+// configures Git to read the token of each host from it with a credential helper. The tokens are also written to a
+// .netrc file passed to Terraform with NETRC, which is used by the modules downloaded over HTTPS without Git. HOME is
+// not changed, so that the rest of the configuration of the user is still used.
+func configureGitPAT(refs map[string]datamodel.SecretReference, secrets map[string]map[string]string, authDir string, env map[string]string) error {
+	if len(refs) == 0 {
+		return nil
+	}
+
+	credentialsFile := filepath.Join(authDir, "git-credentials")
+	var credentials, netrc strings.Builder
+	for _, host := range sortedHosts(refs) {
+		pat, err := getSecretValue(secrets, refs[host], gitPATSecretKey, host)
+		if err != nil {
+			return err
+		}
+
+		username := secrets[refs[host].Secret][gitUsernameSecretKey]
+		if username == "" {
+			username = defaultGitUsername
+		}
+
+		credentialURL := url.URL{Scheme: "https", User: url.UserPassword(username, pat), Host: host}
+		fmt.Fprintf(&credentials, "%s\n", credentialURL.String())
+		fmt.Fprintf(&netrc, "machine %s\nlogin %s\npassword %s\n", host, username, pat)
+
+		addGitConfig(env, fmt.Sprintf("credential.https://%s.helper", host), fmt.Sprintf("store --file=%q", credentialsFile))
+	}
+
+	if err := os.WriteFile(credentialsFile, []byte(credentials.String()), 0600); err != nil {
+		return fmt.Errorf("failed to write Git credentials: %w", err)
+	}
+
+	netrcFile := filepath.Join(authDir, ".netrc")
+	if err := os.WriteFile(netrcFile, []byte(netrc.String()), 0600); err != nil {
+		return fmt.Errorf("failed to write Git credentials: %w", err)
+	}
+
+	env["NETRC"] = netrcFile
+	return nil
+}
+
+// addGitConfig adds a Git configuration entry to the given environment with the GIT_CONFIG_KEY_<n> and
+// GIT_CONFIG_VALUE_<n> environment variables, after the entries which are already set.
+func addGitConfig(env map[string]string, key, value string) {
+	count, err := strconv.Atoi(env[gitConfigCountEnvVar])
+	if err != nil || count < 0 {
+		count = 0
+	}
+
+	env[fmt.Sprintf("GIT_CONFIG_KEY_%d", count)] = key
+	env[fmt.Sprintf("GIT_CONFIG_VALUE_%d", count)] = value
+	env[gitConfigCountEnvVar] = strconv.Itoa(count + 1)
+}
+
+// configureGitSSH writes the SSH private keys to the given directory along with an SSH configuration file which uses
+// the key of each host, and points Git to the configuration with GIT_SSH_COMMAND. Host keys are always verified,
+// against the known hosts of the secret store if they are set for a host, and against the known hosts of the system
+// otherwise.
+func configureGitSSH(refs map[string]datamodel.SecretReference, secrets map[string]map[string]string, authDir string, env map[string]string) error {
+	if len(refs) == 0 {
+		return nil
+	}
+
+	var b strings.Builder
+	for i, host := range sortedHosts(refs) {
+		privateKey, err := getSecretValue(secrets, refs[host], sshPrivateKeySecretKey, host)
+		if err != nil {
+			return err
+		}
+
+		// OpenSSH fails to load private keys which don't end with a new line.
+		if !strings.HasSuffix(privateKey, "\n") {
+			privateKey += "\n"
+		}
+
+		keyFile := filepath.Join(authDir, fmt.Sprintf("id_%d", i))
+		if err := os.WriteFile(keyFile, []byte(privateKey), 0600); err != nil {
+			return fmt.Errorf("failed to write SSH private key: %w", err)
+		}
+
+		fmt.Fprintf(&b, "Host %s\n  IdentityFile %q\n  IdentitiesOnly yes\n", host, keyFile)
+
+		if knownHosts := secrets[refs[host].Secret][sshKnownHostsSecretKey]; knownHosts != "" {
+			knownHostsFile := filepath.Join(authDir, fmt.Sprintf("known_hosts_%d", i))
+			if err := os.WriteFile(knownHostsFile, []byte(knownHosts), 0600); err != nil {
+				return fmt.Errorf("failed to write SSH known hosts: %w", err)
+			}
+			fmt.Fprintf(&b, "  UserKnownHostsFile %q\n", knownHostsFile)
+		}
+
+		fmt.Fprintf(&b, "  StrictHostKeyChecking yes\n")
+	}
+
+	configFile := filepath.Join(authDir, "ssh_config")
+	if err := os.WriteFile(configFile, []byte(b.String()), 0600); err != nil {
+		return fmt.Errorf("failed to write SSH configuration: %w", err)
+	}
+
+	env["GIT_SSH_COMMAND"] = fmt.Sprintf("ssh -F %q", configFile)
+	return nil
+}
+
+// ModuleCredentialID returns the identity of the credentials used to download Terraform modules, made of the secret
+// stores referenced by the given authentication configuration. It's empty if no credentials are configured. Modules
+// downloaded with different credentials are cached separately, so that a private module downloaded by an environment
+// is never restored for an environment which isn't allowed to download it.
+func ModuleCredentialID(auth datamodel.TerraformAuthentication) string {
+	var b strings.Builder
+	for _, kind := range []struct {
+		name string
+		refs map[string]datamodel.SecretReference
+	}{
+		{"git-pat", auth.Git.PAT},
+		{"git-ssh", auth.Git.SSH},
+		{"registry", auth.Registry},
+	} {
+		for _, host := range sortedHosts(kind.refs) {
+			fmt.Fprintf(&b, "%s|%s|%s\n", kind.name, host, strings.ToLower(kind.refs[host].Secret))
+		}
+	}
+
+	return b.String()
+}
+
+// getSecretValue returns the value of the given key of the referenced secret store.
+func getSecretValue(secrets map[string]map[string]string, ref datamodel.SecretReference, key string, host string) (string, error) {
+	value, ok := secrets[ref.Secret][key]
+	if !ok || value == "" {
+		return "", fmt.Errorf("secret store %q doesn't contain the %q secret required to authenticate to %q", ref.Secret, key, host)
+	}
+
+	return value, nil
+}
+
+// registryTokenEnvVar returns the name of the environment variable holding the API token of the given module registry
+// host. Periods are encoded as underscores and hyphens as double underscores.
+// https://developer.hashicorp.com/terraform/cli/config/config-file#environment-variable-credentials
+func registryTokenEnvVar(host string) string {
+	host = strings.ReplaceAll(host, "-", "__")
+	return "TF_TOKEN_" + strings.ReplaceAll(host, ".", "_")
+}
+
+// sortedHosts returns the hosts of the given references in a stable order.
+func sortedHosts(refs map[string]datamodel.SecretReference) []string {
+	hosts := make([]string, 0, len(refs))
+	for host := range refs {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+
+	return hosts
+}
@@ -0,0 +1,642 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// cacheModulesSubDir is the subdirectory of the cache where downloaded modules are stored.
+	cacheModulesSubDir = "modules"
+
+	// cachePluginsSubDir is the subdirectory of the cache used as the Terraform plugin cache directory.
+	cachePluginsSubDir = "plugins"
+
+	// cacheTerraformSubDir is the subdirectory of the cache where the downloaded Terraform executables are stored,
+	// keyed by version.
+	cacheTerraformSubDir = "terraform"
+
+	// latestTerraformVersion is the key of the latest version of Terraform in the cache.
+	latestTerraformVersion = "latest"
+
+	// latestTerraformMaxAge is the duration the cached latest version of Terraform is used before the latest version
+	// is downloaded again.
+	latestTerraformMaxAge = 24 * time.Hour
+
+	// cachedModuleName is the local name under which a module is stored in the cache, independent of the name
+	// of the recipe which downloaded it.
+	cachedModuleName = "module"
+
+	// moduleManifestFile is the name of the file where Terraform records the modules installed in a working directory.
+	moduleManifestFile = "modules.json"
+
+	// providerRootDir is the directory of a working directory where Terraform installs the providers. The providers
+	// installed through the plugin cache are linked to the plugin cache.
+	providerRootDir = ".terraform/providers"
+
+	// providerDirDepth is the depth of the directory of a provider version, both in the plugin cache and in the
+	// providers directory of a working directory, laid out as <HOSTNAME>/<NAMESPACE>/<TYPE>/<VERSION>.
+	providerDirDepth = 4
+)
+
+var (
+	// exactVersionRegex matches an exact module version, as opposed to a version constraint such as "~> 1.0".
+	exactVersionRegex = regexp.MustCompile(`^=?\s*v?\d+\.\d+\.\d+(-[0-9A-Za-z.-]+)?(\+[0-9A-Za-z.-]+)?$`)
+
+	// commitSHARegex matches a full git commit SHA.
+	commitSHARegex = regexp.MustCompile(`^([0-9a-fA-F]{40}|[0-9a-fA-F]{64})$`)
+)
+
+// Cache is a local cache of Terraform modules and providers shared across the executions of Terraform recipes.
+// Modules are content-addressed by their template path and version and by the identity of the credentials used to
+// download them, and providers are shared through the Terraform plugin cache directory. Only modules pinned to an
+// immutable version are cached, so that a module whose source can change, such as a git branch, is always downloaded
+// again.
+type Cache struct {
+	// dir is the root directory of the cache.
+	dir string
+
+	// maxSizeBytes is the maximum size of the cached modules, and separately of the cached providers. The least
+	// recently used modules and providers are evicted once they grow beyond it. The size of the cache is not limited
+	// if it is zero.
+	maxSizeBytes int64
+
+	// pluginCacheUsers is the number of executions using the plugin cache. Terraform links the providers installed in
+	// a working directory to the plugin cache, so providers are only evicted when no execution uses the plugin cache.
+	pluginCacheUsers int
+
+	// mu serializes the updates of the cached modules and providers.
+	mu sync.Mutex
+}
+
+// NewCache creates a new cache of Terraform modules and providers rooted at the given directory.
+func NewCache(dir string, maxSizeBytes int64) *Cache {
+	return &Cache{dir: dir, maxSizeBytes: maxSizeBytes}
+}
+
+// PluginCacheDir returns the directory used as the Terraform plugin cache, creating it if it doesn't exist. Every
+// successful call must be followed by a call to ReleasePluginCacheDir once Terraform is no longer run in the working
+// directory using the plugin cache.
+func (c *Cache) PluginCacheDir() (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	dir := filepath.Join(c.dir, cachePluginsSubDir)
+	if err := os.MkdirAll(dir, workingDirFileMode); err != nil {
+		return "", fmt.Errorf("failed to create terraform plugin cache directory: %w", err)
+	}
+
+	c.pluginCacheUsers++
+	return dir, nil
+}
+
+// ReleasePluginCacheDir records the use of the providers installed in the given working directory, and evicts the
+// least recently used providers from the plugin cache if it grows beyond its maximum size and no other execution
+// uses the plugin cache.
+func (c *Cache) ReleasePluginCacheDir(ctx context.Context, workingDir string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.pluginCacheUsers--
+
+	providers, err := findProviderDirs(filepath.Join(workingDir, providerRootDir))
+	if err != nil {
+		return err
+	}
+
+	// The modification time of a provider version tracks its last use for the eviction of the least recently used
+	// providers. Providers installed from an offline mirror aren't in the plugin cache.
+	pluginCacheDir := filepath.Join(c.dir, cachePluginsSubDir)
+	now := time.Now()
+	for _, provider := range providers {
+		if err := os.Chtimes(filepath.Join(pluginCacheDir, provider), now, now); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+
+	if c.pluginCacheUsers > 0 {
+		return nil
+	}
+
+	return c.evictProviders(ctx)
+}
+
+// moduleManifest is the manifest of the modules installed in a working directory, stored by Terraform in
+// .terraform/modules/modules.json.
+type moduleManifest struct {
+	Records []moduleRecord `json:"Modules"`
+}
+
+// moduleRecord is the record of an installed module in the module manifest.
+type moduleRecord struct {
+	Key     string `json:"Key"`
+	Source  string `json:"Source"`
+	Version string `json:"Version,omitempty"`
+	Dir     string `json:"Dir"`
+}
+
+// isPinnedModule returns true if the module with the given template path and version always resolves to the same
+// content. Registry modules are pinned by an exact version and git modules by a commit SHA. Branches, tags, version
+// constraints and other sources such as HTTP archives can change over time, so they are not pinned.
+func isPinnedModule(templatePath, templateVersion string) bool {
+	source := templatePath
+	if getter, rest, ok := strings.Cut(source, "::"); ok {
+		if getter != "git" {
+			return false
+		}
+		source = rest
+	} else if isRegistryModule(source) {
+		return exactVersionRegex.MatchString(strings.TrimSpace(templateVersion))
+	} else if !isGitModule(source) {
+		return false
+	}
+
+	_, rawQuery, _ := strings.Cut(source, "?")
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return false
+	}
+
+	return commitSHARegex.MatchString(query.Get("ref"))
+}
+
+// isRegistryModule returns true if the given template path is the address of a module in a Terraform registry,
+// in the form [<HOSTNAME>/]<NAMESPACE>/<NAME>/<PROVIDER>.
+func isRegistryModule(templatePath string) bool {
+	if strings.ContainsAny(templatePath, ":?@") || strings.HasPrefix(templatePath, ".") || strings.HasPrefix(templatePath, "/") {
+		return false
+	}
+
+	// The address can be followed by the subdirectory of the module, as in hashicorp/consul/aws//modules/consul-cluster.
+	address, _, _ := strings.Cut(templatePath, "//")
+	segments := strings.Split(address, "/")
+	if len(segments) == 4 && strings.Contains(segments[0], ".") {
+		// GitHub and Bitbucket addresses have the same shape as registry addresses with a hostname.
+		return !isGitModule(templatePath)
+	}
+
+	return len(segments) == 3
+}
+
+// isGitModule returns true if the given template path is the address of a module which Terraform downloads with git
+// without the "git::" prefix.
+func isGitModule(templatePath string) bool {
+	return strings.HasPrefix(templatePath, "github.com/") || strings.HasPrefix(templatePath, "bitbucket.org/") || strings.HasPrefix(templatePath, "git@")
+}
+
+// moduleKey returns the key of the module with the given template path and version, downloaded with the credentials
+// of the given identity, in the cache.
+func moduleKey(templatePath, templateVersion, credentialID string) string {
+	hash := sha256.Sum256([]byte(templatePath + "@" + templateVersion + "\n" + credentialID))
+	return hex.EncodeToString(hash[:])
+}
+
+// RestoreModule copies the module with the given template path and version, downloaded with the credentials of the
+// given identity, from the cache to the working directory, installed under the given local module name. It returns
+// false if the module is not in the cache or isn't pinned to an immutable version.
+func (c *Cache) RestoreModule(ctx context.Context, workingDir, localModuleName, templatePath, templateVersion, credentialID string) (bool, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	if !isPinnedModule(templatePath, templateVersion) {
+		return false, nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entryDir := filepath.Join(c.dir, cacheModulesSubDir, moduleKey(templatePath, templateVersion, credentialID))
+	if _, err := os.Stat(filepath.Join(entryDir, moduleManifestFile)); errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	logger.Info(fmt.Sprintf("Restoring Terraform module %q from the cache", templatePath))
+	if err := copyModules(entryDir, filepath.Join(workingDir, moduleRootDir), cachedModuleName, localModuleName); err != nil {
+		return false, fmt.Errorf("failed to restore terraform module from the cache: %w", err)
+	}
+
+	// The modification time of the entry tracks its last use for the eviction of the least recently used modules.
+	now := time.Now()
+	if err := os.Chtimes(entryDir, now, now); err != nil {
+		logger.Info(fmt.Sprintf("Failed to update the last use of the cached Terraform module: %s", err.Error()))
+	}
+
+	return true, nil
+}
+
+// StoreModule copies the module installed in the working directory under the given local module name, downloaded with
+// the credentials of the given identity, to the cache, and evicts the least recently used modules if the cache grows
+// beyond its maximum size. Modules installed from a local path are not copied to the working directory by Terraform,
+// and modules which aren't pinned to an immutable version can change, so neither are cached.
+func (c *Cache) StoreModule(ctx context.Context, workingDir, localModuleName, templatePath, templateVersion, credentialID string) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	if !isPinnedModule(templatePath, templateVersion) {
+		return nil
+	}
+
+	modulesDir := filepath.Join(workingDir, moduleRootDir)
+	manifest, err := readModuleManifest(modulesDir)
+	if err != nil {
+		return err
+	}
+
+	installed := false
+	for _, record := range manifest.Records {
+		if record.Key == localModuleName && strings.HasPrefix(record.Dir, moduleRootDir+"/") {
+			installed = true
+		}
+	}
+	if !installed {
+		return nil
+	}
+
+	modulesCacheDir := filepath.Join(c.dir, cacheModulesSubDir)
+	if err := os.MkdirAll(modulesCacheDir, workingDirFileMode); err != nil {
+		return fmt.Errorf("failed to create terraform module cache directory: %w", err)
+	}
+
+	// The module is copied to a temporary directory first so that a partially copied module is never restored.
+	tmpDir := filepath.Join(modulesCacheDir, ".tmp-"+uuid.NewString())
+	defer os.RemoveAll(tmpDir)
+	if err := copyModules(modulesDir, tmpDir, localModuleName, cachedModuleName); err != nil {
+		return fmt.Errorf("failed to store terraform module in the cache: %w", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entryDir := filepath.Join(modulesCacheDir, moduleKey(templatePath, templateVersion, credentialID))
+	if _, err := os.Stat(entryDir); err == nil {
+		// The module was stored by a concurrent execution.
+		return nil
+	}
+
+	if err := os.Rename(tmpDir, entryDir); err != nil {
+		return fmt.Errorf("failed to store terraform module in the cache: %w", err)
+	}
+	logger.Info(fmt.Sprintf("Stored Terraform module %q in the cache", templatePath))
+
+	return c.evictModules(ctx)
+}
+
+// TerraformExecPath returns the path to the cached Terraform executable of the given version, or of the latest version
+// if the version is empty. It returns false if the executable is not in the cache, or if the cached latest version was
+// downloaded too long ago to still be the latest version.
+func (c *Cache) TerraformExecPath(version string) (string, bool) {
+	execPath := c.terraformExecPath(version)
+	info, err := os.Stat(execPath)
+	if err != nil || !info.Mode().IsRegular() {
+		return "", false
+	}
+
+	if version == "" && time.Since(info.ModTime()) > latestTerraformMaxAge {
+		return "", false
+	}
+
+	return execPath, true
+}
+
+// StoreTerraform copies the Terraform executable of the given version, or of the latest version if the version is
+// empty, to the cache and returns the path to the cached executable.
+func (c *Cache) StoreTerraform(ctx context.Context, version, execPath string) (string, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	cachedPath := c.terraformExecPath(version)
+	if err := os.MkdirAll(filepath.Dir(cachedPath), workingDirFileMode); err != nil {
+		return "", fmt.Errorf("failed to create terraform executable cache directory: %w", err)
+	}
+
+	// The executable is copied to a temporary file first so that a partially copied executable is never used. Renaming
+	// it over a cached executable doesn't affect the executions which are already running it.
+	tmpPath := cachedPath + ".tmp-" + uuid.NewString()
+	defer os.Remove(tmpPath)
+	if err := copyFile(execPath, tmpPath, 0755); err != nil {
+		return "", fmt.Errorf("failed to store terraform executable in the cache: %w", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := os.Rename(tmpPath, cachedPath); err != nil {
+		return "", fmt.Errorf("failed to store terraform executable in the cache: %w", err)
+	}
+	logger.Info(fmt.Sprintf("Stored Terraform executable %q in the cache", cachedPath))
+
+	return cachedPath, nil
+}
+
+// terraformExecPath returns the path to the Terraform executable of the given version in the cache.
+func (c *Cache) terraformExecPath(version string) string {
+	if version == "" {
+		version = latestTerraformVersion
+	}
+	return filepath.Join(c.dir, cacheTerraformSubDir, version, "terraform")
+}
+
+// evictModules removes the least recently used modules from the cache until the size of the cached modules is within
+// the maximum size of the cache. It must be called with the lock held.
+func (c *Cache) evictModules(ctx context.Context) error {
+	if c.maxSizeBytes <= 0 {
+		return nil
+	}
+
+	modulesCacheDir := filepath.Join(c.dir, cacheModulesSubDir)
+	entries, err := os.ReadDir(modulesCacheDir)
+	if err != nil {
+		return err
+	}
+
+	modules := []string{}
+	for _, entry := range entries {
+		if entry.IsDir() && !strings.HasPrefix(entry.Name(), ".tmp-") {
+			modules = append(modules, entry.Name())
+		}
+	}
+
+	return c.evictLeastRecentlyUsed(ctx, "module", modulesCacheDir, modules)
+}
+
+// evictProviders removes the least recently used provider versions from the plugin cache until the size of the cached
+// providers is within the maximum size of the cache. It must be called with the lock held.
+func (c *Cache) evictProviders(ctx context.Context) error {
+	if c.maxSizeBytes <= 0 {
+		return nil
+	}
+
+	pluginCacheDir := filepath.Join(c.dir, cachePluginsSubDir)
+	providers, err := findProviderDirs(pluginCacheDir)
+	if err != nil {
+		return err
+	}
+
+	return c.evictLeastRecentlyUsed(ctx, "provider", pluginCacheDir, providers)
+}
+
+// evictLeastRecentlyUsed removes the least recently used of the given entries, which are directories relative to the
+// given root directory, until their total size is within the maximum size of the cache. The last use of an entry is
+// the modification time of its directory.
+func (c *Cache) evictLeastRecentlyUsed(ctx context.Context, kind, root string, entries []string) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	type cacheEntry struct {
+		name     string
+		size     int64
+		lastUsed time.Time
+	}
+
+	cached := []cacheEntry{}
+	var total int64
+	for _, name := range entries {
+		dir := filepath.Join(root, name)
+		info, err := os.Stat(dir)
+		if err != nil {
+			return err
+		}
+
+		size, err := dirSize(dir)
+		if err != nil {
+			return err
+		}
+
+		cached = append(cached, cacheEntry{name: name, size: size, lastUsed: info.ModTime()})
+		total += size
+	}
+
+	sort.Slice(cached, func(i, j int) bool {
+		return cached[i].lastUsed.Before(cached[j].lastUsed)
+	})
+
+	for _, entry := range cached {
+		if total <= c.maxSizeBytes {
+			break
+		}
+
+		logger.Info(fmt.Sprintf("Evicting Terraform %s %q from the cache", kind, entry.name))
+		if err := os.RemoveAll(filepath.Join(root, entry.name)); err != nil {
+			return fmt.Errorf("failed to evict terraform %s from the cache: %w", kind, err)
+		}
+		total -= entry.size
+	}
+
+	return nil
+}
+
+// findProviderDirs returns the directories of the provider versions in the given providers directory, relative to it.
+// It returns no directories if the providers directory doesn't exist.
+func findProviderDirs(root string) ([]string, error) {
+	dirs := []string{}
+	if _, err := os.Stat(root); errors.Is(err, fs.ErrNotExist) {
+		return dirs, nil
+	}
+
+	err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+
+		if rel == "." || !d.IsDir() {
+			return nil
+		}
+
+		if len(strings.Split(rel, string(filepath.Separator))) == providerDirDepth {
+			dirs = append(dirs, rel)
+			return fs.SkipDir
+		}
+		return nil
+	})
+
+	return dirs, err
+}
+
+// readModuleManifest reads the module manifest in the given modules directory.
+func readModuleManifest(modulesDir string) (*moduleManifest, error) {
+	b, err := os.ReadFile(filepath.Join(modulesDir, moduleManifestFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read terraform module manifest: %w", err)
+	}
+
+	manifest := &moduleManifest{}
+	if err := json.Unmarshal(b, manifest); err != nil {
+		return nil, fmt.Errorf("failed to read terraform module manifest: %w", err)
+	}
+
+	return manifest, nil
+}
+
+// copyModules copies the modules installed in srcDir to dstDir, renaming the module installed under the local name
+// from, along with its nested modules, to the local name to. The module manifest is updated to match.
+func copyModules(srcDir, dstDir, from, to string) error {
+	manifest, err := readModuleManifest(srcDir)
+	if err != nil {
+		return err
+	}
+
+	for i, record := range manifest.Records {
+		manifest.Records[i].Key = renameModuleKey(record.Key, from, to)
+		if rel, ok := strings.CutPrefix(record.Dir, moduleRootDir+"/"); ok {
+			segments := strings.SplitN(rel, "/", 2)
+			segments[0] = renameModuleKey(segments[0], from, to)
+			manifest.Records[i].Dir = path.Join(moduleRootDir, path.Join(segments...))
+		}
+	}
+
+	entries, err := os.ReadDir(srcDir)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dstDir, workingDirFileMode); err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if entry.Name() == moduleManifestFile {
+			continue
+		}
+
+		if err := copyPath(filepath.Join(srcDir, entry.Name()), filepath.Join(dstDir, renameModuleKey(entry.Name(), from, to))); err != nil {
+			return err
+		}
+	}
+
+	b, err := json.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dstDir, moduleManifestFile), b, 0600)
+}
+
+// renameModuleKey renames the module key of the module installed under the local name from, or one of its nested
+// modules, to the local name to.
+func renameModuleKey(key, from, to string) string {
+	if key == from {
+		return to
+	}
+
+	if rest, ok := strings.CutPrefix(key, from+"."); ok {
+		return to + "." + rest
+	}
+
+	return key
+}
+
+// copyPath recursively copies the file, directory or symbolic link at src to dst.
+func copyPath(src, dst string) error {
+	info, err := os.Lstat(src)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case info.Mode()&fs.ModeSymlink != 0:
+		target, err := os.Readlink(src)
+		if err != nil {
+			return err
+		}
+		return os.Symlink(target, dst)
+
+	case info.IsDir():
+		if err := os.MkdirAll(dst, info.Mode().Perm()|0700); err != nil {
+			return err
+		}
+
+		entries, err := os.ReadDir(src)
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+			if err := copyPath(filepath.Join(src, entry.Name()), filepath.Join(dst, entry.Name())); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	default:
+		return copyFile(src, dst, info.Mode().Perm())
+	}
+}
+
+// copyFile copies the regular file at src to dst with the given permissions.
+func copyFile(src, dst string, perm fs.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+
+	return out.Close()
+}
+
+// dirSize returns the total size of the regular files in the given directory.
+func dirSize(dir string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(dir, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.Type().IsRegular() {
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			size += info.Size()
+		}
+		return nil
+	})
+
+	return size, err
+}
@@ -0,0 +1,347 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testTemplatePath    = "Azure/redis/azurerm"
+	testTemplateVersion = "1.0.0"
+)
+
+// writeInstalledModule simulates terraform get installing a remote module with a nested remote module under the
+// given local module name in the working directory.
+func writeInstalledModule(t *testing.T, workingDir, localModuleName, content string) {
+	modulesDir := filepath.Join(workingDir, moduleRootDir)
+	require.NoError(t, os.MkdirAll(filepath.Join(modulesDir, localModuleName), 0700))
+	require.NoError(t, os.MkdirAll(filepath.Join(modulesDir, localModuleName+".network"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(modulesDir, localModuleName, "main.tf"), []byte(content), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(modulesDir, localModuleName+".network", "main.tf"), []byte("network"), 0600))
+
+	writeModuleManifest(t, modulesDir, moduleManifest{
+		Records: []moduleRecord{
+			{Key: "", Source: "", Dir: "."},
+			{Key: localModuleName, Source: "registry.terraform.io/" + testTemplatePath, Version: testTemplateVersion, Dir: moduleRootDir + "/" + localModuleName},
+			{Key: localModuleName + ".network", Source: "registry.terraform.io/Azure/network/azurerm", Version: "2.0.0", Dir: moduleRootDir + "/" + localModuleName + ".network"},
+		},
+	})
+}
+
+func writeModuleManifest(t *testing.T, modulesDir string, manifest moduleManifest) {
+	b, err := json.Marshal(manifest)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(modulesDir, moduleManifestFile), b, 0600))
+}
+
+func Test_Cache_StoreAndRestoreModule(t *testing.T) {
+	ctx := context.Background()
+	cache := NewCache(t.TempDir(), 0)
+
+	// Module is not cached yet.
+	restoreDir := t.TempDir()
+	restored, err := cache.RestoreModule(ctx, restoreDir, "redis-cache", testTemplatePath, testTemplateVersion, "")
+	require.NoError(t, err)
+	require.False(t, restored)
+
+	storeDir := t.TempDir()
+	writeInstalledModule(t, storeDir, "redis", "redis module")
+	err = cache.StoreModule(ctx, storeDir, "redis", testTemplatePath, testTemplateVersion, "")
+	require.NoError(t, err)
+
+	// Module is restored under the local module name of the recipe restoring it.
+	restored, err = cache.RestoreModule(ctx, restoreDir, "redis-cache", testTemplatePath, testTemplateVersion, "")
+	require.NoError(t, err)
+	require.True(t, restored)
+
+	modulesDir := filepath.Join(restoreDir, moduleRootDir)
+	b, err := os.ReadFile(filepath.Join(modulesDir, "redis-cache", "main.tf"))
+	require.NoError(t, err)
+	require.Equal(t, "redis module", string(b))
+	require.DirExists(t, filepath.Join(modulesDir, "redis-cache.network"))
+
+	manifest, err := readModuleManifest(modulesDir)
+	require.NoError(t, err)
+	require.Equal(t, []moduleRecord{
+		{Key: "", Source: "", Dir: "."},
+		{Key: "redis-cache", Source: "registry.terraform.io/" + testTemplatePath, Version: testTemplateVersion, Dir: moduleRootDir + "/redis-cache"},
+		{Key: "redis-cache.network", Source: "registry.terraform.io/Azure/network/azurerm", Version: "2.0.0", Dir: moduleRootDir + "/redis-cache.network"},
+	}, manifest.Records)
+
+	// A different version of the module is cached separately.
+	restored, err = cache.RestoreModule(ctx, t.TempDir(), "redis-cache", testTemplatePath, "2.0.0", "")
+	require.NoError(t, err)
+	require.False(t, restored)
+}
+
+func Test_Cache_RestoreModule_Credentials(t *testing.T) {
+	ctx := context.Background()
+	cache := NewCache(t.TempDir(), 0)
+
+	privateCredentialID := ModuleCredentialID(datamodel.TerraformAuthentication{
+		Registry: map[string]datamodel.SecretReference{
+			"app.terraform.io": {Secret: testRegistrySecretID},
+		},
+	})
+	otherCredentialID := ModuleCredentialID(datamodel.TerraformAuthentication{
+		Registry: map[string]datamodel.SecretReference{
+			"app.terraform.io": {Secret: testGitSecretID},
+		},
+	})
+
+	storeDir := t.TempDir()
+	writeInstalledModule(t, storeDir, "redis", "private redis module")
+	err := cache.StoreModule(ctx, storeDir, "redis", testTemplatePath, testTemplateVersion, privateCredentialID)
+	require.NoError(t, err)
+
+	// A module downloaded with credentials is not restored without them or with other credentials.
+	restored, err := cache.RestoreModule(ctx, t.TempDir(), "redis", testTemplatePath, testTemplateVersion, "")
+	require.NoError(t, err)
+	require.False(t, restored)
+
+	restored, err = cache.RestoreModule(ctx, t.TempDir(), "redis", testTemplatePath, testTemplateVersion, otherCredentialID)
+	require.NoError(t, err)
+	require.False(t, restored)
+
+	restored, err = cache.RestoreModule(ctx, t.TempDir(), "redis", testTemplatePath, testTemplateVersion, privateCredentialID)
+	require.NoError(t, err)
+	require.True(t, restored)
+}
+
+func Test_Cache_StoreModule_LocalModule(t *testing.T) {
+	ctx := context.Background()
+	cache := NewCache(t.TempDir(), 0)
+
+	// Terraform doesn't copy modules installed from a local path to the working directory.
+	workingDir := t.TempDir()
+	modulesDir := filepath.Join(workingDir, moduleRootDir)
+	require.NoError(t, os.MkdirAll(modulesDir, 0700))
+	writeModuleManifest(t, modulesDir, moduleManifest{
+		Records: []moduleRecord{
+			{Key: "", Source: "", Dir: "."},
+			{Key: "redis", Source: "/recipes/redis", Dir: "/recipes/redis"},
+		},
+	})
+
+	err := cache.StoreModule(ctx, workingDir, "redis", "/recipes/redis", "", "")
+	require.NoError(t, err)
+
+	restored, err := cache.RestoreModule(ctx, t.TempDir(), "redis", "/recipes/redis", "", "")
+	require.NoError(t, err)
+	require.False(t, restored)
+}
+
+func Test_Cache_Evict(t *testing.T) {
+	ctx := context.Background()
+	content := strings.Repeat("x", 1024)
+
+	// Each cached module is a bit larger than 1KB, so only a single module fits in the cache.
+	cache := NewCache(t.TempDir(), 1500)
+
+	storeDir := t.TempDir()
+	writeInstalledModule(t, storeDir, "redis", content)
+	require.NoError(t, cache.StoreModule(ctx, storeDir, "redis", testTemplatePath, "1.0.0", ""))
+
+	// Make sure the first module is the least recently used one.
+	past := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(filepath.Join(cache.dir, cacheModulesSubDir, moduleKey(testTemplatePath, "1.0.0", "")), past, past))
+
+	storeDir = t.TempDir()
+	writeInstalledModule(t, storeDir, "redis", content)
+	require.NoError(t, cache.StoreModule(ctx, storeDir, "redis", testTemplatePath, "2.0.0", ""))
+
+	restored, err := cache.RestoreModule(ctx, t.TempDir(), "redis", testTemplatePath, "1.0.0", "")
+	require.NoError(t, err)
+	require.False(t, restored)
+
+	restored, err = cache.RestoreModule(ctx, t.TempDir(), "redis", testTemplatePath, "2.0.0", "")
+	require.NoError(t, err)
+	require.True(t, restored)
+}
+
+// writeCachedProvider simulates terraform init installing the given provider version through the plugin cache of the
+// given cache in the working directory.
+func writeCachedProvider(t *testing.T, cache *Cache, workingDir, provider, content string) {
+	pluginCacheDir, err := cache.PluginCacheDir()
+	require.NoError(t, err)
+
+	cachedDir := filepath.Join(pluginCacheDir, provider, "linux_amd64")
+	require.NoError(t, os.MkdirAll(cachedDir, 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(cachedDir, "terraform-provider"), []byte(content), 0600))
+
+	installedDir := filepath.Join(workingDir, providerRootDir, provider)
+	require.NoError(t, os.MkdirAll(installedDir, 0700))
+	require.NoError(t, os.Symlink(cachedDir, filepath.Join(installedDir, "linux_amd64")))
+}
+
+func Test_Cache_EvictProviders(t *testing.T) {
+	ctx := context.Background()
+	content := strings.Repeat("x", 1024)
+	azurerm := "registry.terraform.io/hashicorp/azurerm/3.0.0"
+	aws := "registry.terraform.io/hashicorp/aws/5.0.0"
+
+	// Each cached provider is 1KB, so only a single provider fits in the cache.
+	cache := NewCache(t.TempDir(), 1500)
+	pluginCacheDir := filepath.Join(cache.dir, cachePluginsSubDir)
+
+	firstDir := t.TempDir()
+	writeCachedProvider(t, cache, firstDir, azurerm, content)
+	require.NoError(t, cache.ReleasePluginCacheDir(ctx, firstDir))
+	require.DirExists(t, filepath.Join(pluginCacheDir, azurerm))
+
+	// Make sure the first provider is the least recently used one.
+	past := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(filepath.Join(pluginCacheDir, azurerm), past, past))
+
+	// Providers are not evicted while another execution uses the plugin cache.
+	secondDir := t.TempDir()
+	writeCachedProvider(t, cache, secondDir, aws, content)
+	_, err := cache.PluginCacheDir()
+	require.NoError(t, err)
+	require.NoError(t, cache.ReleasePluginCacheDir(ctx, secondDir))
+	require.DirExists(t, filepath.Join(pluginCacheDir, azurerm))
+	require.DirExists(t, filepath.Join(pluginCacheDir, aws))
+
+	require.NoError(t, cache.ReleasePluginCacheDir(ctx, t.TempDir()))
+	require.NoDirExists(t, filepath.Join(pluginCacheDir, azurerm))
+	require.DirExists(t, filepath.Join(pluginCacheDir, aws))
+	require.Equal(t, 0, cache.pluginCacheUsers)
+}
+
+func Test_FindProviderDirs(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "registry.terraform.io", "hashicorp", "aws", "5.0.0", "linux_amd64"), 0700))
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "registry.terraform.io", "hashicorp", "azurerm", "3.0.0"), 0700))
+
+	dirs, err := findProviderDirs(root)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{
+		filepath.Join("registry.terraform.io", "hashicorp", "aws", "5.0.0"),
+		filepath.Join("registry.terraform.io", "hashicorp", "azurerm", "3.0.0"),
+	}, dirs)
+
+	dirs, err = findProviderDirs(filepath.Join(root, "missing"))
+	require.NoError(t, err)
+	require.Empty(t, dirs)
+}
+
+func Test_RenameModuleKey(t *testing.T) {
+	require.Equal(t, "module", renameModuleKey("redis", "redis", "module"))
+	require.Equal(t, "module.network", renameModuleKey("redis.network", "redis", "module"))
+	require.Equal(t, "redis-cache", renameModuleKey("redis-cache", "redis", "module"))
+	require.Equal(t, "", renameModuleKey("", "redis", "module"))
+}
+
+func Test_Cache_StoreModule_UnpinnedModule(t *testing.T) {
+	ctx := context.Background()
+	cache := NewCache(t.TempDir(), 0)
+
+	templatePath := "git::https://dev.azure.com/project/_git/module?ref=main"
+	storeDir := t.TempDir()
+	writeInstalledModule(t, storeDir, "redis", "redis module")
+	err := cache.StoreModule(ctx, storeDir, "redis", templatePath, "", "")
+	require.NoError(t, err)
+
+	restored, err := cache.RestoreModule(ctx, t.TempDir(), "redis", templatePath, "", "")
+	require.NoError(t, err)
+	require.False(t, restored)
+	require.NoDirExists(t, filepath.Join(cache.dir, cacheModulesSubDir, moduleKey(templatePath, "", "")))
+}
+
+func Test_IsPinnedModule(t *testing.T) {
+	sha := "0123456789abcdef0123456789abcdef01234567"
+	tests := []struct {
+		templatePath    string
+		templateVersion string
+		pinned          bool
+	}{
+		{"Azure/redis/azurerm", "1.0.0", true},
+		{"Azure/redis/azurerm", "= 1.0.0", true},
+		{"Azure/redis/azurerm", "v1.0.0-beta.1", true},
+		{"Azure/redis/azurerm", "", false},
+		{"Azure/redis/azurerm", "~> 1.0", false},
+		{"Azure/redis/azurerm", ">= 1.0.0", false},
+		{"app.terraform.io/org/redis/azurerm", "1.0.0", true},
+		{"hashicorp/consul/aws//modules/consul-cluster", "0.1.0", true},
+		{"git::https://dev.azure.com/project/_git/module?ref=main", "", false},
+		{"git::https://dev.azure.com/project/_git/module?ref=v1.0.0", "", false},
+		{"git::https://dev.azure.com/project/_git/module", "", false},
+		{"git::https://dev.azure.com/project/_git/module//redis?ref=" + sha, "", true},
+		{"git::ssh://git@github.com/org/repo.git?depth=1&ref=" + sha, "", true},
+		{"github.com/org/repo//redis?ref=" + sha, "", true},
+		{"github.com/org/repo/redis", "1.0.0", false},
+		{"git@github.com:org/repo.git?ref=" + sha, "", true},
+		{"https://example.com/redis.zip", "", false},
+		{"s3::https://s3.amazonaws.com/bucket/redis.zip", "", false},
+		{"/recipes/redis", "", false},
+		{"./redis", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.templatePath+"@"+tt.templateVersion, func(t *testing.T) {
+			require.Equal(t, tt.pinned, isPinnedModule(tt.templatePath, tt.templateVersion))
+		})
+	}
+}
+
+func Test_Cache_StoreAndGetTerraform(t *testing.T) {
+	ctx := testcontext.New(t)
+	cache := NewCache(t.TempDir(), 0)
+
+	_, ok := cache.TerraformExecPath("1.6.0")
+	require.False(t, ok)
+
+	execPath := filepath.Join(t.TempDir(), "terraform")
+	require.NoError(t, os.WriteFile(execPath, []byte("terraform"), 0755))
+
+	for _, version := range []string{"1.6.0", ""} {
+		cachedPath, err := cache.StoreTerraform(ctx, version, execPath)
+		require.NoError(t, err)
+
+		path, ok := cache.TerraformExecPath(version)
+		require.True(t, ok)
+		require.Equal(t, cachedPath, path)
+
+		b, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.Equal(t, "terraform", string(b))
+	}
+
+	// The cached latest version is downloaded again once it is too old to still be the latest version.
+	old := time.Now().Add(-2 * latestTerraformMaxAge)
+	latestPath, _ := cache.TerraformExecPath("")
+	require.NoError(t, os.Chtimes(latestPath, old, old))
+	_, ok = cache.TerraformExecPath("")
+	require.False(t, ok)
+
+	// Pinned versions don't expire.
+	versionPath, _ := cache.TerraformExecPath("1.6.0")
+	require.NoError(t, os.Chtimes(versionPath, old, old))
+	_, ok = cache.TerraformExecPath("1.6.0")
+	require.True(t, ok)
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-exec/tfexec"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// cliConfigFileName is the name of the Terraform CLI configuration file generated in the working directory.
+	cliConfigFileName = ".terraformrc"
+
+	// cliConfigFileEnvVar is the environment variable pointing Terraform to the CLI configuration file.
+	cliConfigFileEnvVar = "TF_CLI_CONFIG_FILE"
+)
+
+// configureProviderInstallation generates a Terraform CLI configuration file in the working directory which installs
+// the providers through the plugin cache of the given cache, and only from the given offline mirror directory if it is
+// set. The environment variable pointing Terraform to the generated file is added to the given environment. Nothing
+// is configured if neither is set. It returns a function releasing the plugin cache, which must be called once
+// Terraform is no longer run in the working directory.
+// https://developer.hashicorp.com/terraform/cli/config/config-file
+func configureProviderInstallation(ctx context.Context, workingDir string, cache *Cache, mirrorPath string, env map[string]string) (func(), error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	release := func() {}
+	if cache == nil && mirrorPath == "" {
+		return release, nil
+	}
+
+	var b strings.Builder
+	if cache != nil {
+		pluginCacheDir, err := cache.PluginCacheDir()
+		if err != nil {
+			return release, err
+		}
+
+		release = func() {
+			if err := cache.ReleasePluginCacheDir(ctx, workingDir); err != nil {
+				logger.Info(fmt.Sprintf("Failed to release the Terraform plugin cache: %s", err.Error()))
+			}
+		}
+
+		// Every execution uses a new working directory without a dependency lock file, so Terraform would never use
+		// the plugin cache unless it is allowed to record the checksums of the cached providers in the lock file.
+		fmt.Fprintf(&b, "plugin_cache_dir = %q\n", pluginCacheDir)
+		fmt.Fprintf(&b, "plugin_cache_may_break_dependency_lock_file = true\n")
+	}
+
+	if mirrorPath != "" {
+		logger.Info(fmt.Sprintf("Installing Terraform providers from the offline mirror: %q", mirrorPath))
+		fmt.Fprintf(&b, "provider_installation {\n  filesystem_mirror {\n    path = %q\n  }\n}\n", mirrorPath)
+	}
+
+	configFile := filepath.Join(workingDir, cliConfigFileName)
+	if err := os.WriteFile(configFile, []byte(b.String()), 0600); err != nil {
+		release()
+		return func() {}, fmt.Errorf("failed to write terraform CLI configuration file: %w", err)
+	}
+
+	env[cliConfigFileEnvVar] = configFile
+	return release, nil
+}
+
+// newTerraformEnv returns the environment of the current process, which is the base environment of Terraform.
+func newTerraformEnv() map[string]string {
+	env := map[string]string{}
+	for _, kv := range os.Environ() {
+		k, v, _ := strings.Cut(kv, "=")
+		env[k] = v
+	}
+
+	return env
+}
+
+// setTerraformEnv sets the environment of Terraform and returns it as a list of key=value pairs, which can be used to
+// run Terraform directly.
+func setTerraformEnv(tf *tfexec.Terraform, env map[string]string) ([]string, error) {
+
+	// terraform-exec manages some of the Terraform environment variables itself and rejects them.
+	for _, k := range tfexec.ProhibitedEnv(env) {
+		delete(env, k)
+	}
+
+	if err := tf.SetEnv(env); err != nil {
+		return nil, err
+	}
+
+	envList := make([]string, 0, len(env))
+	for k, v := range env {
+		envList = append(envList, k+"="+v)
+	}
+
+	return envList, nil
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-exec/tfexec"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ConfigureProviderInstallation(t *testing.T) {
+	t.Run("no cache and mirror", func(t *testing.T) {
+		workingDir := t.TempDir()
+		env := map[string]string{}
+
+		release, err := configureProviderInstallation(context.Background(), workingDir, nil, "", env)
+		require.NoError(t, err)
+		release()
+		require.NoFileExists(t, filepath.Join(workingDir, cliConfigFileName))
+		require.Empty(t, env)
+	})
+
+	t.Run("cache and mirror", func(t *testing.T) {
+		workingDir := t.TempDir()
+		env := map[string]string{}
+
+		cacheDir := t.TempDir()
+		cache := NewCache(cacheDir, 0)
+		release, err := configureProviderInstallation(context.Background(), workingDir, cache, "/terraform/mirror", env)
+		require.NoError(t, err)
+		require.Equal(t, 1, cache.pluginCacheUsers)
+
+		b, err := os.ReadFile(filepath.Join(workingDir, cliConfigFileName))
+		require.NoError(t, err)
+
+		expected := `plugin_cache_dir = "` + filepath.Join(cacheDir, cachePluginsSubDir) + `"
+plugin_cache_may_break_dependency_lock_file = true
+provider_installation {
+  filesystem_mirror {
+    path = "/terraform/mirror"
+  }
+}
+`
+		require.Equal(t, expected, string(b))
+		require.DirExists(t, filepath.Join(cacheDir, cachePluginsSubDir))
+		require.Equal(t, map[string]string{cliConfigFileEnvVar: filepath.Join(workingDir, cliConfigFileName)}, env)
+
+		release()
+		require.Equal(t, 0, cache.pluginCacheUsers)
+	})
+}
+
+func Test_SetTerraformEnv(t *testing.T) {
+	workingDir := t.TempDir()
+	tf, err := tfexec.NewTerraform(workingDir, filepath.Join(workingDir, "terraform"))
+	require.NoError(t, err)
+
+	env, err := setTerraformEnv(tf, map[string]string{
+		cliConfigFileEnvVar: "/terraform/.terraformrc",
+		"TF_LOG":            "TRACE",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{cliConfigFileEnvVar + "=/terraform/.terraformrc"}, env)
+}
@@ -0,0 +1,231 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+var _ Backend = (*azureRMBackend)(nil)
+
+// Configuration of the azurerm backend. Other properties of the configuration are passed to Terraform as is.
+// https://developer.hashicorp.com/terraform/language/settings/backends/azurerm
+const (
+	BackendAzureRM = "azurerm"
+
+	azureRMStorageAccountNameParam = "storage_account_name"
+	azureRMContainerNameParam      = "container_name"
+	azureRMKeyParam                = "key"
+	azureRMUseAzureADAuthParam     = "use_azuread_auth"
+
+	// azureRMKeyPrefixParam is the prefix of the names of the state blobs in the container. Defaults to "radius".
+	azureRMKeyPrefixParam = "key_prefix"
+
+	// Environment variables which Terraform reads the Azure AD service principal from.
+	armClientIDEnv     = "ARM_CLIENT_ID"
+	armClientSecretEnv = "ARM_CLIENT_SECRET"
+	armTenantIDEnv     = "ARM_TENANT_ID"
+
+	azureStorageScope      = "https://storage.azure.com/.default"
+	azureStorageAPIVersion = "2021-08-06"
+)
+
+type azureRMBackend struct {
+	config      map[string]string
+	credentials *credentials.AzureCredential
+	tokenCred   azcore.TokenCredential
+	httpClient  *http.Client
+
+	// blobEndpoint is the endpoint of the blob service of the storage account.
+	blobEndpoint string
+}
+
+// NewAzureRMBackend creates a new Backend which stores the Terraform state file in an Azure storage blob.
+// The storage account and the container are required in the configuration. The state blob is accessed with
+// the Azure AD service principal registered in UCP. Returns an error if the configuration is invalid or the
+// Azure credentials are empty.
+func NewAzureRMBackend(config map[string]string, azureCredentials *credentials.AzureCredential) (Backend, error) {
+	if err := requireConfig(BackendAzureRM, config, azureRMStorageAccountNameParam, azureRMContainerNameParam); err != nil {
+		return nil, err
+	}
+
+	if azureCredentials == nil || azureCredentials.TenantID == "" || azureCredentials.ClientID == "" || azureCredentials.ClientSecret == "" {
+		return nil, fmt.Errorf("Azure credentials are required to store the Terraform state in the %s backend", BackendAzureRM)
+	}
+
+	tokenCred, err := azidentity.NewClientSecretCredential(azureCredentials.TenantID, azureCredentials.ClientID, azureCredentials.ClientSecret, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &azureRMBackend{
+		config:       config,
+		credentials:  azureCredentials,
+		tokenCred:    tokenCred,
+		httpClient:   http.DefaultClient,
+		blobEndpoint: fmt.Sprintf("https://%s.blob.core.windows.net", config[azureRMStorageAccountNameParam]),
+	}, nil
+}
+
+// BuildBackend generates the Terraform backend configuration for azurerm backend. The state file of the resource recipe
+// is stored in the configured container as the blob "{key_prefix}/{hash}.tfstate".
+// https://developer.hashicorp.com/terraform/language/settings/backends/azurerm
+func (p *azureRMBackend) BuildBackend(resourceRecipe *recipes.ResourceMetadata) (map[string]any, error) {
+	key, err := p.StateName(resourceRecipe)
+	if err != nil {
+		return nil, err
+	}
+
+	backend := map[string]any{}
+	for k, v := range p.config {
+		if k != azureRMKeyPrefixParam {
+			backend[k] = v
+		}
+	}
+
+	backend[azureRMKeyParam] = key
+	backend[azureRMUseAzureADAuthParam] = true
+
+	return map[string]any{BackendAzureRM: backend}, nil
+}
+
+// Env returns the Azure AD service principal, which Terraform reads from ARM_CLIENT_ID, ARM_CLIENT_SECRET and ARM_TENANT_ID.
+func (p *azureRMBackend) Env() map[string]string {
+	return map[string]string{
+		armClientIDEnv:     p.credentials.ClientID,
+		armClientSecretEnv: p.credentials.ClientSecret,
+		armTenantIDEnv:     p.credentials.TenantID,
+	}
+}
+
+// StateName returns the name of the blob which stores the Terraform state file of the given resource recipe.
+func (p *azureRMBackend) StateName(resourceRecipe *recipes.ResourceMetadata) (string, error) {
+	suffix, err := generateSecretSuffix(resourceRecipe)
+	if err != nil {
+		return "", err
+	}
+
+	prefix := p.config[azureRMKeyPrefixParam]
+	if prefix == "" {
+		prefix = defaultKeyPrefix
+	}
+
+	return path.Join(prefix, suffix+".tfstate"), nil
+}
+
+// ValidateBackendExists checks if the blob with the given name exists in the container.
+func (p *azureRMBackend) ValidateBackendExists(ctx context.Context, name string) (bool, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	statusCode, err := p.do(ctx, http.MethodHead, name, "", nil)
+	if err != nil {
+		return false, err
+	}
+
+	switch statusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		logger.Info(fmt.Sprintf("Terraform state blob %q does not exist in container %q", name, p.config[azureRMContainerNameParam]))
+		return false, nil
+	default:
+		return false, fmt.Errorf("failed to retrieve Terraform state blob %q from container %q: unexpected status code %d", name, p.config[azureRMContainerNameParam], statusCode)
+	}
+}
+
+// DeleteState deletes the blob with the given name from the container.
+func (p *azureRMBackend) DeleteState(ctx context.Context, name string) error {
+	statusCode, err := p.do(ctx, http.MethodDelete, name, "", nil)
+	if err != nil {
+		return err
+	}
+
+	if statusCode != http.StatusAccepted && statusCode != http.StatusNotFound {
+		return fmt.Errorf("failed to delete Terraform state blob %q from container %q: unexpected status code %d", name, p.config[azureRMContainerNameParam], statusCode)
+	}
+
+	return nil
+}
+
+// ReleaseLock breaks the lease which Terraform acquires on the blob with the given name to lock the state.
+// Returns nil if the blob doesn't exist or is not leased.
+func (p *azureRMBackend) ReleaseLock(ctx context.Context, name string) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	statusCode, err := p.do(ctx, http.MethodPut, name, "comp=lease", map[string]string{
+		"x-ms-lease-action":       "break",
+		"x-ms-lease-break-period": "0",
+	})
+	if err != nil {
+		return err
+	}
+
+	switch statusCode {
+	case http.StatusAccepted:
+		return nil
+	case http.StatusNotFound, http.StatusConflict:
+		logger.Info(fmt.Sprintf("Terraform state blob %q is not leased", name))
+		return nil
+	default:
+		return fmt.Errorf("failed to break the lease of Terraform state blob %q: unexpected status code %d", name, statusCode)
+	}
+}
+
+// do sends an authorized request with the given method for the blob with the given name and returns the response status code.
+func (p *azureRMBackend) do(ctx context.Context, method string, name string, query string, headers map[string]string) (int, error) {
+	u, err := url.Parse(p.blobEndpoint)
+	if err != nil {
+		return 0, err
+	}
+	u.Path = path.Join("/", u.Path, p.config[azureRMContainerNameParam], name)
+	u.RawQuery = query
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), nil)
+	if err != nil {
+		return 0, err
+	}
+
+	token, err := p.tokenCred.GetToken(ctx, policy.TokenRequestOptions{Scopes: []string{azureStorageScope}})
+	if err != nil {
+		return 0, err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token.Token)
+	req.Header.Set("x-ms-version", azureStorageAPIVersion)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/stretchr/testify/require"
+)
+
+var testAzureCredentials = &credentials.AzureCredential{
+	TenantID:     "test-tenant-id",
+	ClientID:     "test-client-id",
+	ClientSecret: "test-client-secret",
+}
+
+func Test_NewAzureRMBackend_InvalidConfig(t *testing.T) {
+	_, err := NewAzureRMBackend(map[string]string{"container_name": "tfstate"}, testAzureCredentials)
+	require.EqualError(t, err, "\"storage_account_name\" is required in the configuration of the azurerm Terraform backend")
+
+	_, err = NewAzureRMBackend(map[string]string{"storage_account_name": "radius"}, testAzureCredentials)
+	require.EqualError(t, err, "\"container_name\" is required in the configuration of the azurerm Terraform backend")
+
+	_, err = NewAzureRMBackend(map[string]string{"storage_account_name": "radius", "container_name": "tfstate"}, &credentials.AzureCredential{ClientID: "test-client-id"})
+	require.EqualError(t, err, "Azure credentials are required to store the Terraform state in the azurerm backend")
+}
+
+func Test_AzureRMBackend_BuildBackend(t *testing.T) {
+	_, resourceRecipe := getTestInputs()
+	b, err := NewAzureRMBackend(map[string]string{
+		"storage_account_name": "radius",
+		"container_name":       "tfstate",
+		"resource_group_name":  "radius-rg",
+	}, testAzureCredentials)
+	require.NoError(t, err)
+
+	suffix, err := generateSecretSuffix(&resourceRecipe)
+	require.NoError(t, err)
+
+	config, err := b.BuildBackend(&resourceRecipe)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"azurerm": map[string]any{
+			"storage_account_name": "radius",
+			"container_name":       "tfstate",
+			"resource_group_name":  "radius-rg",
+			"key":                  "radius/" + suffix + ".tfstate",
+			"use_azuread_auth":     true,
+		},
+	}, config)
+
+	// The credentials are passed to Terraform through the environment instead of the configuration.
+	require.Equal(t, map[string]string{
+		"ARM_CLIENT_ID":     testAzureCredentials.ClientID,
+		"ARM_CLIENT_SECRET": testAzureCredentials.ClientSecret,
+		"ARM_TENANT_ID":     testAzureCredentials.TenantID,
+	}, b.Env())
+}
+
+func Test_AzureRMBackend(t *testing.T) {
+	blobs := map[string]bool{"/tfstate/radius/state.tfstate": true}
+	leased := map[string]bool{"/tfstate/radius/state.tfstate": true}
+	server := newFakeStorageServer(t, func(r *http.Request) int {
+		if r.Header.Get("x-ms-version") == "" {
+			return http.StatusBadRequest
+		}
+
+		switch r.Method {
+		case http.MethodHead:
+			if blobs[r.URL.Path] {
+				return http.StatusOK
+			}
+			return http.StatusNotFound
+		case http.MethodPut:
+			if r.URL.Query().Get("comp") != "lease" || r.Header.Get("x-ms-lease-action") != "break" {
+				return http.StatusBadRequest
+			}
+			if !blobs[r.URL.Path] {
+				return http.StatusNotFound
+			}
+			if !leased[r.URL.Path] {
+				return http.StatusConflict
+			}
+			delete(leased, r.URL.Path)
+			return http.StatusAccepted
+		case http.MethodDelete:
+			if leased[r.URL.Path] {
+				return http.StatusPreconditionFailed
+			}
+			if !blobs[r.URL.Path] {
+				return http.StatusNotFound
+			}
+			delete(blobs, r.URL.Path)
+			return http.StatusAccepted
+		}
+		return http.StatusMethodNotAllowed
+	})
+
+	b, err := NewAzureRMBackend(map[string]string{"storage_account_name": "radius", "container_name": "tfstate"}, testAzureCredentials)
+	require.NoError(t, err)
+	b.(*azureRMBackend).blobEndpoint = server.URL
+	b.(*azureRMBackend).tokenCred = &tokencredentials.AnonymousCredential{}
+
+	ctx := context.Background()
+	exists, err := b.ValidateBackendExists(ctx, "radius/state.tfstate")
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	// Validate error is returned when the state blob is leased.
+	err = b.DeleteState(ctx, "radius/state.tfstate")
+	require.ErrorContains(t, err, "unexpected status code 412")
+
+	err = b.ReleaseLock(ctx, "radius/state.tfstate")
+	require.NoError(t, err)
+
+	// Validate that the function returns no error when the state is not locked.
+	err = b.ReleaseLock(ctx, "radius/state.tfstate")
+	require.NoError(t, err)
+
+	err = b.DeleteState(ctx, "radius/state.tfstate")
+	require.NoError(t, err)
+
+	exists, err = b.ValidateBackendExists(ctx, "radius/state.tfstate")
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	err = b.ReleaseLock(ctx, "radius/state.tfstate")
+	require.NoError(t, err)
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	ucp_provider "github.com/radius-project/radius/pkg/ucp/secret/provider"
+	"k8s.io/client-go/kubernetes"
+)
+
+// defaultKeyPrefix is the default prefix of the names of the state files stored in the object storage backends.
+const defaultKeyPrefix = "radius"
+
+// NewBackend creates the Backend configured on the Environment to store the Terraform state files of the recipes.
+// The Kubernetes backend is used if no backend is configured. The credentials of the s3 and azurerm backends are
+// fetched from the UCP credential providers, and the credentials of the pg backend are read from the secret store
+// of the backend in the given secrets, keyed by the secret store ID. Returns an error if the backend kind is not
+// supported, the backend configuration is invalid or the credentials can't be fetched.
+func NewBackend(ctx context.Context, envConfig *recipes.Configuration, secrets map[string]map[string]string, k8sClientSet kubernetes.Interface, ucpConn sdk.Connection, secretProvider *ucp_provider.SecretProvider) (Backend, error) {
+	if envConfig == nil || envConfig.RecipeConfig.Terraform.Backend == nil {
+		return NewKubernetesBackend(k8sClientSet), nil
+	}
+
+	backend := envConfig.RecipeConfig.Terraform.Backend
+	for _, key := range recipes.TerraformBackendSecretConfig[backend.Kind] {
+		if _, ok := backend.Config[key]; ok {
+			return nil, fmt.Errorf("%q holds credentials and can't be set in the configuration of the %s Terraform backend", key, backend.Kind)
+		}
+	}
+
+	switch backend.Kind {
+	case "", recipes.TerraformBackendKubernetes:
+		return NewKubernetesBackend(k8sClientSet), nil
+	case recipes.TerraformBackendS3:
+		credentialsProvider, err := credentials.NewAWSCredentialProvider(secretProvider, ucpConn, &tokencredentials.AnonymousCredential{})
+		if err != nil {
+			return nil, err
+		}
+
+		awsCredentials, err := fetchCredentials[credentials.AWSCredential](ctx, credentialsProvider, credentials.AWSPublic, "AWS")
+		if err != nil {
+			return nil, err
+		}
+
+		return NewS3Backend(backend.Config, awsCredentials)
+	case recipes.TerraformBackendAzureRM:
+		credentialsProvider, err := credentials.NewAzureCredentialProvider(secretProvider, ucpConn, &tokencredentials.AnonymousCredential{})
+		if err != nil {
+			return nil, err
+		}
+
+		azureCredentials, err := fetchCredentials[credentials.AzureCredential](ctx, credentialsProvider, credentials.AzureCloud, "Azure")
+		if err != nil {
+			return nil, err
+		}
+
+		return NewAzureRMBackend(backend.Config, azureCredentials)
+	case recipes.TerraformBackendPostgres:
+		if backend.Secret == "" {
+			return nil, fmt.Errorf("a secret store holding the credentials is required by the %s Terraform backend", backend.Kind)
+		}
+
+		return NewPostgresBackend(backend.Config, secrets[backend.Secret])
+	case recipes.TerraformBackendLocal:
+		return NewLocalBackend(backend.Config)
+	default:
+		return nil, fmt.Errorf("unsupported Terraform backend kind %q", backend.Kind)
+	}
+}
+
+// fetchCredentials fetches the default credentials of the given plane from UCP. Returns an error if the credentials are not registered.
+func fetchCredentials[T any](ctx context.Context, provider credentials.CredentialProvider[T], planeName string, cloud string) (*T, error) {
+	c, err := provider.Fetch(ctx, planeName, "default")
+	if err != nil {
+		if errors.Is(err, &secret.ErrNotFound{}) {
+			return nil, fmt.Errorf("%s credentials are not registered, they are required to store the Terraform state", cloud)
+		}
+
+		return nil, fmt.Errorf("failed to fetch %s credentials: %w", cloud, err)
+	}
+
+	if c == nil {
+		return nil, fmt.Errorf("%s credentials are not registered, they are required to store the Terraform state", cloud)
+	}
+
+	return c, nil
+}
+
+// requireConfig checks that the given required properties are set in the backend configuration.
+func requireConfig(kind string, config map[string]string, keys ...string) error {
+	for _, key := range keys {
+		if config[key] == "" {
+			return fmt.Errorf("%q is required in the configuration of the %s Terraform backend", key, kind)
+		}
+	}
+
+	return nil
+}
@@ -45,14 +45,17 @@ const (
 	// https://developer.hashicorp.com/terraform/language/settings/backends/kubernetes
 	// https://developer.hashicorp.com/terraform/language/state/workspaces
 	KubernetesBackendNamePrefix = "tfstate-default-"
-)
 
-var _ Backend = (*kubernetesBackend)(nil)
+	// KubernetesBackendLockPrefix is the prefix added by Terraform to the name of the Kubernetes lease which is used to
+	// lock the state file stored in the Kubernetes secret.
+	KubernetesBackendLockPrefix = "lock-"
+)
 
 type kubernetesBackend struct {
 	k8sClientSet kubernetes.Interface
 }
 
+// NewKubernetesBackend creates a new Backend which stores the Terraform state file in a Kubernetes secret.
 func NewKubernetesBackend(k8sClientSet kubernetes.Interface) Backend {
 	return &kubernetesBackend{k8sClientSet: k8sClientSet}
 }
@@ -70,6 +73,11 @@ func (p *kubernetesBackend) BuildBackend(resourceRecipe *recipes.ResourceMetadat
 	return generateKubernetesBackendConfig(secretSuffix)
 }
 
+// Env returns no environment variables, Terraform accesses the Kubernetes secrets with the in-cluster configuration.
+func (p *kubernetesBackend) Env() map[string]string {
+	return nil
+}
+
 // ValidateBackendExists checks if the Kubernetes secret for Terraform state file exists.
 // name is the name of the backend Kubernetes secret resource that is created as a part of terraform apply
 // during recipe deployment.
@@ -89,6 +97,44 @@ func (p *kubernetesBackend) ValidateBackendExists(ctx context.Context, name stri
 	return true, nil
 }
 
+// StateName returns the name of the Kubernetes secret which Terraform creates to store the state file of the given resource recipe.
+func (p *kubernetesBackend) StateName(resourceRecipe *recipes.ResourceMetadata) (string, error) {
+	secretSuffix, err := generateSecretSuffix(resourceRecipe)
+	if err != nil {
+		return "", err
+	}
+
+	return KubernetesBackendNamePrefix + secretSuffix, nil
+}
+
+// DeleteState deletes the Kubernetes secret for Terraform state file with the given name.
+func (p *kubernetesBackend) DeleteState(ctx context.Context, name string) error {
+	err := p.k8sClientSet.CoreV1().Secrets(RadiusNamespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		return fmt.Errorf("error deleting kubernetes secret for terraform state: %w", err)
+	}
+
+	return nil
+}
+
+// ReleaseLock deletes the Kubernetes lease which Terraform uses to lock the state file stored in the Kubernetes secret
+// with the given name. Terraform creates the lease again when it locks the state next time.
+func (p *kubernetesBackend) ReleaseLock(ctx context.Context, name string) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	err := p.k8sClientSet.CoordinationV1().Leases(RadiusNamespace).Delete(ctx, KubernetesBackendLockPrefix+name, metav1.DeleteOptions{})
+	if err != nil {
+		if k8s_errors.IsNotFound(err) {
+			logger.Info(fmt.Sprintf("Kubernetes lease %q does not exist: %s", KubernetesBackendLockPrefix+name, err.Error()))
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
+
 // generateSecretSuffix returns a unique string from the resourceID, environmentID, and applicationID
 // which is used as key for kubernetes secret in defining terraform backend.
 func generateSecretSuffix(resourceRecipe *recipes.ResourceMetadata) (string, error) {
@@ -25,6 +25,7 @@ import (
 
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/stretchr/testify/require"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	v1 "k8s.io/api/core/v1"
 	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -156,3 +157,64 @@ func Test_ValidateBackendExists(t *testing.T) {
 	require.True(t, k8s_errors.IsServerTimeout(err))
 	require.False(t, exists)
 }
+
+func Test_ReleaseLock(t *testing.T) {
+	clientset := fake.NewSimpleClientset()
+	lease := &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      KubernetesBackendLockPrefix + "test-secret",
+			Namespace: RadiusNamespace,
+		},
+	}
+	_, err := clientset.CoordinationV1().Leases(RadiusNamespace).Create(context.Background(), lease, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	b := NewKubernetesBackend(clientset)
+	err = b.ReleaseLock(context.Background(), "test-secret")
+	require.NoError(t, err)
+
+	_, err = clientset.CoordinationV1().Leases(RadiusNamespace).Get(context.Background(), KubernetesBackendLockPrefix+"test-secret", metav1.GetOptions{})
+	require.True(t, k8s_errors.IsNotFound(err))
+
+	// Validate that the function returns no error when the state is not locked.
+	err = b.ReleaseLock(context.Background(), "test-secret")
+	require.NoError(t, err)
+
+	// Validate error is returned for errors other than NotFound.
+	clientset.Fake.PrependReactor("delete", "leases", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
+		return true, nil, k8s_errors.NewServerTimeout(schema.GroupResource{Resource: "test-lease"}, "delete", 1)
+	})
+	err = b.ReleaseLock(context.Background(), "test-secret")
+	require.Error(t, err)
+	require.True(t, k8s_errors.IsServerTimeout(err))
+}
+
+func Test_StateName(t *testing.T) {
+	_, resourceRecipe := getTestInputs()
+	suffix, err := generateSecretSuffix(&resourceRecipe)
+	require.NoError(t, err)
+
+	b := NewKubernetesBackend(fake.NewSimpleClientset())
+	name, err := b.StateName(&resourceRecipe)
+	require.NoError(t, err)
+	require.Equal(t, KubernetesBackendNamePrefix+suffix, name)
+}
+
+func Test_DeleteState(t *testing.T) {
+	clientset := fake.NewSimpleClientset()
+	secret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-secret",
+			Namespace: RadiusNamespace,
+		},
+	}
+	_, err := clientset.CoreV1().Secrets(RadiusNamespace).Create(context.Background(), secret, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	b := NewKubernetesBackend(clientset)
+	err = b.DeleteState(context.Background(), "test-secret")
+	require.NoError(t, err)
+
+	_, err = clientset.CoreV1().Secrets(RadiusNamespace).Get(context.Background(), "test-secret", metav1.GetOptions{})
+	require.True(t, k8s_errors.IsNotFound(err))
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+var _ Backend = (*localBackend)(nil)
+
+// Configuration of the local backend.
+// https://developer.hashicorp.com/terraform/language/settings/backends/local
+const (
+	BackendLocal = "local"
+
+	// localPathParam is the directory which stores the state files. Terraform's path property is set to the state file in the directory.
+	localPathParam = "path"
+)
+
+type localBackend struct {
+	dir string
+}
+
+// NewLocalBackend creates a new Backend which stores the Terraform state file in a directory of the local filesystem.
+// The directory is required in the configuration and must be persisted across the restarts of the Radius pods,
+// for example by mounting a persistent volume.
+func NewLocalBackend(config map[string]string) (Backend, error) {
+	if err := requireConfig(BackendLocal, config, localPathParam); err != nil {
+		return nil, err
+	}
+
+	if !filepath.IsAbs(config[localPathParam]) {
+		return nil, fmt.Errorf("%q must be an absolute path in the configuration of the %s Terraform backend", localPathParam, BackendLocal)
+	}
+
+	return &localBackend{dir: config[localPathParam]}, nil
+}
+
+// BuildBackend generates the Terraform backend configuration for local backend. The state file of the resource recipe
+// is stored as "{path}/{hash}.tfstate".
+// https://developer.hashicorp.com/terraform/language/settings/backends/local
+func (p *localBackend) BuildBackend(resourceRecipe *recipes.ResourceMetadata) (map[string]any, error) {
+	statePath, err := p.StateName(resourceRecipe)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		BackendLocal: map[string]any{
+			localPathParam: statePath,
+		},
+	}, nil
+}
+
+// StateName returns the path of the Terraform state file of the given resource recipe.
+func (p *localBackend) StateName(resourceRecipe *recipes.ResourceMetadata) (string, error) {
+	suffix, err := generateSecretSuffix(resourceRecipe)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(p.dir, suffix+".tfstate"), nil
+}
+
+// Env returns no environment variables, the local backend doesn't require credentials.
+func (p *localBackend) Env() map[string]string {
+	return nil
+}
+
+// ValidateBackendExists checks if the Terraform state file exists at the given path.
+func (p *localBackend) ValidateBackendExists(ctx context.Context, name string) (bool, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	_, err := os.Stat(name)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			logger.Info(fmt.Sprintf("Terraform state file %q does not exist", name))
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+// DeleteState deletes the Terraform state file and its backup at the given path.
+func (p *localBackend) DeleteState(ctx context.Context, name string) error {
+	for _, file := range []string{name, name + ".backup"} {
+		if err := os.Remove(file); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("error deleting terraform state file: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ReleaseLock deletes the lock info file which Terraform writes next to the state file at the given path.
+// The state file itself is locked with a file lock, which is released by the OS when the Terraform process stops.
+func (p *localBackend) ReleaseLock(ctx context.Context, name string) error {
+	lockInfo := filepath.Join(filepath.Dir(name), "."+filepath.Base(name)+".lock.info")
+	if err := os.Remove(lockInfo); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
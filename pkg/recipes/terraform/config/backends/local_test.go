@@ -0,0 +1,81 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewLocalBackend_InvalidConfig(t *testing.T) {
+	_, err := NewLocalBackend(map[string]string{})
+	require.EqualError(t, err, "\"path\" is required in the configuration of the local Terraform backend")
+
+	_, err = NewLocalBackend(map[string]string{"path": "tfstate"})
+	require.EqualError(t, err, "\"path\" must be an absolute path in the configuration of the local Terraform backend")
+}
+
+func Test_LocalBackend(t *testing.T) {
+	_, resourceRecipe := getTestInputs()
+	dir := t.TempDir()
+	b, err := NewLocalBackend(map[string]string{"path": dir})
+	require.NoError(t, err)
+
+	suffix, err := generateSecretSuffix(&resourceRecipe)
+	require.NoError(t, err)
+	statePath := filepath.Join(dir, suffix+".tfstate")
+
+	config, err := b.BuildBackend(&resourceRecipe)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"local": map[string]any{"path": statePath}}, config)
+
+	stateName, err := b.StateName(&resourceRecipe)
+	require.NoError(t, err)
+	require.Equal(t, statePath, stateName)
+
+	ctx := context.Background()
+	exists, err := b.ValidateBackendExists(ctx, stateName)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	lockInfo := filepath.Join(dir, "."+suffix+".tfstate.lock.info")
+	require.NoError(t, os.WriteFile(statePath, []byte("{}"), 0600))
+	require.NoError(t, os.WriteFile(lockInfo, []byte("{}"), 0600))
+
+	exists, err = b.ValidateBackendExists(ctx, stateName)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	err = b.ReleaseLock(ctx, stateName)
+	require.NoError(t, err)
+	require.NoFileExists(t, lockInfo)
+
+	// Validate that the function returns no error when the state is not locked.
+	err = b.ReleaseLock(ctx, stateName)
+	require.NoError(t, err)
+
+	err = b.DeleteState(ctx, stateName)
+	require.NoError(t, err)
+	require.NoFileExists(t, statePath)
+
+	err = b.DeleteState(ctx, stateName)
+	require.NoError(t, err)
+}
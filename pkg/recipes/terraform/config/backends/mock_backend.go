@@ -50,6 +50,63 @@ func (mr *MockBackendMockRecorder) BuildBackend(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildBackend", reflect.TypeOf((*MockBackend)(nil).BuildBackend), arg0)
 }
 
+// DeleteState mocks base method.
+func (m *MockBackend) DeleteState(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteState", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteState indicates an expected call of DeleteState.
+func (mr *MockBackendMockRecorder) DeleteState(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteState", reflect.TypeOf((*MockBackend)(nil).DeleteState), arg0, arg1)
+}
+
+// Env mocks base method.
+func (m *MockBackend) Env() map[string]string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Env")
+	ret0, _ := ret[0].(map[string]string)
+	return ret0
+}
+
+// Env indicates an expected call of Env.
+func (mr *MockBackendMockRecorder) Env() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Env", reflect.TypeOf((*MockBackend)(nil).Env))
+}
+
+// ReleaseLock mocks base method.
+func (m *MockBackend) ReleaseLock(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseLock", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseLock indicates an expected call of ReleaseLock.
+func (mr *MockBackendMockRecorder) ReleaseLock(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseLock", reflect.TypeOf((*MockBackend)(nil).ReleaseLock), arg0, arg1)
+}
+
+// StateName mocks base method.
+func (m *MockBackend) StateName(arg0 *recipes.ResourceMetadata) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StateName", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StateName indicates an expected call of StateName.
+func (mr *MockBackendMockRecorder) StateName(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateName", reflect.TypeOf((*MockBackend)(nil).StateName), arg0)
+}
+
 // ValidateBackendExists mocks base method.
 func (m *MockBackend) ValidateBackendExists(arg0 context.Context, arg1 string) (bool, error) {
 	m.ctrl.T.Helper()
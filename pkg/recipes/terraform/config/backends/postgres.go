@@ -0,0 +1,159 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+var _ Backend = (*postgresBackend)(nil)
+
+// Configuration of the pg backend. Other properties of the configuration are passed to Terraform as is.
+// https://developer.hashicorp.com/terraform/language/settings/backends/pg
+const (
+	BackendPostgres = "pg"
+
+	pgSchemaNameParam = "schema_name"
+
+	// pgConnStrSecret is the key of the connection string in the secret store of the backend.
+	pgConnStrSecret = "connStr"
+	// pgConnStrEnv is the environment variable which Terraform reads the connection string from.
+	pgConnStrEnv = "PG_CONN_STR"
+
+	// PostgresBackendSchemaPrefix is the prefix of the schema which stores the Terraform state of a recipe.
+	// The pg backend stores the state of each workspace as a row of the "states" table in the schema, so
+	// every recipe uses its own schema with the default workspace.
+	PostgresBackendSchemaPrefix = "tfstate_"
+
+	// pgStatesTable and pgDefaultWorkspace are the table and the row name used by Terraform to store the state.
+	pgStatesTable      = "states"
+	pgDefaultWorkspace = "default"
+)
+
+type postgresBackend struct {
+	config  map[string]string
+	connStr string
+}
+
+// NewPostgresBackend creates a new Backend which stores the Terraform state file in a Postgres database.
+// The connection string, including the credentials, is read from the "connStr" key of the given secrets.
+func NewPostgresBackend(config map[string]string, secrets map[string]string) (Backend, error) {
+	if secrets[pgConnStrSecret] == "" {
+		return nil, fmt.Errorf("%q is required in the secret store of the %s Terraform backend", pgConnStrSecret, BackendPostgres)
+	}
+
+	return &postgresBackend{config: config, connStr: secrets[pgConnStrSecret]}, nil
+}
+
+// BuildBackend generates the Terraform backend configuration for pg backend. The state of the resource recipe
+// is stored in the schema "tfstate_{hash}".
+// https://developer.hashicorp.com/terraform/language/settings/backends/pg
+func (p *postgresBackend) BuildBackend(resourceRecipe *recipes.ResourceMetadata) (map[string]any, error) {
+	schemaName, err := p.StateName(resourceRecipe)
+	if err != nil {
+		return nil, err
+	}
+
+	backend := map[string]any{}
+	for k, v := range p.config {
+		backend[k] = v
+	}
+	backend[pgSchemaNameParam] = schemaName
+
+	return map[string]any{BackendPostgres: backend}, nil
+}
+
+// StateName returns the name of the schema which stores the Terraform state of the given resource recipe.
+func (p *postgresBackend) StateName(resourceRecipe *recipes.ResourceMetadata) (string, error) {
+	suffix, err := generateSecretSuffix(resourceRecipe)
+	if err != nil {
+		return "", err
+	}
+
+	return PostgresBackendSchemaPrefix + suffix, nil
+}
+
+// Env returns the connection string of the database, which Terraform reads from PG_CONN_STR.
+func (p *postgresBackend) Env() map[string]string {
+	return map[string]string{pgConnStrEnv: p.connStr}
+}
+
+// ValidateBackendExists checks if the Terraform state is stored in the schema with the given name.
+func (p *postgresBackend) ValidateBackendExists(ctx context.Context, name string) (bool, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	db, err := sql.Open("postgres", p.connStr)
+	if err != nil {
+		return false, err
+	}
+	defer db.Close()
+
+	var tableExists bool
+	err = db.QueryRowContext(ctx,
+		"SELECT EXISTS (SELECT 1 FROM information_schema.tables WHERE table_schema = $1 AND table_name = $2)",
+		name, pgStatesTable).Scan(&tableExists)
+	if err != nil {
+		return false, err
+	}
+
+	if !tableExists {
+		logger.Info(fmt.Sprintf("Terraform state table does not exist in schema %q", name))
+		return false, nil
+	}
+
+	var stateExists bool
+	err = db.QueryRowContext(ctx,
+		fmt.Sprintf("SELECT EXISTS (SELECT 1 FROM %s.%s WHERE name = $1)", pq.QuoteIdentifier(name), pgStatesTable),
+		pgDefaultWorkspace).Scan(&stateExists)
+	if err != nil {
+		return false, err
+	}
+
+	if !stateExists {
+		logger.Info(fmt.Sprintf("Terraform state does not exist in schema %q", name))
+	}
+
+	return stateExists, nil
+}
+
+// DeleteState drops the schema with the given name which stores the Terraform state.
+func (p *postgresBackend) DeleteState(ctx context.Context, name string) error {
+	db, err := sql.Open("postgres", p.connStr)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	_, err = db.ExecContext(ctx, fmt.Sprintf("DROP SCHEMA IF EXISTS %s CASCADE", pq.QuoteIdentifier(name)))
+	if err != nil {
+		return fmt.Errorf("error deleting postgres schema for terraform state: %w", err)
+	}
+
+	return nil
+}
+
+// ReleaseLock is a no-op for pg backend. Terraform locks the state with a session level advisory lock,
+// which is released by Postgres when the connection of the stopped Terraform process is closed.
+func (p *postgresBackend) ReleaseLock(ctx context.Context, name string) error {
+	return nil
+}
@@ -0,0 +1,271 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+var _ Backend = (*s3Backend)(nil)
+
+// Configuration of the s3 backend. Other properties of the configuration are passed to Terraform as is.
+// https://developer.hashicorp.com/terraform/language/settings/backends/s3
+const (
+	BackendS3 = "s3"
+
+	s3BucketParam       = "bucket"
+	s3RegionParam       = "region"
+	s3KeyParam          = "key"
+	s3UsePathStyleParam = "use_path_style"
+	s3UseLockfileParam  = "use_lockfile"
+	s3EndpointsParam    = "endpoints"
+
+	// s3KeyPrefixParam is the prefix of the keys of the state files in the bucket. Defaults to "radius".
+	s3KeyPrefixParam = "key_prefix"
+	// s3EndpointParam is the endpoint of an S3 compatible storage, for example MinIO.
+	s3EndpointParam = "endpoint"
+
+	s3LockfileSuffix = ".tflock"
+
+	// Environment variables which Terraform reads the AWS credentials from.
+	awsAccessKeyIDEnv     = "AWS_ACCESS_KEY_ID"
+	awsSecretAccessKeyEnv = "AWS_SECRET_ACCESS_KEY"
+
+	// emptyPayloadHash is the SHA256 hash of an empty request body.
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+// s3BoolParams are the boolean properties of the s3 backend configuration.
+var s3BoolParams = []string{
+	s3UsePathStyleParam,
+	s3UseLockfileParam,
+	"encrypt",
+	"skip_credentials_validation",
+	"skip_region_validation",
+	"skip_requesting_account_id",
+	"skip_metadata_api_check",
+	"skip_s3_checksum",
+}
+
+type s3Backend struct {
+	config      map[string]string
+	credentials aws.Credentials
+	httpClient  *http.Client
+	signer      *v4.Signer
+}
+
+// NewS3Backend creates a new Backend which stores the Terraform state file in an S3 compatible storage.
+// The bucket and the region are required in the configuration. Returns an error if the configuration is invalid
+// or the AWS credentials are empty.
+func NewS3Backend(config map[string]string, awsCredentials *credentials.AWSCredential) (Backend, error) {
+	if err := requireConfig(BackendS3, config, s3BucketParam, s3RegionParam); err != nil {
+		return nil, err
+	}
+
+	if awsCredentials == nil || awsCredentials.AccessKeyID == "" || awsCredentials.SecretAccessKey == "" {
+		return nil, fmt.Errorf("AWS credentials are required to store the Terraform state in the %s backend", BackendS3)
+	}
+
+	for _, param := range s3BoolParams {
+		if value, ok := config[param]; ok {
+			if _, err := strconv.ParseBool(value); err != nil {
+				return nil, fmt.Errorf("%q must be a boolean in the configuration of the %s Terraform backend", param, BackendS3)
+			}
+		}
+	}
+
+	if endpoint, ok := config[s3EndpointParam]; ok {
+		if _, err := url.ParseRequestURI(endpoint); err != nil {
+			return nil, fmt.Errorf("%q must be a URL in the configuration of the %s Terraform backend", s3EndpointParam, BackendS3)
+		}
+	}
+
+	return &s3Backend{
+		config: config,
+		credentials: aws.Credentials{
+			AccessKeyID:     awsCredentials.AccessKeyID,
+			SecretAccessKey: awsCredentials.SecretAccessKey,
+		},
+		httpClient: http.DefaultClient,
+		signer: v4.NewSigner(func(o *v4.SignerOptions) {
+			// S3 object keys are signed without escaping the path again.
+			o.DisableURIPathEscaping = true
+		}),
+	}, nil
+}
+
+// BuildBackend generates the Terraform backend configuration for s3 backend. The state file of the resource recipe
+// is stored in the configured bucket with the key "{key_prefix}/{hash}.tfstate".
+// https://developer.hashicorp.com/terraform/language/settings/backends/s3
+func (p *s3Backend) BuildBackend(resourceRecipe *recipes.ResourceMetadata) (map[string]any, error) {
+	key, err := p.StateName(resourceRecipe)
+	if err != nil {
+		return nil, err
+	}
+
+	backend := map[string]any{}
+	for k, v := range p.config {
+		switch k {
+		case s3KeyPrefixParam:
+			continue
+		case s3EndpointParam:
+			backend[s3EndpointsParam] = map[string]any{BackendS3: v}
+		default:
+			backend[k] = v
+		}
+	}
+
+	for _, param := range s3BoolParams {
+		if value, ok := p.config[param]; ok {
+			backend[param], _ = strconv.ParseBool(value)
+		}
+	}
+
+	backend[s3KeyParam] = key
+
+	return map[string]any{BackendS3: backend}, nil
+}
+
+// Env returns the AWS credentials, which Terraform reads from AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY.
+func (p *s3Backend) Env() map[string]string {
+	return map[string]string{
+		awsAccessKeyIDEnv:     p.credentials.AccessKeyID,
+		awsSecretAccessKeyEnv: p.credentials.SecretAccessKey,
+	}
+}
+
+// StateName returns the key of the Terraform state file of the given resource recipe in the bucket.
+func (p *s3Backend) StateName(resourceRecipe *recipes.ResourceMetadata) (string, error) {
+	suffix, err := generateSecretSuffix(resourceRecipe)
+	if err != nil {
+		return "", err
+	}
+
+	prefix := p.config[s3KeyPrefixParam]
+	if prefix == "" {
+		prefix = defaultKeyPrefix
+	}
+
+	return path.Join(prefix, suffix+".tfstate"), nil
+}
+
+// ValidateBackendExists checks if the Terraform state file with the given key exists in the bucket.
+func (p *s3Backend) ValidateBackendExists(ctx context.Context, name string) (bool, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	statusCode, err := p.do(ctx, http.MethodHead, name)
+	if err != nil {
+		return false, err
+	}
+
+	switch statusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		logger.Info(fmt.Sprintf("Terraform state file %q does not exist in bucket %q", name, p.config[s3BucketParam]))
+		return false, nil
+	default:
+		return false, fmt.Errorf("failed to retrieve Terraform state file %q from bucket %q: unexpected status code %d", name, p.config[s3BucketParam], statusCode)
+	}
+}
+
+// DeleteState deletes the Terraform state file with the given key from the bucket.
+func (p *s3Backend) DeleteState(ctx context.Context, name string) error {
+	return p.delete(ctx, name)
+}
+
+// ReleaseLock deletes the lock file which Terraform creates next to the state file when use_lockfile is enabled.
+// Terraform creates the lock file again when it locks the state next time. Locks stored in DynamoDB are not released.
+func (p *s3Backend) ReleaseLock(ctx context.Context, name string) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	if useLockfile, _ := strconv.ParseBool(p.config[s3UseLockfileParam]); !useLockfile {
+		logger.Info(fmt.Sprintf("S3 lock file is not enabled for bucket %q, skipping releasing the lock", p.config[s3BucketParam]))
+		return nil
+	}
+
+	return p.delete(ctx, name+s3LockfileSuffix)
+}
+
+// delete deletes the object with the given key from the bucket. S3 returns success for keys which don't exist.
+func (p *s3Backend) delete(ctx context.Context, key string) error {
+	statusCode, err := p.do(ctx, http.MethodDelete, key)
+	if err != nil {
+		return err
+	}
+
+	if statusCode != http.StatusNoContent && statusCode != http.StatusOK && statusCode != http.StatusNotFound {
+		return fmt.Errorf("failed to delete %q from bucket %q: unexpected status code %d", key, p.config[s3BucketParam], statusCode)
+	}
+
+	return nil
+}
+
+// do sends a signed request with the given method for the object with the given key and returns the response status code.
+func (p *s3Backend) do(ctx context.Context, method string, key string) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, method, p.objectURL(key), nil)
+	if err != nil {
+		return 0, err
+	}
+
+	req.Header.Set("X-Amz-Content-Sha256", emptyPayloadHash)
+	if err := p.signer.SignHTTP(ctx, p.credentials, req, emptyPayloadHash, BackendS3, p.config[s3RegionParam], time.Now()); err != nil {
+		return 0, err
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	return resp.StatusCode, nil
+}
+
+// objectURL returns the URL of the object with the given key. Path-style URLs are used if use_path_style is enabled,
+// which is required by most S3 compatible storages.
+func (p *s3Backend) objectURL(key string) string {
+	bucket := p.config[s3BucketParam]
+	usePathStyle, _ := strconv.ParseBool(p.config[s3UsePathStyleParam])
+
+	endpoint := p.config[s3EndpointParam]
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", p.config[s3RegionParam])
+	}
+
+	u, _ := url.Parse(endpoint)
+	if usePathStyle {
+		u.Path = path.Join("/", u.Path, bucket, key)
+	} else {
+		u.Host = bucket + "." + u.Host
+		u.Path = path.Join("/", u.Path, key)
+	}
+
+	return u.String()
+}
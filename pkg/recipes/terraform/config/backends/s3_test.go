@@ -0,0 +1,255 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/stretchr/testify/require"
+)
+
+var testAWSCredentials = &credentials.AWSCredential{
+	AccessKeyID:     "test-access-key",
+	SecretAccessKey: "test-secret-key",
+}
+
+// newFakeStorageServer starts a stand-in for an object storage which responds with the status code returned by the given function.
+func newFakeStorageServer(t *testing.T, status func(r *http.Request) int) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status(r))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func Test_NewS3Backend_InvalidConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      map[string]string
+		credentials *credentials.AWSCredential
+		err         string
+	}{
+		{
+			name:        "missing bucket",
+			config:      map[string]string{"region": "us-west-2"},
+			credentials: testAWSCredentials,
+			err:         "\"bucket\" is required in the configuration of the s3 Terraform backend",
+		},
+		{
+			name:        "missing region",
+			config:      map[string]string{"bucket": "tfstate"},
+			credentials: testAWSCredentials,
+			err:         "\"region\" is required in the configuration of the s3 Terraform backend",
+		},
+		{
+			name:   "missing credentials",
+			config: map[string]string{"bucket": "tfstate", "region": "us-west-2"},
+			err:    "AWS credentials are required to store the Terraform state in the s3 backend",
+		},
+		{
+			name:        "invalid boolean",
+			config:      map[string]string{"bucket": "tfstate", "region": "us-west-2", "use_path_style": "yes please"},
+			credentials: testAWSCredentials,
+			err:         "\"use_path_style\" must be a boolean in the configuration of the s3 Terraform backend",
+		},
+		{
+			name:        "invalid endpoint",
+			config:      map[string]string{"bucket": "tfstate", "region": "us-west-2", "endpoint": "minio"},
+			credentials: testAWSCredentials,
+			err:         "\"endpoint\" must be a URL in the configuration of the s3 Terraform backend",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewS3Backend(tc.config, tc.credentials)
+			require.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func Test_S3Backend_BuildBackend(t *testing.T) {
+	_, resourceRecipe := getTestInputs()
+	b, err := NewS3Backend(map[string]string{
+		"bucket":         "tfstate",
+		"region":         "us-west-2",
+		"endpoint":       "http://localhost:9000",
+		"use_path_style": "true",
+		"key_prefix":     "recipes",
+	}, testAWSCredentials)
+	require.NoError(t, err)
+
+	suffix, err := generateSecretSuffix(&resourceRecipe)
+	require.NoError(t, err)
+
+	stateName, err := b.StateName(&resourceRecipe)
+	require.NoError(t, err)
+	require.Equal(t, "recipes/"+suffix+".tfstate", stateName)
+
+	config, err := b.BuildBackend(&resourceRecipe)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"s3": map[string]any{
+			"bucket":         "tfstate",
+			"region":         "us-west-2",
+			"key":            "recipes/" + suffix + ".tfstate",
+			"endpoints":      map[string]any{"s3": "http://localhost:9000"},
+			"use_path_style": true,
+		},
+	}, config)
+
+	// The credentials are passed to Terraform through the environment instead of the configuration.
+	require.Equal(t, map[string]string{
+		"AWS_ACCESS_KEY_ID":     testAWSCredentials.AccessKeyID,
+		"AWS_SECRET_ACCESS_KEY": testAWSCredentials.SecretAccessKey,
+	}, b.Env())
+}
+
+func Test_S3Backend_ObjectURL(t *testing.T) {
+	b, err := NewS3Backend(map[string]string{"bucket": "tfstate", "region": "us-west-2"}, testAWSCredentials)
+	require.NoError(t, err)
+	require.Equal(t, "https://tfstate.s3.us-west-2.amazonaws.com/radius/state.tfstate", b.(*s3Backend).objectURL("radius/state.tfstate"))
+
+	b, err = NewS3Backend(map[string]string{"bucket": "tfstate", "region": "us-west-2", "endpoint": "http://localhost:9000", "use_path_style": "true"}, testAWSCredentials)
+	require.NoError(t, err)
+	require.Equal(t, "http://localhost:9000/tfstate/radius/state.tfstate", b.(*s3Backend).objectURL("radius/state.tfstate"))
+}
+
+func Test_S3Backend(t *testing.T) {
+	objects := map[string]bool{"/tfstate/radius/state.tfstate": true, "/tfstate/radius/state.tfstate.tflock": true}
+	var mu sync.Mutex
+	server := newFakeStorageServer(t, func(r *http.Request) int {
+		mu.Lock()
+		defer mu.Unlock()
+
+		// Every request must be signed with the UCP credentials.
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential="+testAWSCredentials.AccessKeyID+"/") {
+			return http.StatusForbidden
+		}
+
+		switch r.Method {
+		case http.MethodHead:
+			if objects[r.URL.Path] {
+				return http.StatusOK
+			}
+			return http.StatusNotFound
+		case http.MethodDelete:
+			delete(objects, r.URL.Path)
+			return http.StatusNoContent
+		}
+		return http.StatusMethodNotAllowed
+	})
+
+	b, err := NewS3Backend(map[string]string{
+		"bucket":         "tfstate",
+		"region":         "us-west-2",
+		"endpoint":       server.URL,
+		"use_path_style": "true",
+		"use_lockfile":   "true",
+	}, testAWSCredentials)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	exists, err := b.ValidateBackendExists(ctx, "radius/state.tfstate")
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	err = b.ReleaseLock(ctx, "radius/state.tfstate")
+	require.NoError(t, err)
+	require.NotContains(t, objects, "/tfstate/radius/state.tfstate.tflock")
+
+	err = b.DeleteState(ctx, "radius/state.tfstate")
+	require.NoError(t, err)
+
+	exists, err = b.ValidateBackendExists(ctx, "radius/state.tfstate")
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	// Validate error is returned for unexpected status codes.
+	b.(*s3Backend).credentials.AccessKeyID = "invalid"
+	_, err = b.ValidateBackendExists(ctx, "radius/state.tfstate")
+	require.ErrorContains(t, err, "unexpected status code 403")
+}
+
+// To run this test, start a local MinIO instance with a bucket and set TEST_MINIO_ENDPOINT to its endpoint.
+//
+// For example:
+//
+//	docker run --rm -p 9000:9000 -e MINIO_ROOT_USER=radius -e MINIO_ROOT_PASSWORD=radius-password minio/minio server /data
+//	docker run --rm --network host --entrypoint sh minio/mc -c "mc alias set local http://localhost:9000 radius radius-password && mc mb local/tfstate"
+//	export TEST_MINIO_ENDPOINT="http://localhost:9000"
+func Test_S3Backend_MinIO(t *testing.T) {
+	endpoint := os.Getenv("TEST_MINIO_ENDPOINT")
+	if endpoint == "" {
+		t.Skip("TEST_MINIO_ENDPOINT is not set.")
+	}
+
+	b, err := NewS3Backend(map[string]string{
+		"bucket":         "tfstate",
+		"region":         "us-east-1",
+		"endpoint":       endpoint,
+		"use_path_style": "true",
+		"use_lockfile":   "true",
+	}, &credentials.AWSCredential{AccessKeyID: "radius", SecretAccessKey: "radius-password"})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	key := "radius/minio-test.tfstate"
+	exists, err := b.ValidateBackendExists(ctx, key)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	// Upload the state file and the lock file as Terraform does.
+	for _, k := range []string{key, key + s3LockfileSuffix} {
+		body := []byte("{}")
+		hash := sha256.Sum256(body)
+		req, err := http.NewRequestWithContext(ctx, http.MethodPut, b.(*s3Backend).objectURL(k), bytes.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("X-Amz-Content-Sha256", hex.EncodeToString(hash[:]))
+		err = b.(*s3Backend).signer.SignHTTP(ctx, b.(*s3Backend).credentials, req, hex.EncodeToString(hash[:]), BackendS3, "us-east-1", time.Now())
+		require.NoError(t, err)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+
+	exists, err = b.ValidateBackendExists(ctx, key)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	require.NoError(t, b.ReleaseLock(ctx, key))
+	exists, err = b.ValidateBackendExists(ctx, key+s3LockfileSuffix)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	require.NoError(t, b.DeleteState(ctx, key))
+	exists, err = b.ValidateBackendExists(ctx, key)
+	require.NoError(t, err)
+	require.False(t, exists)
+}
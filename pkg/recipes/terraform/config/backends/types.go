@@ -35,4 +35,21 @@ type Backend interface {
 	// For example, for Kubernetes backend, it checks if the Kubernetes secret for Terraform state file exists.
 	// returns true if backend is found, false otherwise.
 	ValidateBackendExists(ctx context.Context, name string) (bool, error)
+
+	// StateName returns the name of the Terraform state file stored in the backend source for the given resource recipe.
+	// The name is passed to ValidateBackendExists, ReleaseLock and DeleteState.
+	StateName(resourceRecipe *recipes.ResourceMetadata) (string, error)
+
+	// Env returns the environment variables holding the credentials of the backend. The credentials are passed to
+	// Terraform through its environment so that they are not written to the Terraform configuration.
+	Env() map[string]string
+
+	// DeleteState deletes the Terraform state file stored in the backend source with the given name.
+	// This is used to clean up the state file once the recipe resources are destroyed.
+	DeleteState(ctx context.Context, name string) error
+
+	// ReleaseLock releases the lock of the Terraform state file stored in the backend source with the given name.
+	// This is used to clean up the lock left by a Terraform process which is stopped before it unlocks the state,
+	// for example when the recipe operation is canceled. Returns nil if the state is not locked.
+	ReleaseLock(ctx context.Context, name string) error
 }
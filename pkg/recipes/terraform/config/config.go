@@ -24,11 +24,14 @@ import (
 	"io/fs"
 	"os"
 
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/recipecontext"
 	"github.com/radius-project/radius/pkg/recipes/terraform/config/backends"
 	"github.com/radius-project/radius/pkg/recipes/terraform/config/providers"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 )
 
 const (
@@ -80,23 +83,86 @@ func (cfg *TerraformConfig) Save(ctx context.Context, workingDir string) error {
 }
 
 // AddProviders adds provider configurations for requiredProviders that are supported
-// by Radius to generate custom provider configurations. Save() must be called to save
-// the generated providers config. requiredProviders contains a list of provider names
-// that are required for the module.
-func (cfg *TerraformConfig) AddProviders(ctx context.Context, requiredProviders []string, supportedProviders map[string]providers.Provider, envConfig *recipes.Configuration) error {
-	providerConfigs, err := getProviderConfigs(ctx, requiredProviders, supportedProviders, envConfig)
+// by Radius to generate custom provider configurations, merged with the provider configurations
+// declared by the environment. Save() must be called to save the generated providers config.
+// requiredProviders contains the providers that are required for the module, keyed by the provider name.
+// secrets contains the secrets referenced by the provider configurations of the environment, keyed
+// by the secret store ID and then by the secret name.
+func (cfg *TerraformConfig) AddProviders(ctx context.Context, requiredProviders map[string]*RequiredProviderInfo, supportedProviders map[string]providers.Provider, envConfig *recipes.Configuration, secrets map[string]map[string]string) error {
+	providerConfigs, err := getProviderConfigs(ctx, requiredProviders, supportedProviders, envConfig, secrets)
 	if err != nil {
 		return err
 	}
 
 	// Add generated provider configs for required providers to the existing terraform json config file
 	if len(providerConfigs) > 0 {
-		cfg.Provider = providerConfigs
+		cfg.Provider = map[string]any{}
+		for provider, configs := range providerConfigs {
+			// A single provider block is written as an object, multiple aliased blocks as an array.
+			if len(configs) == 1 {
+				cfg.Provider[provider] = configs[0]
+			} else {
+				cfg.Provider[provider] = configs
+			}
+		}
+	}
+
+	if envConfig != nil {
+		cfg.addProviderRequirements(requiredProviders, envConfig.RecipeConfig.Terraform.Providers)
 	}
 
 	return nil
 }
 
+// addProviderRequirements declares the source addresses of the providers configured by the environment in the root
+// module, so that providers outside of the "hashicorp" namespace are passed to the module. Aliased provider
+// configurations expected by the module are passed explicitly, in which case every provider required by the module
+// must be passed as well.
+func (cfg *TerraformConfig) addProviderRequirements(requiredProviders map[string]*RequiredProviderInfo, envProviders map[string][]datamodel.TerraformProviderConfig) {
+	aliases := []string{}
+	for provider, info := range requiredProviders {
+		for _, config := range envProviders[provider] {
+			alias, ok := config.Values[providerAliasKey].(string)
+			if ok && info != nil && slices.Contains(info.ConfigurationAliases, alias) {
+				aliases = append(aliases, provider+"."+alias)
+			}
+		}
+	}
+
+	requirements := map[string]any{}
+	for provider, info := range requiredProviders {
+		if info == nil || info.Source == "" {
+			continue
+		}
+
+		if _, ok := envProviders[provider]; ok || len(aliases) > 0 {
+			requirements[provider] = map[string]any{moduleSourceKey: info.Source}
+		}
+	}
+
+	if len(requirements) > 0 {
+		if cfg.Terraform == nil {
+			cfg.Terraform = &TerraformDefinition{}
+		}
+		cfg.Terraform.RequiredProviders = requirements
+	}
+
+	if len(aliases) == 0 {
+		return
+	}
+
+	moduleProviders := map[string]any{}
+	for provider := range requiredProviders {
+		moduleProviders[provider] = provider
+	}
+	for _, alias := range aliases {
+		moduleProviders[alias] = alias
+	}
+	for _, mod := range cfg.Module {
+		mod[moduleProvidersKey] = moduleProviders
+	}
+}
+
 // AddRecipeContext adds RecipeContext to TerraformConfig module parameters if recipeCtx is not nil.
 // Save() must be called after adding recipe context to the module config.
 func (cfg *TerraformConfig) AddRecipeContext(ctx context.Context, moduleName string, recipeCtx *recipecontext.Context) error {
@@ -135,39 +201,84 @@ func newModuleConfig(moduleSource string, moduleVersion string, params ...Recipe
 	return moduleConfig
 }
 
-// getProviderConfigs generates the Terraform provider configurations for the required providers.
-func getProviderConfigs(ctx context.Context, requiredProviders []string, supportedProviders map[string]providers.Provider, envConfig *recipes.Configuration) (map[string]any, error) {
-	providerConfigs := make(map[string]any)
-	for _, provider := range requiredProviders {
+// getProviderConfigs generates the Terraform provider configurations for the required providers. The configurations
+// generated by Radius are merged with the provider configurations of the environment: the configuration of the
+// environment without alias overrides the arguments of the configuration generated by Radius, and aliased
+// configurations are added as additional provider blocks.
+func getProviderConfigs(ctx context.Context, requiredProviders map[string]*RequiredProviderInfo, supportedProviders map[string]providers.Provider, envConfig *recipes.Configuration, secrets map[string]map[string]string) (map[string][]map[string]any, error) {
+	// Build the provider configurations in a deterministic order.
+	providerNames := maps.Keys(requiredProviders)
+	slices.Sort(providerNames)
+
+	providerConfigs := make(map[string][]map[string]any)
+	for _, provider := range providerNames {
+		configs := []map[string]any{}
+
 		builder, ok := supportedProviders[provider]
-		if !ok {
-			// No-op: For any other provider, Radius doesn't generate any custom configuration.
-			continue
+		if ok {
+			config, err := builder.BuildConfig(ctx, envConfig)
+			if err != nil {
+				return nil, err
+			}
+			if len(config) > 0 {
+				configs = append(configs, config)
+			}
 		}
 
-		config, err := builder.BuildConfig(ctx, envConfig)
-		if err != nil {
-			return nil, err
+		if envConfig != nil {
+			for _, envProvider := range envConfig.RecipeConfig.Terraform.Providers[provider] {
+				config, err := buildEnvironmentProviderConfig(provider, envProvider, secrets)
+				if err != nil {
+					return nil, err
+				}
+
+				if _, aliased := config[providerAliasKey]; !aliased && len(configs) > 0 {
+					if _, builtInAliased := configs[0][providerAliasKey]; !builtInAliased {
+						maps.Copy(configs[0], config)
+						continue
+					}
+				}
+				configs = append(configs, config)
+			}
 		}
-		if len(config) > 0 {
-			providerConfigs[provider] = config
+
+		if len(configs) > 0 {
+			providerConfigs[provider] = configs
 		}
 	}
 
 	return providerConfigs, nil
 }
 
+// buildEnvironmentProviderConfig builds a provider block from a provider configuration of the environment, reading
+// the values of its secret arguments from the loaded secrets.
+func buildEnvironmentProviderConfig(provider string, envProvider datamodel.TerraformProviderConfig, secrets map[string]map[string]string) (map[string]any, error) {
+	config := map[string]any{}
+	maps.Copy(config, envProvider.Values)
+
+	for name, ref := range envProvider.Secrets {
+		value, ok := secrets[ref.Source][ref.Key]
+		if !ok {
+			return nil, fmt.Errorf("secret %q of provider %q argument %q is not found in secret store %q", ref.Key, provider, name, ref.Source)
+		}
+		config[name] = value
+	}
+
+	return config, nil
+}
+
 // AddTerraformBackend adds backend configurations to store Terraform state file for the deployment.
 // Save() must be called to save the generated backend config.
-// Currently, the supported backend for Terraform Recipes is Kubernetes secret. https://developer.hashicorp.com/terraform/language/settings/backends/kubernetes
+// The backend is configured on the Environment and defaults to Kubernetes secret. https://developer.hashicorp.com/terraform/language/settings/backends/configuration
 func (cfg *TerraformConfig) AddTerraformBackend(resourceRecipe *recipes.ResourceMetadata, backend backends.Backend) (map[string]any, error) {
 	backendConfig, err := backend.BuildBackend(resourceRecipe)
 	if err != nil {
 		return nil, err
 	}
-	cfg.Terraform = &TerraformDefinition{
-		Backend: backendConfig,
+	if cfg.Terraform == nil {
+		cfg.Terraform = &TerraformDefinition{}
 	}
+	cfg.Terraform.Backend = backendConfig
 
 	return backendConfig, nil
 }
@@ -39,6 +39,7 @@ const (
 	testTemplatePath    = "Azure/redis/azurerm"
 	testRecipeName      = "redis-azure"
 	testTemplateVersion = "1.1.0"
+	testSecretStoreID   = "/planes/radius/local/resourcegroups/test-rg/providers/Applications.Core/secretStores/postgres"
 )
 
 var (
@@ -308,7 +309,8 @@ func Test_AddProviders(t *testing.T) {
 	configTests := []struct {
 		desc               string
 		envConfig          recipes.Configuration
-		requiredProviders  []string
+		requiredProviders  map[string]*RequiredProviderInfo
+		secrets            map[string]map[string]string
 		expectedProviders  []map[string]any
 		expectedConfigFile string
 		Err                error
@@ -338,11 +340,11 @@ func Test_AddProviders(t *testing.T) {
 					},
 				},
 			},
-			requiredProviders: []string{
-				providers.AWSProviderName,
-				providers.AzureProviderName,
-				providers.KubernetesProviderName,
-				"sql",
+			requiredProviders: map[string]*RequiredProviderInfo{
+				providers.AWSProviderName:        {},
+				providers.AzureProviderName:      {},
+				providers.KubernetesProviderName: {},
+				"sql":                            {},
 			},
 
 			expectedConfigFile: "testdata/providers-valid.tf.json",
@@ -358,8 +360,8 @@ func Test_AddProviders(t *testing.T) {
 					},
 				},
 			},
-			requiredProviders: []string{
-				providers.AWSProviderName,
+			requiredProviders: map[string]*RequiredProviderInfo{
+				providers.AWSProviderName: {},
 			},
 		},
 		{
@@ -369,8 +371,8 @@ func Test_AddProviders(t *testing.T) {
 			},
 			Err:       nil,
 			envConfig: recipes.Configuration{},
-			requiredProviders: []string{
-				providers.AWSProviderName,
+			requiredProviders: map[string]*RequiredProviderInfo{
+				providers.AWSProviderName: {},
 			},
 			expectedConfigFile: "testdata/providers-empty.tf.json",
 		},
@@ -390,8 +392,8 @@ func Test_AddProviders(t *testing.T) {
 					},
 				},
 			},
-			requiredProviders: []string{
-				providers.AWSProviderName,
+			requiredProviders: map[string]*RequiredProviderInfo{
+				providers.AWSProviderName: {},
 			},
 			expectedConfigFile: "testdata/providers-empty.tf.json",
 		},
@@ -404,11 +406,77 @@ func Test_AddProviders(t *testing.T) {
 			},
 			Err:       nil,
 			envConfig: recipes.Configuration{},
-			requiredProviders: []string{
-				providers.AzureProviderName,
+			requiredProviders: map[string]*RequiredProviderInfo{
+				providers.AzureProviderName: {},
 			},
 			expectedConfigFile: "testdata/providers-emptyazureconfig.tf.json",
 		},
+		{
+			desc: "environment providers",
+			expectedProviders: []map[string]any{
+				{
+					"region": "test-region",
+				},
+			},
+			Err: nil,
+			envConfig: recipes.Configuration{
+				RecipeConfig: datamodel.RecipeConfigProperties{
+					Terraform: datamodel.TerraformConfigProperties{
+						Providers: map[string][]datamodel.TerraformProviderConfig{
+							providers.AWSProviderName: {
+								{Values: map[string]any{"alias": "east", "region": "us-east-1"}},
+							},
+							"postgresql": {
+								{
+									Values: map[string]any{"host": "postgres", "port": 5432},
+									Secrets: map[string]datamodel.ProviderSecretReference{
+										"password": {Source: testSecretStoreID, Key: "password"},
+									},
+								},
+							},
+							"helm": {
+								{Values: map[string]any{"debug": true}},
+							},
+						},
+					},
+				},
+			},
+			requiredProviders: map[string]*RequiredProviderInfo{
+				providers.AWSProviderName: {Source: "hashicorp/aws"},
+				"postgresql":              {Source: "cyrilgdn/postgresql"},
+				"random":                  {Source: "hashicorp/random"},
+			},
+			secrets: map[string]map[string]string{
+				testSecretStoreID: {"password": "p@ssw0rd"},
+			},
+			expectedConfigFile: "testdata/providers-environment.tf.json",
+		},
+		{
+			desc: "environment providers with configuration aliases",
+			expectedProviders: []map[string]any{
+				{
+					"region": "test-region",
+				},
+			},
+			Err: nil,
+			envConfig: recipes.Configuration{
+				RecipeConfig: datamodel.RecipeConfigProperties{
+					Terraform: datamodel.TerraformConfigProperties{
+						Providers: map[string][]datamodel.TerraformProviderConfig{
+							providers.AWSProviderName: {
+								{Values: map[string]any{"region": "us-west-2", "max_retries": 5}},
+								{Values: map[string]any{"alias": "east", "region": "us-east-1"}},
+							},
+						},
+					},
+				},
+			},
+			requiredProviders: map[string]*RequiredProviderInfo{
+				providers.AWSProviderName: {Source: "hashicorp/aws", ConfigurationAliases: []string{"east"}},
+				"random":                  {Source: "hashicorp/random"},
+			},
+			expectedConfigFile: "testdata/providers-aliases.tf.json",
+		},
 	}
 
 	for _, tc := range configTests {
@@ -423,7 +491,7 @@ func Test_AddProviders(t *testing.T) {
 			if tc.Err != nil {
 				mProvider.EXPECT().BuildConfig(ctx, &tc.envConfig).Times(1).Return(nil, tc.Err)
 			}
-			err := tfconfig.AddProviders(ctx, tc.requiredProviders, supportedProviders, &tc.envConfig)
+			err := tfconfig.AddProviders(ctx, tc.requiredProviders, supportedProviders, &tc.envConfig, tc.secrets)
 			if tc.Err != nil {
 				require.ErrorContains(t, err, tc.Err.Error())
 				return
@@ -445,6 +513,30 @@ func Test_AddProviders(t *testing.T) {
 	}
 }
 
+func Test_AddProviders_MissingSecret(t *testing.T) {
+	_, supportedProviders, _ := setup(t)
+	envRecipe, resourceRecipe := getTestInputs()
+	envConfig := recipes.Configuration{
+		RecipeConfig: datamodel.RecipeConfigProperties{
+			Terraform: datamodel.TerraformConfigProperties{
+				Providers: map[string][]datamodel.TerraformProviderConfig{
+					"postgresql": {
+						{
+							Secrets: map[string]datamodel.ProviderSecretReference{
+								"password": {Source: testSecretStoreID, Key: "password"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tfconfig := New(testRecipeName, &envRecipe, &resourceRecipe)
+	err := tfconfig.AddProviders(testcontext.New(t), map[string]*RequiredProviderInfo{"postgresql": {}}, supportedProviders, &envConfig, map[string]map[string]string{})
+	require.EqualError(t, err, fmt.Sprintf("secret \"password\" of provider \"postgresql\" argument \"password\" is not found in secret store %q", testSecretStoreID))
+}
+
 func Test_AddOutputs(t *testing.T) {
 	envRecipe, resourceRecipe := getTestInputs()
 	tests := []struct {
@@ -21,6 +21,10 @@ const (
 	moduleSourceKey = "source"
 	// moduleVersionKey represents the key for the module version parameter.
 	moduleVersionKey = "version"
+	// moduleProvidersKey represents the key for the provider configurations passed to the module.
+	moduleProvidersKey = "providers"
+	// providerAliasKey represents the key for the alias of a provider configuration.
+	providerAliasKey = "alias"
 
 	mainConfigFileName = "main.tf.json"
 )
@@ -60,4 +64,19 @@ type TerraformDefinition struct {
 	// Backend defines where Terraform stores its state.
 	// https://developer.hashicorp.com/terraform/language/state
 	Backend map[string]interface{} `json:"backend"`
+
+	// RequiredProviders declares the source addresses of the providers configured by the root module, which is
+	// required for providers outside of the "hashicorp" namespace.
+	// https://developer.hashicorp.com/terraform/language/providers/requirements
+	RequiredProviders map[string]any `json:"required_providers,omitempty"`
+}
+
+// RequiredProviderInfo represents a provider required by a Terraform module.
+type RequiredProviderInfo struct {
+	// Source is the source address of the provider, e.g. "hashicorp/aws". It is empty if the module doesn't declare it.
+	Source string
+
+	// ConfigurationAliases are the aliases of the provider configurations the module expects to be passed by its
+	// caller. https://developer.hashicorp.com/terraform/language/modules/develop/providers#provider-aliases-within-modules
+	ConfigurationAliases []string
 }
@@ -17,14 +17,23 @@ limitations under the License.
 package terraform
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
 	"time"
 
 	install "github.com/hashicorp/hc-install"
 	"github.com/hashicorp/terraform-exec/tfexec"
 	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/metrics"
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/recipecontext"
@@ -36,20 +45,33 @@ import (
 	ucp_provider "github.com/radius-project/radius/pkg/ucp/secret/provider"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	"go.opentelemetry.io/otel/attribute"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"golang.org/x/exp/maps"
 	"k8s.io/client-go/kubernetes"
 )
 
+const (
+	// planFileName is the name of the file the Terraform plan is saved to in the working directory.
+	planFileName = "recipe.tfplan"
+
+	// mirrorExecName is the name of the Terraform executable in the offline mirror directory.
+	mirrorExecName = "terraform"
+)
+
 var (
 	// ErrRecipeNameEmpty is the error when the recipe name is empty.
 	ErrRecipeNameEmpty = errors.New("recipe name cannot be empty")
+
+	// ErrStateNotFound is the error when the Terraform state of the recipe doesn't exist in the backend.
+	ErrStateNotFound = errors.New("the Terraform state of the recipe was not found in the Terraform backend")
 )
 
 var _ TerraformExecutor = (*executor)(nil)
 
 // NewExecutor creates a new Executor with the given UCP connection and secret provider, to execute a Terraform recipe.
-func NewExecutor(ucpConn sdk.Connection, secretProvider *ucp_provider.SecretProvider, k8sClientSet kubernetes.Interface) *executor {
-	return &executor{ucpConn: ucpConn, secretProvider: secretProvider, k8sClientSet: k8sClientSet}
+// Terraform, modules and providers are shared across executions through the given cache, which is optional. The given
+// version of Terraform is installed, or the latest version if it is empty.
+func NewExecutor(ucpConn sdk.Connection, secretProvider *ucp_provider.SecretProvider, k8sClientSet kubernetes.Interface, cache *Cache, terraformVersion string) *executor {
+	return &executor{ucpConn: ucpConn, secretProvider: secretProvider, k8sClientSet: k8sClientSet, cache: cache, terraformVersion: terraformVersion}
 }
 
 type executor struct {
@@ -61,6 +83,12 @@ type executor struct {
 
 	// k8sClientSet is the Kubernetes client.
 	k8sClientSet kubernetes.Interface
+
+	// cache is the cache of Terraform, modules and providers shared across executions. Nothing is cached if it is nil.
+	cache *Cache
+
+	// terraformVersion is the version of Terraform to install. The latest version is installed if it is empty.
+	terraformVersion string
 }
 
 // Deploy installs Terraform, creates a working directory, generates a config, and runs Terraform init and
@@ -70,7 +98,7 @@ func (e *executor) Deploy(ctx context.Context, options Options) (*tfjson.State,
 
 	// Install Terraform
 	i := install.NewInstaller()
-	tf, err := Install(ctx, i, options.RootDir)
+	tf, err := Install(ctx, i, options.RootDir, e.installOptions(options))
 	// The terraform zip for installation is downloaded in a location outside of the install directory and is only accessible through the installer.Remove function -
 	// stored in latestVersion.pathsToRemove. So this needs to be called for complete cleanup even if the root terraform directory is deleted.
 	defer func() {
@@ -82,8 +110,19 @@ func (e *executor) Deploy(ctx context.Context, options Options) (*tfjson.State,
 		return nil, err
 	}
 
+	backend, err := backends.NewBackend(ctx, options.EnvConfig, options.Secrets, e.k8sClientSet, e.ucpConn, e.secretProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	_, cleanup, err := e.configureEnv(ctx, tf, options, backend)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
 	// Create Terraform config in the working directory
-	kubernetesBackendSuffix, err := e.generateConfig(ctx, tf, options)
+	stateName, err := e.generateConfig(ctx, tf, options, backend)
 	if err != nil {
 		return nil, err
 	}
@@ -91,16 +130,18 @@ func (e *executor) Deploy(ctx context.Context, options Options) (*tfjson.State,
 	// Run TF Init and Apply in the working directory
 	state, err := initAndApply(ctx, tf)
 	if err != nil {
+		if ctx.Err() != nil {
+			e.releaseStateLock(ctx, backend, stateName)
+		}
 		return nil, err
 	}
 
-	// Validate that the terraform state file backend source exists.
-	// Currently only Kubernetes secret backend is supported, which is created by Terraform as a part of Terraform apply.
-	backendExists, err := backends.NewKubernetesBackend(e.k8sClientSet).ValidateBackendExists(ctx, backends.KubernetesBackendNamePrefix+kubernetesBackendSuffix)
+	// Validate that the terraform state file backend source exists, which is created by Terraform as a part of Terraform apply.
+	backendExists, err := backend.ValidateBackendExists(ctx, stateName)
 	if err != nil {
-		return nil, fmt.Errorf("error retrieving kubernetes secret for terraform state: %w", err)
+		return nil, fmt.Errorf("error retrieving terraform state: %w", err)
 	} else if !backendExists {
-		return nil, errors.New("expected kubernetes secret for terraform state is not found")
+		return nil, fmt.Errorf("expected terraform state %q is not found", stateName)
 	}
 
 	return state, nil
@@ -113,7 +154,7 @@ func (e *executor) Delete(ctx context.Context, options Options) error {
 
 	// Install Terraform
 	i := install.NewInstaller()
-	tf, err := Install(ctx, i, options.RootDir)
+	tf, err := Install(ctx, i, options.RootDir, e.installOptions(options))
 	// The terraform zip for installation is downloaded in a location outside of the install directory and is only accessible through the installer.Remove function -
 	// stored in latestVersion.pathsToRemove. So this needs to be called for complete cleanup even if the root terraform directory is deleted.
 	defer func() {
@@ -125,8 +166,19 @@ func (e *executor) Delete(ctx context.Context, options Options) error {
 		return err
 	}
 
+	backend, err := backends.NewBackend(ctx, options.EnvConfig, options.Secrets, e.k8sClientSet, e.ucpConn, e.secretProvider)
+	if err != nil {
+		return err
+	}
+
+	_, cleanup, err := e.configureEnv(ctx, tf, options, backend)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
 	// Create Terraform config in the working directory
-	kubernetesBackendSuffix, err := e.generateConfig(ctx, tf, options)
+	stateName, err := e.generateConfig(ctx, tf, options, backend)
 	if err != nil {
 		return err
 	}
@@ -134,32 +186,109 @@ func (e *executor) Delete(ctx context.Context, options Options) error {
 	// Before running terraform init and destroy, ensure that the Terraform state file storage source exists.
 	// If the state file source has been deleted or wasn't created due to a failure during apply then
 	// terraform initialization will fail due to missing backend source.
-	backendExists, err := backends.NewKubernetesBackend(e.k8sClientSet).ValidateBackendExists(ctx, backends.KubernetesBackendNamePrefix+kubernetesBackendSuffix)
+	backendExists, err := backend.ValidateBackendExists(ctx, stateName)
 	if err != nil {
 		// Continue with the delete flow for all errors other than backend not found.
 		// If it is an intermittent error then the delete flow will fail and should be retried from the client.
 		logger.Info(fmt.Sprintf("Error retrieving Terraform state file backend: %s", err.Error()))
 	} else if !backendExists {
-		// Skip deletion if the backend does not exist. Delete can't be performed without Terraform state file.
-		logger.Info("Skipping deletion of recipe resources: Terraform state file backend does not exist.")
-		return nil
+		// Delete can't be performed without Terraform state file. The caller decides whether the recipe has resources
+		// which would be left behind.
+		return fmt.Errorf("%w, the resources of the recipe can't be destroyed without the state file %q", ErrStateNotFound, stateName)
 	}
 
 	// Run TF Destroy in the working directory to delete the resources deployed by the recipe
 	err = initAndDestroy(ctx, tf)
 	if err != nil {
+		if ctx.Err() != nil {
+			e.releaseStateLock(ctx, backend, stateName)
+		}
 		return err
 	}
 
-	// Delete the kubernetes secret created for terraform state file.
-	err = e.k8sClientSet.CoreV1().
-		Secrets(backends.RadiusNamespace).
-		Delete(ctx, backends.KubernetesBackendNamePrefix+kubernetesBackendSuffix, metav1.DeleteOptions{})
+	// Delete the terraform state file from the backend source.
+	return backend.DeleteState(ctx, stateName)
+}
+
+// Plan installs Terraform, creates a working directory, generates a config, and runs Terraform init and
+// plan in the working directory, returning the planned changes or an error if any of these steps fail.
+func (e *executor) Plan(ctx context.Context, options Options) (*tfjson.Plan, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Install Terraform
+	i := install.NewInstaller()
+	tf, err := Install(ctx, i, options.RootDir, e.installOptions(options))
+	// The terraform zip for installation is downloaded in a location outside of the install directory and is only accessible through the installer.Remove function -
+	// stored in latestVersion.pathsToRemove. So this needs to be called for complete cleanup even if the root terraform directory is deleted.
+	defer func() {
+		if err := i.Remove(ctx); err != nil {
+			logger.Info(fmt.Sprintf("Failed to cleanup Terraform installation: %s", err.Error()))
+		}
+	}()
+	if err != nil {
+		return nil, err
+	}
+
+	backend, err := backends.NewBackend(ctx, options.EnvConfig, options.Secrets, e.k8sClientSet, e.ucpConn, e.secretProvider)
 	if err != nil {
-		return fmt.Errorf("error deleting kubernetes secret for terraform state: %w", err)
+		return nil, err
 	}
 
-	return nil
+	_, cleanup, err := e.configureEnv(ctx, tf, options, backend)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	// Create Terraform config in the working directory
+	_, err = e.generateConfig(ctx, tf, options, backend)
+	if err != nil {
+		return nil, err
+	}
+
+	// Run TF Init and Plan in the working directory
+	return initAndPlan(ctx, tf)
+}
+
+// DetectDrift installs Terraform, creates a working directory, generates a config, and runs Terraform init and a
+// refresh-only plan in the working directory. It returns the Terraform state along with the resources which were
+// changed outside of Terraform since they were last applied.
+func (e *executor) DetectDrift(ctx context.Context, options Options) (*tfjson.State, []ResourceDrift, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Install Terraform
+	i := install.NewInstaller()
+	tf, err := Install(ctx, i, options.RootDir, e.installOptions(options))
+	// The terraform zip for installation is downloaded in a location outside of the install directory and is only accessible through the installer.Remove function -
+	// stored in latestVersion.pathsToRemove. So this needs to be called for complete cleanup even if the root terraform directory is deleted.
+	defer func() {
+		if err := i.Remove(ctx); err != nil {
+			logger.Info(fmt.Sprintf("Failed to cleanup Terraform installation: %s", err.Error()))
+		}
+	}()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	backend, err := backends.NewBackend(ctx, options.EnvConfig, options.Secrets, e.k8sClientSet, e.ucpConn, e.secretProvider)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	env, cleanup, err := e.configureEnv(ctx, tf, options, backend)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer cleanup()
+
+	// Create Terraform config in the working directory
+	_, err = e.generateConfig(ctx, tf, options, backend)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Run TF Init and a refresh-only plan in the working directory
+	return initAndDetectDrift(ctx, tf, env)
 }
 
 func (e *executor) GetRecipeMetadata(ctx context.Context, options Options) (map[string]any, error) {
@@ -167,7 +296,7 @@ func (e *executor) GetRecipeMetadata(ctx context.Context, options Options) (map[
 
 	// Install Terraform
 	i := install.NewInstaller()
-	tf, err := Install(ctx, i, options.RootDir)
+	tf, err := Install(ctx, i, options.RootDir, e.installOptions(options))
 	// The terraform zip for installation is downloaded in a location outside of the install directory and is only accessible through the installer.Remove function -
 	// stored in latestVersion.pathsToRemove. So this needs to be called for complete cleanup even if the root terraform directory is deleted.
 	defer func() {
@@ -179,12 +308,18 @@ func (e *executor) GetRecipeMetadata(ctx context.Context, options Options) (map[
 		return nil, err
 	}
 
+	_, cleanup, err := e.configureEnv(ctx, tf, options, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
 	_, err = getTerraformConfig(ctx, tf.WorkingDir(), options)
 	if err != nil {
 		return nil, err
 	}
 
-	result, err := downloadAndInspect(ctx, tf, options)
+	result, err := e.downloadAndInspect(ctx, tf, options)
 	if err != nil {
 		return nil, err
 	}
@@ -194,8 +329,82 @@ func (e *executor) GetRecipeMetadata(ctx context.Context, options Options) (map[
 	}, nil
 }
 
+// installOptions returns the options of the Terraform installation for the given execution. The Terraform executable
+// of the offline mirror of the environment is used if there is one, so that Terraform isn't downloaded on air-gapped
+// clusters.
+func (e *executor) installOptions(options Options) InstallOptions {
+	installOptions := InstallOptions{Version: e.terraformVersion, Cache: e.cache}
+	if options.EnvConfig == nil || options.EnvConfig.RecipeConfig.Terraform.ProviderMirrorPath == "" {
+		return installOptions
+	}
+
+	execPath := filepath.Join(options.EnvConfig.RecipeConfig.Terraform.ProviderMirrorPath, mirrorExecName)
+	if info, err := os.Stat(execPath); err == nil && info.Mode().IsRegular() {
+		installOptions.ExecPath = execPath
+	}
+
+	return installOptions
+}
+
+// configureEnv configures the installation of the providers, the credentials used to download modules from
+// private sources and the credentials of the given backend, which is optional, and sets the resulting environment of
+// Terraform. It returns the environment along with a cleanup function removing the credentials and releasing the
+// plugin cache, which must be called once Terraform is no longer run.
+func (e *executor) configureEnv(ctx context.Context, tf *tfexec.Terraform, options Options, backend backends.Backend) ([]string, func(), error) {
+	tfConfig := datamodel.TerraformConfigProperties{}
+	if options.EnvConfig != nil {
+		tfConfig = options.EnvConfig.RecipeConfig.Terraform
+	}
+
+	env := newTerraformEnv()
+	releasePluginCache, err := configureProviderInstallation(ctx, tf.WorkingDir(), e.cache, tfConfig.ProviderMirrorPath, env)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cleanupAuthentication, err := configureModuleAuthentication(ctx, tfConfig.Authentication, options.Secrets, env)
+	if err != nil {
+		releasePluginCache()
+		return nil, nil, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	cleanup := func() {
+		cleanupAuthentication()
+		releasePluginCache()
+	}
+
+	if backend != nil {
+		for k, v := range backend.Env() {
+			env[k] = v
+		}
+	}
+
+	envList, err := setTerraformEnv(tf, env)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+
+	return envList, cleanup, nil
+}
+
+// releaseStateLock releases the lock of the Terraform state file when the operation is canceled. terraform-exec kills
+// the running Terraform process when the context is canceled, so Terraform can't unlock the state by itself and the
+// next deployment of the recipe would fail to acquire the lock. The lock is released with a context which is not
+// canceled since the given context is already done.
+func (e *executor) releaseStateLock(ctx context.Context, backend backends.Backend, stateName string) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	logger.Info("Releasing Terraform state lock of the canceled operation")
+	err := backend.ReleaseLock(context.WithoutCancel(ctx), stateName)
+	if err != nil {
+		logger.Info(fmt.Sprintf("Failed to release Terraform state lock: %s", err.Error()))
+	}
+}
+
 // generateConfig generates Terraform configuration with required inputs for the module, providers and backend to be initialized and applied.
-func (e *executor) generateConfig(ctx context.Context, tf *tfexec.Terraform, options Options) (string, error) {
+// It returns the name of the Terraform state file in the backend source.
+func (e *executor) generateConfig(ctx context.Context, tf *tfexec.Terraform, options Options, backend backends.Backend) (string, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 	workingDir := tf.WorkingDir()
 
@@ -204,30 +413,27 @@ func (e *executor) generateConfig(ctx context.Context, tf *tfexec.Terraform, opt
 		return "", err
 	}
 
-	loadedModule, err := downloadAndInspect(ctx, tf, options)
+	loadedModule, err := e.downloadAndInspect(ctx, tf, options)
 	if err != nil {
 		return "", err
 	}
 
 	// Generate Terraform providers configuration for required providers and add it to the Terraform configuration.
-	logger.Info(fmt.Sprintf("Adding provider config for required providers %+v", loadedModule.RequiredProviders))
+	logger.Info(fmt.Sprintf("Adding provider config for required providers %+v", maps.Keys(loadedModule.RequiredProviders)))
 	if err := tfConfig.AddProviders(ctx, loadedModule.RequiredProviders, providers.GetSupportedTerraformProviders(e.ucpConn, e.secretProvider),
-		options.EnvConfig); err != nil {
+		options.EnvConfig, options.Secrets); err != nil {
 		return "", err
 	}
 
-	backendConfig, err := tfConfig.AddTerraformBackend(options.ResourceRecipe, backends.NewKubernetesBackend(e.k8sClientSet))
+	_, err = tfConfig.AddTerraformBackend(options.ResourceRecipe, backend)
 	if err != nil {
 		return "", err
 	}
-	// Retrieving the secret_suffix property from backend config to use it to verify secret creation during terraform init.
-	// This is only used for the backend of type kubernetes and should be moved inside an if block when we add more backends.
-	var secretSuffix string
-	if backendDetails, ok := backendConfig[backends.BackendKubernetes]; ok {
-		backendMap := backendDetails.(map[string]any)
-		if secret, ok := backendMap["secret_suffix"]; ok {
-			secretSuffix = secret.(string)
-		}
+
+	// Retrieving the name of the state file to verify its creation during terraform apply.
+	stateName, err := backend.StateName(options.ResourceRecipe)
+	if err != nil {
+		return "", err
 	}
 
 	// Add recipe context parameter to the generated Terraform config's module parameters.
@@ -258,25 +464,50 @@ func (e *executor) generateConfig(ctx context.Context, tf *tfexec.Terraform, opt
 		return "", err
 	}
 
-	return secretSuffix, nil
+	return stateName, nil
 }
 
-// downloadAndInspect handles downloading the TF module and retrieving the necessary information
-func downloadAndInspect(ctx context.Context, tf *tfexec.Terraform, options Options) (*moduleInspectResult, error) {
+// downloadAndInspect handles downloading the TF module and retrieving the necessary information. The module is
+// restored from the cache if it was downloaded by a previous execution, and stored in the cache otherwise.
+func (e *executor) downloadAndInspect(ctx context.Context, tf *tfexec.Terraform, options Options) (*moduleInspectResult, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
-	// Download the Terraform module to the working directory.
-	logger.Info(fmt.Sprintf("Downloading Terraform module: %s", options.EnvRecipe.TemplatePath))
+	credentialID := ""
+	if options.EnvConfig != nil {
+		credentialID = ModuleCredentialID(options.EnvConfig.RecipeConfig.Terraform.Authentication)
+	}
+
+	cacheState := metrics.CacheMiss
 	downloadStartTime := time.Now()
-	if err := downloadModule(ctx, tf, options.EnvRecipe.TemplatePath); err != nil {
-		metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
-			metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, options.EnvRecipe.Name,
-				options.EnvRecipe, recipes.RecipeDownloadFailed))
-		return nil, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), util.RecipeSetupError, recipes.GetErrorDetails(err))
+	if e.cache != nil {
+		restored, err := e.cache.RestoreModule(ctx, tf.WorkingDir(), options.EnvRecipe.Name, options.EnvRecipe.TemplatePath, options.EnvRecipe.TemplateVersion, credentialID)
+		if err != nil {
+			// The module is downloaded from its source instead.
+			logger.Info(fmt.Sprintf("Failed to restore Terraform module from the cache: %s", err.Error()))
+		} else if restored {
+			cacheState = metrics.CacheHit
+		}
+	}
+
+	if cacheState == metrics.CacheMiss {
+		// Download the Terraform module to the working directory.
+		logger.Info(fmt.Sprintf("Downloading Terraform module: %s", options.EnvRecipe.TemplatePath))
+		if err := downloadModule(ctx, tf, options.EnvRecipe.TemplatePath); err != nil {
+			metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
+				append(metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, options.EnvRecipe.Name,
+					options.EnvRecipe, recipes.RecipeDownloadFailed), metrics.RecipeCacheAttrKey.String(cacheState)))
+			return nil, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), util.RecipeSetupError, recipes.GetErrorDetails(err))
+		}
+
+		if e.cache != nil {
+			if err := e.cache.StoreModule(ctx, tf.WorkingDir(), options.EnvRecipe.Name, options.EnvRecipe.TemplatePath, options.EnvRecipe.TemplateVersion, credentialID); err != nil {
+				logger.Info(fmt.Sprintf("Failed to store Terraform module in the cache: %s", err.Error()))
+			}
+		}
 	}
 	metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
-		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, options.EnvRecipe.Name,
-			options.EnvRecipe, metrics.SuccessfulOperationState))
+		append(metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, options.EnvRecipe.Name,
+			options.EnvRecipe, metrics.SuccessfulOperationState), metrics.RecipeCacheAttrKey.String(cacheState)))
 
 	// Load the downloaded module to retrieve providers and variables required by the module.
 	// This is needed to add the appropriate providers config and populate the value of recipe context variable.
@@ -340,6 +571,116 @@ func initAndApply(ctx context.Context, tf *tfexec.Terraform) (*tfjson.State, err
 	return tf.Show(ctx)
 }
 
+// initAndPlan runs Terraform init and plan in the provided working directory and returns the saved plan.
+func initAndPlan(ctx context.Context, tf *tfexec.Terraform) (*tfjson.Plan, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Initialize Terraform
+	logger.Info("Initializing Terraform")
+	terraformInitStartTime := time.Now()
+	if err := tf.Init(ctx); err != nil {
+		metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime,
+			[]attribute.KeyValue{metrics.OperationStateAttrKey.String(metrics.FailedOperationState)})
+
+		return nil, fmt.Errorf("terraform init failure: %w", err)
+	}
+	metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime,
+		[]attribute.KeyValue{metrics.OperationStateAttrKey.String(metrics.SuccessfulOperationState)})
+
+	// Plan Terraform configuration and save the plan so that it can be read as JSON. The plan only reads the state, so
+	// it doesn't lock the state: a plan must not block deployments of the recipe, nor leave a lock behind when it is canceled.
+	logger.Info("Running Terraform plan")
+	planFile := filepath.Join(tf.WorkingDir(), planFileName)
+	if _, err := tf.Plan(ctx, tfexec.Out(planFile), tfexec.Lock(false)); err != nil {
+		return nil, fmt.Errorf("terraform plan failure: %w", err)
+	}
+
+	logger.Info("Fetching Terraform plan")
+	return tf.ShowPlanFile(ctx, planFile)
+}
+
+// initAndDetectDrift runs Terraform init and a refresh-only plan in the provided working directory and returns the
+// current state along with the drifted resources.
+func initAndDetectDrift(ctx context.Context, tf *tfexec.Terraform, env []string) (*tfjson.State, []ResourceDrift, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Initialize Terraform
+	logger.Info("Initializing Terraform")
+	terraformInitStartTime := time.Now()
+	if err := tf.Init(ctx); err != nil {
+		metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime,
+			[]attribute.KeyValue{metrics.OperationStateAttrKey.String(metrics.FailedOperationState)})
+
+		return nil, nil, fmt.Errorf("terraform init failure: %w", err)
+	}
+	metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime,
+		[]attribute.KeyValue{metrics.OperationStateAttrKey.String(metrics.SuccessfulOperationState)})
+
+	logger.Info("Fetching Terraform state")
+	state, err := tf.Show(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("terraform show failure: %w", err)
+	}
+
+	// terraform-exec doesn't support refresh-only plans, so Terraform is run directly. The plan is not saved and
+	// doesn't modify the state, so the state is not locked to avoid blocking a concurrent deployment of the recipe.
+	logger.Info("Running Terraform refresh-only plan")
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, tf.ExecPath(), "plan", "-refresh-only", "-json", "-input=false", "-lock=false")
+	cmd.Dir = tf.WorkingDir()
+	cmd.Env = env
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, nil, fmt.Errorf("terraform refresh-only plan failure: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	drift, err := parseResourceDrift(&stdout)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read terraform refresh-only plan output: %w", err)
+	}
+
+	return state, drift, nil
+}
+
+// parseResourceDrift reads the machine readable UI output of a Terraform plan and returns the resources reported as
+// changed outside of Terraform. See https://developer.hashicorp.com/terraform/internals/machine-readable-ui.
+func parseResourceDrift(r io.Reader) ([]ResourceDrift, error) {
+	drift := []ResourceDrift{}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		message := struct {
+			Type   string `json:"type"`
+			Change struct {
+				Resource struct {
+					Addr string `json:"addr"`
+				} `json:"resource"`
+				Action string `json:"action"`
+			} `json:"change"`
+		}{}
+		if err := json.Unmarshal(scanner.Bytes(), &message); err != nil {
+			return nil, err
+		}
+
+		if message.Type != "resource_drift" {
+			continue
+		}
+
+		drift = append(drift, ResourceDrift{
+			Address: message.Change.Resource.Addr,
+			Action:  message.Change.Action,
+		})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return drift, nil
+}
+
 // initAndDestroy runs Terraform init and destroy in the provided working directory.
 func initAndDestroy(ctx context.Context, tf *tfexec.Terraform) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
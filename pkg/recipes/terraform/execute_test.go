@@ -17,12 +17,15 @@ limitations under the License.
 package terraform
 
 import (
+	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-exec/tfexec"
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/terraform/config"
+	"github.com/radius-project/radius/pkg/recipes/terraform/config/backends"
 	"github.com/radius-project/radius/test/testcontext"
 	"github.com/stretchr/testify/require"
 )
@@ -55,7 +58,7 @@ func TestGenerateConfig(t *testing.T) {
 			require.NoError(t, err)
 
 			e := executor{}
-			_, err = e.generateConfig(ctx, tf, tc.opts)
+			_, err = e.generateConfig(ctx, tf, tc.opts, backends.NewKubernetesBackend(nil))
 			require.Error(t, err)
 			require.ErrorContains(t, err, tc.err)
 		})
@@ -114,3 +117,57 @@ func Test_GetTerraformConfig_InvalidDirectory(t *testing.T) {
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "error creating file: open invalid-directory/main.tf.json: no such file or directory")
 }
+
+func Test_ParseResourceDrift(t *testing.T) {
+	output := `{"@level":"info","@message":"Terraform 1.5.7","type":"version","terraform":"1.5.7","ui":"1.1"}
+{"@level":"info","@message":"azurerm_redis_cache.cache: Refreshing state...","type":"refresh_start","hook":{"resource":{"addr":"azurerm_redis_cache.cache"},"action":"refresh"}}
+{"@level":"info","@message":"azurerm_redis_cache.cache: Drift detected (update)","type":"resource_drift","change":{"resource":{"addr":"azurerm_redis_cache.cache","resource_type":"azurerm_redis_cache"},"action":"update"}}
+{"@level":"info","@message":"module.redis.kubernetes_deployment.redis: Drift detected (delete)","type":"resource_drift","change":{"resource":{"addr":"module.redis.kubernetes_deployment.redis","resource_type":"kubernetes_deployment"},"action":"delete"}}
+{"@level":"info","@message":"Plan: 0 to add, 0 to change, 0 to destroy.","type":"change_summary","changes":{"add":0,"change":0,"remove":0,"operation":"plan"}}
+`
+
+	drift, err := parseResourceDrift(strings.NewReader(output))
+	require.NoError(t, err)
+	require.Equal(t, []ResourceDrift{
+		{Address: "azurerm_redis_cache.cache", Action: "update"},
+		{Address: "module.redis.kubernetes_deployment.redis", Action: "delete"},
+	}, drift)
+}
+
+func Test_ParseResourceDrift_NoDrift(t *testing.T) {
+	output := `{"@level":"info","@message":"Plan: 0 to add, 0 to change, 0 to destroy.","type":"change_summary","changes":{"add":0,"change":0,"remove":0,"operation":"plan"}}
+`
+
+	drift, err := parseResourceDrift(strings.NewReader(output))
+	require.NoError(t, err)
+	require.Empty(t, drift)
+}
+
+func Test_ParseResourceDrift_InvalidOutput(t *testing.T) {
+	_, err := parseResourceDrift(strings.NewReader("not json"))
+	require.Error(t, err)
+}
+
+func Test_InstallOptions(t *testing.T) {
+	cache := NewCache(t.TempDir(), 0)
+	e := executor{cache: cache, terraformVersion: "1.6.0"}
+
+	t.Run("no mirror", func(t *testing.T) {
+		require.Equal(t, InstallOptions{Version: "1.6.0", Cache: cache}, e.installOptions(Options{}))
+	})
+
+	t.Run("mirror without terraform", func(t *testing.T) {
+		options := Options{EnvConfig: &recipes.Configuration{}}
+		options.EnvConfig.RecipeConfig.Terraform.ProviderMirrorPath = t.TempDir()
+		require.Equal(t, InstallOptions{Version: "1.6.0", Cache: cache}, e.installOptions(options))
+	})
+
+	t.Run("mirror with terraform", func(t *testing.T) {
+		mirrorPath := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(mirrorPath, mirrorExecName), []byte("terraform"), 0755))
+
+		options := Options{EnvConfig: &recipes.Configuration{}}
+		options.EnvConfig.RecipeConfig.Terraform.ProviderMirrorPath = mirrorPath
+		require.Equal(t, InstallOptions{Version: "1.6.0", ExecPath: filepath.Join(mirrorPath, mirrorExecName), Cache: cache}, e.installOptions(options))
+	})
+}
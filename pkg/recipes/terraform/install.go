@@ -23,6 +23,7 @@ import (
 	"path/filepath"
 	"time"
 
+	"github.com/hashicorp/go-version"
 	install "github.com/hashicorp/hc-install"
 	"github.com/hashicorp/hc-install/product"
 	"github.com/hashicorp/hc-install/releases"
@@ -39,33 +40,37 @@ const (
 	installVerificationRetryDelaySecs = 3
 )
 
-// Install installs Terraform under /install in the provided Terraform root directory for the resource. It installs
-// the latest version of Terraform and returns the path to the installed Terraform executable. It returns an error
+// InstallOptions represents the options of the Terraform installation.
+type InstallOptions struct {
+	// Version is the version of Terraform to install. The latest version is installed if it is empty.
+	Version string
+
+	// ExecPath is the path to a local Terraform executable, e.g. in an offline mirror. Terraform is not downloaded if
+	// it is set.
+	ExecPath string
+
+	// Cache is the cache where the downloaded Terraform executables are kept across executions. It is optional.
+	Cache *Cache
+}
+
+// Install installs Terraform under /install in the provided Terraform root directory for the resource and returns
+// the Terraform instance of the installation. The local executable of the options is used if it is set, otherwise
+// the configured version, or the latest version, is downloaded unless it is already in the cache. It returns an error
 // if the directory creation or Terraform installation fails.
-func Install(ctx context.Context, installer *install.Installer, tfDir string) (*tfexec.Terraform, error) {
+func Install(ctx context.Context, installer *install.Installer, tfDir string, options InstallOptions) (*tfexec.Terraform, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
-	// Create Terraform installation directory
-	installDir := filepath.Join(tfDir, installSubDir)
-	if err := os.MkdirAll(installDir, 0755); err != nil {
-		return nil, fmt.Errorf("failed to create directory for terraform installation for resource: %w", err)
+	versionAttr := options.Version
+	if versionAttr == "" {
+		versionAttr = latestTerraformVersion
 	}
 
-	logger.Info(fmt.Sprintf("Installing Terraform in the directory: %q", installDir))
-
 	installStartTime := time.Now()
-	// Re-visit this: consider checking if an existing installation of same version of Terraform is available.
-	// For initial iteration we will always install Terraform for every execution of the recipe driver.
-	execPath, err := installer.Ensure(ctx, []src.Source{
-		&releases.LatestVersion{
-			Product:    product.Terraform,
-			InstallDir: installDir,
-		},
-	})
+	execPath, err := ensureTerraform(ctx, installer, tfDir, options)
 	if err != nil {
 		metrics.DefaultRecipeEngineMetrics.RecordTerraformInstallationDuration(ctx, installStartTime,
 			[]attribute.KeyValue{
-				metrics.TerraformVersionAttrKey.String("latest"),
+				metrics.TerraformVersionAttrKey.String(versionAttr),
 				metrics.OperationStateAttrKey.String(metrics.FailedOperationState),
 			},
 		)
@@ -74,12 +79,12 @@ func Install(ctx context.Context, installer *install.Installer, tfDir string) (*
 
 	metrics.DefaultRecipeEngineMetrics.RecordTerraformInstallationDuration(ctx, installStartTime,
 		[]attribute.KeyValue{
-			metrics.TerraformVersionAttrKey.String("latest"),
+			metrics.TerraformVersionAttrKey.String(versionAttr),
 			metrics.OperationStateAttrKey.String(metrics.SuccessfulOperationState),
 		},
 	)
 
-	logger.Info(fmt.Sprintf("Terraform latest version installed to: %q", execPath))
+	logger.Info(fmt.Sprintf("Using Terraform %s installed at: %q", versionAttr, execPath))
 
 	// Create a new instance of tfexec.Terraform with current Terraform installation path
 	tf, err := NewTerraform(ctx, tfDir, execPath)
@@ -93,7 +98,7 @@ func Install(ctx context.Context, installer *install.Installer, tfDir string) (*
 		if err == nil {
 			metrics.DefaultRecipeEngineMetrics.RecordTerraformInstallVerificationDuration(ctx, installStartTime,
 				[]attribute.KeyValue{
-					metrics.TerraformVersionAttrKey.String("latest"),
+					metrics.TerraformVersionAttrKey.String(versionAttr),
 					metrics.OperationStateAttrKey.String(metrics.SuccessfulOperationState),
 				},
 			)
@@ -103,7 +108,7 @@ func Install(ctx context.Context, installer *install.Installer, tfDir string) (*
 			logger.Info(fmt.Sprintf("Failed to verify Terraform installation completion: %s. Retrying after %d seconds", err.Error(), installVerificationRetryDelaySecs))
 			metrics.DefaultRecipeEngineMetrics.RecordTerraformInstallVerificationDuration(ctx, installStartTime,
 				[]attribute.KeyValue{
-					metrics.TerraformVersionAttrKey.String("latest"),
+					metrics.TerraformVersionAttrKey.String(versionAttr),
 					metrics.OperationStateAttrKey.String(metrics.FailedOperationState),
 				},
 			)
@@ -118,3 +123,68 @@ func Install(ctx context.Context, installer *install.Installer, tfDir string) (*
 
 	return tf, nil
 }
+
+// ensureTerraform returns the path to the Terraform executable described by the options. The executable is downloaded
+// to the installation directory in the Terraform root directory if it is neither local nor in the cache, and stored in
+// the cache once it is downloaded.
+func ensureTerraform(ctx context.Context, installer *install.Installer, tfDir string, options InstallOptions) (string, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	if options.ExecPath != "" {
+		if _, err := os.Stat(options.ExecPath); err != nil {
+			return "", fmt.Errorf("failed to find local terraform executable: %w", err)
+		}
+		return options.ExecPath, nil
+	}
+
+	var v *version.Version
+	if options.Version != "" {
+		var err error
+		v, err = version.NewVersion(options.Version)
+		if err != nil {
+			return "", fmt.Errorf("invalid terraform version %q: %w", options.Version, err)
+		}
+	}
+
+	if options.Cache != nil {
+		if execPath, ok := options.Cache.TerraformExecPath(options.Version); ok {
+			return execPath, nil
+		}
+	}
+
+	// Create Terraform installation directory
+	installDir := filepath.Join(tfDir, installSubDir)
+	if err := os.MkdirAll(installDir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create directory for terraform installation for resource: %w", err)
+	}
+
+	var source src.Source = &releases.LatestVersion{
+		Product:    product.Terraform,
+		InstallDir: installDir,
+	}
+	if v != nil {
+		source = &releases.ExactVersion{
+			Product:    product.Terraform,
+			Version:    v,
+			InstallDir: installDir,
+		}
+	}
+
+	logger.Info(fmt.Sprintf("Installing Terraform in the directory: %q", installDir))
+	execPath, err := installer.Ensure(ctx, []src.Source{source})
+	if err != nil {
+		return "", err
+	}
+
+	if options.Cache != nil {
+		cachedPath, err := options.Cache.StoreTerraform(ctx, options.Version, execPath)
+		if err != nil {
+			// The downloaded executable can still be used for this execution.
+			logger.Info(fmt.Sprintf("Failed to cache Terraform executable: %s", err.Error()))
+			return execPath, nil
+		}
+		return cachedPath, nil
+	}
+
+	return execPath, nil
+}
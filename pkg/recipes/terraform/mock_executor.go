@@ -64,6 +64,22 @@ func (mr *MockTerraformExecutorMockRecorder) Deploy(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deploy", reflect.TypeOf((*MockTerraformExecutor)(nil).Deploy), arg0, arg1)
 }
 
+// DetectDrift mocks base method.
+func (m *MockTerraformExecutor) DetectDrift(arg0 context.Context, arg1 Options) (*terraform_json.State, []ResourceDrift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetectDrift", arg0, arg1)
+	ret0, _ := ret[0].(*terraform_json.State)
+	ret1, _ := ret[1].([]ResourceDrift)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// DetectDrift indicates an expected call of DetectDrift.
+func (mr *MockTerraformExecutorMockRecorder) DetectDrift(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectDrift", reflect.TypeOf((*MockTerraformExecutor)(nil).DetectDrift), arg0, arg1)
+}
+
 // GetRecipeMetadata mocks base method.
 func (m *MockTerraformExecutor) GetRecipeMetadata(arg0 context.Context, arg1 Options) (map[string]interface{}, error) {
 	m.ctrl.T.Helper()
@@ -78,3 +94,18 @@ func (mr *MockTerraformExecutorMockRecorder) GetRecipeMetadata(arg0, arg1 interf
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecipeMetadata", reflect.TypeOf((*MockTerraformExecutor)(nil).GetRecipeMetadata), arg0, arg1)
 }
+
+// Plan mocks base method.
+func (m *MockTerraformExecutor) Plan(arg0 context.Context, arg1 Options) (*terraform_json.Plan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Plan", arg0, arg1)
+	ret0, _ := ret[0].(*terraform_json.Plan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Plan indicates an expected call of Plan.
+func (mr *MockTerraformExecutorMockRecorder) Plan(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Plan", reflect.TypeOf((*MockTerraformExecutor)(nil).Plan), arg0, arg1)
+}
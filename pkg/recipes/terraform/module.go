@@ -25,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-exec/tfexec"
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/recipecontext"
+	"github.com/radius-project/radius/pkg/recipes/terraform/config"
 )
 
 const (
@@ -36,8 +37,8 @@ type moduleInspectResult struct {
 	// ContextVarExists is true if the module has a variable defined for recipe context.
 	ContextVarExists bool
 
-	// RequiredProviders is a list of names of required providers for the module.
-	RequiredProviders []string
+	// RequiredProviders contains the providers required by the module, keyed by the provider name.
+	RequiredProviders map[string]*config.RequiredProviderInfo
 
 	// ResultOutputExists is true if the module contains an output named "result".
 	ResultOutputExists bool
@@ -54,7 +55,7 @@ type moduleInspectResult struct {
 // It uses terraform-config-inspect to load the module from the directory. An error is returned if the module
 // could not be loaded.
 func inspectModule(workingDir, localModuleName string) (*moduleInspectResult, error) {
-	result := &moduleInspectResult{ContextVarExists: false, RequiredProviders: []string{}, ResultOutputExists: false, Parameters: map[string]any{}}
+	result := &moduleInspectResult{ContextVarExists: false, RequiredProviders: map[string]*config.RequiredProviderInfo{}, ResultOutputExists: false, Parameters: map[string]any{}}
 
 	// Modules are downloaded in a subdirectory in the working directory.
 	// Name of the module specified in the configuration is used as subdirectory name.
@@ -70,8 +71,12 @@ func inspectModule(workingDir, localModuleName string) (*moduleInspectResult, er
 	}
 
 	// Extract the list of required providers.
-	for providerName := range mod.RequiredProviders {
-		result.RequiredProviders = append(result.RequiredProviders, providerName)
+	for providerName, requirement := range mod.RequiredProviders {
+		info := &config.RequiredProviderInfo{Source: requirement.Source}
+		for _, alias := range requirement.ConfigurationAliases {
+			info.ConfigurationAliases = append(info.ConfigurationAliases, alias.Alias)
+		}
+		result.RequiredProviders[providerName] = info
 	}
 
 	// Check if an output named "result" is defined in the module.
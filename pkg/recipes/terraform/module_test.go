@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-config-inspect/tfconfig"
+	"github.com/radius-project/radius/pkg/recipes/terraform/config"
 	"github.com/stretchr/testify/require"
 )
 
@@ -36,8 +37,10 @@ func Test_InspectTFModuleConfig(t *testing.T) {
 			workingDir: "testdata",
 			moduleName: "test-module-provideronly",
 			result: &moduleInspectResult{
-				ContextVarExists:   false,
-				RequiredProviders:  []string{"aws"},
+				ContextVarExists: false,
+				RequiredProviders: map[string]*config.RequiredProviderInfo{
+					"aws": {Source: "hashicorp/aws"},
+				},
 				ResultOutputExists: false,
 				Parameters:         map[string]any{},
 			},
@@ -47,8 +50,10 @@ func Test_InspectTFModuleConfig(t *testing.T) {
 			workingDir: "testdata",
 			moduleName: "test-module-recipe-context-outputs",
 			result: &moduleInspectResult{
-				ContextVarExists:   true,
-				RequiredProviders:  []string{"aws"},
+				ContextVarExists: true,
+				RequiredProviders: map[string]*config.RequiredProviderInfo{
+					"aws": {Source: "hashicorp/aws"},
+				},
 				ResultOutputExists: true,
 				Parameters: map[string]any{
 					"context": map[string]any{
@@ -66,6 +71,20 @@ func Test_InspectTFModuleConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:       "providers with configuration aliases",
+			workingDir: "testdata",
+			moduleName: "test-module-provider-aliases",
+			result: &moduleInspectResult{
+				ContextVarExists: false,
+				RequiredProviders: map[string]*config.RequiredProviderInfo{
+					"aws":        {Source: "hashicorp/aws", ConfigurationAliases: []string{"east", "west"}},
+					"postgresql": {Source: "cyrilgdn/postgresql"},
+				},
+				ResultOutputExists: false,
+				Parameters:         map[string]any{},
+			},
+		},
 		{
 			name:       "invalid module name - non existent module directory",
 			workingDir: "testdata",
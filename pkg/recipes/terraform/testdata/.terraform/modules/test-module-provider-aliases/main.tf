@@ -0,0 +1,12 @@
+terraform {
+  required_providers {
+    aws = {
+      source                = "hashicorp/aws"
+      version               = ">=3.0"
+      configuration_aliases = [aws.east, aws.west]
+    }
+    postgresql = {
+      source = "cyrilgdn/postgresql"
+    }
+  }
+}
@@ -40,11 +40,29 @@ type TerraformExecutor interface {
 	Deploy(ctx context.Context, options Options) (*tfjson.State, error)
 
 	// Delete installs terraform and runs terraform destroy on the terraform module referenced by the recipe using terraform-exec,
-	// and deletes the Kubernetes secret created for terraform state store.
+	// and deletes the Terraform state file from the backend. Returns ErrStateNotFound if the state file doesn't exist in the backend.
 	Delete(ctx context.Context, options Options) error
 
 	// GetRecipeMetadata installs terraform and runs terraform get to retrieve information on the terraform module
 	GetRecipeMetadata(ctx context.Context, options Options) (map[string]any, error)
+
+	// Plan installs terraform and runs terraform init and plan on the terraform module referenced by the recipe using terraform-exec,
+	// and returns the changes terraform apply would make.
+	Plan(ctx context.Context, options Options) (*tfjson.Plan, error)
+
+	// DetectDrift installs terraform and runs terraform init and a refresh-only plan on the terraform module referenced by the recipe,
+	// and returns the current state along with the resources which were changed outside of Terraform.
+	DetectDrift(ctx context.Context, options Options) (*tfjson.State, []ResourceDrift, error)
+}
+
+// ResourceDrift represents a change made outside of Terraform to a resource managed by the Terraform recipe, as reported by a
+// refresh-only plan.
+type ResourceDrift struct {
+	// Address is the address of the resource in the Terraform configuration.
+	Address string
+
+	// Action is the change detected by Terraform, either "update" or "delete".
+	Action string
 }
 
 // Options represents the options required to build inputs to interact with Terraform.
@@ -60,6 +78,10 @@ type Options struct {
 
 	// ResourceRecipe is recipe metadata associated with the Radius resource deploying the Terraform recipe.
 	ResourceRecipe *recipes.ResourceMetadata
+
+	// Secrets contains the secrets of the secret stores referenced by the Terraform authentication, provider and backend
+	// configurations of the environment, keyed by the secret store ID and then by the secret name.
+	Secrets map[string]map[string]string
 }
 
 // NewTerraform creates a working directory for Terraform execution and new Terraform executor with Terraform logs enabled.
@@ -30,6 +30,8 @@ type Configuration struct {
 	Runtime RuntimeConfiguration
 	// Cloud providers configuration for the environment
 	Providers datamodel.Providers
+	// RecipeConfig represents the configuration for Recipes of the environment.
+	RecipeConfig datamodel.RecipeConfigProperties
 	// Simulated represents whether the environment is simulated or not.
 	Simulated bool
 }
@@ -80,13 +82,32 @@ type ResourceMetadata struct {
 const (
 	TemplateKindBicep     = "bicep"
 	TemplateKindTerraform = "terraform"
+	TemplateKindHelm      = "helm"
+
+	// Kinds of the Terraform backends which can store the Terraform state of the Recipes.
+	TerraformBackendKubernetes = "kubernetes"
+	TerraformBackendS3         = "s3"
+	TerraformBackendAzureRM    = "azurerm"
+	TerraformBackendPostgres   = "pg"
+	TerraformBackendLocal      = "local"
 
 	// Recipe outputs are expected to be wrapped under an object named "result"
 	ResultPropertyName = "result"
 )
 
 var (
-	SupportedTemplateKind = []string{TemplateKindBicep, TemplateKindTerraform}
+	SupportedTemplateKind = []string{TemplateKindBicep, TemplateKindTerraform, TemplateKindHelm}
+
+	SupportedTerraformBackends = []string{TerraformBackendKubernetes, TerraformBackendS3, TerraformBackendAzureRM, TerraformBackendPostgres, TerraformBackendLocal}
+
+	// TerraformBackendSecretConfig are the properties of the Terraform backend configurations which hold credentials,
+	// keyed by the backend kind. They can't be set in the configuration of the backend, the credentials are fetched from
+	// the UCP credential providers or the secret store of the backend instead.
+	TerraformBackendSecretConfig = map[string][]string{
+		TerraformBackendS3:       {"access_key", "secret_key", "token"},
+		TerraformBackendAzureRM:  {"access_key", "sas_token", "client_secret", "client_certificate_password", "oidc_token"},
+		TerraformBackendPostgres: {"conn_str"},
+	}
 )
 
 // RecipeOutput represents recipe deployment output.
@@ -102,6 +123,9 @@ type RecipeOutput struct {
 
 	// Status represents the recipe status at deployment time of resource.
 	Status *rpv1.RecipeStatus
+
+	// RawOutput is the unparsed "result" output of the recipe deployment.
+	RawOutput map[string]any `json:"-"`
 }
 
 // PrepareRecipeOutput populates the recipe output from the recipe deployment output stored in the "result" object.
@@ -130,6 +154,52 @@ func (ro *RecipeOutput) PrepareRecipeResponse(resultValue map[string]any) error
 	if ro.Resources == nil {
 		ro.Resources = []string{}
 	}
+	ro.RawOutput = resultValue
 
 	return nil
 }
+
+// ResourceChangeType represents the type of change a recipe deployment would make to a resource.
+type ResourceChangeType string
+
+const (
+	// ResourceChangeTypeCreate means the resource doesn't exist and would be created.
+	ResourceChangeTypeCreate ResourceChangeType = "Create"
+
+	// ResourceChangeTypeUpdate means the resource exists and would be changed.
+	ResourceChangeTypeUpdate ResourceChangeType = "Update"
+
+	// ResourceChangeTypeDelete means the resource exists and would be deleted.
+	ResourceChangeTypeDelete ResourceChangeType = "Delete"
+)
+
+// RecipePlan represents the changes a recipe deployment would make, without applying them.
+type RecipePlan struct {
+	// Changes represents the list of resources which would be created, updated or deleted by the recipe.
+	Changes []ResourceChange
+}
+
+// ResourceChange represents the change a recipe deployment would make to a resource.
+type ResourceChange struct {
+	// ChangeType represents the type of change made to the resource.
+	ChangeType ResourceChangeType
+
+	// ResourceID represents the fully qualified resource ID of the resource. It is empty if the ID is only known after the
+	// resource is created, such as for some resources created by Terraform.
+	ResourceID string
+
+	// Address represents the address of the resource in the recipe template, such as the Terraform resource address.
+	Address string
+}
+
+// RecipeDrift represents the result of comparing the resources deployed by a recipe against their current state.
+type RecipeDrift struct {
+	// Resources represents the list of output resources which no longer match what was deployed by the recipe.
+	Resources []rpv1.DriftedResource
+
+	// Fingerprints represents the baseline fingerprints of the output resources for the next drift check, keyed by
+	// resource ID. It contains the fingerprints recorded at deployment time, and the fingerprints observed during the
+	// drift check for the resources without a recorded fingerprint. It is used by drivers which can't ask the recipe
+	// template for the desired state.
+	Fingerprints map[string]string
+}
@@ -62,6 +62,7 @@ func TestRecipeOutput_PrepareRecipeResponse(t *testing.T) {
 			if !tt.expectedErr {
 				err := ro.PrepareRecipeResponse(tt.result)
 				require.NoError(t, err)
+				require.Equal(t, tt.result, ro.RawOutput)
 
 				if tt.result["values"] != nil {
 					require.Equal(t, tt.result["values"], ro.Values)
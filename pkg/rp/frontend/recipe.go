@@ -0,0 +1,110 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	cdm "github.com/radius-project/radius/pkg/corerp/datamodel"
+	pr_dm "github.com/radius-project/radius/pkg/portableresources/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/configloader"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// ValidateRecipeParameters returns a filter which validates the recipe parameters of a portable resource against the
+// parameters declared by the recipe template, and validates that every required parameter is set by either the
+// environment or the resource. The recipe is not validated if the resource doesn't use a recipe, if the recipe is not
+// changed by the request, or if the recipe parameters can't be retrieved in time, in which case the failure is reported
+// by the deployment of the recipe. The recipe parameters are retrieved through the given metadata cache, so that the
+// recipe template isn't downloaded on every request.
+func ValidateRecipeParameters[P interface {
+	*T
+	rpv1.RadiusResourceModel
+}, T any](metadataCache *engine.MetadataCache, configLoader configloader.ConfigurationLoader) controller.UpdateFilter[T] {
+	return func(ctx context.Context, newResource *T, oldResource *T, options *controller.Options) (rest.Response, error) {
+		logger := ucplog.FromContextOrDiscard(ctx)
+		serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+		recipeDataModel, ok := any(P(newResource)).(pr_dm.RecipeDataModel)
+		if !ok || recipeDataModel.Recipe() == nil {
+			return nil, nil
+		}
+		recipe := recipeDataModel.Recipe()
+
+		if oldResource != nil {
+			oldRecipe := any(P(oldResource)).(pr_dm.RecipeDataModel).Recipe()
+			if oldRecipe != nil && oldRecipe.Name == recipe.Name && reflect.DeepEqual(oldRecipe.Parameters, recipe.Parameters) {
+				return nil, nil
+			}
+		}
+
+		metadata := P(newResource).ResourceMetadata()
+		recipeMetadata := &recipes.ResourceMetadata{
+			Name:          recipe.Name,
+			EnvironmentID: metadata.Environment,
+			ApplicationID: metadata.Application,
+			ResourceID:    serviceCtx.ResourceID.String(),
+			Parameters:    recipe.Parameters,
+		}
+		definition, err := configLoader.LoadRecipe(ctx, recipeMetadata)
+		if err != nil {
+			logger.Info(fmt.Sprintf("Skipping parameter validation of recipe %q: failed to load the recipe: %s", recipe.Name, err.Error()))
+			return nil, nil
+		}
+
+		// The metadata of Terraform modules is cached by the identity of the module credentials of the environment.
+		recipeConfig := cdm.RecipeConfigProperties{}
+		if definition.Driver == recipes.TemplateKindTerraform {
+			configuration, err := configLoader.LoadConfiguration(ctx, *recipeMetadata)
+			if err != nil {
+				logger.Info(fmt.Sprintf("Skipping parameter validation of recipe %q: failed to load the recipe configuration: %s", recipe.Name, err.Error()))
+				return nil, nil
+			}
+			recipeConfig = configuration.RecipeConfig
+		}
+
+		recipeData, err := metadataCache.GetRecipeMetadata(ctx, *definition, recipeConfig)
+		if err != nil {
+			logger.Info(fmt.Sprintf("Skipping parameter validation of recipe %q: failed to retrieve the recipe parameters: %s", recipe.Name, err.Error()))
+			return nil, nil
+		}
+
+		schema, err := recipes.NewParameterSchema(definition.Driver, recipeData)
+		if err != nil {
+			logger.Info(fmt.Sprintf("Skipping parameter validation of recipe %q: %s", recipe.Name, err.Error()))
+			return nil, nil
+		}
+
+		if err := schema.Validate(recipe.Parameters); err != nil {
+			return rest.NewBadRequestResponse(fmt.Sprintf("invalid parameters for recipe %q: %s", recipe.Name, err.Error())), nil
+		}
+
+		if err := schema.ValidateRequired(definition.Parameters, recipe.Parameters); err != nil {
+			return rest.NewBadRequestResponse(fmt.Sprintf("invalid parameters for recipe %q: %s", recipe.Name, err.Error())), nil
+		}
+
+		return nil, nil
+	}
+}
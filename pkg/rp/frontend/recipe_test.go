@@ -0,0 +1,178 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/daprrp/datamodel"
+	"github.com/radius-project/radius/pkg/portableresources"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/configloader"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEnvironmentID = "/planes/radius/local/resourceGroups/testGroup/providers/Applications.Core/environments/env0"
+	testStateStoreID  = "/planes/radius/local/resourceGroups/testGroup/providers/Applications.Dapr/stateStores/statestore0"
+)
+
+func newTestStateStore(provisioning portableresources.ResourceProvisioning, parameters map[string]any) *datamodel.DaprStateStore {
+	return &datamodel.DaprStateStore{
+		Properties: datamodel.DaprStateStoreProperties{
+			BasicResourceProperties: rpv1.BasicResourceProperties{
+				Environment: testEnvironmentID,
+			},
+			ResourceProvisioning: provisioning,
+			Recipe: portableresources.ResourceRecipe{
+				Name:       "default",
+				Parameters: parameters,
+			},
+		},
+	}
+}
+
+func TestValidateRecipeParameters(t *testing.T) {
+	definition := &recipes.EnvironmentDefinition{
+		Name:         "default",
+		Driver:       recipes.TemplateKindBicep,
+		ResourceType: "Applications.Dapr/stateStores",
+		Parameters:   map[string]any{"location": "westus"},
+		TemplatePath: "ghcr.io/radius-project/recipes/statestore:1.0",
+	}
+	recipeData := map[string]any{
+		"parameters": map[string]any{
+			"location": map[string]any{"type": "string"},
+			"sku":      map[string]any{"type": "string", "allowedValues": []any{"Basic", "Standard"}},
+			"capacity": map[string]any{"type": "int", "defaultValue": float64(1)},
+		},
+	}
+
+	tests := []struct {
+		desc            string
+		newResource     *datamodel.DaprStateStore
+		oldResource     *datamodel.DaprStateStore
+		loadRecipe      bool
+		loadRecipeErr   error
+		recipeDataErr   error
+		recipeDataHangs bool
+		expectedMessage string
+	}{
+		{
+			desc:        "valid parameters",
+			newResource: newTestStateStore(portableresources.ResourceProvisioningRecipe, map[string]any{"sku": "Basic", "capacity": float64(2)}),
+			loadRecipe:  true,
+		},
+		{
+			desc:            "invalid parameters",
+			newResource:     newTestStateStore(portableresources.ResourceProvisioningRecipe, map[string]any{"sku": "Premium", "size": "large"}),
+			loadRecipe:      true,
+			expectedMessage: "invalid parameters for recipe \"default\": parameter \"size\" is not declared by the recipe; parameter \"sku\" must be one of [\"Basic\", \"Standard\"]",
+		},
+		{
+			desc:            "missing required parameter",
+			newResource:     newTestStateStore(portableresources.ResourceProvisioningRecipe, map[string]any{"capacity": float64(2)}),
+			loadRecipe:      true,
+			expectedMessage: "invalid parameters for recipe \"default\": required parameter \"sku\" is not set",
+		},
+		{
+			desc:        "manual provisioning",
+			newResource: newTestStateStore(portableresources.ResourceProvisioningManual, nil),
+		},
+		{
+			desc:        "unchanged recipe",
+			newResource: newTestStateStore(portableresources.ResourceProvisioningRecipe, map[string]any{"sku": "Premium"}),
+			oldResource: newTestStateStore(portableresources.ResourceProvisioningRecipe, map[string]any{"sku": "Premium"}),
+		},
+		{
+			desc:          "recipe not found",
+			newResource:   newTestStateStore(portableresources.ResourceProvisioningRecipe, map[string]any{"sku": "Premium"}),
+			loadRecipe:    true,
+			loadRecipeErr: errors.New("could not find recipe"),
+		},
+		{
+			desc:          "recipe metadata not available",
+			newResource:   newTestStateStore(portableresources.ResourceProvisioningRecipe, map[string]any{"sku": "Premium"}),
+			loadRecipe:    true,
+			recipeDataErr: errors.New("failed to pull the recipe"),
+		},
+		{
+			desc:            "recipe metadata timeout",
+			newResource:     newTestStateStore(portableresources.ResourceProvisioningRecipe, map[string]any{"sku": "Premium"}),
+			loadRecipe:      true,
+			recipeDataHangs: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			mEngine := engine.NewMockEngine(mctrl)
+			mConfigLoader := configloader.NewMockConfigurationLoader(mctrl)
+
+			id, err := resources.ParseResource(testStateStoreID)
+			require.NoError(t, err)
+			ctx := v1.WithARMRequestContext(context.Background(), &v1.ARMRequestContext{ResourceID: id})
+
+			if tt.loadRecipe {
+				mConfigLoader.EXPECT().
+					LoadRecipe(gomock.Any(), &recipes.ResourceMetadata{
+						Name:          "default",
+						EnvironmentID: testEnvironmentID,
+						ResourceID:    testStateStoreID,
+						Parameters:    tt.newResource.Properties.Recipe.Parameters,
+					}).
+					Return(definition, tt.loadRecipeErr)
+
+				if tt.recipeDataHangs {
+					mEngine.EXPECT().GetRecipeMetadata(gomock.Any(), *definition).
+						DoAndReturn(func(ctx context.Context, _ recipes.EnvironmentDefinition) (map[string]any, error) {
+							<-ctx.Done()
+							return nil, ctx.Err()
+						})
+				} else if tt.loadRecipeErr == nil {
+					mEngine.EXPECT().GetRecipeMetadata(gomock.Any(), *definition).Return(recipeData, tt.recipeDataErr)
+				}
+			}
+
+			metadataCache := engine.NewMetadataCache(mEngine, 100*time.Millisecond, time.Minute)
+			filter := ValidateRecipeParameters[*datamodel.DaprStateStore](metadataCache, mConfigLoader)
+			resp, err := filter(ctx, tt.newResource, tt.oldResource, &controller.Options{})
+			require.NoError(t, err)
+
+			if tt.expectedMessage == "" {
+				require.Nil(t, resp)
+				return
+			}
+
+			require.IsType(t, &rest.BadRequestResponse{}, resp)
+			badRequest := resp.(*rest.BadRequestResponse)
+			require.Equal(t, v1.CodeInvalid, badRequest.Body.Error.Code)
+			require.Equal(t, tt.expectedMessage, badRequest.Body.Error.Message)
+		})
+	}
+}
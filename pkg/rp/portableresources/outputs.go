@@ -0,0 +1,104 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portableresources
+
+import (
+	"strings"
+
+	dapr_ctrl "github.com/radius-project/radius/pkg/daprrp/frontend/controller"
+	ds_ctrl "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
+	msg_ctrl "github.com/radius-project/radius/pkg/messagingrp/frontend/controller"
+	"github.com/radius-project/radius/pkg/portableresources/renderers"
+	"github.com/radius-project/radius/pkg/recipes"
+)
+
+// recipeOutputSchemas are the contracts of the outputs returned by recipes for each portable resource type, keyed by
+// the lowercase resource type. The fields match the connection values and secrets read by the processor of each
+// resource type, which validates the recipe output against the contract. Values which the processor computes when
+// they are not provided are optional.
+var recipeOutputSchemas = map[string]recipes.OutputSchema{
+	strings.ToLower(ds_ctrl.RedisCachesResourceType): {
+		Values: []recipes.OutputField{
+			{Name: renderers.Host, Type: recipes.OutputTypeString, Required: true, Description: "The host name of the Redis cache."},
+			{Name: renderers.Port, Type: recipes.OutputTypeInt, Required: true, Description: "The port of the Redis cache."},
+			{Name: renderers.UsernameStringValue, Type: recipes.OutputTypeString, Description: "The username of the Redis cache."},
+			{Name: renderers.TLS, Type: recipes.OutputTypeBool, Description: "Whether TLS is enabled. Computed from the port if not provided."},
+		},
+		Secrets: []recipes.OutputField{
+			{Name: renderers.PasswordStringHolder, Type: recipes.OutputTypeString, Description: "The password of the Redis cache."},
+			{Name: renderers.ConnectionStringValue, Type: recipes.OutputTypeString, Description: "The connection string of the Redis cache. Computed if not provided."},
+			{Name: renderers.ConnectionURIValue, Type: recipes.OutputTypeString, Description: "The connection URL of the Redis cache. Computed if not provided."},
+		},
+	},
+	strings.ToLower(ds_ctrl.MongoDatabasesResourceType): {
+		Values: []recipes.OutputField{
+			{Name: renderers.Host, Type: recipes.OutputTypeString, Required: true, Description: "The host name of the MongoDB server."},
+			{Name: renderers.Port, Type: recipes.OutputTypeInt, Required: true, Description: "The port of the MongoDB server."},
+			{Name: renderers.DatabaseNameValue, Type: recipes.OutputTypeString, Required: true, Description: "The name of the database."},
+			{Name: renderers.UsernameStringValue, Type: recipes.OutputTypeString, Description: "The username of the database."},
+		},
+		Secrets: []recipes.OutputField{
+			{Name: renderers.PasswordStringHolder, Type: recipes.OutputTypeString, Description: "The password of the database."},
+			{Name: renderers.ConnectionStringValue, Type: recipes.OutputTypeString, Description: "The connection string of the database. Computed if not provided."},
+		},
+	},
+	strings.ToLower(ds_ctrl.SqlDatabasesResourceType): {
+		Values: []recipes.OutputField{
+			{Name: renderers.DatabaseNameValue, Type: recipes.OutputTypeString, Required: true, Description: "The name of the database."},
+			{Name: renderers.ServerNameValue, Type: recipes.OutputTypeString, Required: true, Description: "The fully qualified domain name of the SQL server."},
+			{Name: renderers.Port, Type: recipes.OutputTypeInt, Required: true, Description: "The port of the SQL server."},
+			{Name: renderers.UsernameStringValue, Type: recipes.OutputTypeString, Description: "The username of the database."},
+		},
+		Secrets: []recipes.OutputField{
+			{Name: renderers.PasswordStringHolder, Type: recipes.OutputTypeString, Description: "The password of the database."},
+			{Name: renderers.ConnectionStringValue, Type: recipes.OutputTypeString, Description: "The connection string of the database. Computed if not provided."},
+		},
+	},
+	strings.ToLower(msg_ctrl.RabbitMQQueuesResourceType): {
+		Values: []recipes.OutputField{
+			{Name: "queue", Type: recipes.OutputTypeString, Required: true, Description: "The name of the queue."},
+			{Name: renderers.Host, Type: recipes.OutputTypeString, Required: true, Description: "The host name of the RabbitMQ server."},
+			{Name: renderers.Port, Type: recipes.OutputTypeInt, Required: true, Description: "The port of the RabbitMQ server."},
+			{Name: renderers.VHost, Type: recipes.OutputTypeString, Description: "The virtual host of the queue."},
+			{Name: renderers.UsernameStringValue, Type: recipes.OutputTypeString, Description: "The username of the RabbitMQ server."},
+			{Name: renderers.TLS, Type: recipes.OutputTypeBool, Description: "Whether TLS is enabled. Defaults to false."},
+		},
+		Secrets: []recipes.OutputField{
+			{Name: renderers.PasswordStringHolder, Type: recipes.OutputTypeString, Description: "The password of the RabbitMQ server."},
+			{Name: renderers.URI, Type: recipes.OutputTypeString, Description: "The connection URI of the queue. Computed if not provided."},
+		},
+	},
+	strings.ToLower(dapr_ctrl.DaprPubSubBrokersResourceType): daprOutputSchema("pub/sub broker"),
+	strings.ToLower(dapr_ctrl.DaprSecretStoresResourceType):  daprOutputSchema("secret store"),
+	strings.ToLower(dapr_ctrl.DaprStateStoresResourceType):   daprOutputSchema("state store"),
+}
+
+func daprOutputSchema(kind string) recipes.OutputSchema {
+	return recipes.OutputSchema{
+		Values: []recipes.OutputField{
+			{Name: renderers.ComponentNameKey, Type: recipes.OutputTypeString, Description: "The name of the Dapr " + kind + " component. Computed if not provided."},
+		},
+	}
+}
+
+// GetRecipeOutputSchema returns the contract of the outputs a recipe must return for the given portable resource type.
+// It returns false if the resource type doesn't declare a contract, as is the case for extenders whose outputs are
+// free-form.
+func GetRecipeOutputSchema(resourceType string) (recipes.OutputSchema, bool) {
+	schema, ok := recipeOutputSchemas[strings.ToLower(resourceType)]
+	return schema, ok
+}
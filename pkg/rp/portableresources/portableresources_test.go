@@ -19,6 +19,7 @@ package portableresources
 import (
 	"testing"
 
+	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/stretchr/testify/require"
 )
 
@@ -31,3 +32,23 @@ func TestInvalidPortableResourceType(t *testing.T) {
 	isValid := IsValidPortableResourceType("Applications.Dapr/pubSubBroker")
 	require.Equal(t, false, isValid)
 }
+
+func TestGetRecipeOutputSchema(t *testing.T) {
+	schema, ok := GetRecipeOutputSchema("applications.datastores/rediscaches")
+	require.True(t, ok)
+	require.NotEmpty(t, schema.Values)
+
+	err := schema.Validate(&recipes.RecipeOutput{
+		Values: map[string]any{"host": "localhost", "port": float64(6379)},
+	})
+	require.NoError(t, err)
+
+	err = schema.Validate(&recipes.RecipeOutput{
+		Values: map[string]any{"hostname": "localhost", "port": "6379"},
+	})
+	require.ErrorContains(t, err, `missing required value "host"`)
+	require.ErrorContains(t, err, `value "port" is expected to be of type int, got string`)
+
+	_, ok = GetRecipeOutputSchema(ExtendersResourceType)
+	require.False(t, ok)
+}
@@ -54,6 +54,7 @@ const (
 	LocalIDDeployment                   = "Deployment"
 	LocalIDGateway                      = "Gateway"
 	LocalIDHttpRoute                    = "HttpRoute"
+	LocalIDHorizontalPodAutoscaler      = "HorizontalPodAutoscaler"
 	LocalIDKeyVault                     = "KeyVault"
 	LocalIDSecret                       = "Secret"
 	LocalIDConfigMap                    = "ConfigMap"
@@ -62,6 +63,7 @@ const (
 	LocalIDKubernetesRole               = "KubernetesRole"
 	LocalIDKubernetesRoleBinding        = "KubernetesRoleBinding"
 	LocalIDService                      = "Service"
+	LocalIDScaledObject                 = "ScaledObject"
 	LocalIDUserAssignedManagedIdentity  = "UserAssignedManagedIdentity"
 	LocalIDFederatedIdentity            = "FederatedIdentity"
 	LocalIDRoleAssignmentPrefix         = "RoleAssignment"
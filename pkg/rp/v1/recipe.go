@@ -16,6 +16,16 @@ limitations under the License.
 
 package v1
 
+import "time"
+
+const (
+	// DriftReasonModified indicates that the resource was modified outside of the recipe.
+	DriftReasonModified = "Modified"
+
+	// DriftReasonDeleted indicates that the resource was deleted outside of the recipe.
+	DriftReasonDeleted = "Deleted"
+)
+
 // RecipeStatus defines the status of the recipe
 type RecipeStatus struct {
 	// TemplateKind specifies the kind of template used for the recipe.
@@ -26,4 +36,54 @@ type RecipeStatus struct {
 
 	// TemplateVersion specifies the version of the template used for the recipe.
 	TemplateVersion string `json:"templateVersion,omitempty"`
+
+	// Drift is the result of the last drift check of the resources deployed by the recipe.
+	Drift *RecipeDriftStatus `json:"drift,omitempty"`
+
+	// TerraformBackend is the Terraform backend storing the Terraform state of the recipe. It is recorded when a
+	// Terraform recipe is deployed, so that the resources of the recipe are destroyed through the same backend.
+	TerraformBackend *RecipeTerraformBackend `json:"terraformBackend,omitempty"`
+}
+
+// RecipeTerraformBackend defines the Terraform backend storing the Terraform state of a recipe.
+type RecipeTerraformBackend struct {
+	// Kind is the kind of the Terraform backend, for example s3.
+	Kind string `json:"kind"`
+
+	// Config is the configuration of the Terraform backend. It doesn't hold credentials.
+	Config map[string]string `json:"config,omitempty"`
+
+	// Secret is the resource ID of the secret store holding the credentials of the Terraform backend.
+	Secret string `json:"secret,omitempty"`
+}
+
+// RecipeDriftStatus defines the result of checking the resources deployed by a recipe for changes made outside of the recipe.
+type RecipeDriftStatus struct {
+	// Detected is true if any of the resources deployed by the recipe has drifted.
+	Detected bool `json:"detected"`
+
+	// LastCheckedTime is the time of the last drift check.
+	LastCheckedTime time.Time `json:"lastCheckedTime,omitempty"`
+
+	// LastRemediatedTime is the time the redeployment of the recipe was last queued to remediate drift.
+	LastRemediatedTime *time.Time `json:"lastRemediatedTime,omitempty"`
+
+	// Resources are the resources which have drifted.
+	Resources []DriftedResource `json:"resources,omitempty"`
+
+	// Fingerprints are the fingerprints of the deployed resources keyed by resource ID. They are recorded when
+	// the recipe is deployed and used as the baseline of the following drift checks.
+	Fingerprints map[string]string `json:"fingerprints,omitempty"`
+}
+
+// DriftedResource defines a resource deployed by a recipe which was changed outside of the recipe.
+type DriftedResource struct {
+	// ResourceID is the resource ID of the drifted resource.
+	ResourceID string `json:"resourceId,omitempty"`
+
+	// Address is the address of the drifted resource in the recipe template, such as the address of a Terraform resource.
+	Address string `json:"address,omitempty"`
+
+	// Reason is the reason of the drift, either Modified or Deleted.
+	Reason string `json:"reason"`
 }
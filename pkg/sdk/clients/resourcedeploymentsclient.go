@@ -175,3 +175,77 @@ func (client *ResourceDeploymentsClient) createOrUpdateCreateRequest(ctx context
 	req.Raw().Header["Accept"] = []string{"application/json"}
 	return req, runtime.MarshalAsJSON(req, parameters)
 }
+
+// Cancel creates a request to cancel the deployment which is currently running. The resources which are already
+// deployed are not rolled back.
+func (client *ResourceDeploymentsClient) Cancel(ctx context.Context, resourceID, apiVersion string) error {
+	if !strings.HasPrefix(resourceID, "/") {
+		return fmt.Errorf("error canceling a deployment: resourceID must start with a slash")
+	}
+
+	_, err := resources.ParseResource(resourceID)
+	if err != nil {
+		return fmt.Errorf("invalid resourceID: %v", resourceID)
+	}
+
+	urlPath := DeploymentEngineURL(client.baseURI, resourceID+"/cancel")
+	req, err := runtime.NewRequest(ctx, http.MethodPost, urlPath)
+	if err != nil {
+		return err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", apiVersion)
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+
+	resp, err := client.pipeline.Do(req)
+	if err != nil {
+		return err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK, http.StatusNoContent) {
+		return runtime.NewResponseError(resp)
+	}
+
+	return nil
+}
+
+// ClientWhatIfResponse contains the response from method Client.WhatIf.
+type ClientWhatIfResponse struct {
+	armresources.WhatIfOperationResult
+}
+
+// WhatIf creates a request to compute the changes the deployment would make to the resources without deploying it, and
+// returns a poller to track the progress of the operation.
+func (client *ResourceDeploymentsClient) WhatIf(ctx context.Context, parameters Deployment, resourceID, apiVersion string) (*runtime.Poller[ClientWhatIfResponse], error) {
+	if !strings.HasPrefix(resourceID, "/") {
+		return nil, fmt.Errorf("error running what-if for a deployment: resourceID must start with a slash")
+	}
+
+	_, err := resources.ParseResource(resourceID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid resourceID: %v", resourceID)
+	}
+
+	urlPath := DeploymentEngineURL(client.baseURI, resourceID+"/whatIf")
+	req, err := runtime.NewRequest(ctx, http.MethodPost, urlPath)
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", apiVersion)
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, parameters); err != nil {
+		return nil, err
+	}
+
+	resp, err := client.pipeline.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK, http.StatusAccepted) {
+		return nil, runtime.NewResponseError(resp)
+	}
+
+	return runtime.NewPoller[ClientWhatIfResponse](resp, *client.pipeline, nil)
+}
@@ -0,0 +1,273 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/kubeutil"
+	pr_ctrl "github.com/radius-project/radius/pkg/portableresources/backend/controller"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	qprovider "github.com/radius-project/radius/pkg/ucp/queue/provider"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// OperationDetectDrift is the operation method used for the drift detection of a resource.
+	OperationDetectDrift v1.OperationMethod = "DETECTDRIFT"
+
+	// defaultDriftDetectionInterval is the default interval between drift detection runs.
+	defaultDriftDetectionInterval = time.Hour
+
+	// defaultDriftDetectionRootScope is the default scope of the resources checked for drift.
+	defaultDriftDetectionRootScope = "/planes/radius/local"
+
+	// driftDetectionTimeout is the timeout to check a single resource for drift.
+	driftDetectionTimeout = 10 * time.Minute
+
+	// driftDetectionLeaseName is the name of the lease held by the replica which runs the drift detection.
+	driftDetectionLeaseName = "radius-drift-detector"
+
+	// defaultDriftDetectionLeaseNamespace is the default namespace of the lease.
+	defaultDriftDetectionLeaseNamespace = "radius-system"
+)
+
+// DriftDetector is a service to periodically check the resources deployed by recipes for drift.
+type DriftDetector struct {
+	options     hostoptions.HostOptions
+	controllers map[string]pr_ctrl.DriftControllerFactoryFunc
+}
+
+// NewDriftDetector creates new service instance to run the drift detection. The controllers are keyed by the
+// fully-qualified resource type they check.
+func NewDriftDetector(options hostoptions.HostOptions, controllers map[string]pr_ctrl.DriftControllerFactoryFunc) *DriftDetector {
+	return &DriftDetector{
+		options:     options,
+		controllers: controllers,
+	}
+}
+
+// Name represents the service name.
+func (d *DriftDetector) Name() string {
+	return "radiusdriftdetector"
+}
+
+// Run creates the drift detection controllers and checks the resources of each resource type for drift at the
+// configured interval until the context is cancelled. Only the replica holding the drift detection lease checks the
+// resources, so that each resource is checked and remediated once per interval.
+func (d *DriftDetector) Run(ctx context.Context) error {
+	ctx = hostoptions.WithContext(ctx, d.options.Config)
+
+	storageProvider := dataprovider.NewStorageProvider(d.options.Config.StorageProvider)
+	k8s, err := kubeutil.NewClients(d.options.K8sConfig)
+	if err != nil {
+		return fmt.Errorf("failed to initialize kubernetes clients: %w", err)
+	}
+
+	requestQueue, err := qprovider.New(d.options.Config.QueueProvider).GetClient(ctx)
+	if err != nil {
+		return err
+	}
+	statusManager := statusmanager.New(storageProvider, requestQueue, d.options.Config.Env.RoleLocation)
+
+	controllers := map[string]ctrl.Controller{}
+	for resourceType, factory := range d.controllers {
+		storageClient, err := storageProvider.GetStorageClient(ctx, resourceType)
+		if err != nil {
+			return err
+		}
+
+		controller, err := factory(ctrl.Options{
+			StorageClient: storageClient,
+			DataProvider:  storageProvider,
+			KubeClient:    k8s.RuntimeClient,
+			ResourceType:  resourceType,
+		}, statusManager)
+		if err != nil {
+			return err
+		}
+		controllers[resourceType] = controller
+	}
+
+	identity, err := os.Hostname()
+	if err != nil || identity == "" {
+		identity = uuid.NewString()
+	}
+
+	return d.runWithLeaderElection(ctx, k8s.ClientSet, identity, func(ctx context.Context) {
+		d.runDetection(ctx, controllers)
+	})
+}
+
+// runWithLeaderElection runs the given function while this replica holds the drift detection lease. The context of
+// the function is cancelled when the lease is lost, and the replica competes for the lease again until the given
+// context is cancelled.
+func (d *DriftDetector) runWithLeaderElection(ctx context.Context, client kubernetes.Interface, identity string, run func(ctx context.Context)) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	namespace := defaultDriftDetectionLeaseNamespace
+	if d.options.Config.DriftDetection != nil && d.options.Config.DriftDetection.LeaseNamespace != "" {
+		namespace = d.options.Config.DriftDetection.LeaseNamespace
+	}
+
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock: &resourcelock.LeaseLock{
+			LeaseMeta:  metav1.ObjectMeta{Name: driftDetectionLeaseName, Namespace: namespace},
+			Client:     client.CoordinationV1(),
+			LockConfig: resourcelock.ResourceLockConfig{Identity: identity},
+		},
+		ReleaseOnCancel: true,
+		LeaseDuration:   15 * time.Second,
+		RenewDeadline:   10 * time.Second,
+		RetryPeriod:     2 * time.Second,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: run,
+			OnStoppedLeading: func() {
+				logger.Info("Stopped leading drift detection", "identity", identity)
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	for ctx.Err() == nil {
+		elector.Run(ctx)
+	}
+
+	return nil
+}
+
+// runDetection checks the resources of each resource type for drift at the configured interval until the context is
+// cancelled.
+func (d *DriftDetector) runDetection(ctx context.Context, controllers map[string]ctrl.Controller) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	interval := d.interval()
+	logger.Info(fmt.Sprintf("Start drift detection every %s...", interval))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("Drift detection stopped...")
+			return
+		case <-ticker.C:
+			for resourceType, controller := range controllers {
+				d.detectDrift(ctx, resourceType, controller)
+			}
+		}
+	}
+}
+
+// interval returns the configured interval between drift detection runs.
+func (d *DriftDetector) interval() time.Duration {
+	config := d.options.Config.DriftDetection
+	if config == nil || config.IntervalSeconds == nil || *config.IntervalSeconds <= 0 {
+		return defaultDriftDetectionInterval
+	}
+
+	return time.Duration(*config.IntervalSeconds) * time.Second
+}
+
+// rootScopes returns the configured scopes of the resources checked for drift.
+func (d *DriftDetector) rootScopes() []string {
+	config := d.options.Config.DriftDetection
+	if config == nil || len(config.RootScopes) == 0 {
+		return []string{defaultDriftDetectionRootScope}
+	}
+	return config.RootScopes
+}
+
+// detectDrift runs the drift detection controller for all resources of the given resource type in the configured
+// scopes. Failures are logged so that a single resource or scope doesn't prevent the others from being checked.
+func (d *DriftDetector) detectDrift(ctx context.Context, resourceType string, controller ctrl.Controller) {
+	for _, rootScope := range d.rootScopes() {
+		d.detectScopeDrift(ctx, resourceType, rootScope, controller)
+	}
+}
+
+// detectScopeDrift runs the drift detection controller for all resources of the given resource type in the given scope.
+func (d *DriftDetector) detectScopeDrift(ctx context.Context, resourceType string, rootScope string, controller ctrl.Controller) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	query := store.Query{
+		RootScope:      rootScope,
+		ScopeRecursive: true,
+		ResourceType:   resourceType,
+	}
+
+	paginationToken := ""
+	for {
+		result, err := controller.StorageClient().Query(ctx, query, store.WithPaginationToken(paginationToken))
+		if err != nil {
+			logger.Error(err, "Failed to list resources for drift detection", "resourceType", resourceType, "rootScope", rootScope)
+			return
+		}
+
+		for _, item := range result.Items {
+			if err := d.detectResourceDrift(ctx, resourceType, item.ID, controller); err != nil {
+				logger.Error(err, "Failed to detect drift", "resourceID", item.ID)
+			}
+		}
+
+		if result.PaginationToken == "" {
+			return
+		}
+		paginationToken = result.PaginationToken
+	}
+}
+
+// detectResourceDrift runs the drift detection controller for a single resource.
+func (d *DriftDetector) detectResourceDrift(ctx context.Context, resourceType string, resourceID string, controller ctrl.Controller) error {
+	timeout := driftDetectionTimeout
+	req := &ctrl.Request{
+		OperationID:      uuid.New(),
+		OperationType:    v1.OperationType{Type: strings.ToUpper(resourceType), Method: OperationDetectDrift}.String(),
+		ResourceID:       resourceID,
+		CorrelationID:    uuid.NewString(),
+		OperationTimeout: &timeout,
+	}
+
+	armReqCtx, err := req.ARMRequestContext()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(v1.WithARMRequestContext(ctx, armReqCtx), timeout)
+	defer cancel()
+
+	_, err = controller.Run(ctx, req)
+	return err
+}
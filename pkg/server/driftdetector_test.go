@@ -0,0 +1,203 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/store"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const testResourceType = "Applications.Datastores/redisCaches"
+
+type testDriftController struct {
+	ctrl.BaseController
+	requests []*ctrl.Request
+	err      error
+}
+
+func (c *testDriftController) Run(ctx context.Context, req *ctrl.Request) (ctrl.Result, error) {
+	c.requests = append(c.requests, req)
+	return ctrl.Result{}, c.err
+}
+
+func TestDriftDetector_Interval(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   *hostoptions.DriftDetectionOptions
+		expected time.Duration
+	}{
+		{"not configured", nil, defaultDriftDetectionInterval},
+		{"no interval", &hostoptions.DriftDetectionOptions{Enabled: true}, defaultDriftDetectionInterval},
+		{"invalid interval", &hostoptions.DriftDetectionOptions{Enabled: true, IntervalSeconds: to.Ptr(0)}, defaultDriftDetectionInterval},
+		{"interval", &hostoptions.DriftDetectionOptions{Enabled: true, IntervalSeconds: to.Ptr(300)}, 5 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDriftDetector(hostoptions.HostOptions{Config: &hostoptions.ProviderConfig{DriftDetection: tt.config}}, nil)
+			require.Equal(t, tt.expected, d.interval())
+		})
+	}
+}
+
+func TestDriftDetector_DetectDrift(t *testing.T) {
+	resourceIDs := []string{
+		"/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/redis0",
+		"/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/redis1",
+	}
+
+	setup := func(t *testing.T, controllerErr error) *testDriftController {
+		mctrl := gomock.NewController(t)
+		storageClient := store.NewMockStorageClient(mctrl)
+
+		storageClient.EXPECT().
+			Query(gomock.Any(), store.Query{RootScope: defaultDriftDetectionRootScope, ScopeRecursive: true, ResourceType: testResourceType}, gomock.Any()).
+			Return(&store.ObjectQueryResult{
+				Items:           []store.Object{{Metadata: store.Metadata{ID: resourceIDs[0]}}},
+				PaginationToken: "next",
+			}, nil)
+		storageClient.EXPECT().
+			Query(gomock.Any(), store.Query{RootScope: defaultDriftDetectionRootScope, ScopeRecursive: true, ResourceType: testResourceType}, gomock.Any()).
+			Return(&store.ObjectQueryResult{
+				Items: []store.Object{{Metadata: store.Metadata{ID: resourceIDs[1]}}},
+			}, nil)
+
+		return &testDriftController{
+			BaseController: ctrl.NewBaseAsyncController(ctrl.Options{StorageClient: storageClient}),
+			err:            controllerErr,
+		}
+	}
+
+	t.Run("all resources are checked", func(t *testing.T) {
+		controller := setup(t, nil)
+		d := NewDriftDetector(hostoptions.HostOptions{Config: &hostoptions.ProviderConfig{}}, nil)
+
+		d.detectDrift(context.Background(), testResourceType, controller)
+
+		require.Len(t, controller.requests, 2)
+		for i, req := range controller.requests {
+			require.Equal(t, resourceIDs[i], req.ResourceID)
+			require.Equal(t, v1.OperationType{Type: "APPLICATIONS.DATASTORES/REDISCACHES", Method: OperationDetectDrift}.String(), req.OperationType)
+			require.NotEmpty(t, req.OperationID)
+		}
+	})
+
+	t.Run("failures don't stop the detection", func(t *testing.T) {
+		controller := setup(t, errors.New("failed to detect drift"))
+		d := NewDriftDetector(hostoptions.HostOptions{Config: &hostoptions.ProviderConfig{}}, nil)
+
+		d.detectDrift(context.Background(), testResourceType, controller)
+
+		require.Len(t, controller.requests, 2)
+	})
+}
+
+func TestDriftDetector_RootScopes(t *testing.T) {
+	const otherScope = "/planes/radius/other"
+
+	tests := []struct {
+		name     string
+		config   *hostoptions.DriftDetectionOptions
+		expected []string
+	}{
+		{"not configured", nil, []string{defaultDriftDetectionRootScope}},
+		{"no root scopes", &hostoptions.DriftDetectionOptions{Enabled: true}, []string{defaultDriftDetectionRootScope}},
+		{"root scopes", &hostoptions.DriftDetectionOptions{Enabled: true, RootScopes: []string{defaultDriftDetectionRootScope, otherScope}}, []string{defaultDriftDetectionRootScope, otherScope}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDriftDetector(hostoptions.HostOptions{Config: &hostoptions.ProviderConfig{DriftDetection: tt.config}}, nil)
+			require.Equal(t, tt.expected, d.rootScopes())
+		})
+	}
+
+	t.Run("all root scopes are checked", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		storageClient := store.NewMockStorageClient(mctrl)
+		for _, scope := range []string{defaultDriftDetectionRootScope, otherScope} {
+			storageClient.EXPECT().
+				Query(gomock.Any(), store.Query{RootScope: scope, ScopeRecursive: true, ResourceType: testResourceType}, gomock.Any()).
+				Return(&store.ObjectQueryResult{
+					Items: []store.Object{{Metadata: store.Metadata{ID: scope + "/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/redis"}}},
+				}, nil)
+		}
+		controller := &testDriftController{BaseController: ctrl.NewBaseAsyncController(ctrl.Options{StorageClient: storageClient})}
+
+		d := NewDriftDetector(hostoptions.HostOptions{
+			Config: &hostoptions.ProviderConfig{
+				DriftDetection: &hostoptions.DriftDetectionOptions{Enabled: true, RootScopes: []string{defaultDriftDetectionRootScope, otherScope}},
+			},
+		}, nil)
+		d.detectDrift(context.Background(), testResourceType, controller)
+
+		require.Len(t, controller.requests, 2)
+	})
+}
+
+func TestDriftDetector_RunWithLeaderElection(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	d := NewDriftDetector(hostoptions.HostOptions{
+		Config: &hostoptions.ProviderConfig{
+			DriftDetection: &hostoptions.DriftDetectionOptions{Enabled: true, LeaseNamespace: "test-namespace"},
+		},
+	}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	leading := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- d.runWithLeaderElection(ctx, client, "replica-1", func(ctx context.Context) {
+			close(leading)
+			<-ctx.Done()
+		})
+	}()
+
+	select {
+	case <-leading:
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "replica didn't acquire the lease")
+	}
+
+	lease, err := client.CoordinationV1().Leases("test-namespace").Get(ctx, driftDetectionLeaseName, metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "replica-1", *lease.Spec.HolderIdentity)
+
+	cancel()
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "leader election didn't stop when the context was cancelled")
+	}
+}
@@ -18,6 +18,7 @@ package dataprovider
 
 import (
 	context "context"
+	"database/sql"
 	"errors"
 	"fmt"
 
@@ -27,6 +28,7 @@ import (
 	ucpv1alpha1 "github.com/radius-project/radius/pkg/ucp/store/apiserverstore/api/ucp.dev/v1alpha1"
 	"github.com/radius-project/radius/pkg/ucp/store/cosmosdb"
 	"github.com/radius-project/radius/pkg/ucp/store/etcdstore"
+	"github.com/radius-project/radius/pkg/ucp/store/postgres"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -38,6 +40,7 @@ var storageClientFactory = map[StorageProviderType]storageFactoryFunc{
 	TypeAPIServer: initAPIServerClient,
 	TypeCosmosDB:  initCosmosDBClient,
 	TypeETCD:      InitETCDClient,
+	TypePostgres:  initPostgresClient,
 }
 
 func initAPIServerClient(ctx context.Context, opt StorageProviderOptions, _ string) (store.StorageClient, error) {
@@ -69,7 +72,8 @@ func initAPIServerClient(ctx context.Context, opt StorageProviderOptions, _ stri
 		},
 	}
 
-	rc, err := runtimeclient.New(cfg, options)
+	// The client needs to support watches so the store can implement Watch().
+	rc, err := runtimeclient.NewWithWatch(cfg, options)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize APIServer client: %w", err)
 	}
@@ -117,3 +121,31 @@ func InitETCDClient(ctx context.Context, opt StorageProviderOptions, _ string) (
 	etcdClient := etcdstore.NewETCDClient(client)
 	return etcdClient, nil
 }
+
+func initPostgresClient(ctx context.Context, opt StorageProviderOptions, _ string) (store.StorageClient, error) {
+	if opt.Postgres.URL == "" {
+		return nil, errors.New("failed to initialize PostgreSQL client: url is required")
+	}
+
+	db, err := sql.Open("postgres", opt.Postgres.URL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize PostgreSQL client: %w", err)
+	}
+
+	if opt.Postgres.MaxOpenConnections > 0 {
+		db.SetMaxOpenConns(opt.Postgres.MaxOpenConnections)
+	}
+
+	if err = db.PingContext(ctx); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to connect to PostgreSQL - configuration may be invalid: %w", err)
+	}
+
+	client := postgres.NewPostgresClient(db, opt.Postgres.URL)
+	if err = client.Init(ctx); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to initialize PostgreSQL client: %w", err)
+	}
+
+	return client, nil
+}
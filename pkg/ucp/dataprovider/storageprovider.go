@@ -47,11 +47,16 @@ func NewStorageProvider(opts StorageProviderOptions) DataStorageProvider {
 	}
 }
 
-// GetStorageClient checks if a StorageClient for the given resourceType already exists in the map, and
-// if so, returns it. If not, it creates a new StorageClient using the storageClientFactory and adds it to the map,
-// returning it. If an error occurs, it returns an error.
+// GetStorageClient returns the StorageClient for the given resourceType, creating it with the storageClientFactory
+// and caching it on first use. All resource types share the same StorageClient, except with CosmosDB where each
+// resource type is stored in its own collection. If an error occurs, it returns an error.
 func (p *storageProvider) GetStorageClient(ctx context.Context, resourceType string) (store.StorageClient, error) {
-	cn := util.NormalizeStringToLower(resourceType)
+	// Only CosmosDB stores each resource type in its own collection. The other providers store all resource types
+	// together so they share a single client, which also allows transactions across resource types.
+	cn := ""
+	if p.options.Provider == TypeCosmosDB {
+		cn = util.NormalizeStringToLower(resourceType)
+	}
 
 	p.clientsMu.RLock()
 	c, ok := p.clients[cn]
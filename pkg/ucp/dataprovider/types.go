@@ -34,6 +34,9 @@ const (
 
 	// TypeETCD represents the etcd provider.
 	TypeETCD StorageProviderType = "etcd"
+
+	// TypePostgres represents the PostgreSQL provider.
+	TypePostgres StorageProviderType = "postgres"
 )
 
 //go:generate mockgen -destination=./mock_datastorage_provider.go -package=dataprovider -self_package github.com/radius-project/radius/pkg/ucp/dataprovider github.com/radius-project/radius/pkg/ucp/dataprovider DataStorageProvider
@@ -29,6 +29,7 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
+	deadletters_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/deadletters"
 	kubernetes_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/kubernetes"
 	planes_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/planes"
 	"github.com/radius-project/radius/pkg/ucp/frontend/modules"
@@ -40,6 +41,10 @@ const (
 	planeCollectionPath       = "/planes"
 	planeCollectionByTypePath = "/planes/{planeType}"
 
+	// deadLetterCollectionPath is the admin path for the dead-letter queue of the async operation queue.
+	deadLetterCollectionPath = "/admin/queues/{" + deadletters_ctrl.QueueNameParam + "}/deadletters"
+	deadLetterPath           = "/{" + deadletters_ctrl.MessageIDParam + "}"
+
 	// OperationTypeKubernetesOpenAPIV2Doc is the operation type for the required OpenAPI v2 discovery document.
 	//
 	// This is required by the Kubernetes API Server.
@@ -58,6 +63,15 @@ const (
 
 	// OperationTypePlanes is the operation type for the planes (specific type) endpoints
 	OperationTypePlanesByType = "PLANESBYTYPE"
+
+	// OperationTypeDeadLetters is the operation type for the dead-letter queue collection endpoints.
+	OperationTypeDeadLetters = "DEADLETTERS"
+
+	// OperationTypeDeadLetter is the operation type for the dead-lettered message endpoints.
+	OperationTypeDeadLetter = "DEADLETTER"
+
+	// OperationRequeue is the operation method to requeue the dead-lettered message.
+	OperationRequeue v1.OperationMethod = "REQUEUE"
 )
 
 func initModules(ctx context.Context, modules []modules.Initializer) (map[string]http.Handler, []string, error) {
@@ -191,6 +205,54 @@ func Register(ctx context.Context, router chi.Router, planeModules []modules.Ini
 		},
 	}...)
 
+	// Configures the admin routes for the dead-letter queue. These are not ARM resources, so there is no validation.
+	deadLetterCollectionRouter := server.NewSubrouter(router, options.PathBase+deadLetterCollectionPath)
+	handlerOptions = append(handlerOptions, []server.HandlerOptions{
+		{
+			ParentRouter:  deadLetterCollectionRouter,
+			Method:        v1.OperationList,
+			OperationType: &v1.OperationType{Type: OperationTypeDeadLetters, Method: v1.OperationList},
+			ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+				return deadletters_ctrl.NewListDeadLetters(opt, options.QueueProvider)
+			},
+		},
+		{
+			ParentRouter:  deadLetterCollectionRouter,
+			Method:        v1.OperationDelete,
+			OperationType: &v1.OperationType{Type: OperationTypeDeadLetters, Method: v1.OperationDelete},
+			ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+				return deadletters_ctrl.NewPurgeDeadLetters(opt, options.QueueProvider)
+			},
+		},
+		{
+			ParentRouter:  deadLetterCollectionRouter,
+			Path:          deadLetterPath,
+			Method:        v1.OperationGet,
+			OperationType: &v1.OperationType{Type: OperationTypeDeadLetter, Method: v1.OperationGet},
+			ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+				return deadletters_ctrl.NewGetDeadLetter(opt, options.QueueProvider)
+			},
+		},
+		{
+			ParentRouter:  deadLetterCollectionRouter,
+			Path:          deadLetterPath,
+			Method:        v1.OperationDelete,
+			OperationType: &v1.OperationType{Type: OperationTypeDeadLetter, Method: v1.OperationDelete},
+			ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+				return deadletters_ctrl.NewPurgeDeadLetters(opt, options.QueueProvider)
+			},
+		},
+		{
+			ParentRouter:  deadLetterCollectionRouter,
+			Path:          deadLetterPath + "/requeue",
+			Method:        OperationRequeue,
+			OperationType: &v1.OperationType{Type: OperationTypeDeadLetter, Method: OperationRequeue},
+			ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+				return deadletters_ctrl.NewRequeueDeadLetter(opt, options.QueueProvider)
+			},
+		},
+	}...)
+
 	ctrlOptions := controller.Options{
 		Address:      options.Address,
 		PathBase:     options.PathBase,
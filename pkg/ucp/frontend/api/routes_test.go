@@ -74,6 +74,31 @@ func Test_Routes(t *testing.T) {
 			Method:        http.MethodDelete,
 			Path:          "/planes/someType/someName",
 		},
+		{
+			OperationType: v1.OperationType{Type: OperationTypeDeadLetters, Method: v1.OperationList},
+			Method:        http.MethodGet,
+			Path:          "/admin/queues/radius/deadletters",
+		},
+		{
+			OperationType: v1.OperationType{Type: OperationTypeDeadLetters, Method: v1.OperationDelete},
+			Method:        http.MethodDelete,
+			Path:          "/admin/queues/radius/deadletters",
+		},
+		{
+			OperationType: v1.OperationType{Type: OperationTypeDeadLetter, Method: v1.OperationGet},
+			Method:        http.MethodGet,
+			Path:          "/admin/queues/radius/deadletters/someMessage",
+		},
+		{
+			OperationType: v1.OperationType{Type: OperationTypeDeadLetter, Method: v1.OperationDelete},
+			Method:        http.MethodDelete,
+			Path:          "/admin/queues/radius/deadletters/someMessage",
+		},
+		{
+			OperationType: v1.OperationType{Type: OperationTypeDeadLetter, Method: OperationRequeue},
+			Method:        http.MethodPost,
+			Path:          "/admin/queues/radius/deadletters/someMessage/requeue",
+		},
 	}
 
 	ctrl := gomock.NewController(t)
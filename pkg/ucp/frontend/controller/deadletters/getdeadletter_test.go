@@ -0,0 +1,53 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadletters
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+)
+
+func Test_GetDeadLetter(t *testing.T) {
+	provider, queueName, msg := setupQueue(t)
+
+	ctrl, err := NewGetDeadLetter(armrpc_controller.Options{}, provider)
+	require.NoError(t, err)
+
+	t.Run("found", func(t *testing.T) {
+		resp, err := ctrl.Run(context.Background(), nil, newTestRequest(t, http.MethodGet, queueName, msg.ID))
+		require.NoError(t, err)
+
+		okResp, ok := resp.(*armrpc_rest.OKResponse)
+		require.True(t, ok)
+
+		result := okResp.Body.(*DeadLetterMessage)
+		require.Equal(t, msg.ID, result.ID)
+		require.JSONEq(t, `{"operationId":"op1"}`, string(result.Data))
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		resp, err := ctrl.Run(context.Background(), nil, newTestRequest(t, http.MethodGet, queueName, "invalid"))
+		require.NoError(t, err)
+		require.IsType(t, &armrpc_rest.NotFoundResponse{}, resp)
+	})
+}
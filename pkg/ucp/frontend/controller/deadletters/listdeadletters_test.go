@@ -0,0 +1,59 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadletters
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+)
+
+func Test_ListDeadLetters(t *testing.T) {
+	provider, queueName, msg := setupQueue(t)
+
+	ctrl, err := NewListDeadLetters(armrpc_controller.Options{}, provider)
+	require.NoError(t, err)
+
+	resp, err := ctrl.Run(context.Background(), nil, newTestRequest(t, http.MethodGet, queueName, ""))
+	require.NoError(t, err)
+
+	okResp, ok := resp.(*armrpc_rest.OKResponse)
+	require.True(t, ok)
+
+	list := okResp.Body.(*DeadLetterMessageList)
+	require.Len(t, list.Value, 1)
+	require.Equal(t, msg.ID, list.Value[0].ID)
+	require.Equal(t, "processing failed", list.Value[0].LastError)
+}
+
+func Test_ListDeadLetters_UnknownQueue(t *testing.T) {
+	provider, _, _ := setupQueue(t)
+
+	ctrl, err := NewListDeadLetters(armrpc_controller.Options{}, provider)
+	require.NoError(t, err)
+
+	resp, err := ctrl.Run(context.Background(), nil, newTestRequest(t, http.MethodGet, "unknown", ""))
+	require.NoError(t, err)
+
+	_, ok := resp.(*armrpc_rest.NotFoundResponse)
+	require.True(t, ok)
+}
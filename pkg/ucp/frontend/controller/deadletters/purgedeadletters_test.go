@@ -0,0 +1,63 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadletters
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+)
+
+func Test_PurgeDeadLetters(t *testing.T) {
+	t.Run("purge message", func(t *testing.T) {
+		provider, queueName, msg := setupQueue(t)
+
+		ctrl, err := NewPurgeDeadLetters(armrpc_controller.Options{}, provider)
+		require.NoError(t, err)
+
+		resp, err := ctrl.Run(context.Background(), nil, newTestRequest(t, http.MethodDelete, queueName, msg.ID))
+		require.NoError(t, err)
+		require.IsType(t, &armrpc_rest.NoContentResponse{}, resp)
+
+		resp, err = ctrl.Run(context.Background(), nil, newTestRequest(t, http.MethodDelete, queueName, msg.ID))
+		require.NoError(t, err)
+		require.IsType(t, &armrpc_rest.NotFoundResponse{}, resp)
+	})
+
+	t.Run("purge all", func(t *testing.T) {
+		provider, queueName, _ := setupQueue(t)
+
+		ctrl, err := NewPurgeDeadLetters(armrpc_controller.Options{}, provider)
+		require.NoError(t, err)
+
+		resp, err := ctrl.Run(context.Background(), nil, newTestRequest(t, http.MethodDelete, queueName, ""))
+		require.NoError(t, err)
+		require.IsType(t, &armrpc_rest.NoContentResponse{}, resp)
+
+		client, err := provider.GetClientForQueue(context.Background(), queueName)
+		require.NoError(t, err)
+
+		msgs, err := client.ListDeadLetterMessages(context.Background())
+		require.NoError(t, err)
+		require.Empty(t, msgs)
+	})
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadletters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
+	queueprovider "github.com/radius-project/radius/pkg/ucp/queue/provider"
+)
+
+var _ armrpc_controller.Controller = (*RequeueDeadLetter)(nil)
+
+// RequeueDeadLetter is the controller implementation to move a message from the dead-letter queue back to the queue.
+type RequeueDeadLetter struct {
+	armrpc_controller.BaseController
+
+	queueProvider *queueprovider.QueueProvider
+}
+
+// NewRequeueDeadLetter creates a new controller for requeueing a message in the dead-letter queue.
+func NewRequeueDeadLetter(opts armrpc_controller.Options, queueProvider *queueprovider.QueueProvider) (armrpc_controller.Controller, error) {
+	return &RequeueDeadLetter{
+		BaseController: armrpc_controller.NewBaseController(opts),
+		queueProvider:  queueProvider,
+	}, nil
+}
+
+// Run requeues the dead-lettered message in the request URL so that the async operation is processed again.
+func (c *RequeueDeadLetter) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	client, resp, err := getQueueClient(ctx, c.queueProvider, req)
+	if resp != nil || err != nil {
+		return resp, err
+	}
+
+	id := chi.URLParam(req, MessageIDParam)
+	err = client.RequeueDeadLetterMessage(ctx, id)
+	if errors.Is(err, queue.ErrDeadLetterMessageNotFound) {
+		return armrpc_rest.NewNotFoundMessageResponse(fmt.Sprintf("the message '%s' was not found in the dead-letter queue", id)), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return armrpc_rest.NewNoContentResponse(), nil
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadletters
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
+)
+
+func Test_RequeueDeadLetter(t *testing.T) {
+	provider, queueName, msg := setupQueue(t)
+
+	ctrl, err := NewRequeueDeadLetter(armrpc_controller.Options{}, provider)
+	require.NoError(t, err)
+
+	resp, err := ctrl.Run(context.Background(), nil, newTestRequest(t, http.MethodPost, queueName, msg.ID))
+	require.NoError(t, err)
+	require.IsType(t, &armrpc_rest.NoContentResponse{}, resp)
+
+	client, err := provider.GetClientForQueue(context.Background(), queueName)
+	require.NoError(t, err)
+
+	requeued, err := client.Dequeue(context.Background(), queue.QueueClientConfig{})
+	require.NoError(t, err)
+	require.Equal(t, msg.Data, requeued.Data)
+
+	resp, err = ctrl.Run(context.Background(), nil, newTestRequest(t, http.MethodPost, queueName, msg.ID))
+	require.NoError(t, err)
+	require.IsType(t, &armrpc_rest.NotFoundResponse{}, resp)
+}
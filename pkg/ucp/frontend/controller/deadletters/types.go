@@ -0,0 +1,94 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadletters
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
+	queueprovider "github.com/radius-project/radius/pkg/ucp/queue/provider"
+)
+
+const (
+	// QueueNameParam is the name of the URL parameter for the queue name.
+	QueueNameParam = "queueName"
+
+	// MessageIDParam is the name of the URL parameter for the dead-lettered message id.
+	MessageIDParam = "messageId"
+)
+
+// DeadLetterMessage is the representation of a dead-lettered async operation message.
+type DeadLetterMessage struct {
+	// ID is the id of the message.
+	ID string `json:"id"`
+	// DequeueCount is the number of times the message was dequeued before it was dead-lettered.
+	DequeueCount int `json:"dequeueCount"`
+	// EnqueueAt is the time when the message was enqueued.
+	EnqueueAt time.Time `json:"enqueueAt"`
+	// DeadLetteredAt is the time when the message was moved to the dead-letter queue.
+	DeadLetteredAt time.Time `json:"deadLetteredAt"`
+	// LastError is the last error that occurred while processing the message.
+	LastError string `json:"lastError,omitempty"`
+	// Data is the async operation request of the message.
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+// DeadLetterMessageList is the list of dead-lettered async operation messages.
+type DeadLetterMessageList struct {
+	// Value is the list of messages.
+	Value []DeadLetterMessage `json:"value"`
+}
+
+// NewDeadLetterMessage creates the DeadLetterMessage from the queue message.
+func NewDeadLetterMessage(msg *queue.Message) DeadLetterMessage {
+	result := DeadLetterMessage{
+		ID:             msg.ID,
+		DequeueCount:   msg.DequeueCount,
+		EnqueueAt:      msg.EnqueueAt,
+		DeadLetteredAt: msg.DeadLetteredAt,
+		LastError:      msg.LastError,
+	}
+
+	// Only JSON messages are supported by the queue, but we don't want to fail the request for a malformed message.
+	if json.Valid(msg.Data) {
+		result.Data = json.RawMessage(msg.Data)
+	}
+
+	return result
+}
+
+// getQueueClient gets the client for the queue specified in the request URL. It returns a NotFound response if the
+// queue is not one of the configured queues.
+func getQueueClient(ctx context.Context, queueProvider *queueprovider.QueueProvider, req *http.Request) (queue.Client, armrpc_rest.Response, error) {
+	queueName := chi.URLParam(req, QueueNameParam)
+	client, err := queueProvider.GetClientForQueue(ctx, queueName)
+	if errors.Is(err, queueprovider.ErrQueueNotFound) {
+		return nil, armrpc_rest.NewNotFoundMessageResponse(fmt.Sprintf("the queue '%s' was not found", queueName)), nil
+	} else if err != nil {
+		return nil, nil, err
+	}
+
+	return client, nil, nil
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadletters
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
+	queueprovider "github.com/radius-project/radius/pkg/ucp/queue/provider"
+)
+
+// setupQueue creates the queue provider and the in-memory queue with a unique name, and dead-letters a message.
+func setupQueue(t *testing.T) (*queueprovider.QueueProvider, string, *queue.Message) {
+	ctx := context.Background()
+	queueName := "test-" + uuid.NewString()
+	provider := queueprovider.New(queueprovider.QueueProviderOptions{
+		Provider:        queueprovider.TypeInmemory,
+		Name:            "ucp",
+		AdditionalNames: []string{queueName},
+	})
+
+	client, err := provider.GetClientForQueue(ctx, queueName)
+	require.NoError(t, err)
+
+	err = client.Enqueue(ctx, queue.NewMessage(map[string]string{"operationId": "op1"}))
+	require.NoError(t, err)
+
+	msg, err := client.Dequeue(ctx, queue.QueueClientConfig{})
+	require.NoError(t, err)
+
+	err = client.DeadLetterMessage(ctx, msg, "processing failed")
+	require.NoError(t, err)
+
+	return provider, queueName, msg
+}
+
+// newTestRequest creates the request with the chi route parameters for the queue name and message id.
+func newTestRequest(t *testing.T, method string, queueName string, messageID string) *http.Request {
+	req, err := http.NewRequest(method, "/admin/queues/"+queueName+"/deadletters", nil)
+	require.NoError(t, err)
+
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add(QueueNameParam, queueName)
+	if messageID != "" {
+		rctx.URLParams.Add(MessageIDParam, messageID)
+	}
+
+	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+}
+
+func Test_NewDeadLetterMessage(t *testing.T) {
+	msg := &queue.Message{
+		Metadata: queue.Metadata{ID: "id", DequeueCount: 4, LastError: "failed"},
+		Data:     []byte(`{"operationId":"op1"}`),
+	}
+
+	result := NewDeadLetterMessage(msg)
+	require.Equal(t, "id", result.ID)
+	require.Equal(t, 4, result.DequeueCount)
+	require.Equal(t, "failed", result.LastError)
+	require.JSONEq(t, `{"operationId":"op1"}`, string(result.Data))
+
+	msg.Data = []byte("not json")
+	result = NewDeadLetterMessage(msg)
+	require.Nil(t, result.Data)
+}
@@ -59,7 +59,7 @@ func (e *ListPlanes) Run(ctx context.Context, w http.ResponseWriter, req *http.R
 		IsScopeQuery: true,
 	}
 	logger.Info(fmt.Sprintf("Listing planes in scope %s", query.RootScope))
-	result, err := e.StorageClient().Query(ctx, query)
+	result, err := e.StorageClient().Query(ctx, query, store.WithPaginationToken(serviceCtx.SkipToken), store.WithMaxQueryItemCount(serviceCtx.Top))
 	if err != nil {
 		return nil, err
 	}
@@ -90,5 +90,7 @@ func (p *ListPlanes) createResponse(ctx context.Context, req *http.Request, resu
 		items.Value = append(items.Value, versioned)
 	}
 
+	items.NextLink = armrpc_controller.GetNextLinkURL(ctx, req, result.PaginationToken)
+
 	return &items, nil
 }
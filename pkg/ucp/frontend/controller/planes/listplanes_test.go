@@ -63,7 +63,7 @@ func Test_ListPlanes(t *testing.T) {
 		},
 	}
 
-	mockStorageClient.EXPECT().Query(gomock.Any(), query).Return(&store.ObjectQueryResult{
+	mockStorageClient.EXPECT().Query(gomock.Any(), query, gomock.Any(), gomock.Any()).Return(&store.ObjectQueryResult{
 		Items: []store.Object{
 			{
 				Metadata: store.Metadata{},
@@ -59,6 +59,7 @@ func (e *ListPlanesByType) Run(ctx context.Context, w http.ResponseWriter, req *
 	path := middleware.GetRelativePath(e.Options().PathBase, req.URL.Path)
 	// The path is /planes/{planeType}
 	planeType := strings.Split(path, resources.SegmentSeparator)[2]
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 	query := store.Query{
 		RootScope:    resources.SegmentSeparator + resources.PlanesSegment,
 		IsScopeQuery: true,
@@ -66,7 +67,7 @@ func (e *ListPlanesByType) Run(ctx context.Context, w http.ResponseWriter, req *
 	}
 	logger := ucplog.FromContextOrDiscard(ctx)
 	logger.Info(fmt.Sprintf("Listing planes in scope %s/%s", query.RootScope, planeType))
-	result, err := e.StorageClient().Query(ctx, query)
+	result, err := e.StorageClient().Query(ctx, query, store.WithPaginationToken(serviceCtx.SkipToken), store.WithMaxQueryItemCount(serviceCtx.Top))
 	if err != nil {
 		return nil, err
 	}
@@ -97,5 +98,7 @@ func (p *ListPlanesByType) createResponse(ctx context.Context, req *http.Request
 		items.Value = append(items.Value, versioned)
 	}
 
+	items.NextLink = armrpc_controller.GetNextLinkURL(ctx, req, result.PaginationToken)
+
 	return &items, nil
 }
@@ -64,7 +64,7 @@ func Test_ListPlanesByType(t *testing.T) {
 		},
 	}
 
-	mockStorageClient.EXPECT().Query(gomock.Any(), query).Return(&store.ObjectQueryResult{
+	mockStorageClient.EXPECT().Query(gomock.Any(), query, gomock.Any(), gomock.Any()).Return(&store.ObjectQueryResult{
 		Items: []store.Object{
 			{
 				Metadata: store.Metadata{},
@@ -66,7 +66,7 @@ func (r *ListResourceGroups) Run(ctx context.Context, w http.ResponseWriter, req
 	query.ResourceType = "resourcegroups"
 	logger.Info(fmt.Sprintf("Listing resource groups in scope %s", query.RootScope))
 
-	result, err := r.StorageClient().Query(ctx, query)
+	result, err := r.StorageClient().Query(ctx, query, store.WithPaginationToken(serviceCtx.SkipToken), store.WithMaxQueryItemCount(serviceCtx.Top))
 	if err != nil {
 		return nil, err
 	}
@@ -98,5 +98,7 @@ func (e *ListResourceGroups) createResponse(ctx context.Context, req *http.Reque
 		items.Value = append(items.Value, versioned)
 	}
 
+	items.NextLink = armrpc_controller.GetNextLinkURL(ctx, req, result.PaginationToken)
+
 	return &items, nil
 }
@@ -63,7 +63,7 @@ func Test_ListResourceGroups(t *testing.T) {
 		},
 	}
 
-	mockStorageClient.EXPECT().Query(gomock.Any(), query).DoAndReturn(func(ctx context.Context, query store.Query, options ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+	mockStorageClient.EXPECT().Query(gomock.Any(), query, gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, query store.Query, options ...store.QueryOptions) (*store.ObjectQueryResult, error) {
 		return &store.ObjectQueryResult{
 			Items: []store.Object{
 				{
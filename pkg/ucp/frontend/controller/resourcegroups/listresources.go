@@ -69,12 +69,13 @@ func (r *ListResources) Run(ctx context.Context, w http.ResponseWriter, req *htt
 		return nil, err
 	}
 
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 	query := store.Query{
 		RootScope:    resourceGroupID.String(),
 		ResourceType: v20231001preview.ResourceType,
 	}
 
-	result, err := r.StorageClient().Query(ctx, query)
+	result, err := r.StorageClient().Query(ctx, query, store.WithPaginationToken(serviceCtx.SkipToken), store.WithMaxQueryItemCount(serviceCtx.Top))
 	if err != nil {
 		return nil, err
 	}
@@ -106,5 +107,7 @@ func (r *ListResources) createResponse(ctx context.Context, req *http.Request, r
 		items.Value = append(items.Value, versioned)
 	}
 
+	items.NextLink = armrpc_controller.GetNextLinkURL(ctx, req, result.PaginationToken)
+
 	return &items, nil
 }
@@ -16,6 +16,7 @@ limitations under the License.
 package resourcegroups
 
 import (
+	"context"
 	"net/http"
 	"testing"
 
@@ -71,7 +72,7 @@ func Test_ListResources(t *testing.T) {
 
 		expectedQuery := store.Query{RootScope: resourceGroupID, ResourceType: v20231001preview.ResourceType}
 		storage.EXPECT().
-			Query(gomock.Any(), expectedQuery).
+			Query(gomock.Any(), expectedQuery, gomock.Any(), gomock.Any()).
 			Return(&store.ObjectQueryResult{Items: []store.Object{{Data: entryDatamodel}}}, nil).
 			Times(1)
 
@@ -97,7 +98,7 @@ func Test_ListResources(t *testing.T) {
 
 		expectedQuery := store.Query{RootScope: resourceGroupID, ResourceType: v20231001preview.ResourceType}
 		storage.EXPECT().
-			Query(gomock.Any(), expectedQuery).
+			Query(gomock.Any(), expectedQuery, gomock.Any(), gomock.Any()).
 			Return(&store.ObjectQueryResult{Items: []store.Object{}}, nil).
 			Times(1)
 
@@ -111,6 +112,42 @@ func Test_ListResources(t *testing.T) {
 		require.Equal(t, expected, response)
 	})
 
+	t.Run("success - paged", func(t *testing.T) {
+		storage, ctrl := setupListResources(t)
+
+		storage.EXPECT().
+			Get(gomock.Any(), resourceGroupID).
+			Return(&store.Object{Data: resourceGroupDatamodel}, nil).
+			Times(1)
+
+		expectedQuery := store.Query{RootScope: resourceGroupID, ResourceType: v20231001preview.ResourceType}
+		storage.EXPECT().
+			Query(gomock.Any(), expectedQuery, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, query store.Query, options ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+				cfg := store.NewQueryConfig(options...)
+				require.Equal(t, "previous-token", cfg.PaginationToken)
+				require.Equal(t, 5, cfg.MaxQueryItemCount)
+
+				return &store.ObjectQueryResult{Items: []store.Object{{Data: entryDatamodel}}, PaginationToken: "next-token"}, nil
+			}).
+			Times(1)
+
+		request, err := http.NewRequest(http.MethodGet, ctrl.Options().PathBase+id+"?api-version="+v20231001preview.Version+"&skipToken=previous-token&top=5", nil)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(request)
+		response, err := ctrl.Run(ctx, nil, request)
+		require.NoError(t, err)
+
+		okResponse, ok := response.(*armrpc_rest.OKResponse)
+		require.True(t, ok)
+
+		list, ok := okResponse.Body.(*v1.PaginatedList)
+		require.True(t, ok)
+		require.Equal(t, []any{&entryResource}, list.Value)
+		require.Contains(t, list.NextLink, "skipToken=next-token")
+		require.Contains(t, list.NextLink, "top=5")
+	})
+
 	t.Run("resource group not found", func(t *testing.T) {
 		storage, ctrl := setupListResources(t)
 
@@ -28,6 +28,10 @@ limitations under the License.
 // 3. FinishMessage: Deletes the leased message CR to remove message in the queue completely if the message is not re-queued.
 // 4. ExtendMessage: Extends the leased message to postpone the re-queue operation.
 //
+// Messages which can't be processed are moved to the dead-letter queue by DeadLetterMessage. Dead-lettered messages keep
+// the same QueueMessage resource, but are marked with `ucp.dev/deadletter` label which excludes them from Dequeue, and store
+// the last processing error in the spec. They can be inspected, requeued or purged until they are deleted.
+//
 // To create new QueueMessage resource, we generate the below unique id to avoid the conflict.
 //
 //         applications.core.1656452659.70a6f0f8003943a6abe3319c5a4f1b9d
@@ -41,7 +45,8 @@ limitations under the License.
 // time. It will get the item which was re-queued or was not dequeued message. Then it will increase DequeueCount and update
 // `ucp.dev/nextvisibleat` timestamp (current time + 5 mins(default)) and try to update the item. If the other clients already
 // fetched message, then Update() API would return conflict error by optimistic concurrency and retry to query new message
-// and update it again until the conflict is resolved.
+// and update it again until the conflict is resolved. Delayed delivery (client.WithVisibleAfter or client.WithDelay) uses
+// the same label - Enqueue() sets `ucp.dev/nextvisibleat` to the requested time so that Dequeue() skips the message until then.
 //
 // How to handle clock skew - Dequeue operation in this implementation relies on system clock. If Client A and B
 // run in the different physical node A and B respectively, client B in node B could deqeueue the same message in the clock skew
@@ -63,6 +68,7 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/queue/client"
 
 	v1alpha1 "github.com/radius-project/radius/pkg/ucp/store/apiserverstore/api/ucp.dev/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -76,6 +82,8 @@ const (
 	LabelQueueName = "ucp.dev/queuename"
 	// LabelNextVisibleAt is the label representing the time when message is visible in the queue or requeued.
 	LabelNextVisibleAt = "ucp.dev/nextvisibleat"
+	// LabelDeadLetter is the label representing that the message was moved to the dead-letter queue.
+	LabelDeadLetter = "ucp.dev/deadletter"
 
 	defaultMessageLockDuration = time.Duration(5) * time.Minute
 	defaultExpiryDuration      = time.Duration(10) * time.Hour
@@ -124,6 +132,13 @@ func copyMessage(msg *client.Message, queueMessage *v1alpha1.QueueMessage) {
 		EnqueueAt:     queueMessage.Spec.EnqueueAt.Time,
 		ExpireAt:      queueMessage.Spec.ExpireAt.Time,
 		NextVisibleAt: getTimeFromString(queueMessage.Labels[LabelNextVisibleAt]),
+		LastError:     queueMessage.Spec.LastError,
+	}
+	if queueMessage.Spec.DeadLetteredAt != nil {
+		msg.Metadata.DeadLetteredAt = queueMessage.Spec.DeadLetteredAt.Time
+	}
+	if queueMessage.Spec.RequeuedAt != nil {
+		msg.Metadata.RequeuedAt = queueMessage.Spec.RequeuedAt.Time
 	}
 	msg.ContentType = client.JSONContentType
 	msg.Data = make([]byte, len(queueMessage.Spec.Data.Raw))
@@ -170,19 +185,25 @@ func (c *Client) Enqueue(ctx context.Context, msg *client.Message, options ...cl
 		return err
 	}
 
+	// The message is not visible to Dequeue until LabelNextVisibleAt if the delivery is delayed.
+	visibleAt := now
+	if cfg := client.NewEnqueueConfig(options...); cfg.VisibleAfter.After(now) {
+		visibleAt = cfg.VisibleAfter
+	}
+
 	resource := &v1alpha1.QueueMessage{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      id,
 			Namespace: c.opts.Namespace,
 			Labels: map[string]string{
-				LabelNextVisibleAt: int64toa(now.UnixNano()),
+				LabelNextVisibleAt: int64toa(visibleAt.UnixNano()),
 				LabelQueueName:     c.opts.Name,
 			},
 		},
 		Spec: v1alpha1.QueueMessageSpec{
 			DequeueCount: 0,
 			EnqueueAt:    metav1.Time{Time: now.UTC()},
-			ExpireAt:     metav1.Time{Time: now.Add(c.opts.ExpiryDuration).UTC()},
+			ExpireAt:     metav1.Time{Time: visibleAt.Add(c.opts.ExpiryDuration).UTC()},
 			ContentType:  client.JSONContentType, // RawExtension supports only JSON seralized data
 			Data:         &runtime.RawExtension{Raw: msg.Data},
 		},
@@ -204,6 +225,30 @@ func newMessageLabelSelector(now time.Time, name string) (labels.Selector, error
 	}
 	selector = selector.Add(*nextVisibleLabel)
 
+	// Dead-lettered messages must not be dequeued.
+	deadLetterLabel, err := labels.NewRequirement(LabelDeadLetter, selection.DoesNotExist, nil)
+	if err != nil {
+		return nil, err
+	}
+	selector = selector.Add(*deadLetterLabel)
+
+	nameLabel, err := labels.NewRequirement(LabelQueueName, selection.Equals, []string{name})
+	if err != nil {
+		return nil, err
+	}
+
+	return selector.Add(*nameLabel), nil
+}
+
+func newDeadLetterLabelSelector(name string) (labels.Selector, error) {
+	selector := labels.NewSelector()
+
+	deadLetterLabel, err := labels.NewRequirement(LabelDeadLetter, selection.Exists, nil)
+	if err != nil {
+		return nil, err
+	}
+	selector = selector.Add(*deadLetterLabel)
+
 	nameLabel, err := labels.NewRequirement(LabelQueueName, selection.Equals, []string{name})
 	if err != nil {
 		return nil, err
@@ -356,3 +401,126 @@ func (c *Client) ExtendMessage(ctx context.Context, msg *client.Message) error {
 	copyMessage(msg, result)
 	return nil
 }
+
+// DeadLetterMessage moves the leased message to the dead-letter queue by labeling the message with LabelDeadLetter.
+func (c *Client) DeadLetterMessage(ctx context.Context, msg *client.Message, lastError string) error {
+	if msg == nil {
+		return client.ErrEmptyMessage
+	}
+
+	result := &v1alpha1.QueueMessage{}
+	retryErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		getErr := c.client.Get(ctx, runtimeclient.ObjectKey{Namespace: c.opts.Namespace, Name: msg.ID}, result)
+		if getErr != nil {
+			return getErr
+		}
+
+		// Ensure that it doesn't dead-letter the message that another client leased.
+		if result.Spec.DequeueCount != msg.DequeueCount {
+			return client.ErrDequeuedMessage
+		}
+
+		result.Labels[LabelDeadLetter] = "true"
+		result.Spec.DeadLetteredAt = &metav1.Time{Time: time.Now().UTC()}
+		result.Spec.LastError = lastError
+
+		return c.client.Update(ctx, result)
+	})
+
+	return retryErr
+}
+
+// ListDeadLetterMessages lists the messages in the dead-letter queue.
+func (c *Client) ListDeadLetterMessages(ctx context.Context) ([]*client.Message, error) {
+	selector, err := newDeadLetterLabelSelector(c.opts.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	ql := &v1alpha1.QueueMessageList{}
+	err = c.client.List(
+		ctx, ql,
+		runtimeclient.InNamespace(c.opts.Namespace),
+		runtimeclient.MatchingLabelsSelector{Selector: selector})
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := []*client.Message{}
+	for i := range ql.Items {
+		msg := &client.Message{}
+		copyMessage(msg, &ql.Items[i])
+		msgs = append(msgs, msg)
+	}
+
+	return msgs, nil
+}
+
+// getDeadLetterItem gets the dead-lettered QueueMessage with the given id.
+func (c *Client) getDeadLetterItem(ctx context.Context, id string, result *v1alpha1.QueueMessage) error {
+	err := c.client.Get(ctx, runtimeclient.ObjectKey{Namespace: c.opts.Namespace, Name: id}, result)
+	if apierrors.IsNotFound(err) {
+		return client.ErrDeadLetterMessageNotFound
+	} else if err != nil {
+		return err
+	}
+
+	if _, ok := result.Labels[LabelDeadLetter]; !ok || result.Labels[LabelQueueName] != c.opts.Name {
+		return client.ErrDeadLetterMessageNotFound
+	}
+
+	return nil
+}
+
+// GetDeadLetterMessage gets the message with the given id from the dead-letter queue.
+func (c *Client) GetDeadLetterMessage(ctx context.Context, id string) (*client.Message, error) {
+	result := &v1alpha1.QueueMessage{}
+	if err := c.getDeadLetterItem(ctx, id, result); err != nil {
+		return nil, err
+	}
+
+	msg := &client.Message{}
+	copyMessage(msg, result)
+	return msg, nil
+}
+
+// RequeueDeadLetterMessage moves the message with the given id from the dead-letter queue back to the queue. The message
+// is visible immediately, its dequeue count is reset and the time when it was requeued is recorded.
+func (c *Client) RequeueDeadLetterMessage(ctx context.Context, id string) error {
+	result := &v1alpha1.QueueMessage{}
+	retryErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if err := c.getDeadLetterItem(ctx, id, result); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		delete(result.Labels, LabelDeadLetter)
+		result.Labels[LabelNextVisibleAt] = int64toa(now.UnixNano())
+		result.Spec.DequeueCount = 0
+		result.Spec.EnqueueAt = metav1.Time{Time: now.UTC()}
+		result.Spec.ExpireAt = metav1.Time{Time: now.Add(c.opts.ExpiryDuration).UTC()}
+		result.Spec.DeadLetteredAt = nil
+		result.Spec.LastError = ""
+		result.Spec.RequeuedAt = &metav1.Time{Time: now.UTC()}
+
+		return c.client.Update(ctx, result)
+	})
+
+	return retryErr
+}
+
+// PurgeDeadLetterMessage deletes the message with the given id from the dead-letter queue.
+func (c *Client) PurgeDeadLetterMessage(ctx context.Context, id string) error {
+	result := &v1alpha1.QueueMessage{}
+	if err := c.getDeadLetterItem(ctx, id, result); err != nil {
+		return err
+	}
+
+	options := &runtimeclient.DeleteOptions{
+		Preconditions: &metav1.Preconditions{
+			UID:             &result.UID,
+			ResourceVersion: &result.ResourceVersion,
+		},
+	}
+	return c.client.Delete(ctx, result, options)
+}
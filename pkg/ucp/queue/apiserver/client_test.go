@@ -29,6 +29,7 @@ import (
 	sharedtest "github.com/radius-project/radius/test/ucp/queuetest"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -87,6 +88,32 @@ func TestCopyMessage(t *testing.T) {
 	require.Equal(t, getTimeFromString(queueM.ObjectMeta.Labels[LabelNextVisibleAt]), msg.NextVisibleAt)
 }
 
+func TestLabelSelectors(t *testing.T) {
+	now := time.Now()
+	visible := labels.Set{
+		LabelQueueName:     "applications.core",
+		LabelNextVisibleAt: int64toa(now.Add(-time.Second).UnixNano()),
+	}
+	deadLettered := labels.Set{
+		LabelQueueName:     "applications.core",
+		LabelNextVisibleAt: int64toa(now.Add(-time.Second).UnixNano()),
+		LabelDeadLetter:    "true",
+	}
+
+	selector, err := newMessageLabelSelector(now, "applications.core")
+	require.NoError(t, err)
+	require.True(t, selector.Matches(visible))
+	require.False(t, selector.Matches(deadLettered))
+
+	selector, err = newDeadLetterLabelSelector("applications.core")
+	require.NoError(t, err)
+	require.False(t, selector.Matches(visible))
+	require.True(t, selector.Matches(deadLettered))
+
+	deadLettered[LabelQueueName] = "other"
+	require.False(t, selector.Matches(deadLettered))
+}
+
 func TestGenerateID(t *testing.T) {
 	cli, err := New(nil, Options{Name: "applications.core", Namespace: "test"})
 	require.NoError(t, err)
@@ -39,6 +39,9 @@ var (
 
 	// ErrEmptyMessage represents nil or empty Message.
 	ErrEmptyMessage = errors.New("message must not be nil or message is empty")
+
+	// ErrDeadLetterMessageNotFound represents the error when the message is not in the dead-letter queue.
+	ErrDeadLetterMessageNotFound = errors.New("message is not in the dead-letter queue")
 )
 
 //go:generate mockgen -destination=./mock_client.go -package=client -self_package github.com/radius-project/radius/pkg/ucp/queue/client github.com/radius-project/radius/pkg/ucp/queue/client Client
@@ -56,6 +59,23 @@ type Client interface {
 
 	// ExtendMessage extends the message lock.
 	ExtendMessage(ctx context.Context, msg *Message) error
+
+	// DeadLetterMessage moves the leased message to the dead-letter queue with the last error that
+	// occurred while processing it. Dead-lettered messages are never dequeued until they are requeued.
+	DeadLetterMessage(ctx context.Context, msg *Message, lastError string) error
+
+	// ListDeadLetterMessages lists the messages in the dead-letter queue.
+	ListDeadLetterMessages(ctx context.Context) ([]*Message, error)
+
+	// GetDeadLetterMessage gets the message with the given id from the dead-letter queue.
+	GetDeadLetterMessage(ctx context.Context, id string) (*Message, error)
+
+	// RequeueDeadLetterMessage moves the message with the given id from the dead-letter queue back to the queue. The
+	// requeued message records the time when it was requeued in RequeuedAt, so that the consumer can start it over.
+	RequeueDeadLetterMessage(ctx context.Context, id string) error
+
+	// PurgeDeadLetterMessage deletes the message with the given id from the dead-letter queue.
+	PurgeDeadLetterMessage(ctx context.Context, id string) error
 }
 
 // StartDequeuer starts a dequeuer to consume the message from the queue and return the output channel.
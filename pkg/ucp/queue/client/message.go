@@ -46,6 +46,13 @@ type Metadata struct {
 	ExpireAt time.Time
 	// NextVisibleAt represents the next visible time after dequeuing the message.
 	NextVisibleAt time.Time
+
+	// DeadLetteredAt represents the time when the message was moved to the dead-letter queue.
+	DeadLetteredAt time.Time
+	// LastError represents the last error that occurred while processing the dead-lettered message.
+	LastError string
+	// RequeuedAt represents the time when the message was requeued from the dead-letter queue.
+	RequeuedAt time.Time
 }
 
 // NewMessage creates Message.
@@ -34,6 +34,20 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// DeadLetterMessage mocks base method.
+func (m *MockClient) DeadLetterMessage(arg0 context.Context, arg1 *Message, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeadLetterMessage", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeadLetterMessage indicates an expected call of DeadLetterMessage.
+func (mr *MockClientMockRecorder) DeadLetterMessage(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeadLetterMessage", reflect.TypeOf((*MockClient)(nil).DeadLetterMessage), arg0, arg1, arg2)
+}
+
 // Dequeue mocks base method.
 func (m *MockClient) Dequeue(arg0 context.Context, arg1 QueueClientConfig) (*Message, error) {
 	m.ctrl.T.Helper()
@@ -95,3 +109,61 @@ func (mr *MockClientMockRecorder) FinishMessage(arg0, arg1 interface{}) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishMessage", reflect.TypeOf((*MockClient)(nil).FinishMessage), arg0, arg1)
 }
+
+// GetDeadLetterMessage mocks base method.
+func (m *MockClient) GetDeadLetterMessage(arg0 context.Context, arg1 string) (*Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeadLetterMessage", arg0, arg1)
+	ret0, _ := ret[0].(*Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeadLetterMessage indicates an expected call of GetDeadLetterMessage.
+func (mr *MockClientMockRecorder) GetDeadLetterMessage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadLetterMessage", reflect.TypeOf((*MockClient)(nil).GetDeadLetterMessage), arg0, arg1)
+}
+
+// ListDeadLetterMessages mocks base method.
+func (m *MockClient) ListDeadLetterMessages(arg0 context.Context) ([]*Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeadLetterMessages", arg0)
+	ret0, _ := ret[0].([]*Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeadLetterMessages indicates an expected call of ListDeadLetterMessages.
+func (mr *MockClientMockRecorder) ListDeadLetterMessages(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeadLetterMessages", reflect.TypeOf((*MockClient)(nil).ListDeadLetterMessages), arg0)
+}
+
+// PurgeDeadLetterMessage mocks base method.
+func (m *MockClient) PurgeDeadLetterMessage(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeadLetterMessage", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeDeadLetterMessage indicates an expected call of PurgeDeadLetterMessage.
+func (mr *MockClientMockRecorder) PurgeDeadLetterMessage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeadLetterMessage", reflect.TypeOf((*MockClient)(nil).PurgeDeadLetterMessage), arg0, arg1)
+}
+
+// RequeueDeadLetterMessage mocks base method.
+func (m *MockClient) RequeueDeadLetterMessage(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequeueDeadLetterMessage", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequeueDeadLetterMessage indicates an expected call of RequeueDeadLetterMessage.
+func (mr *MockClientMockRecorder) RequeueDeadLetterMessage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequeueDeadLetterMessage", reflect.TypeOf((*MockClient)(nil).RequeueDeadLetterMessage), arg0, arg1)
+}
@@ -21,6 +21,8 @@ import "time"
 type (
 	// EnqueueOptions applies an option to Enqueue().
 	EnqueueOptions interface {
+		// ApplyEnqueueOption applies EnqueueOptions to EnqueueConfig.
+		ApplyEnqueueOption(EnqueueConfig) EnqueueConfig
 		// A private method to prevent users implementing the
 		// interface and so future additions to it will not
 		// violate compatibility.
@@ -43,6 +45,53 @@ type QueueClientConfig struct {
 	DequeueIntervalDuration time.Duration
 }
 
+// EnqueueConfig is a configuration for Enqueue().
+type EnqueueConfig struct {
+	// VisibleAfter is the time when the enqueued message becomes visible to Dequeue(). The message is
+	// delivered right away when it is zero.
+	VisibleAfter time.Time
+}
+
+type enqueueOptions struct {
+	fn func(EnqueueConfig) EnqueueConfig
+}
+
+// ApplyEnqueueOption applies the configuration to Enqueue().
+func (q *enqueueOptions) ApplyEnqueueOption(cfg EnqueueConfig) EnqueueConfig {
+	return q.fn(cfg)
+}
+
+func (q enqueueOptions) private() {}
+
+// WithVisibleAfter delays the delivery of the enqueued message until the given time.
+func WithVisibleAfter(t time.Time) EnqueueOptions {
+	return &enqueueOptions{
+		fn: func(cfg EnqueueConfig) EnqueueConfig {
+			cfg.VisibleAfter = t
+			return cfg
+		},
+	}
+}
+
+// WithDelay delays the delivery of the enqueued message by the given duration from the time of Enqueue().
+func WithDelay(d time.Duration) EnqueueOptions {
+	return &enqueueOptions{
+		fn: func(cfg EnqueueConfig) EnqueueConfig {
+			cfg.VisibleAfter = time.Now().Add(d)
+			return cfg
+		},
+	}
+}
+
+// NewEnqueueConfig returns new enqueue config for Enqueue().
+func NewEnqueueConfig(opts ...EnqueueOptions) EnqueueConfig {
+	cfg := EnqueueConfig{}
+	for _, opt := range opts {
+		cfg = opt.ApplyEnqueueOption(cfg)
+	}
+	return cfg
+}
+
 type dequeueOptions struct {
 	fn func(QueueClientConfig) QueueClientConfig
 }
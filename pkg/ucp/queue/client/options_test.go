@@ -0,0 +1,44 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEnqueueConfig(t *testing.T) {
+	t.Run("no options", func(t *testing.T) {
+		cfg := NewEnqueueConfig()
+		require.True(t, cfg.VisibleAfter.IsZero())
+	})
+
+	t.Run("WithVisibleAfter", func(t *testing.T) {
+		visibleAfter := time.Now().Add(time.Hour)
+		cfg := NewEnqueueConfig(WithVisibleAfter(visibleAfter))
+		require.Equal(t, visibleAfter, cfg.VisibleAfter)
+	})
+
+	t.Run("WithDelay", func(t *testing.T) {
+		now := time.Now()
+		cfg := NewEnqueueConfig(WithDelay(time.Minute))
+		require.False(t, cfg.VisibleAfter.Before(now.Add(time.Minute)))
+		require.True(t, cfg.VisibleAfter.Before(now.Add(2*time.Minute)))
+	})
+}
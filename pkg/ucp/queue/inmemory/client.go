@@ -55,7 +55,8 @@ func (c *Client) Enqueue(ctx context.Context, msg *client.Message, options ...cl
 	if msg == nil || msg.Data == nil || len(msg.Data) == 0 {
 		return client.ErrEmptyMessage
 	}
-	c.queue.Enqueue(msg)
+	cfg := client.NewEnqueueConfig(options...)
+	c.queue.EnqueueVisibleAfter(msg, cfg.VisibleAfter)
 	return nil
 }
 
@@ -89,3 +90,32 @@ func (c *Client) ExtendMessage(ctx context.Context, msg *client.Message) error {
 	}
 	return err
 }
+
+// DeadLetterMessage moves the leased message to the dead-letter queue.
+func (c *Client) DeadLetterMessage(ctx context.Context, msg *client.Message, lastError string) error {
+	if msg == nil {
+		return client.ErrEmptyMessage
+	}
+
+	return c.queue.DeadLetter(msg, lastError)
+}
+
+// ListDeadLetterMessages lists the messages in the dead-letter queue.
+func (c *Client) ListDeadLetterMessages(ctx context.Context) ([]*client.Message, error) {
+	return c.queue.DeadLetters(), nil
+}
+
+// GetDeadLetterMessage gets the message with the given id from the dead-letter queue.
+func (c *Client) GetDeadLetterMessage(ctx context.Context, id string) (*client.Message, error) {
+	return c.queue.GetDeadLetter(id)
+}
+
+// RequeueDeadLetterMessage moves the message with the given id from the dead-letter queue back to the queue.
+func (c *Client) RequeueDeadLetterMessage(ctx context.Context, id string) error {
+	return c.queue.RequeueDeadLetter(id)
+}
+
+// PurgeDeadLetterMessage deletes the message with the given id from the dead-letter queue.
+func (c *Client) PurgeDeadLetterMessage(ctx context.Context, id string) error {
+	return c.queue.PurgeDeadLetter(id)
+}
@@ -43,12 +43,16 @@ type InmemQueue struct {
 	v   *list.List
 	vMu sync.Mutex
 
+	// deadLetters is the list of dead-lettered messages. This is guarded by vMu.
+	deadLetters *list.List
+
 	lockDuration time.Duration
 }
 
 func NewInMemQueue(lockDuration time.Duration) *InmemQueue {
 	return &InmemQueue{
 		v:            &list.List{},
+		deadLetters:  &list.List{},
 		lockDuration: lockDuration,
 	}
 }
@@ -63,9 +67,15 @@ func (q *InmemQueue) DeleteAll() {
 	q.vMu.Lock()
 	defer q.vMu.Unlock()
 	_ = q.v.Init()
+	_ = q.deadLetters.Init()
 }
 
 func (q *InmemQueue) Enqueue(msg *client.Message) {
+	q.EnqueueVisibleAfter(msg, time.Time{})
+}
+
+// EnqueueVisibleAfter enqueues the message which is not visible to Dequeue until visibleAfter.
+func (q *InmemQueue) EnqueueVisibleAfter(msg *client.Message, visibleAfter time.Time) {
 	q.updateQueue()
 
 	q.vMu.Lock()
@@ -73,10 +83,17 @@ func (q *InmemQueue) Enqueue(msg *client.Message) {
 
 	msg.Metadata.ID = uuid.NewString()
 	msg.Metadata.DequeueCount = 0
-	msg.Metadata.EnqueueAt = time.Now().UTC()
-	msg.Metadata.ExpireAt = time.Now().UTC().Add(messageExpireDuration)
+	now := time.Now().UTC()
+	msg.Metadata.EnqueueAt = now
+	msg.Metadata.ExpireAt = now.Add(messageExpireDuration)
+	msg.Metadata.NextVisibleAt = visibleAfter
+
+	visible := !visibleAfter.After(now)
+	if !visible {
+		msg.Metadata.ExpireAt = visibleAfter.UTC().Add(messageExpireDuration)
+	}
 
-	q.v.PushBack(&element{val: msg, visible: true})
+	q.v.PushBack(&element{val: msg, visible: visible})
 }
 
 func (q *InmemQueue) Dequeue() *client.Message {
@@ -141,6 +158,103 @@ func (q *InmemQueue) Extend(msg *client.Message) error {
 	return nil
 }
 
+// DeadLetter moves the leased message to the dead-letter queue.
+func (q *InmemQueue) DeadLetter(msg *client.Message, lastError string) error {
+	var found *client.Message
+	q.elementRange(func(e *list.Element, elem *element) bool {
+		if elem.val.ID == msg.ID {
+			if elem.visible || elem.val.DequeueCount != msg.DequeueCount {
+				return true
+			}
+			found = elem.val
+			q.v.Remove(e)
+			return true
+		}
+		return false
+	})
+
+	if found == nil {
+		return client.ErrInvalidMessage
+	}
+
+	q.vMu.Lock()
+	defer q.vMu.Unlock()
+
+	found.DeadLetteredAt = time.Now().UTC()
+	found.LastError = lastError
+	q.deadLetters.PushBack(found)
+
+	return nil
+}
+
+// DeadLetters returns the copy of messages in the dead-letter queue.
+func (q *InmemQueue) DeadLetters() []*client.Message {
+	q.vMu.Lock()
+	defer q.vMu.Unlock()
+
+	msgs := []*client.Message{}
+	for e := q.deadLetters.Front(); e != nil; e = e.Next() {
+		msg := *e.Value.(*client.Message)
+		msgs = append(msgs, &msg)
+	}
+	return msgs
+}
+
+// GetDeadLetter returns the copy of the dead-lettered message with the given id.
+func (q *InmemQueue) GetDeadLetter(id string) (*client.Message, error) {
+	q.vMu.Lock()
+	defer q.vMu.Unlock()
+
+	e := q.findDeadLetter(id)
+	if e == nil {
+		return nil, client.ErrDeadLetterMessageNotFound
+	}
+
+	msg := *e.Value.(*client.Message)
+	return &msg, nil
+}
+
+// RequeueDeadLetter moves the dead-lettered message with the given id back to the queue, recording the time when it
+// was requeued.
+func (q *InmemQueue) RequeueDeadLetter(id string) error {
+	q.vMu.Lock()
+	e := q.findDeadLetter(id)
+	if e == nil {
+		q.vMu.Unlock()
+		return client.ErrDeadLetterMessageNotFound
+	}
+	msg := q.deadLetters.Remove(e).(*client.Message)
+	q.vMu.Unlock()
+
+	requeued := &client.Message{ContentType: msg.ContentType, Data: msg.Data}
+	requeued.RequeuedAt = time.Now().UTC()
+	q.Enqueue(requeued)
+	return nil
+}
+
+// PurgeDeadLetter deletes the dead-lettered message with the given id.
+func (q *InmemQueue) PurgeDeadLetter(id string) error {
+	q.vMu.Lock()
+	defer q.vMu.Unlock()
+
+	e := q.findDeadLetter(id)
+	if e == nil {
+		return client.ErrDeadLetterMessageNotFound
+	}
+
+	q.deadLetters.Remove(e)
+	return nil
+}
+
+func (q *InmemQueue) findDeadLetter(id string) *list.Element {
+	for e := q.deadLetters.Front(); e != nil; e = e.Next() {
+		if e.Value.(*client.Message).ID == id {
+			return e
+		}
+	}
+	return nil
+}
+
 func (q *InmemQueue) updateQueue() {
 	q.elementRange(func(e *list.Element, elem *element) bool {
 		now := time.Now().UTC()
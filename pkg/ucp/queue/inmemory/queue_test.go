@@ -64,6 +64,25 @@ func TestMessageLock(t *testing.T) {
 	require.Equal(t, 2, msg2.DequeueCount)
 }
 
+func TestEnqueueVisibleAfter(t *testing.T) {
+	q := NewInMemQueue(messageLockDuration)
+
+	q.EnqueueVisibleAfter(&client.Message{
+		Data: []byte("test"),
+	}, time.Now().Add(10*time.Millisecond))
+
+	// The message is invisible until the requested time.
+	msg := q.Dequeue()
+	require.Nil(t, msg)
+
+	time.Sleep(20 * time.Millisecond)
+
+	msg = q.Dequeue()
+	require.NotNil(t, msg)
+	require.Equal(t, []byte("test"), msg.Data)
+	require.Equal(t, 1, msg.DequeueCount)
+}
+
 func TestExpiry(t *testing.T) {
 	q := NewInMemQueue(messageLockDuration)
 
@@ -101,3 +120,68 @@ func TestComplete(t *testing.T) {
 	msg2 := q.Dequeue()
 	require.Nil(t, msg2)
 }
+
+func TestDeadLetter(t *testing.T) {
+	q := NewInMemQueue(messageLockDuration)
+
+	q.Enqueue(&client.Message{
+		Data: []byte("test"),
+	})
+
+	msg := q.Dequeue()
+	err := q.DeadLetter(msg, "failed")
+	require.NoError(t, err)
+
+	// Dead-lettered message must not be dequeued.
+	require.Nil(t, q.Dequeue())
+	require.Equal(t, 0, q.Len())
+
+	deadLetters := q.DeadLetters()
+	require.Len(t, deadLetters, 1)
+	require.Equal(t, msg.ID, deadLetters[0].ID)
+	require.Equal(t, "failed", deadLetters[0].LastError)
+	require.False(t, deadLetters[0].DeadLetteredAt.IsZero())
+
+	found, err := q.GetDeadLetter(msg.ID)
+	require.NoError(t, err)
+	require.Equal(t, []byte("test"), found.Data)
+
+	_, err = q.GetDeadLetter("invalid")
+	require.ErrorIs(t, err, client.ErrDeadLetterMessageNotFound)
+
+	err = q.RequeueDeadLetter(msg.ID)
+	require.NoError(t, err)
+	require.Empty(t, q.DeadLetters())
+
+	requeued := q.Dequeue()
+	require.NotNil(t, requeued)
+	require.Equal(t, []byte("test"), requeued.Data)
+	require.Equal(t, 1, requeued.DequeueCount)
+	require.Empty(t, requeued.LastError)
+
+	err = q.DeadLetter(requeued, "failed again")
+	require.NoError(t, err)
+
+	err = q.PurgeDeadLetter(requeued.ID)
+	require.NoError(t, err)
+	require.Empty(t, q.DeadLetters())
+
+	err = q.PurgeDeadLetter(requeued.ID)
+	require.ErrorIs(t, err, client.ErrDeadLetterMessageNotFound)
+}
+
+func TestDeadLetter_NotLeased(t *testing.T) {
+	q := NewInMemQueue(messageLockDuration)
+
+	q.Enqueue(&client.Message{
+		Data: []byte("test"),
+	})
+
+	msg := q.Dequeue()
+	stale := *msg
+	stale.DequeueCount = 0
+
+	err := q.DeadLetter(&stale, "failed")
+	require.ErrorIs(t, err, client.ErrInvalidMessage)
+	require.Empty(t, q.DeadLetters())
+}
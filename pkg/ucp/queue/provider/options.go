@@ -24,6 +24,10 @@ type QueueProviderOptions struct {
 	// Name represents the unique name of queue.
 	Name string `yaml:"name"`
 
+	// AdditionalNames is the list of the queues of the other services that can be managed using GetClientForQueue,
+	// for example to inspect their dead-letter queues. (Optional)
+	AdditionalNames []string `yaml:"additionalNames,omitempty"`
+
 	// InMemory represents inmemory queue client options. (Optional)
 	InMemory *InMemoryQueueOptions `yaml:"inMemoryQueue,omitempty"`
 
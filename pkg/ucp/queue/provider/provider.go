@@ -19,6 +19,7 @@ package provider
 import (
 	"context"
 	"errors"
+	"slices"
 	"sync"
 
 	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
@@ -26,6 +27,9 @@ import (
 
 var (
 	ErrUnsupportedStorageProvider = errors.New("unsupported queue provider")
+
+	// ErrQueueNotFound is returned by GetClientForQueue when the queue is not one of the configured queues.
+	ErrQueueNotFound = errors.New("queue not found")
 )
 
 // QueueProvider is the provider to create and manage queue client.
@@ -34,6 +38,10 @@ type QueueProvider struct {
 
 	queueClient queue.Client
 	once        sync.Once
+
+	// namedClients caches the clients for the other queues created by GetClientForQueue.
+	namedClients map[string]queue.Client
+	namedMu      sync.Mutex
 }
 
 // New creates new QueueProvider instance.
@@ -60,6 +68,44 @@ func (p *QueueProvider) GetClient(ctx context.Context) (queue.Client, error) {
 	return p.queueClient, err
 }
 
+// GetClientForQueue creates or gets the client for the queue with the given name using the same provider options.
+// This is used to manage the queues of the other services, for example to inspect their dead-letter queues. Only the
+// queue of the provider and the queues in AdditionalNames can be used, otherwise ErrQueueNotFound is returned.
+func (p *QueueProvider) GetClientForQueue(ctx context.Context, name string) (queue.Client, error) {
+	if name == p.options.Name {
+		return p.GetClient(ctx)
+	}
+
+	if !slices.Contains(p.options.AdditionalNames, name) {
+		return nil, ErrQueueNotFound
+	}
+
+	p.namedMu.Lock()
+	defer p.namedMu.Unlock()
+
+	if cli, ok := p.namedClients[name]; ok {
+		return cli, nil
+	}
+
+	fn, ok := clientFactory[p.options.Provider]
+	if !ok {
+		return nil, ErrUnsupportedStorageProvider
+	}
+
+	opts := p.options
+	opts.Name = name
+	cli, err := fn(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.namedClients == nil {
+		p.namedClients = map[string]queue.Client{}
+	}
+	p.namedClients[name] = cli
+	return cli, nil
+}
+
 // SetClient sets the queue client for the QueueProvider. This should be used by tests that need to mock the queue client.
 func (p *QueueProvider) SetClient(client queue.Client) {
 	p.queueClient = client
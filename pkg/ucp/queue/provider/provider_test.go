@@ -47,3 +47,51 @@ func TestGetClient_InvalidQueue(t *testing.T) {
 	_, err := p.GetClient(context.TODO())
 	require.ErrorIs(t, ErrUnsupportedStorageProvider, err)
 }
+
+func TestGetClientForQueue(t *testing.T) {
+	p := New(QueueProviderOptions{
+		Name:            "Applications.Core",
+		AdditionalNames: []string{"other"},
+		Provider:        TypeInmemory,
+		InMemory:        &InMemoryQueueOptions{},
+	})
+
+	cli, err := p.GetClient(context.TODO())
+	require.NoError(t, err)
+
+	samecli, err := p.GetClientForQueue(context.TODO(), "Applications.Core")
+	require.NoError(t, err)
+	require.Equal(t, cli, samecli)
+
+	othercli, err := p.GetClientForQueue(context.TODO(), "other")
+	require.NoError(t, err)
+	require.NotSame(t, cli, othercli)
+
+	cached, err := p.GetClientForQueue(context.TODO(), "other")
+	require.NoError(t, err)
+	require.Same(t, othercli, cached)
+}
+
+func TestGetClientForQueue_InvalidQueue(t *testing.T) {
+	p := New(QueueProviderOptions{
+		Name:            "Applications.Core",
+		AdditionalNames: []string{"other"},
+		Provider:        QueueProviderType("undefined"),
+	})
+
+	_, err := p.GetClientForQueue(context.TODO(), "other")
+	require.ErrorIs(t, ErrUnsupportedStorageProvider, err)
+}
+
+func TestGetClientForQueue_UnknownQueue(t *testing.T) {
+	p := New(QueueProviderOptions{
+		Name:            "Applications.Core",
+		AdditionalNames: []string{"other"},
+		Provider:        TypeInmemory,
+		InMemory:        &InMemoryQueueOptions{},
+	})
+
+	_, err := p.GetClientForQueue(context.TODO(), "unknown")
+	require.ErrorIs(t, err, ErrQueueNotFound)
+	require.Empty(t, p.namedClients)
+}
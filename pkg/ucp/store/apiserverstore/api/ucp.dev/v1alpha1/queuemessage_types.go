@@ -37,6 +37,16 @@ type QueueMessageSpec struct {
 	// +kubebuilder:pruning:PreserveUnknownFields
 	// +kubebuilder:validation:PreserveUnknownFields
 	Data *runtime.RawExtension `json:"data"`
+
+	// DeadLetteredAt represents the time when the message was moved to the dead-letter queue.
+	// +optional
+	DeadLetteredAt *metav1.Time `json:"deadLetteredAt,omitempty"`
+	// LastError represents the last error that occurred while processing the dead-lettered message.
+	// +optional
+	LastError string `json:"lastError,omitempty"`
+	// RequeuedAt represents the time when the message was requeued from the dead-letter queue.
+	// +optional
+	RequeuedAt *metav1.Time `json:"requeuedAt,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -93,6 +93,14 @@ func (in *QueueMessageSpec) DeepCopyInto(out *QueueMessageSpec) {
 		*out = new(runtime.RawExtension)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DeadLetteredAt != nil {
+		in, out := &in.DeadLetteredAt, &out.DeadLetteredAt
+		*out = (*in).DeepCopy()
+	}
+	if in.RequeuedAt != nil {
+		in, out := &in.RequeuedAt, &out.RequeuedAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueMessageSpec.
@@ -117,13 +117,34 @@ func (c *APIServerClient) Query(ctx context.Context, query store.Query, options
 		return nil, err
 	}
 
+	cfg := store.NewQueryConfig(options...)
+
+	listOptions := []runtimeclient.ListOption{
+		runtimeclient.InNamespace(c.namespace),
+		runtimeclient.MatchingLabelsSelector{Selector: selector},
+	}
+
+	// Paging is done by the API Server using its continue token. The page size applies to the Kubernetes
+	// objects and not the entries they contain, so a page may contain fewer (or more) items than requested
+	// after client-side filtering.
+	if cfg.MaxQueryItemCount > 0 {
+		listOptions = append(listOptions, runtimeclient.Limit(int64(cfg.MaxQueryItemCount)))
+	}
+	if cfg.PaginationToken != "" {
+		listOptions = append(listOptions, runtimeclient.Continue(cfg.PaginationToken))
+	}
+
 	rs := ucpv1alpha1.ResourceList{}
-	err = c.client.List(ctx, &rs, runtimeclient.InNamespace(c.namespace), runtimeclient.MatchingLabelsSelector{Selector: selector})
-	if err != nil {
+	err = c.client.List(ctx, &rs, listOptions...)
+	if apierrors.IsResourceExpired(err) || (apierrors.IsBadRequest(err) && cfg.PaginationToken != "") {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'PaginationToken' is not a valid pagination token"}
+	} else if err != nil {
 		return nil, err
 	}
 
-	results := store.ObjectQueryResult{}
+	results := store.ObjectQueryResult{
+		PaginationToken: rs.Continue,
+	}
 	for _, resource := range rs.Items {
 		for _, entry := range resource.Entries {
 			id, err := resources.Parse(entry.ID)
@@ -141,6 +162,8 @@ func (c *APIServerClient) Query(ctx context.Context, query store.Query, options
 					return nil, err
 				}
 
+				// Labels only describe the scopes and type of a resource, so filters on the resource data
+				// are evaluated client-side.
 				match, err := converted.MatchesFilters(query.Filters)
 				if err != nil {
 					return nil, err
@@ -130,6 +130,7 @@ func Test_APIServer_Client(t *testing.T) {
 
 	// The actual test logic lives in a shared package, we're just doing the setup here.
 	shared.RunTest(t, client, clear)
+	shared.RunWatchTest(t, client, clear)
 
 	// The APIServer implementation is complex enough that we have some of our tests in addition
 	// to the standard suite.
@@ -857,3 +858,22 @@ func Test_CreateLabelSelector_ScopeQuery(t *testing.T) {
 	set = assignLabels(&resource)
 	require.True(t, selector.Matches(set))
 }
+
+func Test_DiffEntries(t *testing.T) {
+	obj1 := store.Object{Metadata: store.Metadata{ID: "/planes/radius/local/resourceGroups/g/providers/A.B/c/one", ETag: "1"}}
+	obj2 := store.Object{Metadata: store.Metadata{ID: "/planes/radius/local/resourceGroups/g/providers/A.B/c/two", ETag: "1"}}
+	obj2Updated := store.Object{Metadata: store.Metadata{ID: obj2.ID, ETag: "2"}}
+	obj3 := store.Object{Metadata: store.Metadata{ID: "/planes/radius/local/resourceGroups/g/providers/A.B/c/three", ETag: "1"}}
+
+	previous := map[string]store.Object{"one": obj1, "two": obj2}
+	current := map[string]store.Object{"two": obj2Updated, "three": obj3}
+
+	events := diffEntries(previous, current)
+	require.ElementsMatch(t, []store.WatchEvent{
+		{Type: store.WatchEventDeleted, Object: obj1},
+		{Type: store.WatchEventUpdated, Object: obj2Updated},
+		{Type: store.WatchEventCreated, Object: obj3},
+	}, events)
+
+	require.Empty(t, diffEntries(current, current))
+}
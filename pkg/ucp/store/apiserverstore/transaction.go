@@ -0,0 +1,69 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserverstore
+
+import (
+	"context"
+	"errors"
+
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+// Transaction applies the operations on a best-effort basis.
+//
+// The Kubernetes API Server has no support for transactions across objects, so this is NOT atomic. All preconditions are
+// checked before any changes are made, which covers the common failure cases. Then the operations are applied in order
+// with their preconditions. If an operation fails after that, for example because of a concurrent write, the earlier
+// operations are not rolled back.
+func (c *APIServerClient) Transaction(ctx context.Context, operations []store.TransactionOperation) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if _, err := store.ValidateTransaction(operations); err != nil {
+		return err
+	}
+
+	for _, op := range operations {
+		existing, err := c.Get(ctx, op.GetID())
+		if errors.Is(err, &store.ErrNotFound{}) {
+			if op.ETag != "" {
+				return &store.ErrConcurrency{}
+			} else if op.Type == store.TransactionOperationDelete {
+				return &store.ErrNotFound{ID: op.ID}
+			}
+		} else if err != nil {
+			return err
+		} else if op.ETag != "" && op.ETag != existing.ETag {
+			return &store.ErrConcurrency{}
+		}
+	}
+
+	for _, op := range operations {
+		var err error
+		switch op.Type {
+		case store.TransactionOperationSave:
+			err = c.Save(ctx, op.Object, store.WithETag(op.ETag))
+		case store.TransactionOperationDelete:
+			err = c.Delete(ctx, op.ID, store.WithETag(op.ETag))
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserverstore
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	ucpv1alpha1 "github.com/radius-project/radius/pkg/ucp/store/apiserverstore/api/ucp.dev/v1alpha1"
+	"github.com/radius-project/radius/pkg/ucp/store/storeutil"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Watch streams changes to the objects matching the query.
+//
+// This works like a Kubernetes informer: we list the matching Kubernetes objects to build a cache of their entries and
+// then watch from the resource version of the list. Since each Kubernetes object can hold multiple UCP resources, each
+// change is compared with the cache to determine which entries were created, updated or deleted.
+func (c *APIServerClient) Watch(ctx context.Context, query store.Query) (<-chan store.WatchEvent, error) {
+	if ctx == nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if err := store.ValidateWatchQuery(query); err != nil {
+		return nil, err
+	}
+
+	wc, ok := c.client.(runtimeclient.WithWatch)
+	if !ok {
+		return nil, errors.New("the Kubernetes client does not support watch")
+	}
+
+	selector, err := createLabelSelector(query)
+	if err != nil {
+		return nil, err
+	}
+
+	rs := ucpv1alpha1.ResourceList{}
+	err = wc.List(ctx, &rs, runtimeclient.InNamespace(c.namespace), runtimeclient.MatchingLabelsSelector{Selector: selector})
+	if err != nil {
+		return nil, err
+	}
+
+	cache := map[string]map[string]store.Object{}
+	for i := range rs.Items {
+		entries, err := matchingEntries(ctx, &rs.Items[i], query)
+		if err != nil {
+			return nil, err
+		}
+
+		cache[rs.Items[i].Name] = entries
+	}
+
+	watcher, err := wc.Watch(ctx, &ucpv1alpha1.ResourceList{},
+		runtimeclient.InNamespace(c.namespace),
+		runtimeclient.MatchingLabelsSelector{Selector: selector},
+		&runtimeclient.ListOptions{Raw: &v1.ListOptions{ResourceVersion: rs.ResourceVersion}})
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan store.WatchEvent)
+	go func() {
+		defer close(ch)
+		defer watcher.Stop()
+
+		logger := ucplog.FromContextOrDiscard(ctx)
+		for {
+			var event watch.Event
+			select {
+			case <-ctx.Done():
+				return
+			case e, ok := <-watcher.ResultChan():
+				if !ok {
+					return
+				}
+				event = e
+			}
+
+			resource, ok := event.Object.(*ucpv1alpha1.Resource)
+			if !ok {
+				// Errors are reported as a Status object. The watch can't continue after an error.
+				if event.Type == watch.Error {
+					logger.Info("watch of Kubernetes resources failed", "status", event.Object)
+					return
+				}
+
+				continue
+			}
+
+			previous := cache[resource.Name]
+			current := map[string]store.Object{}
+			switch event.Type {
+			case watch.Added, watch.Modified:
+				entries, err := matchingEntries(ctx, resource, query)
+				if err != nil {
+					logger.Error(err, "failed to read resource entries", "name", resource.Name, "namespace", resource.Namespace)
+					return
+				}
+				current = entries
+				cache[resource.Name] = current
+			case watch.Deleted:
+				delete(cache, resource.Name)
+			default:
+				continue
+			}
+
+			for _, watchEvent := range diffEntries(previous, current) {
+				match, err := watchEvent.Object.MatchesFilters(query.Filters)
+				if err != nil {
+					logger.Error(err, "failed to match filters")
+					return
+				} else if !match {
+					continue
+				}
+
+				select {
+				case ch <- watchEvent:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// matchingEntries returns the entries of a Kubernetes object that match the query, keyed by their normalized ID. Filters
+// are not evaluated here so that changes to the filtered fields are reported correctly.
+func matchingEntries(ctx context.Context, resource *ucpv1alpha1.Resource, query store.Query) (map[string]store.Object, error) {
+	entries := map[string]store.Object{}
+	for _, entry := range resource.Entries {
+		id, err := resources.Parse(entry.ID)
+		if err != nil {
+			// Ignore invalid IDs when watching, we don't want a single piece of bad data to break the watch.
+			logger := ucplog.FromContextOrDiscard(ctx)
+			logger.Error(err, "found an invalid resource id as part of a watch", "name", resource.Name, "namespace", resource.Namespace)
+			continue
+		}
+
+		if !storeutil.IDMatchesQuery(id, query) {
+			continue
+		}
+
+		obj, err := readEntry(&entry)
+		if err != nil {
+			return nil, err
+		}
+
+		entries[strings.ToLower(entry.ID)] = *obj
+	}
+
+	return entries, nil
+}
+
+// diffEntries compares the entries of a Kubernetes object before and after a change and returns the corresponding
+// watch events. Deleted entries are reported with their previous value.
+func diffEntries(previous map[string]store.Object, current map[string]store.Object) []store.WatchEvent {
+	events := []store.WatchEvent{}
+	for key, obj := range current {
+		old, ok := previous[key]
+		if !ok {
+			events = append(events, store.WatchEvent{Type: store.WatchEventCreated, Object: obj})
+		} else if old.ETag != obj.ETag {
+			events = append(events, store.WatchEvent{Type: store.WatchEventUpdated, Object: obj})
+		}
+	}
+
+	for key, obj := range previous {
+		if _, ok := current[key]; !ok {
+			events = append(events, store.WatchEvent{Type: store.WatchEventDeleted, Object: obj})
+		}
+	}
+
+	return events
+}
@@ -27,6 +27,19 @@ type StorageClient interface {
 	Get(ctx context.Context, id string, options ...GetOptions) (*Object, error)
 	Delete(ctx context.Context, id string, options ...DeleteOptions) error
 	Save(ctx context.Context, obj *Object, options ...SaveOptions) error
+
+	// Watch streams the changes to objects matching the query. Only changes made after Watch returns are reported.
+	//
+	// The returned channel is closed when the context is cancelled or when the watch can no longer continue, for
+	// example if the connection to the data-store is lost. Callers should call Watch again to resume watching.
+	//
+	// Data-stores that can't report creates, updates and deletes return ErrNotSupported.
+	Watch(ctx context.Context, query Query) (<-chan WatchEvent, error)
+
+	// Transaction applies the operations atomically: either all of the operations are applied or none of them are.
+	// If the ETag precondition of any operation does not match then ErrConcurrency is returned. On success the ETag
+	// of each saved object is updated.
+	Transaction(ctx context.Context, operations []TransactionOperation) error
 }
 
 // Query specifies the structure of a query. RootScope is required and other fields are optional.
@@ -77,8 +90,60 @@ type Query struct {
 	Filters []QueryFilter
 }
 
+// FilterOperator is the comparison operator used by a QueryFilter. String comparisons are case-sensitive for every
+// operator and every store implementation.
+type FilterOperator string
+
+const (
+	// FilterOperatorEquals matches when the field is equal to Value. Values of different types are never equal.
+	// This is the default when no operator is specified.
+	FilterOperatorEquals FilterOperator = "eq"
+
+	// FilterOperatorNotEquals matches when the field is not equal to Value, including when the field is not present.
+	FilterOperatorNotEquals FilterOperator = "ne"
+
+	// FilterOperatorIn matches when the field is equal to any of Values.
+	FilterOperatorIn FilterOperator = "in"
+
+	// FilterOperatorStartsWith matches when the field is a string that starts with Value. Value must be a string.
+	FilterOperatorStartsWith FilterOperator = "startsWith"
+
+	// FilterOperatorExists matches when the field is present and not null. Value is ignored.
+	FilterOperatorExists FilterOperator = "exists"
+
+	// FilterOperatorGreaterThan matches when the field is a number greater than Value. Value must be a number.
+	FilterOperatorGreaterThan FilterOperator = "gt"
+
+	// FilterOperatorGreaterThanOrEquals matches when the field is a number greater than or equal to Value. Value must be a number.
+	FilterOperatorGreaterThanOrEquals FilterOperator = "ge"
+
+	// FilterOperatorLessThan matches when the field is a number less than Value. Value must be a number.
+	FilterOperatorLessThan FilterOperator = "lt"
+
+	// FilterOperatorLessThanOrEquals matches when the field is a number less than or equal to Value. Value must be a number.
+	FilterOperatorLessThanOrEquals FilterOperator = "le"
+
+	// FilterOperatorContains matches when the field is an array that contains an element equal to Value.
+	FilterOperatorContains FilterOperator = "contains"
+)
+
 // QueryFilter is the filter which filters property in resource entity.
+//
+// Example:
+//
+//	QueryFilter{Field: "properties.application", Value: "/planes/radius/local/..."}
+//	QueryFilter{Field: "properties.replicas", Operator: FilterOperatorGreaterThan, Value: 3}
+//	QueryFilter{Field: "properties.status", Operator: FilterOperatorIn, Values: []any{"Succeeded", "Failed"}}
 type QueryFilter struct {
+	// Field is the dotted path of the property to filter on.
 	Field string
-	Value string
+
+	// Operator is the comparison operator. Defaults to FilterOperatorEquals if empty.
+	Operator FilterOperator
+
+	// Value is the value to compare against. Value can be a string, bool, or number.
+	Value any
+
+	// Values is the list of values to compare against when Operator is FilterOperatorIn.
+	Values []any
 }
@@ -93,8 +93,8 @@ func NewCosmosDBStorageClient(options *ConnectionOptions) (*CosmosDBStorageClien
 	}, nil
 }
 
-// Init checks if the database and collection exist, and if not, creates them. It returns an error if
-// either of the checks or creations fail.
+// Init checks if the database, collection and the stored procedure used for transactions exist, and if not, creates
+// them. It returns an error if any of the checks or creations fail.
 func (c *CosmosDBStorageClient) Init(ctx context.Context) error {
 	if err := c.createDatabaseIfNotExists(ctx); err != nil {
 		return err
@@ -102,6 +102,9 @@ func (c *CosmosDBStorageClient) Init(ctx context.Context) error {
 	if err := c.createCollectionIfNotExists(ctx); err != nil {
 		return err
 	}
+	if err := c.createTransactionSprocIfNotExists(ctx); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -212,15 +215,22 @@ func constructCosmosDBQuery(query store.Query) (*cosmosapi.Query, error) {
 	}
 
 	for i, filter := range query.Filters {
+		if err := filter.Validate(); err != nil {
+			return nil, err
+		}
+
 		if whereParam != "" {
 			whereParam += " and "
 		}
-		filterParam := fmt.Sprintf("filter%d", i)
-		whereParam += fmt.Sprintf("STRINGEQUALS(c.entity.%s, @%s, true)", filter.Field, filterParam)
-		queryParams = append(queryParams, cosmosapi.QueryParam{
-			Name:  "@" + filterParam,
-			Value: filter.Value,
-		})
+		filterParam := fmt.Sprintf("@filter%d", i)
+		condition, value := constructCosmosDBFilter(filter, "c.entity."+filter.Field, filterParam)
+		whereParam += condition
+		if value != nil {
+			queryParams = append(queryParams, cosmosapi.QueryParam{
+				Name:  filterParam,
+				Value: value,
+			})
+		}
 	}
 
 	if whereParam == "" {
@@ -230,6 +240,37 @@ func constructCosmosDBQuery(query store.Query) (*cosmosapi.Query, error) {
 	return &cosmosapi.Query{Query: queryString + whereParam, Params: queryParams}, nil
 }
 
+// constructCosmosDBFilter returns the condition for a single filter and the value of its parameter. The returned value
+// is nil when the filter has no parameter.
+func constructCosmosDBFilter(filter store.QueryFilter, field string, param string) (string, any) {
+	switch filter.GetOperator() {
+	case store.FilterOperatorNotEquals:
+		return fmt.Sprintf("(NOT IS_DEFINED(%[1]s) OR IS_NULL(%[1]s) OR %[1]s != %[2]s)", field, param), filter.Value
+	case store.FilterOperatorIn:
+		values := filter.Values
+		if values == nil {
+			values = []any{}
+		}
+		return fmt.Sprintf("ARRAY_CONTAINS(%s, %s)", param, field), values
+	case store.FilterOperatorStartsWith:
+		return fmt.Sprintf("STARTSWITH(%s, %s, false)", field, param), filter.Value
+	case store.FilterOperatorExists:
+		return fmt.Sprintf("(IS_DEFINED(%[1]s) AND NOT IS_NULL(%[1]s))", field), nil
+	case store.FilterOperatorGreaterThan:
+		return fmt.Sprintf("(IS_NUMBER(%[1]s) AND %[1]s > %[2]s)", field, param), filter.Value
+	case store.FilterOperatorGreaterThanOrEquals:
+		return fmt.Sprintf("(IS_NUMBER(%[1]s) AND %[1]s >= %[2]s)", field, param), filter.Value
+	case store.FilterOperatorLessThan:
+		return fmt.Sprintf("(IS_NUMBER(%[1]s) AND %[1]s < %[2]s)", field, param), filter.Value
+	case store.FilterOperatorLessThanOrEquals:
+		return fmt.Sprintf("(IS_NUMBER(%[1]s) AND %[1]s <= %[2]s)", field, param), filter.Value
+	case store.FilterOperatorContains:
+		return fmt.Sprintf("ARRAY_CONTAINS(%s, %s)", field, param), filter.Value
+	default:
+		return fmt.Sprintf("%s = %s", field, param), filter.Value
+	}
+}
+
 // Query builds and executes a CosmosDB query based on the provided store.Query and returns the results.
 func (c *CosmosDBStorageClient) Query(ctx context.Context, query store.Query, opts ...store.QueryOptions) (*store.ObjectQueryResult, error) {
 	if ctx == nil {
@@ -444,6 +485,12 @@ func (c *CosmosDBStorageClient) Save(ctx context.Context, obj *store.Object, opt
 	return nil
 }
 
+// Watch is not supported by CosmosDB and returns store.ErrNotSupported. The change feed can't distinguish creates from
+// updates and does not report deletes, so it can't provide the events that Watch requires.
+func (c *CosmosDBStorageClient) Watch(ctx context.Context, query store.Query) (<-chan store.WatchEvent, error) {
+	return nil, &store.ErrNotSupported{Message: "watch is not supported by the CosmosDB storage client"}
+}
+
 // GetPartitionKey returns a partition key based on the given ID, normalizing the subscription ID and normalizing the
 // plane namespace if the ID is UCP-qualified.
 // Examples:
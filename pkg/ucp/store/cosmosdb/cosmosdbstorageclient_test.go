@@ -211,7 +211,7 @@ func TestConstructCosmosDBQuery(t *testing.T) {
 					},
 				},
 			},
-			queryString: "SELECT * FROM c WHERE c.rootScope = @rootScope and STRINGEQUALS(c.entity.type, @rtype, true) and STRINGEQUALS(c.entity.properties.environment, @filter0, true) and STRINGEQUALS(c.entity.properties.application, @filter1, true)",
+			queryString: "SELECT * FROM c WHERE c.rootScope = @rootScope and STRINGEQUALS(c.entity.type, @rtype, true) and c.entity.properties.environment = @filter0 and c.entity.properties.application = @filter1",
 			params: []cosmosapi.QueryParam{{
 				Name:  "@rootScope",
 				Value: "/subscriptions/00000000-0000-0000-1000-000000000001/resourcegroups/testgroup",
@@ -227,6 +227,51 @@ func TestConstructCosmosDBQuery(t *testing.T) {
 			}},
 			err: nil,
 		},
+		{
+			desc: "filter-operators",
+			storeQuery: store.Query{
+				RootScope:    "/subscriptions/00000000-0000-0000-1000-000000000001/resourcegroups/testgroup",
+				ResourceType: "applications.core/environments",
+				Filters: []store.QueryFilter{
+					{Field: "properties.enabled", Value: true},
+					{Field: "properties.status", Operator: store.FilterOperatorNotEquals, Value: "Failed"},
+					{Field: "properties.kind", Operator: store.FilterOperatorIn, Values: []any{"a", "b"}},
+					{Field: "name", Operator: store.FilterOperatorStartsWith, Value: "env"},
+					{Field: "properties.compute", Operator: store.FilterOperatorExists},
+					{Field: "properties.replicas", Operator: store.FilterOperatorGreaterThanOrEquals, Value: 3},
+					{Field: "properties.tags", Operator: store.FilterOperatorContains, Value: "prod"},
+				},
+			},
+			queryString: "SELECT * FROM c WHERE c.rootScope = @rootScope and STRINGEQUALS(c.entity.type, @rtype, true) and " +
+				"c.entity.properties.enabled = @filter0 and " +
+				"(NOT IS_DEFINED(c.entity.properties.status) OR IS_NULL(c.entity.properties.status) OR c.entity.properties.status != @filter1) and " +
+				"ARRAY_CONTAINS(@filter2, c.entity.properties.kind) and " +
+				"STARTSWITH(c.entity.name, @filter3, false) and " +
+				"(IS_DEFINED(c.entity.properties.compute) AND NOT IS_NULL(c.entity.properties.compute)) and " +
+				"(IS_NUMBER(c.entity.properties.replicas) AND c.entity.properties.replicas >= @filter5) and " +
+				"ARRAY_CONTAINS(c.entity.properties.tags, @filter6)",
+			params: []cosmosapi.QueryParam{
+				{Name: "@rootScope", Value: "/subscriptions/00000000-0000-0000-1000-000000000001/resourcegroups/testgroup"},
+				{Name: "@rtype", Value: "applications.core/environments"},
+				{Name: "@filter0", Value: true},
+				{Name: "@filter1", Value: "Failed"},
+				{Name: "@filter2", Value: []any{"a", "b"}},
+				{Name: "@filter3", Value: "env"},
+				{Name: "@filter5", Value: 3},
+				{Name: "@filter6", Value: "prod"},
+			},
+			err: nil,
+		},
+		{
+			desc: "filter-invalid-operator",
+			storeQuery: store.Query{
+				RootScope: "/subscriptions/00000000-0000-0000-1000-000000000001/resourcegroups/testgroup",
+				Filters: []store.QueryFilter{
+					{Field: "properties.enabled", Operator: "unknown", Value: true},
+				},
+			},
+			err: &store.ErrInvalid{Message: "invalid argument. filter operator 'unknown' is not supported"},
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -259,6 +304,14 @@ func TestDeleteNotFound(t *testing.T) {
 	require.ErrorIs(t, &store.ErrNotFound{ID: resourceID}, err)
 }
 
+func TestWatchNotSupported(t *testing.T) {
+	client := &CosmosDBStorageClient{}
+
+	ch, err := client.Watch(context.Background(), store.Query{RootScope: "/subscriptions/00000000-0000-0000-1000-000000000001"})
+	require.ErrorIs(t, err, &store.ErrNotSupported{})
+	require.Nil(t, ch)
+}
+
 func TestSave(t *testing.T) {
 	ctx := context.Background()
 	client := mustGetTestClient(t)
@@ -785,3 +838,54 @@ func buildAndSaveTestModel(ctx context.Context, t *testing.T, rootScope string,
 	require.NoError(t, err)
 	return model
 }
+
+func TestBuildTransactionOperations(t *testing.T) {
+	obj := &store.Object{
+		Metadata: store.Metadata{ID: "/planes/radius/local/resourceGroups/group1/providers/Applications.Core/containers/c1"},
+		Data:     map[string]any{"name": "c1"},
+	}
+	operations := []store.TransactionOperation{
+		store.TransactionSave(obj, store.WithETag("etag1")),
+		store.TransactionDelete("/planes/radius/local/providers/applications.core/locations/global/operationstatuses/op1"),
+	}
+
+	ids, err := store.ValidateTransaction(operations)
+	require.NoError(t, err)
+
+	ops, partitionKey, err := buildTransactionOperations(operations, ids)
+	require.NoError(t, err)
+	require.Equal(t, "RADIUSLOCAL", partitionKey)
+	require.Len(t, ops, 2)
+
+	require.Equal(t, store.TransactionOperationSave, ops[0].Type)
+	require.Equal(t, "etag1", ops[0].ETag)
+	require.Equal(t, ops[0].ID, ops[0].Document.ID)
+	require.Equal(t, strings.ToLower(obj.ID), ops[0].Document.ResourceID)
+	require.Equal(t, obj.Data, ops[0].Document.Entity)
+
+	require.Equal(t, store.TransactionOperationDelete, ops[1].Type)
+	require.Nil(t, ops[1].Document)
+
+	t.Run("different partitions", func(t *testing.T) {
+		operations := []store.TransactionOperation{
+			store.TransactionDelete("/planes/radius/local/resourceGroups/group1/providers/Applications.Core/containers/c1"),
+			store.TransactionDelete("/subscriptions/" + randomSubscriptionIDs[0] + "/resourceGroups/group1/providers/Applications.Core/containers/c1"),
+		}
+		ids, err := store.ValidateTransaction(operations)
+		require.NoError(t, err)
+
+		_, _, err = buildTransactionOperations(operations, ids)
+		require.ErrorIs(t, err, &store.ErrInvalid{})
+	})
+}
+
+func TestNotFoundID(t *testing.T) {
+	operations := []store.TransactionOperation{
+		store.TransactionDelete("/planes/radius/local/resourceGroups/group1/providers/Applications.Core/containers/c1"),
+		store.TransactionDelete("/planes/radius/local/resourceGroups/group1/providers/Applications.Core/containers/c2"),
+	}
+
+	require.Equal(t, operations[1].ID, notFoundID("Error: "+errTransactionNotFoundMsg+":1\nStack trace", operations))
+	require.Equal(t, "", notFoundID("Error: "+errTransactionNotFoundMsg+":5", operations))
+	require.Equal(t, "", notFoundID("unexpected", operations))
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cosmosdb
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/vippsas/go-cosmosdb/cosmosapi"
+)
+
+const (
+	// transactionSprocName is the name of the stored procedure used to execute transactions.
+	transactionSprocName = "radiusTransaction"
+
+	// errTransactionConcurrencyMsg and errTransactionNotFoundMsg are thrown by the stored procedure when a
+	// precondition fails. Throwing an error aborts the stored procedure and rolls back all of its writes.
+	errTransactionConcurrencyMsg = "RADIUS_TRANSACTION_CONCURRENCY"
+	errTransactionNotFoundMsg    = "RADIUS_TRANSACTION_NOT_FOUND"
+)
+
+// transactionSprocBody is the body of the stored procedure used to execute transactions. The writes of a stored
+// procedure are committed atomically, and it runs within a single partition just like a transactional batch.
+// go-cosmosdb doesn't support the transactional batch API so we use a stored procedure instead.
+//
+// The stored procedure returns the ETag of each operation, which is empty for deletes.
+const transactionSprocBody = `function radiusTransaction(operations) {
+	var collection = getContext().getCollection();
+	var link = collection.getAltLink();
+	var etags = [];
+
+	function next(i) {
+		if (i >= operations.length) {
+			getContext().getResponse().setBody(etags);
+			return;
+		}
+
+		var op = operations[i];
+		var accepted = collection.readDocument(link + "/docs/" + op.id, {}, function (err, existing) {
+			if (err && err.number !== 404) {
+				throw err;
+			}
+			if (err) {
+				existing = null;
+			}
+			if (op.etag && (!existing || existing._etag !== op.etag)) {
+				throw new Error("` + errTransactionConcurrencyMsg + `");
+			}
+
+			if (op.type === "Delete") {
+				if (!existing) {
+					throw new Error("` + errTransactionNotFoundMsg + `:" + i);
+				}
+				collection.deleteDocument(existing._self, {}, function (err) {
+					if (err) {
+						throw err;
+					}
+					etags.push("");
+					next(i + 1);
+				});
+			} else {
+				collection.upsertDocument(link, op.document, {}, function (err, doc) {
+					if (err) {
+						throw err;
+					}
+					etags.push(doc._etag);
+					next(i + 1);
+				});
+			}
+		});
+
+		if (!accepted) {
+			throw new Error("the transaction was not accepted by the server");
+		}
+	}
+
+	next(0);
+}`
+
+// transactionOperation is an operation passed to the transaction stored procedure.
+type transactionOperation struct {
+	Type     store.TransactionOperationType `json:"type"`
+	ID       string                         `json:"id"`
+	ETag     string                         `json:"etag,omitempty"`
+	Document *ResourceEntity                `json:"document,omitempty"`
+}
+
+func (c *CosmosDBStorageClient) createTransactionSprocIfNotExists(ctx context.Context) error {
+	_, err := c.client.GetStoredProcedure(ctx, c.options.DatabaseName, c.options.CollectionName, transactionSprocName)
+	if err == nil {
+		// Replace the stored procedure in case it was created by an older version.
+		_, err = c.client.ReplaceStoredProcedure(ctx, c.options.DatabaseName, c.options.CollectionName, transactionSprocName, transactionSprocBody)
+		return err
+	}
+	if !strings.EqualFold(err.Error(), errResourceNotFoundMsg) {
+		return err
+	}
+
+	_, err = c.client.CreateStoredProcedure(ctx, c.options.DatabaseName, c.options.CollectionName, transactionSprocName, transactionSprocBody)
+	if err != nil && strings.EqualFold(err.Error(), errIDConflictMsg) {
+		return nil
+	}
+
+	return err
+}
+
+// Transaction applies the operations atomically using a stored procedure. All of the objects must be in the same
+// partition.
+func (c *CosmosDBStorageClient) Transaction(ctx context.Context, operations []store.TransactionOperation) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	ids, err := store.ValidateTransaction(operations)
+	if err != nil {
+		return err
+	}
+
+	ops, partitionKey, err := buildTransactionOperations(operations, ids)
+	if err != nil {
+		return err
+	}
+
+	etags := []string{}
+	opts := cosmosapi.ExecuteStoredProcedureOptions{PartitionKeyValue: partitionKey}
+	err = c.client.ExecuteStoredProcedure(ctx, c.options.DatabaseName, c.options.CollectionName, transactionSprocName, opts, &etags, ops)
+
+	// TODO: use the response code when switching to official SDK.
+	if err != nil && strings.Contains(err.Error(), errTransactionConcurrencyMsg) {
+		return &store.ErrConcurrency{}
+	} else if err != nil && strings.Contains(err.Error(), errTransactionNotFoundMsg) {
+		return &store.ErrNotFound{ID: notFoundID(err.Error(), operations)}
+	} else if err != nil {
+		return err
+	}
+
+	for i, op := range operations {
+		if op.Type == store.TransactionOperationSave && i < len(etags) {
+			op.Object.ETag = etags[i]
+		}
+	}
+
+	return nil
+}
+
+// buildTransactionOperations converts the operations to the format used by the stored procedure and returns the
+// partition key shared by all of the operations.
+func buildTransactionOperations(operations []store.TransactionOperation, ids []resources.ID) ([]transactionOperation, string, error) {
+	ops := []transactionOperation{}
+	partitionKey := ""
+	for i, op := range operations {
+		docID, err := GenerateCosmosDBKey(ids[i])
+		if err != nil {
+			return nil, "", err
+		}
+
+		pk, err := GetPartitionKey(ids[i])
+		if err != nil {
+			return nil, "", err
+		}
+
+		if i == 0 {
+			partitionKey = pk
+		} else if pk != partitionKey {
+			return nil, "", &store.ErrInvalid{Message: "invalid argument. all operations in a transaction must be in the same partition"}
+		}
+
+		converted := transactionOperation{Type: op.Type, ID: docID, ETag: op.ETag}
+		if op.Type == store.TransactionOperationSave {
+			converted.Document = &ResourceEntity{
+				ID:           docID,
+				ResourceID:   strings.ToLower(ids[i].String()),
+				RootScope:    strings.ToLower(ids[i].RootScope()),
+				PartitionKey: pk,
+				Entity:       op.Object.Data,
+			}
+		}
+
+		ops = append(ops, converted)
+	}
+
+	return ops, partitionKey, nil
+}
+
+// notFoundID returns the id of the deleted object that was not found, using the index reported by the stored procedure.
+func notFoundID(message string, operations []store.TransactionOperation) string {
+	_, after, _ := strings.Cut(message, errTransactionNotFoundMsg+":")
+	end := strings.IndexFunc(after, func(r rune) bool { return r < '0' || r > '9' })
+	if end >= 0 {
+		after = after[:end]
+	}
+
+	i, err := strconv.Atoi(after)
+	if err != nil || i < 0 || i >= len(operations) {
+		return ""
+	}
+
+	return operations[i].ID
+}
@@ -74,3 +74,21 @@ func (e *ErrConcurrency) Is(target error) bool {
 	_, ok := target.(*ErrConcurrency)
 	return ok
 }
+
+var _ error = (*ErrNotSupported)(nil)
+
+// ErrNotSupported is returned when a data-store does not support an operation.
+type ErrNotSupported struct {
+	Message string
+}
+
+// Error returns the error message for ErrNotSupported error.
+func (e *ErrNotSupported) Error() string {
+	return e.Message
+}
+
+// Is checks if the target error is an instance of ErrNotSupported.
+func (e *ErrNotSupported) Is(target error) bool {
+	_, ok := target.(*ErrNotSupported)
+	return ok
+}
@@ -85,26 +85,52 @@ func (c *ETCDClient) Query(ctx context.Context, query store.Query, options ...st
 		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RoutingScopePrefix' is not supported for scope queries"}
 	}
 
+	cfg := store.NewQueryConfig(options...)
+
 	key := keyFromQuery(query)
+	rangeEnd := etcdclient.GetPrefixRangeEnd(key)
 
-	// TODO: We don't place a limit/top value on the query right now so we get all
-	// results as a single page. This would be a nice future improvement
-	//
-	// https://stackoverflow.com/questions/44873514/etcd3-go-client-how-to-paginate-large-sets-of-keys
-	response, err := c.client.Get(ctx, key, etcdclient.WithPrefix())
-	if err != nil {
-		return nil, err
+	// The pagination token is the key of the last item returned in the previous page. Since
+	// keys are returned in sorted order we can resume from the next possible key.
+	start := key
+	if cfg.PaginationToken != "" {
+		last, err := storeutil.DecodePaginationToken(cfg.PaginationToken)
+		if err != nil {
+			return nil, err
+		}
+		if !strings.HasPrefix(last, key) {
+			return nil, &store.ErrInvalid{Message: "invalid argument. 'PaginationToken' does not match the query"}
+		}
+
+		start = last + "\x00"
 	}
 
 	results := store.ObjectQueryResult{}
-	for _, kv := range response.Kvs {
-		if keyMatchesQuery(kv.Key, query) {
+	for {
+		// When there's no page size we don't place a limit on the query and get all results as a
+		// single page. Otherwise we need to fetch in batches since some keys will be filtered out client-side.
+		opts := []etcdclient.OpOption{etcdclient.WithRange(rangeEnd)}
+		if cfg.MaxQueryItemCount > 0 {
+			opts = append(opts, etcdclient.WithLimit(int64(cfg.MaxQueryItemCount)))
+		}
+
+		response, err := c.client.Get(ctx, start, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, kv := range response.Kvs {
+			if !keyMatchesQuery(kv.Key, query) {
+				continue
+			}
+
 			value := store.Object{}
 			err = json.Unmarshal(kv.Value, &value)
 			if err != nil {
 				return nil, err
 			}
 
+			// Filters apply to the resource data which is not part of the key, so they are always evaluated client-side.
 			match, err := value.MatchesFilters(query.Filters)
 			if err != nil {
 				return nil, err
@@ -114,10 +140,22 @@ func (c *ETCDClient) Query(ctx context.Context, query store.Query, options ...st
 
 			value.ETag = etag.NewFromRevision(kv.ModRevision)
 			results.Items = append(results.Items, value)
+
+			if cfg.MaxQueryItemCount > 0 && len(results.Items) == cfg.MaxQueryItemCount {
+				if i < len(response.Kvs)-1 || response.More {
+					results.PaginationToken = storeutil.EncodePaginationToken(string(kv.Key))
+				}
+
+				return &results, nil
+			}
+		}
+
+		if !response.More || len(response.Kvs) == 0 {
+			return &results, nil
 		}
-	}
 
-	return &results, nil
+		start = string(response.Kvs[len(response.Kvs)-1].Key) + "\x00"
+	}
 }
 
 // Get checks if the provided context, id and options are valid, then retrieves the corresponding object from
@@ -279,6 +317,176 @@ func (c *ETCDClient) Save(ctx context.Context, obj *store.Object, options ...sto
 	return nil
 }
 
+// Transaction applies the operations atomically using an etcd transaction. The ETag preconditions and the existence
+// of deleted objects are checked as part of the transaction.
+func (c *ETCDClient) Transaction(ctx context.Context, operations []store.TransactionOperation) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	ids, err := store.ValidateTransaction(operations)
+	if err != nil {
+		return err
+	}
+
+	conditions := []etcdclient.Cmp{}
+	ops := []etcdclient.Op{}
+	for i, op := range operations {
+		key := keyFromID(ids[i])
+
+		if op.ETag != "" {
+			revision, err := etag.ParseRevision(op.ETag)
+			if err != nil {
+				// Treat an invalid ETag as a concurrency failure, since it will never match.
+				return &store.ErrConcurrency{}
+			}
+			conditions = append(conditions, etcdclient.Compare(etcdclient.ModRevision(key), "=", revision))
+		}
+
+		switch op.Type {
+		case store.TransactionOperationSave:
+			b, err := json.Marshal(op.Object)
+			if err != nil {
+				return err
+			}
+			ops = append(ops, etcdclient.OpPut(key, string(b)))
+		case store.TransactionOperationDelete:
+			if op.ETag == "" {
+				conditions = append(conditions, etcdclient.Compare(etcdclient.CreateRevision(key), ">", 0))
+			}
+			ops = append(ops, etcdclient.OpDelete(key))
+		}
+	}
+
+	txn, err := c.client.Txn(ctx).If(conditions...).Then(ops...).Commit()
+	if err != nil {
+		return err
+	}
+
+	if !txn.Succeeded {
+		// The transaction doesn't tell us which condition failed. Report a missing object being deleted
+		// the same way as Delete() does, otherwise it's a concurrency failure.
+		for i, op := range operations {
+			if op.Type != store.TransactionOperationDelete || op.ETag != "" {
+				continue
+			}
+
+			response, err := c.client.Get(ctx, keyFromID(ids[i]), etcdclient.WithCountOnly())
+			if err != nil {
+				return err
+			} else if response.Count == 0 {
+				return &store.ErrNotFound{ID: op.ID}
+			}
+		}
+
+		return &store.ErrConcurrency{}
+	}
+
+	for _, op := range operations {
+		if op.Type == store.TransactionOperationSave {
+			op.Object.ETag = etag.NewFromRevision(txn.Header.Revision)
+		}
+	}
+
+	return nil
+}
+
+// Watch streams changes to the objects matching the query using an etcd watch on the query's key prefix.
+func (c *ETCDClient) Watch(ctx context.Context, query store.Query) (<-chan store.WatchEvent, error) {
+	if ctx == nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if err := store.ValidateWatchQuery(query); err != nil {
+		return nil, err
+	}
+
+	key := keyFromQuery(query)
+
+	// Read the current revision so the watch starts right after it. Otherwise changes made after
+	// we return but before the watch is established on the server could be missed.
+	response, err := c.client.Get(ctx, key, etcdclient.WithPrefix(), etcdclient.WithCountOnly())
+	if err != nil {
+		return nil, err
+	}
+
+	watchCh := c.client.Watch(ctx, key, etcdclient.WithPrefix(), etcdclient.WithPrevKV(), etcdclient.WithRev(response.Header.Revision+1))
+
+	ch := make(chan store.WatchEvent)
+	go func() {
+		defer close(ch)
+		for watchResponse := range watchCh {
+			if watchResponse.Err() != nil {
+				return
+			}
+
+			for _, event := range watchResponse.Events {
+				if !keyMatchesQuery(event.Kv.Key, query) {
+					continue
+				}
+
+				watchEvent, ok, err := watchEventFromETCD(event, query)
+				if err != nil {
+					return
+				} else if !ok {
+					continue
+				}
+
+				select {
+				case ch <- *watchEvent:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// watchEventFromETCD converts an etcd event to a store.WatchEvent. Returns false if the event does not match the
+// query filters.
+func watchEventFromETCD(event *etcdclient.Event, query store.Query) (*store.WatchEvent, bool, error) {
+	watchEvent := store.WatchEvent{}
+	kv := event.Kv
+
+	switch {
+	case event.Type == etcdclient.EventTypeDelete:
+		watchEvent.Type = store.WatchEventDeleted
+
+		// The deleted key has no value, so use the previous value when it's available.
+		if event.PrevKv == nil {
+			id, err := idFromKey(kv.Key)
+			if err != nil {
+				return nil, false, err
+			}
+
+			watchEvent.Object.ID = id.String()
+			watchEvent.Object.ETag = etag.NewFromRevision(kv.ModRevision)
+			return &watchEvent, true, nil
+		}
+
+		kv = event.PrevKv
+	case event.IsCreate():
+		watchEvent.Type = store.WatchEventCreated
+	default:
+		watchEvent.Type = store.WatchEventUpdated
+	}
+
+	err := json.Unmarshal(kv.Value, &watchEvent.Object)
+	if err != nil {
+		return nil, false, err
+	}
+
+	match, err := watchEvent.Object.MatchesFilters(query.Filters)
+	if err != nil {
+		return nil, false, err
+	} else if !match {
+		return nil, false, nil
+	}
+
+	watchEvent.Object.ETag = etag.NewFromRevision(kv.ModRevision)
+	return &watchEvent, true, nil
+}
+
 // Client returns the etcdclient.Client instance stored in the ETCDClient struct.
 func (c *ETCDClient) Client() *etcdclient.Client {
 	return c.client
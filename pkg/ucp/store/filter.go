@@ -17,10 +17,54 @@ limitations under the License.
 package store
 
 import (
+	"fmt"
 	"reflect"
 	"strings"
 )
 
+// GetOperator returns the operator of the filter, or FilterOperatorEquals if none is specified.
+func (f QueryFilter) GetOperator() FilterOperator {
+	if f.Operator == "" {
+		return FilterOperatorEquals
+	}
+
+	return f.Operator
+}
+
+// Validate checks that the filter has a supported operator and that the values are valid for the operator.
+func (f QueryFilter) Validate() error {
+	if f.Field == "" {
+		return &ErrInvalid{Message: "invalid argument. 'filter.Field' is required"}
+	}
+
+	switch f.GetOperator() {
+	case FilterOperatorEquals, FilterOperatorNotEquals, FilterOperatorContains:
+		if _, ok := normalizeValue(f.Value); !ok {
+			return &ErrInvalid{Message: fmt.Sprintf("invalid argument. filter value for field '%s' must be a string, bool, or number", f.Field)}
+		}
+	case FilterOperatorIn:
+		for _, value := range f.Values {
+			if _, ok := normalizeValue(value); !ok {
+				return &ErrInvalid{Message: fmt.Sprintf("invalid argument. filter values for field '%s' must be strings, bools, or numbers", f.Field)}
+			}
+		}
+	case FilterOperatorStartsWith:
+		if _, ok := f.Value.(string); !ok {
+			return &ErrInvalid{Message: fmt.Sprintf("invalid argument. filter value for field '%s' must be a string", f.Field)}
+		}
+	case FilterOperatorGreaterThan, FilterOperatorGreaterThanOrEquals, FilterOperatorLessThan, FilterOperatorLessThanOrEquals:
+		if _, ok := normalizeValue(f.Value); !ok || !isNumber(f.Value) {
+			return &ErrInvalid{Message: fmt.Sprintf("invalid argument. filter value for field '%s' must be a number", f.Field)}
+		}
+	case FilterOperatorExists:
+		// No value required.
+	default:
+		return &ErrInvalid{Message: fmt.Sprintf("invalid argument. filter operator '%s' is not supported", f.Operator)}
+	}
+
+	return nil
+}
+
 // MatchesFilters checks if the object's data matches the given filters and returns a boolean and an error.
 func (o Object) MatchesFilters(filters []QueryFilter) (bool, error) {
 	if len(filters) == 0 {
@@ -28,6 +72,12 @@ func (o Object) MatchesFilters(filters []QueryFilter) (bool, error) {
 		return true, nil
 	}
 
+	for _, filter := range filters {
+		if err := filter.Validate(); err != nil {
+			return false, err
+		}
+	}
+
 	data := o.Data
 	if data == nil {
 		// Treat nil as "empty" data
@@ -44,32 +94,142 @@ func (o Object) MatchesFilters(filters []QueryFilter) (bool, error) {
 	}
 
 	for _, filter := range filters {
-		value := reflect.ValueOf(data)
-		fields := strings.Split(filter.Field, ".")
-		for i, field := range fields {
-			value = value.MapIndex(reflect.ValueOf(field))
-			if i < len(fields)-1 {
-				// Need to go further into the nested fields
-				value = reflect.ValueOf(value.Interface())
+		value, found := lookupField(data, filter.Field)
+		if !filter.matches(value, found) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// matches evaluates the filter against the value of the field. The filter must be valid.
+func (f QueryFilter) matches(value any, found bool) bool {
+	actual, ok := normalizeValue(value)
+	if !found || value == nil {
+		// Only 'ne' can match a missing or null field.
+		return f.GetOperator() == FilterOperatorNotEquals
+	}
+
+	switch f.GetOperator() {
+	case FilterOperatorEquals:
+		expected, _ := normalizeValue(f.Value)
+		return ok && actual == expected
+
+	case FilterOperatorNotEquals:
+		expected, _ := normalizeValue(f.Value)
+		return !ok || actual != expected
+
+	case FilterOperatorIn:
+		if !ok {
+			return false
+		}
+		for _, v := range f.Values {
+			expected, _ := normalizeValue(v)
+			if actual == expected {
+				return true
 			}
 		}
-		comparator := reflect.ValueOf(filter.Value)
+		return false
 
-		if value.Type().Kind() == reflect.Interface {
-			// Unwrap interface{}
-			value = reflect.ValueOf(value.Interface())
+	case FilterOperatorStartsWith:
+		s, isString := actual.(string)
+		return isString && strings.HasPrefix(s, f.Value.(string))
+
+	case FilterOperatorExists:
+		return true
+
+	case FilterOperatorGreaterThan, FilterOperatorGreaterThanOrEquals, FilterOperatorLessThan, FilterOperatorLessThanOrEquals:
+		n, isNumber := actual.(float64)
+		if !isNumber {
+			return false
 		}
+		expected, _ := normalizeValue(f.Value)
+		return compareNumbers(f.GetOperator(), n, expected.(float64))
 
-		if value.Type().Kind() != reflect.String {
-			// not a string, can't compare!
-			return false, nil
+	case FilterOperatorContains:
+		expected, _ := normalizeValue(f.Value)
+		items := reflect.ValueOf(value)
+		if items.Kind() != reflect.Slice && items.Kind() != reflect.Array {
+			return false
 		}
+		for i := 0; i < items.Len(); i++ {
+			item, ok := normalizeValue(items.Index(i).Interface())
+			if ok && item == expected {
+				return true
+			}
+		}
+		return false
+	}
 
-		if value.String() != comparator.String() {
-			// not the same value!
-			return false, nil
+	return false
+}
+
+// lookupField finds the value of a dotted field path in the data. Returns false if the field is not present.
+func lookupField(data any, field string) (any, bool) {
+	value := data
+	for _, segment := range strings.Split(field, ".") {
+		v := reflect.ValueOf(value)
+		if v.Kind() == reflect.Pointer {
+			v = v.Elem()
+		}
+		if v.Kind() != reflect.Map || v.Type().Key().Kind() != reflect.String {
+			return nil, false
 		}
+
+		item := v.MapIndex(reflect.ValueOf(segment).Convert(v.Type().Key()))
+		if !item.IsValid() {
+			return nil, false
+		}
+
+		value = item.Interface()
 	}
 
-	return true, nil
+	return value, true
+}
+
+// normalizeValue converts a scalar value to a comparable form. All numbers are converted to float64 so that
+// values decoded from JSON can be compared with values provided by the caller. Returns false if the value is not
+// a string, bool, or number.
+func normalizeValue(value any) (any, bool) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), true
+	case reflect.Bool:
+		return v.Bool(), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	default:
+		return nil, false
+	}
+}
+
+func isNumber(value any) bool {
+	n, ok := normalizeValue(value)
+	if !ok {
+		return false
+	}
+
+	_, ok = n.(float64)
+	return ok
+}
+
+func compareNumbers(operator FilterOperator, actual float64, expected float64) bool {
+	switch operator {
+	case FilterOperatorGreaterThan:
+		return actual > expected
+	case FilterOperatorGreaterThanOrEquals:
+		return actual >= expected
+	case FilterOperatorLessThan:
+		return actual < expected
+	case FilterOperatorLessThanOrEquals:
+		return actual <= expected
+	default:
+		return false
+	}
 }
@@ -113,6 +113,136 @@ func Test_MatchesFilters(t *testing.T) {
 			Filters:       []QueryFilter{{Field: "properties.value", Value: "warm"}},
 			ExpectedMatch: false,
 		},
+		{
+			Description:   "nested_missing",
+			Obj:           &Object{Data: map[string]any{"properties": map[string]any{"value": "freezing"}}},
+			Filters:       []QueryFilter{{Field: "properties.other.value", Value: "freezing"}},
+			ExpectedMatch: false,
+		},
+
+		// Non-string values
+		{
+			Description:   "bool_match",
+			Obj:           &Object{Data: map[string]any{"enabled": true}},
+			Filters:       []QueryFilter{{Field: "enabled", Value: true}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "bool_not_match_string",
+			Obj:           &Object{Data: map[string]any{"enabled": true}},
+			Filters:       []QueryFilter{{Field: "enabled", Value: "true"}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "number_match_different_types",
+			Obj:           &Object{Data: map[string]any{"replicas": float64(3)}},
+			Filters:       []QueryFilter{{Field: "replicas", Value: 3}},
+			ExpectedMatch: true,
+		},
+
+		// Operators
+		{
+			Description:   "ne_match",
+			Obj:           &Object{Data: map[string]any{"value": "cool"}},
+			Filters:       []QueryFilter{{Field: "value", Operator: FilterOperatorNotEquals, Value: "uncool"}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "ne_match_missing",
+			Obj:           &Object{Data: map[string]any{}},
+			Filters:       []QueryFilter{{Field: "value", Operator: FilterOperatorNotEquals, Value: "uncool"}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "ne_not_match",
+			Obj:           &Object{Data: map[string]any{"value": "cool"}},
+			Filters:       []QueryFilter{{Field: "value", Operator: FilterOperatorNotEquals, Value: "cool"}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "in_match",
+			Obj:           &Object{Data: map[string]any{"value": "cool"}},
+			Filters:       []QueryFilter{{Field: "value", Operator: FilterOperatorIn, Values: []any{"warm", "cool"}}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "in_not_match",
+			Obj:           &Object{Data: map[string]any{"value": "cool"}},
+			Filters:       []QueryFilter{{Field: "value", Operator: FilterOperatorIn, Values: []any{"warm", "hot"}}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "startswith_match",
+			Obj:           &Object{Data: map[string]any{"value": "very-cool"}},
+			Filters:       []QueryFilter{{Field: "value", Operator: FilterOperatorStartsWith, Value: "very-"}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "startswith_not_match",
+			Obj:           &Object{Data: map[string]any{"value": "very-cool"}},
+			Filters:       []QueryFilter{{Field: "value", Operator: FilterOperatorStartsWith, Value: "cool"}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "exists_match",
+			Obj:           &Object{Data: map[string]any{"properties": map[string]any{"value": 3}}},
+			Filters:       []QueryFilter{{Field: "properties.value", Operator: FilterOperatorExists}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "exists_not_match_null",
+			Obj:           &Object{Data: map[string]any{"properties": map[string]any{"value": nil}}},
+			Filters:       []QueryFilter{{Field: "properties.value", Operator: FilterOperatorExists}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "exists_not_match_missing",
+			Obj:           &Object{Data: map[string]any{}},
+			Filters:       []QueryFilter{{Field: "properties.value", Operator: FilterOperatorExists}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "gt_match",
+			Obj:           &Object{Data: map[string]any{"replicas": 5}},
+			Filters:       []QueryFilter{{Field: "replicas", Operator: FilterOperatorGreaterThan, Value: 3}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "ge_match_equal",
+			Obj:           &Object{Data: map[string]any{"replicas": 3}},
+			Filters:       []QueryFilter{{Field: "replicas", Operator: FilterOperatorGreaterThanOrEquals, Value: 3.0}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "lt_not_match",
+			Obj:           &Object{Data: map[string]any{"replicas": 3}},
+			Filters:       []QueryFilter{{Field: "replicas", Operator: FilterOperatorLessThan, Value: 3}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "le_not_match_string",
+			Obj:           &Object{Data: map[string]any{"replicas": "3"}},
+			Filters:       []QueryFilter{{Field: "replicas", Operator: FilterOperatorLessThanOrEquals, Value: 3}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "contains_match",
+			Obj:           &Object{Data: map[string]any{"tags": []any{"a", "b"}}},
+			Filters:       []QueryFilter{{Field: "tags", Operator: FilterOperatorContains, Value: "b"}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "contains_not_match",
+			Obj:           &Object{Data: map[string]any{"tags": []string{"a", "b"}}},
+			Filters:       []QueryFilter{{Field: "tags", Operator: FilterOperatorContains, Value: "c"}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "contains_not_match_not_array",
+			Obj:           &Object{Data: map[string]any{"tags": "b"}},
+			Filters:       []QueryFilter{{Field: "tags", Operator: FilterOperatorContains, Value: "b"}},
+			ExpectedMatch: false,
+		},
 	}
 
 	for _, testcase := range cases {
@@ -123,3 +253,25 @@ func Test_MatchesFilters(t *testing.T) {
 		})
 	}
 }
+
+func Test_MatchesFilters_Invalid(t *testing.T) {
+	cases := []struct {
+		Description string
+		Filter      QueryFilter
+	}{
+		{Description: "unknown_operator", Filter: QueryFilter{Field: "value", Operator: "unknown", Value: "cool"}},
+		{Description: "missing_field", Filter: QueryFilter{Value: "cool"}},
+		{Description: "non_scalar_value", Filter: QueryFilter{Field: "value", Value: []string{"cool"}}},
+		{Description: "startswith_non_string", Filter: QueryFilter{Field: "value", Operator: FilterOperatorStartsWith, Value: 3}},
+		{Description: "gt_non_number", Filter: QueryFilter{Field: "value", Operator: FilterOperatorGreaterThan, Value: "3"}},
+	}
+
+	for _, testcase := range cases {
+		t.Run(testcase.Description, func(t *testing.T) {
+			obj := &Object{Data: map[string]any{"value": "cool"}}
+			match, err := obj.MatchesFilters([]QueryFilter{testcase.Filter})
+			require.ErrorIs(t, err, &ErrInvalid{})
+			require.False(t, match)
+		})
+	}
+}
@@ -0,0 +1,414 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package inmemory provides an in-memory implementation of store.StorageClient for dev and test purposes.
+// Objects are stored using the same key scheme as the etcd store so that queries behave the same way.
+package inmemory
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/store/storeutil"
+	"github.com/radius-project/radius/pkg/ucp/util/etag"
+)
+
+const (
+	// SectionSeparator is the separator used between the sections of the key.
+	SectionSeparator = "|"
+)
+
+var _ store.StorageClient = (*Client)(nil)
+
+// Client is the storage client used for dev and test purposes.
+type Client struct {
+	mu       sync.Mutex
+	objects  map[string]store.Object
+	revision int64
+	watchers map[*watcher]struct{}
+}
+
+// NewClient creates an empty in-memory storage Client.
+func NewClient() *Client {
+	return &Client{
+		objects:  map[string]store.Object{},
+		watchers: map[*watcher]struct{}{},
+	}
+}
+
+// Query returns the objects matching the given query and filters. Objects are returned in key order.
+func (c *Client) Query(ctx context.Context, query store.Query, options ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+	if ctx == nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if query.RootScope == "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RootScope' is required"}
+	}
+	if query.IsScopeQuery && query.RoutingScopePrefix != "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RoutingScopePrefix' is not supported for scope queries"}
+	}
+
+	cfg := store.NewQueryConfig(options...)
+	after, err := storeutil.DecodePaginationToken(cfg.PaginationToken)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	keys := []string{}
+	for key := range c.objects {
+		if key > after {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	results := store.ObjectQueryResult{}
+	for _, key := range keys {
+		stored := c.objects[key]
+		match, err := objectMatchesQuery(&stored, query)
+		if err != nil {
+			return nil, err
+		} else if !match {
+			continue
+		}
+
+		if cfg.MaxQueryItemCount > 0 && len(results.Items) == cfg.MaxQueryItemCount {
+			results.PaginationToken = storeutil.EncodePaginationToken(keyFromObject(&results.Items[len(results.Items)-1]))
+			break
+		}
+
+		obj, err := copyObject(&stored)
+		if err != nil {
+			return nil, err
+		}
+		results.Items = append(results.Items, *obj)
+	}
+
+	return &results, nil
+}
+
+// Get returns the object with the given id, or store.ErrNotFound if it does not exist.
+func (c *Client) Get(ctx context.Context, id string, options ...store.GetOptions) (*store.Object, error) {
+	if ctx == nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	parsed, err := parseNamedID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stored, ok := c.objects[keyFromID(parsed)]
+	if !ok {
+		return nil, &store.ErrNotFound{ID: id}
+	}
+
+	return copyObject(&stored)
+}
+
+// Delete deletes the object with the given id. If an ETag is provided then the object is only deleted if the ETag
+// matches, otherwise store.ErrConcurrency is returned.
+func (c *Client) Delete(ctx context.Context, id string, options ...store.DeleteOptions) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	parsed, err := parseNamedID(id)
+	if err != nil {
+		return err
+	}
+
+	config := store.NewDeleteConfig(options...)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := keyFromID(parsed)
+	stored, ok := c.objects[key]
+	if !ok && config.ETag != "" {
+		return &store.ErrConcurrency{}
+	} else if !ok {
+		return &store.ErrNotFound{ID: id}
+	} else if config.ETag != "" && config.ETag != stored.ETag {
+		return &store.ErrConcurrency{}
+	}
+
+	delete(c.objects, key)
+	c.notify(store.WatchEventDeleted, &stored)
+
+	return nil
+}
+
+// Save creates or updates the object. If an ETag is provided then the object is only updated if the ETag matches,
+// otherwise store.ErrConcurrency is returned.
+func (c *Client) Save(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if obj == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'obj' is required"}
+	}
+
+	parsed, err := resources.Parse(obj.ID)
+	if err != nil {
+		return err
+	}
+
+	config := store.NewSaveConfig(options...)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := keyFromID(parsed)
+	existing, ok := c.objects[key]
+	if config.ETag != "" && (!ok || config.ETag != existing.ETag) {
+		return &store.ErrConcurrency{}
+	}
+
+	// Store a copy so that the caller can't modify the stored data.
+	copied, err := copyObject(obj)
+	if err != nil {
+		return err
+	}
+
+	c.revision++
+	copied.ETag = etag.NewFromRevision(c.revision)
+	obj.ETag = copied.ETag
+	c.objects[key] = *copied
+
+	if ok {
+		c.notify(store.WatchEventUpdated, copied)
+	} else {
+		c.notify(store.WatchEventCreated, copied)
+	}
+
+	return nil
+}
+
+// Transaction applies the operations atomically. All preconditions are checked before any changes are made.
+func (c *Client) Transaction(ctx context.Context, operations []store.TransactionOperation) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	ids, err := store.ValidateTransaction(operations)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	copies := make([]*store.Object, len(operations))
+	for i, op := range operations {
+		stored, ok := c.objects[keyFromID(ids[i])]
+		if op.ETag != "" && (!ok || op.ETag != stored.ETag) {
+			return &store.ErrConcurrency{}
+		} else if op.Type == store.TransactionOperationDelete && !ok {
+			return &store.ErrNotFound{ID: op.ID}
+		}
+
+		if op.Type == store.TransactionOperationSave {
+			copies[i], err = copyObject(op.Object)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	c.revision++
+	for i, op := range operations {
+		key := keyFromID(ids[i])
+		stored, ok := c.objects[key]
+
+		switch op.Type {
+		case store.TransactionOperationSave:
+			copies[i].ETag = etag.NewFromRevision(c.revision)
+			op.Object.ETag = copies[i].ETag
+			c.objects[key] = *copies[i]
+
+			if ok {
+				c.notify(store.WatchEventUpdated, copies[i])
+			} else {
+				c.notify(store.WatchEventCreated, copies[i])
+			}
+		case store.TransactionOperationDelete:
+			delete(c.objects, key)
+			c.notify(store.WatchEventDeleted, &stored)
+		}
+	}
+
+	return nil
+}
+
+// Watch streams changes to the objects matching the query.
+func (c *Client) Watch(ctx context.Context, query store.Query) (<-chan store.WatchEvent, error) {
+	if ctx == nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if err := store.ValidateWatchQuery(query); err != nil {
+		return nil, err
+	}
+
+	w := &watcher{query: query, signal: make(chan struct{}, 1)}
+
+	c.mu.Lock()
+	c.watchers[w] = struct{}{}
+	c.mu.Unlock()
+
+	ch := make(chan store.WatchEvent)
+	go func() {
+		defer close(ch)
+		defer func() {
+			c.mu.Lock()
+			delete(c.watchers, w)
+			c.mu.Unlock()
+		}()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-w.signal:
+			}
+
+			for _, event := range w.drain() {
+				select {
+				case ch <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// notify reports a change to the watchers with a matching query. The caller must hold the lock.
+func (c *Client) notify(eventType store.WatchEventType, obj *store.Object) {
+	for w := range c.watchers {
+		match, err := objectMatchesQuery(obj, w.query)
+		if err != nil || !match {
+			continue
+		}
+
+		// Each watcher gets its own copy so that consumers can't modify the stored data.
+		copied, err := copyObject(obj)
+		if err != nil {
+			continue
+		}
+
+		w.push(store.WatchEvent{Type: eventType, Object: *copied})
+	}
+}
+
+// watcher buffers the events for a single call to Watch so that writes never block on slow consumers.
+type watcher struct {
+	query  store.Query
+	signal chan struct{}
+
+	mu      sync.Mutex
+	pending []store.WatchEvent
+}
+
+func (w *watcher) push(event store.WatchEvent) {
+	w.mu.Lock()
+	w.pending = append(w.pending, event)
+	w.mu.Unlock()
+
+	select {
+	case w.signal <- struct{}{}:
+	default:
+	}
+}
+
+func (w *watcher) drain() []store.WatchEvent {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	events := w.pending
+	w.pending = nil
+	return events
+}
+
+func objectMatchesQuery(obj *store.Object, query store.Query) (bool, error) {
+	id, err := resources.Parse(obj.ID)
+	if err != nil {
+		return false, nil
+	}
+
+	if !storeutil.IDMatchesQuery(id, query) {
+		return false, nil
+	}
+
+	return obj.MatchesFilters(query.Filters)
+}
+
+// copyObject makes a deep copy of the object by round-tripping the data through JSON. This also matches the
+// behavior of the other stores, which return data decoded from JSON.
+func copyObject(obj *store.Object) (*store.Object, error) {
+	copied := store.Object{Metadata: obj.Metadata}
+	if obj.Data == nil {
+		return &copied, nil
+	}
+
+	b, err := json.Marshal(obj.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(b, &copied.Data); err != nil {
+		return nil, err
+	}
+
+	return &copied, nil
+}
+
+func parseNamedID(id string) (resources.ID, error) {
+	parsed, err := resources.Parse(id)
+	if err != nil {
+		return resources.ID{}, &store.ErrInvalid{Message: "invalid argument. 'id' must be a valid resource id"}
+	}
+	if parsed.IsEmpty() {
+		return resources.ID{}, &store.ErrInvalid{Message: "invalid argument. 'id' must not be empty"}
+	}
+	if parsed.IsResourceCollection() || parsed.IsScopeCollection() {
+		return resources.ID{}, &store.ErrInvalid{Message: "invalid argument. 'id' must refer to a named resource, not a collection"}
+	}
+
+	return parsed, nil
+}
+
+// keyFromID returns the key to use for an ID.
+func keyFromID(id resources.ID) string {
+	prefix, rootScope, routingScope, _ := storeutil.ExtractStorageParts(id)
+	return strings.Join([]string{prefix, rootScope, routingScope}, SectionSeparator)
+}
+
+func keyFromObject(obj *store.Object) string {
+	// IDs are validated when objects are saved.
+	id, _ := resources.Parse(obj.ID)
+	return keyFromID(id)
+}
@@ -64,10 +64,12 @@ type (
 
 // Store Config represents the configurations of storageclient APIs.
 type StoreConfig struct {
-	// PaginationToken represents pagination token such as continuation token.
+	// PaginationToken represents pagination token such as continuation token. The token is opaque and is
+	// returned by a previous call to Query() in ObjectQueryResult.PaginationToken.
 	PaginationToken string
 
-	// MaxQueryItemCount represents max items in query result.
+	// MaxQueryItemCount represents max items in query result. When zero, all matching items are returned as
+	// a single page.
 	MaxQueryItemCount int
 
 	// ETag represents the entity tag for optimistic consistency control.
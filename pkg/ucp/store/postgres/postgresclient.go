@@ -0,0 +1,562 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package postgres stores resources in a PostgreSQL database. Each resource or scope is stored as a row
+// in a single table. The storage parts of the resource id (prefix, root scope, routing scope and resource type)
+// are stored in their own indexed columns so that queries can be executed entirely by the database.
+//
+// The key of a row uses the same scheme as the etcd store:
+//
+//	scope|/planes/radius/local/|/resourcegroups/cool-group/
+//	resource|/planes/radius/local/resourcegroups/cool-group/|/applications.core/applications/cool-app/
+//
+// ETags are generated from a database sequence that is incremented on every write. This gives us
+// optimistic concurrency using a simple conditional UPDATE or DELETE.
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/store/storeutil"
+	"github.com/radius-project/radius/pkg/ucp/util/etag"
+)
+
+const (
+	// SectionSeparator is the separator used between the sections of the row key.
+	SectionSeparator = "|"
+
+	// TableName is the name of the table used to store resources.
+	TableName = "resources"
+
+	// NotifyChannel is the name of the channel used to publish changes to the resources table.
+	NotifyChannel = "resources_changes"
+)
+
+// schema is the set of statements used to initialize the database. Each statement is idempotent.
+var schema = []string{
+	`CREATE SEQUENCE IF NOT EXISTS resources_revision_seq`,
+	`CREATE TABLE IF NOT EXISTS resources (
+		id TEXT PRIMARY KEY NOT NULL,
+		original_id TEXT NOT NULL,
+		prefix TEXT NOT NULL,
+		root_scope TEXT NOT NULL,
+		routing_scope TEXT NOT NULL,
+		resource_type TEXT NOT NULL,
+		api_version TEXT NOT NULL DEFAULT '',
+		content_type TEXT NOT NULL DEFAULT '',
+		revision BIGINT NOT NULL,
+		data JSONB
+	)`,
+	`CREATE INDEX IF NOT EXISTS resources_root_scope_idx ON resources (prefix, root_scope text_pattern_ops)`,
+	`CREATE INDEX IF NOT EXISTS resources_routing_scope_idx ON resources (routing_scope text_pattern_ops)`,
+	`CREATE INDEX IF NOT EXISTS resources_resource_type_idx ON resources (resource_type)`,
+
+	// Changes are published using NOTIFY so they can be watched. The payload only contains the key of the row since
+	// notifications are limited in size.
+	`CREATE OR REPLACE FUNCTION resources_notify() RETURNS trigger AS $$
+	DECLARE
+		r resources;
+	BEGIN
+		IF TG_OP = 'DELETE' THEN
+			r := OLD;
+		ELSE
+			r := NEW;
+		END IF;
+		PERFORM pg_notify('` + NotifyChannel + `', json_build_object('op', TG_OP, 'id', r.id, 'originalId', r.original_id, 'revision', r.revision)::text);
+		RETURN NULL;
+	END;
+	$$ LANGUAGE plpgsql`,
+	`DO $$
+	BEGIN
+		IF NOT EXISTS (SELECT 1 FROM pg_trigger WHERE tgname = 'resources_notify_trigger') THEN
+			CREATE TRIGGER resources_notify_trigger AFTER INSERT OR UPDATE OR DELETE ON resources
+				FOR EACH ROW EXECUTE FUNCTION resources_notify();
+		END IF;
+	END
+	$$`,
+}
+
+var _ store.StorageClient = (*PostgresClient)(nil)
+
+// PostgresClient implements store.StorageClient using a PostgreSQL database.
+type PostgresClient struct {
+	db  *sql.DB
+	url string
+}
+
+// NewPostgresClient creates a new PostgresClient instance with the given database handle. The connection url is
+// used to open dedicated connections for Watch since notifications can't be received through the database handle.
+func NewPostgresClient(db *sql.DB, url string) *PostgresClient {
+	return &PostgresClient{db: db, url: url}
+}
+
+// Init creates the table, sequence and indexes used by the client if they do not already exist.
+func (c *PostgresClient) Init(ctx context.Context) error {
+	for _, stmt := range schema {
+		if _, err := c.db.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("failed to initialize database schema: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// DB returns the database handle used by the client.
+func (c *PostgresClient) DB() *sql.DB {
+	return c.db
+}
+
+// Query executes the given query against the database, including the filters, and returns the matching objects.
+func (c *PostgresClient) Query(ctx context.Context, query store.Query, options ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+	if ctx == nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if query.RootScope == "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RootScope' is required"}
+	}
+	if query.IsScopeQuery && query.RoutingScopePrefix != "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RoutingScopePrefix' is not supported for scope queries"}
+	}
+
+	cfg := store.NewQueryConfig(options...)
+	after, err := storeutil.DecodePaginationToken(cfg.PaginationToken)
+	if err != nil {
+		return nil, err
+	}
+
+	stmt, args, err := buildQuery(query, after, cfg.MaxQueryItemCount)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := c.db.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	results := store.ObjectQueryResult{}
+	lastKey := ""
+	for rows.Next() {
+		// We fetch one more row than the page size to find out if there's another page.
+		if cfg.MaxQueryItemCount > 0 && len(results.Items) == cfg.MaxQueryItemCount {
+			results.PaginationToken = storeutil.EncodePaginationToken(lastKey)
+			break
+		}
+
+		obj, key, err := scanObject(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		results.Items = append(results.Items, *obj)
+		lastKey = key
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &results, nil
+}
+
+// Get retrieves the object with the given id from the database, or returns store.ErrNotFound if it does not exist.
+func (c *PostgresClient) Get(ctx context.Context, id string, options ...store.GetOptions) (*store.Object, error) {
+	if ctx == nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	parsed, err := parseNamedID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	row := c.db.QueryRowContext(ctx, "SELECT "+selectColumns+" FROM "+TableName+" WHERE id = $1", keyFromID(parsed))
+	obj, _, err := scanObject(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, &store.ErrNotFound{ID: id}
+	} else if err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+// Delete deletes the object with the given id from the database. If an ETag is provided then the object is only deleted
+// if the ETag matches, otherwise store.ErrConcurrency is returned.
+func (c *PostgresClient) Delete(ctx context.Context, id string, options ...store.DeleteOptions) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	parsed, err := parseNamedID(id)
+	if err != nil {
+		return err
+	}
+
+	config := store.NewDeleteConfig(options...)
+	return deleteObject(ctx, c.db, id, parsed, config.ETag)
+}
+
+// Save saves the object to the database and sets the object's ETag. If an ETag is provided then the object is only
+// updated if the ETag matches, otherwise store.ErrConcurrency is returned.
+func (c *PostgresClient) Save(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if obj == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'obj' is required"}
+	}
+
+	parsed, err := resources.Parse(obj.Metadata.ID)
+	if err != nil {
+		return err
+	}
+
+	config := store.NewSaveConfig(options...)
+	revision, err := saveObject(ctx, c.db, obj, parsed, config.ETag)
+	if err != nil {
+		return err
+	}
+
+	obj.ETag = etag.NewFromRevision(revision)
+	return nil
+}
+
+// Transaction applies the operations atomically using a database transaction.
+func (c *PostgresClient) Transaction(ctx context.Context, operations []store.TransactionOperation) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	ids, err := store.ValidateTransaction(operations)
+	if err != nil {
+		return err
+	}
+
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// This is a no-op if the transaction was committed.
+		_ = tx.Rollback()
+	}()
+
+	revisions := make([]int64, len(operations))
+	for i, op := range operations {
+		switch op.Type {
+		case store.TransactionOperationSave:
+			revisions[i], err = saveObject(ctx, tx, op.Object, ids[i], op.ETag)
+		case store.TransactionOperationDelete:
+			err = deleteObject(ctx, tx, op.ID, ids[i], op.ETag)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	// Only update the ETags once the transaction has been committed.
+	for i, op := range operations {
+		if op.Type == store.TransactionOperationSave {
+			op.Object.ETag = etag.NewFromRevision(revisions[i])
+		}
+	}
+
+	return nil
+}
+
+// queryer is implemented by both *sql.DB and *sql.Tx so that writes can be shared between single operations
+// and transactions.
+type queryer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// saveObject writes the object and returns its new revision. If etag is set then the object is only updated if the
+// revision matches.
+func saveObject(ctx context.Context, q queryer, obj *store.Object, id resources.ID, etagValue string) (int64, error) {
+	data, err := json.Marshal(obj.Data)
+	if err != nil {
+		return 0, err
+	}
+
+	prefix, rootScope, routingScope, resourceType := storeutil.ExtractStorageParts(id)
+	key := keyFromID(id)
+
+	var revision int64
+	if etagValue != "" {
+		expected, err := etag.ParseRevision(etagValue)
+		if err != nil {
+			// Treat an invalid ETag as a concurrency failure, since it will never match.
+			return 0, &store.ErrConcurrency{}
+		}
+
+		stmt := "UPDATE " + TableName + ` SET original_id = $2, api_version = $3, content_type = $4, data = $5,
+			revision = nextval('resources_revision_seq')
+			WHERE id = $1 AND revision = $6
+			RETURNING revision`
+		err = q.QueryRowContext(ctx, stmt, key, obj.ID, obj.APIVersion, obj.ContentType, string(data), expected).Scan(&revision)
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, &store.ErrConcurrency{}
+		} else if err != nil {
+			return 0, err
+		}
+
+		return revision, nil
+	}
+
+	stmt := "INSERT INTO " + TableName + ` (id, original_id, prefix, root_scope, routing_scope, resource_type, api_version, content_type, data, revision)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, nextval('resources_revision_seq'))
+		ON CONFLICT (id) DO UPDATE SET original_id = EXCLUDED.original_id, api_version = EXCLUDED.api_version,
+			content_type = EXCLUDED.content_type, data = EXCLUDED.data, revision = EXCLUDED.revision
+		RETURNING revision`
+	err = q.QueryRowContext(ctx, stmt, key, obj.ID, prefix, rootScope, routingScope, resourceType, obj.APIVersion, obj.ContentType, string(data)).Scan(&revision)
+	if err != nil {
+		return 0, err
+	}
+
+	return revision, nil
+}
+
+// deleteObject deletes the object. If etag is set then the object is only deleted if the revision matches.
+func deleteObject(ctx context.Context, q queryer, id string, parsed resources.ID, etagValue string) error {
+	key := keyFromID(parsed)
+
+	if etagValue != "" {
+		revision, err := etag.ParseRevision(etagValue)
+		if err != nil {
+			// Treat an invalid ETag as a concurrency failure, since it will never match.
+			return &store.ErrConcurrency{}
+		}
+
+		result, err := q.ExecContext(ctx, "DELETE FROM "+TableName+" WHERE id = $1 AND revision = $2", key, revision)
+		if err != nil {
+			return err
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+
+		if affected == 0 {
+			return &store.ErrConcurrency{}
+		}
+
+		return nil
+	}
+
+	result, err := q.ExecContext(ctx, "DELETE FROM "+TableName+" WHERE id = $1", key)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return &store.ErrNotFound{ID: id}
+	}
+
+	return nil
+}
+
+// selectColumns is the list of columns read by scanObject.
+const selectColumns = "id, original_id, api_version, content_type, revision, data"
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanObject(row scanner) (*store.Object, string, error) {
+	var key string
+	var revision int64
+	var data []byte
+	obj := &store.Object{}
+
+	err := row.Scan(&key, &obj.ID, &obj.APIVersion, &obj.ContentType, &revision, &data)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &obj.Data); err != nil {
+			return nil, "", err
+		}
+	}
+
+	obj.ETag = etag.NewFromRevision(revision)
+	return obj, key, nil
+}
+
+func parseNamedID(id string) (resources.ID, error) {
+	parsed, err := resources.Parse(id)
+	if err != nil {
+		return resources.ID{}, &store.ErrInvalid{Message: "invalid argument. 'id' must be a valid resource id"}
+	}
+	if parsed.IsEmpty() {
+		return resources.ID{}, &store.ErrInvalid{Message: "invalid argument. 'id' must not be empty"}
+	}
+	if parsed.IsResourceCollection() || parsed.IsScopeCollection() {
+		return resources.ID{}, &store.ErrInvalid{Message: "invalid argument. 'id' must refer to a named resource, not a collection"}
+	}
+
+	return parsed, nil
+}
+
+// keyFromID returns the primary key to use for an ID.
+func keyFromID(id resources.ID) string {
+	prefix, rootScope, routingScope, _ := storeutil.ExtractStorageParts(id)
+	return prefix + SectionSeparator + rootScope + SectionSeparator + routingScope
+}
+
+// buildQuery returns the SQL statement and arguments used to execute the query. Results are ordered by key so that
+// pages can be resumed after the last key of the previous page. When pageSize is positive, one more row than the page
+// size is requested so the caller can tell whether there is another page.
+func buildQuery(query store.Query, after string, pageSize int) (string, []any, error) {
+	prefix := storeutil.ResourcePrefix
+	if query.IsScopeQuery {
+		prefix = storeutil.ScopePrefix
+	}
+
+	args := []any{prefix}
+	where := []string{"prefix = $1"}
+
+	addArg := func(value any) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if query.ScopeRecursive {
+		where = append(where, "root_scope LIKE "+addArg(escapeLike(storeutil.NormalizePart(query.RootScope))+"%"))
+	} else {
+		where = append(where, "root_scope = "+addArg(storeutil.NormalizePart(query.RootScope)))
+	}
+
+	if query.RoutingScopePrefix != "" {
+		where = append(where, "routing_scope LIKE "+addArg(escapeLike(storeutil.NormalizePart(query.RoutingScopePrefix))+"%"))
+	}
+
+	if query.ResourceType != "" {
+		where = append(where, "resource_type = "+addArg(strings.ToLower(query.ResourceType)))
+	}
+
+	for _, filter := range query.Filters {
+		if err := filter.Validate(); err != nil {
+			return "", nil, err
+		}
+
+		condition, err := buildFilter(filter, addArg)
+		if err != nil {
+			return "", nil, err
+		}
+
+		where = append(where, condition)
+	}
+
+	if after != "" {
+		where = append(where, "id > "+addArg(after))
+	}
+
+	stmt := "SELECT " + selectColumns + " FROM " + TableName + " WHERE " + strings.Join(where, " AND ") + " ORDER BY id"
+	if pageSize > 0 {
+		stmt += " LIMIT " + addArg(pageSize+1)
+	}
+
+	return stmt, args, nil
+}
+
+// buildFilter returns the SQL condition for a single filter. The semantics match store.Object.MatchesFilters. Values
+// are compared as JSONB so that values of different types never match.
+func buildFilter(filter store.QueryFilter, addArg func(any) string) (string, error) {
+	field := fmt.Sprintf("data #> %s::text[]", addArg(pq.Array(strings.Split(filter.Field, "."))))
+
+	jsonArg := func(value any) (string, error) {
+		b, err := json.Marshal(value)
+		if err != nil {
+			return "", err
+		}
+		return addArg(string(b)) + "::jsonb", nil
+	}
+
+	switch filter.GetOperator() {
+	case store.FilterOperatorEquals, store.FilterOperatorNotEquals, store.FilterOperatorContains:
+		value, err := jsonArg(filter.Value)
+		if err != nil {
+			return "", err
+		}
+
+		switch filter.GetOperator() {
+		case store.FilterOperatorNotEquals:
+			return fmt.Sprintf("(%[1]s IS NULL OR jsonb_typeof(%[1]s) = 'null' OR %[1]s <> %[2]s)", field, value), nil
+		case store.FilterOperatorContains:
+			return fmt.Sprintf("(jsonb_typeof(%[1]s) = 'array' AND %[1]s @> jsonb_build_array(%[2]s))", field, value), nil
+		default:
+			return fmt.Sprintf("%s = %s", field, value), nil
+		}
+
+	case store.FilterOperatorIn:
+		values := filter.Values
+		if values == nil {
+			values = []any{}
+		}
+		value, err := jsonArg(values)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("(jsonb_typeof(%[1]s) IN ('string', 'number', 'boolean') AND %[2]s @> jsonb_build_array(%[1]s))", field, value), nil
+
+	case store.FilterOperatorStartsWith:
+		value := addArg(escapeLike(filter.Value.(string)) + "%")
+		return fmt.Sprintf("(jsonb_typeof(%[1]s) = 'string' AND %[1]s #>> '{}' LIKE %[2]s)", field, value), nil
+
+	case store.FilterOperatorExists:
+		return fmt.Sprintf("(%[1]s IS NOT NULL AND jsonb_typeof(%[1]s) <> 'null')", field), nil
+
+	case store.FilterOperatorGreaterThan, store.FilterOperatorGreaterThanOrEquals, store.FilterOperatorLessThan, store.FilterOperatorLessThanOrEquals:
+		operators := map[store.FilterOperator]string{
+			store.FilterOperatorGreaterThan:         ">",
+			store.FilterOperatorGreaterThanOrEquals: ">=",
+			store.FilterOperatorLessThan:            "<",
+			store.FilterOperatorLessThanOrEquals:    "<=",
+		}
+		value, err := jsonArg(filter.Value)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("(jsonb_typeof(%[1]s) = 'number' AND %[1]s %[2]s %[3]s)", field, operators[filter.GetOperator()], value), nil
+	}
+
+	return "", &store.ErrInvalid{Message: fmt.Sprintf("invalid argument. filter operator '%s' is not supported", filter.Operator)}
+}
+
+// escapeLike escapes the special characters of a LIKE pattern so the value is matched literally.
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
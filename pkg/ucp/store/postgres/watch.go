@@ -0,0 +1,149 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/store/storeutil"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	"github.com/radius-project/radius/pkg/ucp/util/etag"
+)
+
+const (
+	minReconnectInterval = 10 * time.Second
+	maxReconnectInterval = time.Minute
+)
+
+// notification is the payload of a notification published by the resources_notify trigger.
+type notification struct {
+	Op         string `json:"op"`
+	ID         string `json:"id"`
+	OriginalID string `json:"originalId"`
+	Revision   int64  `json:"revision"`
+}
+
+// Watch streams changes to the objects matching the query using LISTEN/NOTIFY. Each call to Watch opens a dedicated
+// connection to the database.
+//
+// Notifications only contain the key of the changed row, so the current value is read when a notification is received.
+// Filters can't be evaluated for deleted objects, so deletes of objects matching the rest of the query are always reported.
+func (c *PostgresClient) Watch(ctx context.Context, query store.Query) (<-chan store.WatchEvent, error) {
+	if ctx == nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if err := store.ValidateWatchQuery(query); err != nil {
+		return nil, err
+	}
+	if c.url == "" {
+		return nil, errors.New("watch requires the connection url of the database")
+	}
+
+	listener := pq.NewListener(c.url, minReconnectInterval, maxReconnectInterval, nil)
+	if err := listener.Listen(NotifyChannel); err != nil {
+		_ = listener.Close()
+		return nil, err
+	}
+
+	ch := make(chan store.WatchEvent)
+	go func() {
+		defer close(ch)
+		defer listener.Close()
+
+		logger := ucplog.FromContextOrDiscard(ctx)
+		for {
+			var n *pq.Notification
+			select {
+			case <-ctx.Done():
+				return
+			case n = <-listener.Notify:
+			}
+
+			// A nil notification is sent after the connection is re-established. Notifications may have been
+			// lost while disconnected, so the watch can't continue.
+			if n == nil {
+				return
+			}
+
+			event, ok, err := c.watchEventFromNotification(ctx, n, query)
+			if err != nil {
+				logger.Error(err, "failed to process notification", "payload", n.Extra)
+				return
+			} else if !ok {
+				continue
+			}
+
+			select {
+			case ch <- *event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// watchEventFromNotification converts a notification to a store.WatchEvent. Returns false if the object does not match
+// the query.
+func (c *PostgresClient) watchEventFromNotification(ctx context.Context, n *pq.Notification, query store.Query) (*store.WatchEvent, bool, error) {
+	payload := notification{}
+	if err := json.Unmarshal([]byte(n.Extra), &payload); err != nil {
+		return nil, false, err
+	}
+
+	id, err := resources.Parse(payload.OriginalID)
+	if err != nil || !storeutil.IDMatchesQuery(id, query) {
+		return nil, false, nil
+	}
+
+	if payload.Op == "DELETE" {
+		event := store.WatchEvent{Type: store.WatchEventDeleted}
+		event.Object.ID = payload.OriginalID
+		event.Object.ETag = etag.NewFromRevision(payload.Revision)
+		return &event, true, nil
+	}
+
+	row := c.db.QueryRowContext(ctx, "SELECT "+selectColumns+" FROM "+TableName+" WHERE id = $1 AND revision = $2", payload.ID, payload.Revision)
+	obj, _, err := scanObject(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		// The row has changed again since the notification was sent, the later notification will report it.
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+
+	match, err := obj.MatchesFilters(query.Filters)
+	if err != nil || !match {
+		return nil, false, err
+	}
+
+	event := store.WatchEvent{Type: store.WatchEventUpdated, Object: *obj}
+	if payload.Op == "INSERT" {
+		event.Type = store.WatchEventCreated
+	}
+
+	return &event, true, nil
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storeutil
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_PaginationToken_RoundTrip(t *testing.T) {
+	key := "resource|/planes/radius/local/resourcegroups/cool-group/|/applications.core/applications/cool-app/"
+
+	token := EncodePaginationToken(key)
+	require.NotEmpty(t, token)
+	require.NotContains(t, token, "/")
+
+	decoded, err := DecodePaginationToken(token)
+	require.NoError(t, err)
+	require.Equal(t, key, decoded)
+}
+
+func Test_PaginationToken_Empty(t *testing.T) {
+	require.Empty(t, EncodePaginationToken(""))
+
+	decoded, err := DecodePaginationToken("")
+	require.NoError(t, err)
+	require.Empty(t, decoded)
+}
+
+func Test_DecodePaginationToken_Invalid(t *testing.T) {
+	_, err := DecodePaginationToken("not a valid token!")
+	require.ErrorIs(t, err, &store.ErrInvalid{})
+}
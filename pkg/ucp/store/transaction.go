@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// TransactionOperationType represents the type of an operation in a transaction.
+type TransactionOperationType string
+
+const (
+	// TransactionOperationSave saves an object.
+	TransactionOperationSave TransactionOperationType = "Save"
+
+	// TransactionOperationDelete deletes an object.
+	TransactionOperationDelete TransactionOperationType = "Delete"
+)
+
+// TransactionOperation represents a single save or delete in a transaction. Use TransactionSave or TransactionDelete
+// to create an operation.
+type TransactionOperation struct {
+	// Type is the type of the operation.
+	Type TransactionOperationType
+
+	// Object is the object to save. Only set for TransactionOperationSave.
+	Object *Object
+
+	// ID is the id of the object to delete. Only set for TransactionOperationDelete.
+	ID string
+
+	// ETag is the ETag precondition of the operation. An empty ETag means the operation is unconditional.
+	ETag string
+}
+
+// TransactionSave creates an operation that saves the object as part of a transaction.
+func TransactionSave(obj *Object, options ...SaveOptions) TransactionOperation {
+	cfg := NewSaveConfig(options...)
+	return TransactionOperation{Type: TransactionOperationSave, Object: obj, ETag: cfg.ETag}
+}
+
+// TransactionDelete creates an operation that deletes the object with the given id as part of a transaction.
+func TransactionDelete(id string, options ...DeleteOptions) TransactionOperation {
+	cfg := NewDeleteConfig(options...)
+	return TransactionOperation{Type: TransactionOperationDelete, ID: id, ETag: cfg.ETag}
+}
+
+// GetID returns the id of the object the operation applies to.
+func (op TransactionOperation) GetID() string {
+	if op.Type == TransactionOperationSave && op.Object != nil {
+		return op.Object.ID
+	}
+
+	return op.ID
+}
+
+// ValidateTransaction validates the operations passed to Transaction(). Returns the parsed id of each operation.
+func ValidateTransaction(operations []TransactionOperation) ([]resources.ID, error) {
+	if len(operations) == 0 {
+		return nil, &ErrInvalid{Message: "invalid argument. 'operations' must not be empty"}
+	}
+
+	ids := []resources.ID{}
+	seen := map[string]bool{}
+	for i, op := range operations {
+		switch op.Type {
+		case TransactionOperationSave:
+			if op.Object == nil {
+				return nil, &ErrInvalid{Message: fmt.Sprintf("invalid argument. 'operations[%d].Object' is required", i)}
+			}
+		case TransactionOperationDelete:
+		default:
+			return nil, &ErrInvalid{Message: fmt.Sprintf("invalid argument. 'operations[%d].Type' is not supported", i)}
+		}
+
+		id, err := resources.Parse(op.GetID())
+		if err != nil || id.IsEmpty() || id.IsResourceCollection() || id.IsScopeCollection() {
+			return nil, &ErrInvalid{Message: fmt.Sprintf("invalid argument. 'operations[%d]' must refer to a named resource", i)}
+		}
+
+		// Applying multiple operations to the same object in a transaction is ambiguous.
+		key := strings.ToLower(id.String())
+		if seen[key] {
+			return nil, &ErrInvalid{Message: fmt.Sprintf("invalid argument. 'operations[%d]' refers to the same object as a previous operation", i)}
+		}
+		seen[key] = true
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+// WatchEventType represents the type of change reported by Watch().
+type WatchEventType string
+
+const (
+	// WatchEventCreated is reported when an object is created.
+	WatchEventCreated WatchEventType = "Created"
+
+	// WatchEventUpdated is reported when an existing object is updated.
+	WatchEventUpdated WatchEventType = "Updated"
+
+	// WatchEventDeleted is reported when an object is deleted.
+	WatchEventDeleted WatchEventType = "Deleted"
+)
+
+// WatchEvent represents a change to an object in the data-store.
+type WatchEvent struct {
+	// Type is the type of change.
+	Type WatchEventType
+
+	// Object is the object after the change. For WatchEventDeleted, Object is the last known state of the object
+	// if the data-store provides it, otherwise only the ID is set. The ETag is set in all cases.
+	Object Object
+}
+
+// ValidateWatchQuery validates the query passed to Watch(). Watch accepts the same queries as Query().
+func ValidateWatchQuery(query Query) error {
+	if query.RootScope == "" {
+		return &ErrInvalid{Message: "invalid argument. 'query.RootScope' is required"}
+	}
+	if query.IsScopeQuery && query.RoutingScopePrefix != "" {
+		return &ErrInvalid{Message: "invalid argument. 'query.RoutingScopePrefix' is not supported for scope queries"}
+	}
+	for _, filter := range query.Filters {
+		if err := filter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
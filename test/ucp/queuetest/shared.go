@@ -137,6 +137,36 @@ func RunTest(t *testing.T, cli client.Client, clear func(t *testing.T)) {
 		require.Equal(t, msg1.ID, msg3.ID)
 	})
 
+	t.Run("delayed message is not dequeued until it is visible", func(t *testing.T) {
+		clear(t)
+
+		msg := &testQueueMessage{ID: "delayed", Message: "hello world"}
+		err := cli.Enqueue(ctx, client.NewMessage(msg), client.WithDelay(time.Second))
+		require.NoError(t, err)
+
+		_, err = cli.Dequeue(ctx, client.QueueClientConfig{})
+		require.ErrorIs(t, err, client.ErrMessageNotFound)
+
+		// Dequeue until the message is visible.
+		var delayed *client.Message
+		for {
+			delayed, err = cli.Dequeue(ctx, client.QueueClientConfig{})
+			if err == nil {
+				break
+			}
+			time.Sleep(pollingInterval)
+		}
+
+		result := &testQueueMessage{}
+		err = json.Unmarshal(delayed.Data, result)
+		require.NoError(t, err)
+		require.Equal(t, "delayed", result.ID)
+		require.Equal(t, 1, delayed.DequeueCount)
+
+		err = cli.FinishMessage(ctx, delayed)
+		require.NoError(t, err)
+	})
+
 	t.Run("extend valid message lock", func(t *testing.T) {
 		clear(t)
 
@@ -204,6 +234,70 @@ func RunTest(t *testing.T, cli client.Client, clear func(t *testing.T)) {
 		require.ErrorIs(t, err, client.ErrInvalidMessage)
 	})
 
+	t.Run("dead-lettered message is not dequeued", func(t *testing.T) {
+		clear(t)
+
+		err := queueTestMessage(cli, 1)
+		require.NoError(t, err)
+
+		msg, err := cli.Dequeue(ctx, client.QueueClientConfig{})
+		require.NoError(t, err)
+
+		err = cli.DeadLetterMessage(ctx, msg, "processing failed")
+		require.NoError(t, err)
+
+		_, err = cli.Dequeue(ctx, client.QueueClientConfig{})
+		require.ErrorIs(t, err, client.ErrMessageNotFound)
+
+		deadLetters, err := cli.ListDeadLetterMessages(ctx)
+		require.NoError(t, err)
+		require.Len(t, deadLetters, 1)
+		require.Equal(t, msg.ID, deadLetters[0].ID)
+		require.Equal(t, "processing failed", deadLetters[0].LastError)
+		require.False(t, deadLetters[0].DeadLetteredAt.IsZero())
+
+		found, err := cli.GetDeadLetterMessage(ctx, msg.ID)
+		require.NoError(t, err)
+		require.Equal(t, msg.Data, found.Data)
+	})
+
+	t.Run("requeue and purge dead-lettered message", func(t *testing.T) {
+		clear(t)
+
+		err := queueTestMessage(cli, 1)
+		require.NoError(t, err)
+
+		msg, err := cli.Dequeue(ctx, client.QueueClientConfig{})
+		require.NoError(t, err)
+		err = cli.DeadLetterMessage(ctx, msg, "processing failed")
+		require.NoError(t, err)
+
+		err = cli.RequeueDeadLetterMessage(ctx, msg.ID)
+		require.NoError(t, err)
+
+		deadLetters, err := cli.ListDeadLetterMessages(ctx)
+		require.NoError(t, err)
+		require.Empty(t, deadLetters)
+
+		requeued, err := cli.Dequeue(ctx, client.QueueClientConfig{})
+		require.NoError(t, err)
+		require.Equal(t, msg.Data, requeued.Data)
+		require.Equal(t, 1, requeued.DequeueCount)
+		require.False(t, requeued.RequeuedAt.IsZero())
+
+		err = cli.DeadLetterMessage(ctx, requeued, "processing failed again")
+		require.NoError(t, err)
+
+		err = cli.PurgeDeadLetterMessage(ctx, requeued.ID)
+		require.NoError(t, err)
+
+		_, err = cli.GetDeadLetterMessage(ctx, requeued.ID)
+		require.ErrorIs(t, err, client.ErrDeadLetterMessageNotFound)
+
+		err = cli.RequeueDeadLetterMessage(ctx, requeued.ID)
+		require.ErrorIs(t, err, client.ErrDeadLetterMessageNotFound)
+	})
+
 	t.Run("StartDequeuer dequeues message via channel", func(t *testing.T) {
 		clear(t)
 		msgCh, err := client.StartDequeuer(ctx, cli, client.WithDequeueInterval(defaultTestDequeueInterval))
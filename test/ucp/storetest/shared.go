@@ -18,8 +18,11 @@ limitations under the License.
 package storetest
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/store"
@@ -588,4 +591,308 @@ func RunTest(t *testing.T, client store.StorageClient, clear func(t *testing.T))
 			CompareObjectLists(t, expected, objs.Items)
 		})
 	})
+
+	t.Run("query_paginated", func(t *testing.T) {
+		clear(t)
+
+		expected := []store.Object{}
+		for i := 0; i < 5; i++ {
+			id := parseOrPanic(fmt.Sprintf("%s/providers/%s/paged%d", ResourceGroup1Scope, ResourceType1, i))
+			obj := createObject(id, Data1)
+			err := client.Save(ctx, &obj)
+			require.NoError(t, err)
+
+			expected = append(expected, obj)
+		}
+
+		// Add a resource that is filtered out to make sure paging works with filters.
+		obj2 := createObject(Resource2ID, Data2)
+		err := client.Save(ctx, &obj2)
+		require.NoError(t, err)
+
+		actual := []store.Object{}
+		token := ""
+		for pages := 0; ; pages++ {
+			require.Less(t, pages, len(expected)+1, "too many pages returned")
+
+			result, err := client.Query(ctx, store.Query{RootScope: RadiusScope, ScopeRecursive: true, ResourceType: ResourceType1},
+				store.WithMaxQueryItemCount(2), store.WithPaginationToken(token))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(result.Items), 2)
+
+			actual = append(actual, result.Items...)
+			token = result.PaginationToken
+			if token == "" {
+				break
+			}
+		}
+
+		CompareObjectLists(t, expected, actual)
+	})
+
+	t.Run("query_with_filter_operators", func(t *testing.T) {
+		clear(t)
+
+		obj1 := createObject(Resource1ID, map[string]any{
+			"name": "cool-resource",
+			"properties": map[string]any{
+				"enabled":  true,
+				"replicas": float64(3),
+				"tags":     []any{"a", "b"},
+			},
+		})
+		err := client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		obj2 := createObject(Resource2ID, map[string]any{
+			"name": "other-resource",
+			"properties": map[string]any{
+				"enabled":  false,
+				"replicas": float64(5),
+			},
+		})
+		err = client.Save(ctx, &obj2)
+		require.NoError(t, err)
+
+		cases := []struct {
+			name     string
+			filter   store.QueryFilter
+			expected []store.Object
+		}{
+			{"eq_bool", store.QueryFilter{Field: "properties.enabled", Value: true}, []store.Object{obj1}},
+			{"eq_number", store.QueryFilter{Field: "properties.replicas", Value: 5}, []store.Object{obj2}},
+			{"ne", store.QueryFilter{Field: "name", Operator: store.FilterOperatorNotEquals, Value: "cool-resource"}, []store.Object{obj2}},
+			{"in", store.QueryFilter{Field: "name", Operator: store.FilterOperatorIn, Values: []any{"cool-resource", "other-resource"}}, []store.Object{obj1, obj2}},
+			{"starts_with", store.QueryFilter{Field: "name", Operator: store.FilterOperatorStartsWith, Value: "cool-"}, []store.Object{obj1}},
+			{"exists", store.QueryFilter{Field: "properties.tags", Operator: store.FilterOperatorExists}, []store.Object{obj1}},
+			{"gt", store.QueryFilter{Field: "properties.replicas", Operator: store.FilterOperatorGreaterThan, Value: 3}, []store.Object{obj2}},
+			{"le", store.QueryFilter{Field: "properties.replicas", Operator: store.FilterOperatorLessThanOrEquals, Value: 5}, []store.Object{obj1, obj2}},
+			{"contains", store.QueryFilter{Field: "properties.tags", Operator: store.FilterOperatorContains, Value: "b"}, []store.Object{obj1}},
+
+			// String comparisons are case-sensitive for all operators.
+			{"eq_string_case_sensitive", store.QueryFilter{Field: "name", Value: "COOL-RESOURCE"}, []store.Object{}},
+			{"ne_string_case_sensitive", store.QueryFilter{Field: "name", Operator: store.FilterOperatorNotEquals, Value: "COOL-RESOURCE"}, []store.Object{obj1, obj2}},
+			{"in_string_case_sensitive", store.QueryFilter{Field: "name", Operator: store.FilterOperatorIn, Values: []any{"COOL-RESOURCE"}}, []store.Object{}},
+			{"starts_with_case_sensitive", store.QueryFilter{Field: "name", Operator: store.FilterOperatorStartsWith, Value: "COOL-"}, []store.Object{}},
+			{"contains_string_case_sensitive", store.QueryFilter{Field: "properties.tags", Operator: store.FilterOperatorContains, Value: "B"}, []store.Object{}},
+		}
+
+		for _, tc := range cases {
+			t.Run(tc.name, func(t *testing.T) {
+				objs, err := client.Query(ctx, store.Query{RootScope: RadiusScope, ScopeRecursive: true, Filters: []store.QueryFilter{tc.filter}})
+				require.NoError(t, err)
+				CompareObjectLists(t, tc.expected, objs.Items)
+			})
+		}
+	})
+
+	t.Run("transaction_save_multiple", func(t *testing.T) {
+		clear(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		obj2 := createObject(Resource2ID, Data2)
+		err := client.Transaction(ctx, []store.TransactionOperation{store.TransactionSave(&obj1), store.TransactionSave(&obj2)})
+		require.NoError(t, err)
+		require.NotEmpty(t, obj1.ETag)
+		require.NotEmpty(t, obj2.ETag)
+
+		obj1Get, err := client.Get(ctx, Resource1ID.String())
+		require.NoError(t, err)
+		compareObjects(t, &obj1, obj1Get)
+		require.Equal(t, obj1.ETag, obj1Get.ETag)
+
+		obj2Get, err := client.Get(ctx, Resource2ID.String())
+		require.NoError(t, err)
+		compareObjects(t, &obj2, obj2Get)
+		require.Equal(t, obj2.ETag, obj2Get.ETag)
+	})
+
+	t.Run("transaction_save_and_delete_with_matching_etags", func(t *testing.T) {
+		clear(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err := client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		obj2 := createObject(Resource2ID, Data1)
+		err = client.Save(ctx, &obj2)
+		require.NoError(t, err)
+
+		obj2.Data = Data2
+		err = client.Transaction(ctx, []store.TransactionOperation{
+			store.TransactionDelete(Resource1ID.String(), store.WithETag(obj1.ETag)),
+			store.TransactionSave(&obj2, store.WithETag(obj2.ETag)),
+		})
+		require.NoError(t, err)
+
+		_, err = client.Get(ctx, Resource1ID.String())
+		require.ErrorIs(t, err, &store.ErrNotFound{ID: Resource1ID.String()})
+
+		obj2Get, err := client.Get(ctx, Resource2ID.String())
+		require.NoError(t, err)
+		compareObjects(t, &obj2, obj2Get)
+	})
+
+	t.Run("transaction_not_matching_etag_applies_nothing", func(t *testing.T) {
+		clear(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err := client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		obj2 := createObject(Resource2ID, Data2)
+		update := createObject(Resource1ID, Data2)
+		err = client.Transaction(ctx, []store.TransactionOperation{
+			store.TransactionSave(&obj2),
+			store.TransactionSave(&update, store.WithETag(etag.New(MarshalOrPanic(Data2)))),
+		})
+		require.ErrorIs(t, err, &store.ErrConcurrency{})
+
+		obj1Get, err := client.Get(ctx, Resource1ID.String())
+		require.NoError(t, err)
+		compareObjects(t, &obj1, obj1Get)
+
+		_, err = client.Get(ctx, Resource2ID.String())
+		require.ErrorIs(t, err, &store.ErrNotFound{ID: Resource2ID.String()})
+	})
+
+	t.Run("transaction_delete_not_found_applies_nothing", func(t *testing.T) {
+		clear(t)
+
+		obj2 := createObject(Resource2ID, Data2)
+		err := client.Transaction(ctx, []store.TransactionOperation{
+			store.TransactionSave(&obj2),
+			store.TransactionDelete(Resource1ID.String()),
+		})
+		require.ErrorIs(t, err, &store.ErrNotFound{ID: Resource1ID.String()})
+
+		_, err = client.Get(ctx, Resource2ID.String())
+		require.ErrorIs(t, err, &store.ErrNotFound{ID: Resource2ID.String()})
+	})
+
+	t.Run("transaction_invalid", func(t *testing.T) {
+		clear(t)
+
+		err := client.Transaction(ctx, []store.TransactionOperation{})
+		require.ErrorIs(t, err, &store.ErrInvalid{})
+
+		obj1 := createObject(Resource1ID, Data1)
+		err = client.Transaction(ctx, []store.TransactionOperation{
+			store.TransactionSave(&obj1),
+			store.TransactionDelete(Resource1ID.String()),
+		})
+		require.ErrorIs(t, err, &store.ErrInvalid{})
+	})
+}
+
+// RunWatchTest tests the StorageClient's Watch method by saving and deleting objects and checking the events that
+// are reported. Data-stores that support Watch should call this in addition to RunTest.
+func RunWatchTest(t *testing.T, client store.StorageClient, clear func(t *testing.T)) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	t.Run("watch_reports_changes", func(t *testing.T) {
+		clear(t)
+
+		watchCtx, watchCancel := context.WithCancel(ctx)
+		defer watchCancel()
+
+		ch, err := client.Watch(watchCtx, store.Query{RootScope: ResourceGroup1Scope})
+		require.NoError(t, err)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err = client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		event := nextWatchEvent(t, ch)
+		require.Equal(t, store.WatchEventCreated, event.Type)
+		compareObjects(t, &obj1, &event.Object)
+		require.Equal(t, obj1.ETag, event.Object.ETag)
+
+		obj1.Data = Data2
+		err = client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		event = nextWatchEvent(t, ch)
+		require.Equal(t, store.WatchEventUpdated, event.Type)
+		compareObjects(t, &obj1, &event.Object)
+		require.Equal(t, obj1.ETag, event.Object.ETag)
+
+		err = client.Delete(ctx, Resource1ID.String())
+		require.NoError(t, err)
+
+		event = nextWatchEvent(t, ch)
+		require.Equal(t, store.WatchEventDeleted, event.Type)
+		require.Equal(t, obj1.ID, event.Object.ID)
+	})
+
+	t.Run("watch_ignores_non_matching_changes", func(t *testing.T) {
+		clear(t)
+
+		watchCtx, watchCancel := context.WithCancel(ctx)
+		defer watchCancel()
+
+		query := store.Query{
+			RootScope:      RadiusScope,
+			ScopeRecursive: true,
+			Filters:        []store.QueryFilter{{Field: "value", Value: "1"}},
+		}
+		ch, err := client.Watch(watchCtx, query)
+		require.NoError(t, err)
+
+		// Does not match the filter.
+		obj2 := createObject(Resource2ID, Data2)
+		err = client.Save(ctx, &obj2)
+		require.NoError(t, err)
+
+		// Not a resource.
+		group1 := createObject(ResourceGroup1ID, ResourceGroup1Data)
+		err = client.Save(ctx, &group1)
+		require.NoError(t, err)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err = client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		event := nextWatchEvent(t, ch)
+		require.Equal(t, store.WatchEventCreated, event.Type)
+		compareObjects(t, &obj1, &event.Object)
+	})
+
+	t.Run("watch_closes_channel_on_cancel", func(t *testing.T) {
+		clear(t)
+
+		watchCtx, watchCancel := context.WithCancel(ctx)
+		ch, err := client.Watch(watchCtx, store.Query{RootScope: ResourceGroup1Scope})
+		require.NoError(t, err)
+
+		watchCancel()
+
+		require.Eventually(t, func() bool {
+			select {
+			case _, ok := <-ch:
+				return !ok
+			default:
+				return false
+			}
+		}, 10*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("watch_invalid_query", func(t *testing.T) {
+		_, err := client.Watch(ctx, store.Query{})
+		require.ErrorIs(t, err, &store.ErrInvalid{})
+	})
+}
+
+func nextWatchEvent(t *testing.T, ch <-chan store.WatchEvent) store.WatchEvent {
+	t.Helper()
+
+	select {
+	case event, ok := <-ch:
+		require.True(t, ok, "watch channel was closed unexpectedly")
+		return event
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "timed out waiting for watch event")
+		return store.WatchEvent{}
+	}
 }